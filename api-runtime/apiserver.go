@@ -7,15 +7,22 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"os/signal"
 	"runtime"
 	"sync"
+	"syscall"
+	"time"
 
 	cr "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 	restruntime "github.com/cloud-barista/cb-spider/api-runtime/rest-runtime"
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
 	"github.com/spf13/cobra"
 )
 
@@ -25,6 +32,9 @@ var (
 	BuildTime string // Populated by ldflags
 )
 
+// the running requests are waited for this time when CB-Spider stops
+const shutdownTimeout = 30 * time.Second
+
 func main() {
 	// Use multi-core CPUs
 	runtime.GOMAXPROCS(runtime.NumCPU())
@@ -39,7 +49,7 @@ func NewRootCmd() *cobra.Command {
 	rootCmd := &cobra.Command{
 		Use:   "cb-spider",
 		Short: "CB-Spider API Server for managing multi-cloud infrastructure",
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get the flags
 			useTLS, _ := cmd.Flags().GetBool("tls")
 			certPath, _ := cmd.Flags().GetString("cert")
@@ -49,11 +59,37 @@ func NewRootCmd() *cobra.Command {
 
 			if versionFlag, _ := cmd.Flags().GetBool("version"); versionFlag {
 				printVersion()
-				return
+				return nil
 			}
 
 			restruntime.SetVersionInfo(Version)
 
+			if err := cim.InitSpiderKey(); err != nil {
+				return err
+			}
+
+			// only one server uses the MetaDB, and the jobs of a previous server are cleaned up under the lock
+			releaseServerLock, err := infostore.AcquireServerLock()
+			if err != nil {
+				return err
+			}
+			defer releaseServerLock()
+			cr.StartJobManager()
+
+			// stop gracefully on SIGINT or SIGTERM
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+			defer signal.Stop(sigCh)
+			go func() {
+				sig := <-sigCh
+				fmt.Printf("[CB-Spider] %v is received, shutting down...\n", sig)
+				ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+				defer cancel()
+				if err := restruntime.ShutdownServers(ctx); err != nil {
+					fmt.Printf("[CB-Spider] failed to stop the servers gracefully: %v\n", err)
+				}
+			}()
+
 			// WaitGroup to manage both servers
 			wg := new(sync.WaitGroup)
 
@@ -81,6 +117,15 @@ func NewRootCmd() *cobra.Command {
 			}
 
 			wg.Wait() // Wait for both servers to finish
+
+			// the servers are stopped, no more calls to the drivers and the MetaDB
+			ccm.ShutdownCloudDrivers()
+			releaseServerLock()
+			if err := infostore.Shutdown(); err != nil {
+				fmt.Printf("[CB-Spider] failed to close the MetaDB: %v\n", err)
+			}
+			fmt.Println("[CB-Spider] stopped.")
+			return nil
 		},
 	}
 
@@ -97,6 +142,8 @@ func NewRootCmd() *cobra.Command {
 
 	// Add subcommands
 	rootCmd.AddCommand(NewInfoCmd())
+	rootCmd.AddCommand(NewMigrateMetaDBCmd())
+	rootCmd.AddCommand(NewRotateKeyCmd())
 
 	return rootCmd
 }
@@ -127,6 +174,120 @@ func NewInfoCmd() *cobra.Command {
 	return infoCmd
 }
 
+// NewMigrateMetaDBCmd copies an existing SQLite MetaDB into the MetaDB configured by META_DB_TYPE and META_DB_DSN.
+// The migration creates the tables of the Info types registered by infostore.AutoMigrate in the target MetaDB.
+func NewMigrateMetaDBCmd() *cobra.Command {
+	migrateCmd := &cobra.Command{
+		Use:   "migrate-metadb",
+		Short: "Copy an existing SQLite MetaDB into the configured MetaDB backend",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer infostore.Shutdown()
+
+			srcPath, _ := cmd.Flags().GetString("source")
+
+			if infostore.DB_TYPE == infostore.SQLITE {
+				return fmt.Errorf("the target MetaDB is SQLite, set META_DB_TYPE and META_DB_DSN for the target")
+			}
+
+			srcDB, err := infostore.OpenSQLite(srcPath)
+			if err != nil {
+				return fmt.Errorf("failed to open the source MetaDB(%s): %v", srcPath, err)
+			}
+			defer infostore.Close(srcDB)
+
+			dstDB, err := infostore.Open()
+			if err != nil {
+				return fmt.Errorf("failed to open the target MetaDB(%s): %v", infostore.DB_TYPE, err)
+			}
+			defer infostore.Close(dstDB)
+
+			fmt.Printf("Migrating MetaDB: %s => %s\n", srcPath, infostore.DB_TYPE)
+			copiedList, err := infostore.CopyTables(srcDB, dstDB)
+			if err != nil {
+				return fmt.Errorf("failed to migrate the MetaDB, nothing is copied: %v", err)
+			}
+			for _, copied := range copiedList {
+				if copied.Skipped != "" {
+					fmt.Printf("  %-30s skipped (%s)\n", copied.TableName, copied.Skipped)
+				} else {
+					fmt.Printf("  %-30s %d rows\n", copied.TableName, copied.RowCount)
+				}
+			}
+			return nil
+		},
+	}
+
+	migrateCmd.Flags().String("source", infostore.DB_FILE_PATH, "SQLite MetaDB file to copy")
+
+	return migrateCmd
+}
+
+// NewRotateKeyCmd re-encrypts all credentials and local private keys with a new SPIDER_KEY.
+// The current key is loaded from SPIDER_KEY_FILE or SPIDER_KEY as usual.
+// It refuses to rotate while a CB-Spider server is running on the MetaDB.
+func NewRotateKeyCmd() *cobra.Command {
+	rotateCmd := &cobra.Command{
+		Use:   "rotate-key",
+		Short: "Re-encrypt all credentials and local private keys with a new SPIDER_KEY",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer infostore.Shutdown()
+
+			newKeyFile, _ := cmd.Flags().GetString("new-key-file")
+			generate, _ := cmd.Flags().GetBool("generate")
+
+			if newKeyFile == "" {
+				return fmt.Errorf("--new-key-file is required")
+			}
+
+			if generate {
+				if _, err := os.Stat(newKeyFile); err == nil {
+					return fmt.Errorf("%s already exists, remove it or do not use --generate", newKeyFile)
+				}
+				strKey, err := cim.GenerateSpiderKey()
+				if err != nil {
+					return err
+				}
+				if err := os.WriteFile(newKeyFile, []byte(strKey+"\n"), 0600); err != nil {
+					return err
+				}
+				fmt.Printf("Generated a new key: %s\n", newKeyFile)
+			}
+
+			newKey, err := cim.ReadSpiderKeyFile(newKeyFile)
+			if err != nil {
+				return err
+			}
+
+			if err := cim.InitSpiderKey(); err != nil {
+				return err
+			}
+
+			// a running server keeps the old key, so the rotation takes the server lock
+			releaseServerLock, err := infostore.AcquireServerLock()
+			if err != nil {
+				return fmt.Errorf("stop the CB-Spider server before rotating the key: %v", err)
+			}
+			defer releaseServerLock()
+
+			rotated, err := cim.RotateKey(newKey)
+			if err != nil {
+				return fmt.Errorf("failed to rotate the key, nothing is changed: %v", err)
+			}
+
+			fmt.Printf("Rotated SPIDER_KEY: %s => %s\n", rotated.OldKeyID, rotated.NewKeyID)
+			fmt.Printf("  credentials:         %d\n", rotated.CredentialCount)
+			fmt.Printf("  local private keys:  %d\n", rotated.LocalKeyCount)
+			fmt.Printf("Set SPIDER_KEY_FILE=%s before restarting CB-Spider.\n", newKeyFile)
+			return nil
+		},
+	}
+
+	rotateCmd.Flags().String("new-key-file", "", "file which has the new key(32 characters or the base64 encoding of 32 bytes)")
+	rotateCmd.Flags().Bool("generate", false, "generate a new random key into --new-key-file")
+
+	return rotateCmd
+}
+
 // Print the version information
 func printVersion() {
 	fmt.Printf("Version:    %s\n", Version)
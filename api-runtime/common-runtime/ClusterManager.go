@@ -9,6 +9,7 @@
 package commonruntime
 
 import (
+	"context"
 	_ "errors"
 	"fmt"
 	"os"
@@ -44,8 +45,8 @@ func init() {
 		cblog.Error(err)
 		return
 	}
-	db.AutoMigrate(&ClusterIIDInfo{})
-	db.AutoMigrate(&NodeGroupIIDInfo{})
+	infostore.AutoMigrate(db, &ClusterIIDInfo{})
+	infostore.AutoMigrate(db, &NodeGroupIIDInfo{})
 	infostore.Close(db)
 }
 
@@ -322,6 +323,7 @@ func RegisterCluster(connectionName string, vpcUserID string, userIID cres.IID)
 
 func registerNodeGroupList(connectionName string, info *cres.ClusterInfo) error {
 	// insert NodeGroup's spiderIIDs to metadb and setup NodeGroup IID for return info
+	var insertInfoList []interface{}
 	for count, ngInfo := range info.NodeGroupList {
 		// generate NodeGroup's UserID
 		ngUserId := info.IId.NameId + "-nodegroup-" + strconv.Itoa(count)
@@ -330,14 +332,17 @@ func registerNodeGroupList(connectionName string, info *cres.ClusterInfo) error
 		// Do not user NameId, because Azure driver use it like SystemId
 		systemId := getMSShortID(ngInfo.IId.SystemId)
 		ngSpiderIId := cres.IID{NameId: ngUserId, SystemId: systemId + ":" + ngInfo.IId.SystemId}
-		err := infostore.Insert(&NodeGroupIIDInfo{ConnectionName: connectionName, NameId: ngSpiderIId.NameId, SystemId: ngSpiderIId.SystemId,
+		insertInfoList = append(insertInfoList, &NodeGroupIIDInfo{ConnectionName: connectionName, NameId: ngSpiderIId.NameId, SystemId: ngSpiderIId.SystemId,
 			OwnerClusterName: info.IId.NameId})
-		if err != nil {
-			cblog.Error(err)
-			return err
-		}
 	} // end of for _, info
 
+	// all or nothing
+	err := infostore.InsertAll(insertInfoList...)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
 	return nil
 }
 
@@ -349,6 +354,11 @@ func registerNodeGroupList(connectionName string, info *cres.ClusterInfo) error
 // (6) create userIID
 // (7) set used Resources's userIID
 func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInfo, IDTransformMode string) (*cres.ClusterInfo, error) {
+	return CreateClusterWithContext(context.Background(), connectionName, rsType, reqInfo, IDTransformMode)
+}
+
+// CreateClusterWithContext is CreateCluster with a ctx to cancel the request.
+func CreateClusterWithContext(ctx context.Context, connectionName string, rsType string, reqInfo cres.ClusterInfo, IDTransformMode string) (*cres.ClusterInfo, error) {
 	cblog.Info("call CreateCluster()")
 
 	// check empty and trim user inputs
@@ -513,11 +523,12 @@ func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInf
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateClusterHandler()
+	clusterHandler, err := cldConn.CreateClusterHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
+	handler := cres.ToClusterHandlerWithContext(clusterHandler)
 
 	clusterSPLock.Lock(connectionName, reqInfo.IId.NameId)
 	defer clusterSPLock.Unlock(connectionName, reqInfo.IId.NameId)
@@ -611,7 +622,7 @@ func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInf
 	reqInfo.NodeGroupList = ngInfoList
 
 	// (3) create Resource
-	info, err := handler.CreateCluster(reqInfo)
+	info, err := handler.CreateClusterWithContext(ctx, reqInfo)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -621,23 +632,10 @@ func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInf
 	//     ex) spiderIID {"seoul-service", "vm-01-9m4e2mr0ui3e8a215n4g:i-0bc7123b7e5cbf79d"}
 	spiderIId := cres.IID{NameId: reqIId.NameId, SystemId: spUUID + ":" + info.IId.SystemId}
 
-	// (5) insert spiderIID
+	// (5) insert spiderIID with the spiderIIDs of NodeGroup list in a transaction
 	iidInfo := ClusterIIDInfo{ConnectionName: connectionName, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId,
 		OwnerVPCName: vpcIIDInfo.NameId}
-	err = infostore.Insert(&iidInfo)
-	if err != nil {
-		cblog.Error(err)
-		// rollback
-		_, err2 := handler.DeleteCluster(info.IId)
-		if err2 != nil {
-			cblog.Error(err2)
-			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
-		}
-		cblog.Error(err)
-		return nil, err
-	}
-
-	// insert spiderIID for NodeGroup list
+	insertInfoList := []interface{}{&iidInfo}
 	for _, ngInfo := range info.NodeGroupList {
 		// key-value structure: ~/{NGGROUP}/{ConnectionName}/{Cluster-NameId}/{NodeGroup-reqNameId}
 		// 			[NodeGroup-driverNameId:nodegroup-driverSystemId]  # Cluster NameId => rsType
@@ -647,37 +645,21 @@ func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInf
 			continue
 		}
 		ngSpiderIId := cres.IID{NameId: ngReqNameId, SystemId: ngInfo.IId.NameId + ":" + ngInfo.IId.SystemId}
-		err := infostore.Insert(&NodeGroupIIDInfo{ConnectionName: connectionName, NameId: ngSpiderIId.NameId, SystemId: ngSpiderIId.SystemId,
+		insertInfoList = append(insertInfoList, &NodeGroupIIDInfo{ConnectionName: connectionName, NameId: ngSpiderIId.NameId, SystemId: ngSpiderIId.SystemId,
 			OwnerClusterName: reqIId.NameId})
-		if err != nil {
-			cblog.Error(err)
-			// rollback
-			// (1) for resource
-			cblog.Info("<<ROLLBACK:TRY:CLUSTER-CSP>> " + info.IId.SystemId)
-			_, err2 := handler.DeleteCluster(info.IId)
-			if err2 != nil {
-				cblog.Error(err2)
-				return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
-			}
-			// (2) for Cluster IID
-			cblog.Info("<<ROLLBACK:TRY:CLUSTER-IID>> " + info.IId.NameId)
-			_, err3 := infostore.DeleteByConditions(&ClusterIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, iidInfo.NameId)
-			if err3 != nil {
-				cblog.Error(err3)
-				return nil, fmt.Errorf(err.Error() + ", " + err3.Error())
-			}
-			// (3) for NodeGroup IID List
-			// delete all nodegroups of target Cluster
-			_, err := infostore.DeleteByConditions(&NodeGroupIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName,
-				OWNER_CLUSTER_NAME_COLUMN, iidInfo.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-
-			cblog.Error(err)
-			return nil, err
+	}
+	err = infostore.InsertAll(insertInfoList...)
+	if err != nil {
+		cblog.Error(err)
+		// rollback: no IID was inserted, so only the resource is deleted.
+		cblog.Info("<<ROLLBACK:TRY:CLUSTER-CSP>> " + info.IId.SystemId)
+		_, err2 := handler.DeleteCluster(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
 		}
+		cblog.Error(err)
+		return nil, err
 	}
 
 	// (6) create userIID: {reqNameID, driverSystemID}
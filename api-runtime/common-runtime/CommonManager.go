@@ -9,6 +9,7 @@
 package commonruntime
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -20,6 +21,7 @@ import (
 	cblogger "github.com/cloud-barista/cb-log"
 	splock "github.com/cloud-barista/cb-spider/api-runtime/common-runtime/sp-lock"
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	driverplugin "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/driver-plugin"
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
@@ -45,6 +47,13 @@ const (
 	CLUSTER    string = string(cres.CLUSTER)
 	NODEGROUP  string = string(cres.NODEGROUP)
 	FILESYSTEM string = string(cres.FILESYSTEM)
+	VNIC       string = string(cres.VNIC)
+	PUBLICIP   string = string(cres.PUBLICIP)
+	VPCPEERING string = string(cres.VPCPEERING)
+	ROUTETABLE string = string(cres.ROUTETABLE)
+	NATGATEWAY string = string(cres.NATGATEWAY)
+
+	DISKSNAPSHOT string = string(cres.DISKSNAPSHOT)
 )
 
 func RSTypeString(rsType string) string {
@@ -61,6 +70,12 @@ var diskSPLock = splock.New()
 var myImageSPLock = splock.New()
 var clusterSPLock = splock.New()
 var fsSPLock = splock.New()
+var vNicSPLock = splock.New()
+var publicIPSPLock = splock.New()
+var vpcPeeringSPLock = splock.New()
+var routeTableSPLock = splock.New()
+var natGatewaySPLock = splock.New()
+var diskSnapshotSPLock = splock.New()
 
 // ====================================================================
 // Common column name and struct for GORM
@@ -202,6 +217,11 @@ func getAWSNLBShortID(inID string) string {
 }
 
 func checkNotFoundError(err error) bool {
+	// an error of a driver plugin has its error code
+	if driverplugin.IsNotFoundError(err) {
+		return true
+	}
+
 	msg := err.Error()
 	msg = strings.ReplaceAll(msg, " ", "")
 	msg = strings.ToLower(msg)
@@ -723,13 +743,15 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 	case VM:
 		handler, err = cldConn.CreateVMHandler()
 	case NLB:
-		handler, err = cldConn.CreateNLBHandler()
+		handler, err = createNLBHandler(connectionName, cldConn)
 	case DISK:
 		handler, err = cldConn.CreateDiskHandler()
 	case MYIMAGE:
 		handler, err = cldConn.CreateMyImageHandler()
 	case CLUSTER:
 		handler, err = cldConn.CreateClusterHandler()
+	case DISKSNAPSHOT:
+		handler, err = cldConn.CreateDiskSnapshotHandler()
 	default:
 		return AllResourceList{}, fmt.Errorf(rsType + " is not supported Resource!!")
 	}
@@ -830,6 +852,17 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 			iid := makeUserIID(info.NameId, info.SystemId)
 			iidList = append(iidList, &iid)
 		}
+	case DISKSNAPSHOT:
+		var iidInfoList []*DiskSnapshotIIDInfo
+		err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+		if err != nil {
+			cblog.Error(err)
+			return AllResourceList{}, err
+		}
+		for _, info := range iidInfoList {
+			iid := makeUserIID(info.NameId, info.SystemId)
+			iidList = append(iidList, &iid)
+		}
 
 	default:
 		return AllResourceList{}, fmt.Errorf(rsType + " is not supported Resource!!")
@@ -933,11 +966,37 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 				iidCSPList = append(iidCSPList, &info.IId)
 			}
 		}
+	case DISKSNAPSHOT:
+		infoList, err := handler.(cres.DiskSnapshotHandler).ListDiskSnapshot()
+		if err != nil {
+			cblog.Error(err)
+			return AllResourceList{}, err
+		}
+		if infoList != nil {
+			for _, info := range infoList {
+				iidCSPList = append(iidCSPList, &info.IId)
+			}
+		}
 
 	default:
 		return AllResourceList{}, fmt.Errorf(rsType + " is not supported Resource!!")
 	}
 
+	// the proxy VMs and KeyPairs of the emulated NLBs are not the user's resources
+	emulatedNLBResources, err := getEmulatedNLBResources(connectionName, rsType)
+	if err != nil {
+		return AllResourceList{}, err
+	}
+	if len(emulatedNLBResources) > 0 {
+		userCSPList := []*cres.IID{}
+		for _, iid := range iidCSPList {
+			if _, ok := emulatedNLBResources[iid.SystemId]; !ok {
+				userCSPList = append(userCSPList, iid)
+			}
+		}
+		iidCSPList = userCSPList
+	}
+
 	if iidCSPList == nil || len(iidCSPList) <= 0 {
 		// if iidCSPList is empty, iidInfoList is empty => all list is empty <-------------- (1)
 		if iidList == nil || len(iidList) <= 0 {
@@ -1034,7 +1093,7 @@ func ListAllResourceInfo(connectionName string, rsType cres.RSType) (AllResource
 	case cres.VM:
 		handler, err = cldConn.CreateVMHandler()
 	case cres.NLB:
-		handler, err = cldConn.CreateNLBHandler()
+		handler, err = createNLBHandler(connectionName, cldConn)
 	case cres.DISK:
 		handler, err = cldConn.CreateDiskHandler()
 	case cres.MYIMAGE:
@@ -1062,6 +1121,21 @@ func ListAllResourceInfo(connectionName string, rsType cres.RSType) (AllResource
 		return AllResourceInfoList{}, err
 	}
 
+	// the proxy VMs and KeyPairs of the emulated NLBs are not the user's resources
+	emulatedNLBResources, err := getEmulatedNLBResources(connectionName, string(rsType))
+	if err != nil {
+		return AllResourceInfoList{}, err
+	}
+	for systemId := range emulatedNLBResources {
+		userInfoList := []interface{}{}
+		for _, info := range infoList {
+			if !resourceInfoMatchesIID(info, systemId) {
+				userInfoList = append(userInfoList, info)
+			}
+		}
+		infoList = userInfoList
+	}
+
 	if len(iidList) == 0 && len(infoList) == 0 {
 		return allResInfoList, nil
 	}
@@ -1282,6 +1356,11 @@ func DeleteCSPResource(connectionName string, rsType string, systemID string) (b
 		return false, "", err
 	}
 
+	// the proxy VMs and KeyPairs of the emulated NLBs are deleted with their NLBs
+	if err := checkNotEmulatedNLBResource(connectionName, rsType, systemID); err != nil {
+		return false, "", err
+	}
+
 	var cldConn icon.CloudConnection
 	zoneId := ""
 	switch rsType {
@@ -1332,6 +1411,14 @@ func DeleteCSPResource(connectionName string, rsType string, systemID string) (b
 
 		cldConn, err = ccm.GetZoneLevelCloudConnection(connectionName, zoneId)
 
+	case DISKSNAPSHOT: // Zone-Level Control Resource, in the Zone of the source Disk
+		zoneId, err = findDiskSnapshotZoneId(connectionName, systemID)
+		if err != nil {
+			cblog.Error(err)
+			return false, "", err
+		}
+		cldConn, err = ccm.GetZoneLevelCloudConnection(connectionName, zoneId)
+
 	default:
 		cldConn, err = ccm.GetCloudConnection(connectionName)
 	}
@@ -1352,13 +1439,15 @@ func DeleteCSPResource(connectionName string, rsType string, systemID string) (b
 	case VM:
 		handler, err = cldConn.CreateVMHandler()
 	case NLB:
-		handler, err = cldConn.CreateNLBHandler()
+		handler, err = createNLBHandler(connectionName, cldConn)
 	case DISK:
 		handler, err = cldConn.CreateDiskHandler()
 	case MYIMAGE:
 		handler, err = cldConn.CreateMyImageHandler()
 	case CLUSTER:
 		handler, err = cldConn.CreateClusterHandler()
+	case DISKSNAPSHOT:
+		handler, err = cldConn.CreateDiskSnapshotHandler()
 	default:
 		return false, "", fmt.Errorf(rsType + " is not supported Resource!!")
 	}
@@ -1421,6 +1510,12 @@ func DeleteCSPResource(connectionName string, rsType string, systemID string) (b
 			cblog.Error(err)
 			return false, "", err
 		}
+	case DISKSNAPSHOT:
+		result, err = handler.(cres.DiskSnapshotHandler).DeleteDiskSnapshot(iid)
+		if err != nil {
+			cblog.Error(err)
+			return false, "", err
+		}
 
 	default:
 		return false, "", fmt.Errorf(rsType + " is not supported Resource!!")
@@ -1478,6 +1573,20 @@ func findDiskOwnerZoneId(connectionName string, systemID string) (string, error)
 	return "", fmt.Errorf("The '%s' does not exist in %s(%s)", systemID, connectionName, regionName)
 }
 
+// findDiskSnapshotZoneId returns the Zone of a registered DiskSnapshot.
+// A DiskSnapshot only in the CSP is controlled in the Zone of the connection.
+func findDiskSnapshotZoneId(connectionName string, systemID string) (string, error) {
+	var iidInfo DiskSnapshotIIDInfo
+	err := infostore.GetByConditionAndContain(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, systemID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not exist") {
+			return "", nil
+		}
+		return "", err
+	}
+	return iidInfo.ZoneId, nil
+}
+
 // Get Json string of CSP's Resource(SystemId) Info
 func GetCSPResourceInfo(connectionName string, rsType string, systemID string) ([]byte, error) {
 	cblog.Info("call GetCSPResourceInfo()")
@@ -1533,6 +1642,14 @@ func GetCSPResourceInfo(connectionName string, rsType string, systemID string) (
 
 		cldConn, err = ccm.GetZoneLevelCloudConnection(connectionName, zoneId)
 
+	case DISKSNAPSHOT: // Zone-Level Control Resource, in the Zone of the source Disk
+		zoneId, err = findDiskSnapshotZoneId(connectionName, systemID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		cldConn, err = ccm.GetZoneLevelCloudConnection(connectionName, zoneId)
+
 	default:
 		cldConn, err = ccm.GetCloudConnection(connectionName)
 	}
@@ -1553,13 +1670,15 @@ func GetCSPResourceInfo(connectionName string, rsType string, systemID string) (
 	case VM:
 		handler, err = cldConn.CreateVMHandler()
 	case NLB:
-		handler, err = cldConn.CreateNLBHandler()
+		handler, err = createNLBHandler(connectionName, cldConn)
 	case DISK:
 		handler, err = cldConn.CreateDiskHandler()
 	case MYIMAGE:
 		handler, err = cldConn.CreateMyImageHandler()
 	case CLUSTER:
 		handler, err = cldConn.CreateClusterHandler()
+	case DISKSNAPSHOT:
+		handler, err = cldConn.CreateDiskSnapshotHandler()
 	default:
 		return nil, fmt.Errorf(rsType + " is not supported Resource!!")
 	}
@@ -1629,6 +1748,13 @@ func GetCSPResourceInfo(connectionName string, rsType string, systemID string) (
 			return nil, err
 		}
 		jsonResult, _ = json.Marshal(result)
+	case DISKSNAPSHOT:
+		result, err := handler.(cres.DiskSnapshotHandler).GetDiskSnapshot(iid)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		jsonResult, _ = json.Marshal(result)
 
 	default:
 		return nil, fmt.Errorf(rsType + " is not supported Resource!!")
@@ -1763,6 +1889,12 @@ type RemainedErrorInfo struct {
 
 // Destroy all Resources in a Connection
 func Destroy(connectionName string) (DestroyedInfo, error) {
+	return DestroyWithContext(context.Background(), connectionName)
+}
+
+// DestroyWithContext is Destroy with a ctx to cancel the request.
+// If ctx is done, it stops before the next deletion and returns the resources destroyed so far.
+func DestroyWithContext(ctx context.Context, connectionName string) (DestroyedInfo, error) {
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1775,14 +1907,25 @@ func Destroy(connectionName string) (DestroyedInfo, error) {
 
 	// Define resource type groups
 	resourceTypeGroups := [][]string{
-		{CLUSTER, MYIMAGE, NLB},
+		{CLUSTER, MYIMAGE, NLB, DISKSNAPSHOT},
 		{VM},
+		{PUBLICIP}, // detached when the VM is deleted
+		{VNIC},     // detached when the VM is deleted, uses Subnet and SG
 		{DISK},
 		{KEY, SG},
+		{VPCPEERING}, // requested or accepted by the connection
+		{ROUTETABLE}, // routes to NAT gateways and subnet associations
+		{NATGATEWAY},
 		{VPC},
 	}
 
 	for _, resourceTypes := range resourceTypeGroups {
+		if err := ctx.Err(); err != nil {
+			cblog.Error(err)
+			destroyedInfo.IsAllDestroyed = false
+			return destroyedInfo, err
+		}
+
 		var wg sync.WaitGroup
 		var mu sync.Mutex
 		var groupErr error
@@ -1795,7 +1938,7 @@ func Destroy(connectionName string) (DestroyedInfo, error) {
 				var finalDeletedResourceInfoList DeletedResourceInfoList
 				finalDeletedResourceInfoList.ResourceType = resourceType
 
-				for retry := 0; retry < 10; retry++ {
+				for retry := 0; retry < 10 && ctx.Err() == nil; retry++ {
 					deletedResourceInfoList, err := deleteAllResourcesInResType(connectionName, resourceType)
 					mu.Lock()
 					if err != nil {
@@ -1822,7 +1965,10 @@ func Destroy(connectionName string) (DestroyedInfo, error) {
 						return
 					}
 					mu.Unlock()
-					time.Sleep(3 * time.Second)
+					select {
+					case <-time.After(3 * time.Second):
+					case <-ctx.Done():
+					}
 				}
 
 				mu.Lock()
@@ -1839,6 +1985,11 @@ func Destroy(connectionName string) (DestroyedInfo, error) {
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		cblog.Error(err)
+		return destroyedInfo, err
+	}
+
 	return destroyedInfo, nil
 }
 
@@ -1886,6 +2037,26 @@ func deleteAllResourcesInResType(connectionName string, rsType string) (*Deleted
 				_, err = DeleteMyImage(connectionName, MYIMAGE, nameId, "false")
 			case CLUSTER:
 				_, err = DeleteCluster(connectionName, CLUSTER, nameId, "false")
+			case ROUTETABLE:
+				var iidInfo RouteTableIIDInfo
+				err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameId)
+				if err == nil {
+					_, err = DeleteRouteTable(connectionName, ROUTETABLE, iidInfo.OwnerVPCName, nameId, "false")
+				}
+			case NATGATEWAY:
+				var iidInfo NATGatewayIIDInfo
+				err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameId)
+				if err == nil {
+					_, err = DeleteNATGateway(connectionName, NATGATEWAY, iidInfo.OwnerVPCName, nameId, "false")
+				}
+			case DISKSNAPSHOT:
+				_, err = DeleteDiskSnapshot(connectionName, DISKSNAPSHOT, nameId, "false")
+			case VNIC:
+				_, err = DeleteVNic(connectionName, VNIC, nameId, "false")
+			case PUBLICIP:
+				_, err = DeletePublicIP(connectionName, PUBLICIP, nameId, "false")
+			case VPCPEERING:
+				_, err = DeleteVPCPeering(connectionName, VPCPEERING, nameId, "false")
 			default:
 				err = fmt.Errorf("%s is not supported Resource!!", rsType)
 			}
@@ -1939,6 +2110,24 @@ func ListResourceName(connectionName, rsType string) ([]string, error) {
 	case CLUSTER:
 		v := ClusterIIDInfo{}
 		info = &v
+	case ROUTETABLE:
+		v := RouteTableIIDInfo{}
+		info = &v
+	case NATGATEWAY:
+		v := NATGatewayIIDInfo{}
+		info = &v
+	case DISKSNAPSHOT:
+		v := DiskSnapshotIIDInfo{}
+		info = &v
+	case VNIC:
+		v := VNicIIDInfo{}
+		info = &v
+	case PUBLICIP:
+		v := PublicIPIIDInfo{}
+		info = &v
+	case VPCPEERING:
+		// the peerings accepted by the connection are listed, too
+		return listVPCPeeringNames(connectionName)
 	default:
 		return nil, fmt.Errorf("%s is not a supported Resource!!", rsType)
 	}
@@ -2054,7 +2243,7 @@ func getAuthIIDInfoList(connectionName string, iidInfoList interface{}) error {
 		}
 	case *[]*NLBIIDInfo:
 		tmpIIDInfoList := []*NLBIIDInfo{}
-		handler, err := cldConn.CreateNLBHandler()
+		handler, err := createNLBHandler(connectionName, cldConn)
 		// Fetch granted ID list from CSP
 		iidList, err := handler.ListIID()
 		if err != nil {
@@ -2341,6 +2530,13 @@ const (
 	TAG_HANDLER     CapabilityType = "TagHandler"
 
 	ZONE_BASED_CONTROL CapabilityType = "Zone-based Control"
+	SG_REFERENCE_RULE  CapabilityType = "SecurityGroup Reference Rule"
+	SG_DENY_RULE       CapabilityType = "SecurityGroup Deny Rule"
+	IPV6_DUAL_STACK    CapabilityType = "IPv6 Dual-Stack"
+	SPOT_VM            CapabilityType = "Spot VM"
+	SPOT_PRICE         CapabilityType = "Spot Pricing"
+	VM_SPEC_CHANGE     CapabilityType = "VMSpec Change"
+	NLB_MULTI_LISTENER CapabilityType = "NLB Multi Listener"
 )
 
 // checkCapability checks if the given connection supports specified capability
@@ -2361,6 +2557,20 @@ func checkCapability(connectionName string, capability CapabilityType) error {
 		supported = drvCapabilityInfo.TagHandler
 	case ZONE_BASED_CONTROL:
 		supported = drvCapabilityInfo.ZoneBasedControl
+	case SG_REFERENCE_RULE:
+		supported = drvCapabilityInfo.SG_REFERENCE_RULE
+	case SG_DENY_RULE:
+		supported = drvCapabilityInfo.SG_DENY_RULE
+	case IPV6_DUAL_STACK:
+		supported = drvCapabilityInfo.IPV6_DUAL_STACK
+	case SPOT_VM:
+		supported = drvCapabilityInfo.SPOT_VM
+	case SPOT_PRICE:
+		supported = drvCapabilityInfo.SPOT_PRICE
+	case VM_SPEC_CHANGE:
+		supported = drvCapabilityInfo.VM_SPEC_CHANGE
+	case NLB_MULTI_LISTENER:
+		supported = drvCapabilityInfo.NLB_MULTI_LISTENER
 	default:
 		return fmt.Errorf("unknown capability type: %s", capability)
 	}
@@ -9,13 +9,17 @@
 package commonruntime
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	infostore "github.com/cloud-barista/cb-spider/info-store"
 )
 
@@ -36,7 +40,7 @@ func init() {
 		cblog.Error(err)
 		return
 	}
-	db.AutoMigrate(&DiskIIDInfo{})
+	infostore.AutoMigrate(db, &DiskIIDInfo{})
 	infostore.Close(db)
 }
 
@@ -171,6 +175,11 @@ func RegisterDisk(connectionName string, zoneId string, userIID cres.IID) (*cres
 // (5) insert spiderIID
 // (6) create userIID
 func CreateDisk(connectionName string, rsType string, reqInfo cres.DiskInfo, IDTransformMode string) (*cres.DiskInfo, error) {
+	return CreateDiskWithContext(context.Background(), connectionName, rsType, reqInfo, IDTransformMode)
+}
+
+// CreateDiskWithContext is CreateDisk with a ctx to cancel the request.
+func CreateDiskWithContext(ctx context.Context, connectionName string, rsType string, reqInfo cres.DiskInfo, IDTransformMode string) (*cres.DiskInfo, error) {
 	cblog.Info("call CreateDisk()")
 
 	// check empty and trim user inputs
@@ -200,6 +209,25 @@ func CreateDisk(connectionName string, rsType string, reqInfo cres.DiskInfo, IDT
 		}
 	}
 
+	// check the provisioned performance(IOPS, Throughput) of the Disk Type
+	if reqInfo.IOPS != "" || reqInfo.Throughput != "" {
+		providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		if reqInfo.DiskType == "" || strings.ToLower(reqInfo.DiskType) == "default" {
+			err := fmt.Errorf("The DiskType must be specified with the IOPS or Throughput!")
+			cblog.Error(err)
+			return nil, err
+		}
+		err = validateDiskPerformance(providerName, reqInfo.DiskType, reqInfo.IOPS, reqInfo.Throughput)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
 	/*
 	   emptyPermissionList := []string{
 	           "resources.IID:SystemId",
@@ -218,11 +246,12 @@ func CreateDisk(connectionName string, rsType string, reqInfo cres.DiskInfo, IDT
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateDiskHandler()
+	diskHandler, err := cldConn.CreateDiskHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
+	handler := cres.ToDiskHandlerWithContext(diskHandler)
 
 	diskSPLock.Lock(connectionName, reqInfo.IId.NameId)
 	defer diskSPLock.Unlock(connectionName, reqInfo.IId.NameId)
@@ -278,7 +307,7 @@ func CreateDisk(connectionName string, rsType string, reqInfo cres.DiskInfo, IDT
 		reqInfo.DiskType = ""
 	}
 	// (3) create Resource
-	info, err := handler.CreateDisk(reqInfo)
+	info, err := handler.CreateDiskWithContext(ctx, reqInfo)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -579,10 +608,247 @@ func ChangeDiskSize(connectionName string, diskName string, size string) (bool,
 	return info, nil
 }
 
+// ChangeDiskType changes the Disk Type and the provisioned performance(IOPS, Throughput) of a Disk.
+// diskType "" or "default" keeps the current Disk Type, iops and throughput "" keep the current values.
+// (1) check exist(diskName) & get IID(NameId)
+// (2) get the current Disk Type and validate the change with cloudos_meta.yaml
+// (3) change disk type
+func ChangeDiskType(connectionName string, diskName string, diskType string, iops string, throughput string) (*cres.DiskInfo, error) {
+	cblog.Info("call ChangeDiskType()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	diskName, err = EmptyCheckAndTrim("diskName", diskName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	diskType = strings.TrimSpace(diskType)
+	iops = strings.TrimSpace(iops)
+	throughput = strings.TrimSpace(throughput)
+
+	providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	diskSPLock.Lock(connectionName, diskName)
+	defer diskSPLock.Unlock(connectionName, diskName)
+
+	// (1) check exist(diskName) & get IID(NameId)
+	var diskIIDInfo DiskIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var iidInfoList []*DiskIIDInfo
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, diskName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		diskIIDInfo = *castedIIDInfo.(*DiskIIDInfo)
+	} else {
+		err = infostore.GetByConditions(&diskIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, diskName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	cldConn, err := ccm.GetZoneLevelCloudConnection(connectionName, diskIIDInfo.ZoneId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateDiskHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get the current Disk Type and validate the change with cloudos_meta.yaml
+	driverIId := getDriverIID(cres.IID{NameId: diskIIDInfo.NameId, SystemId: diskIIDInfo.SystemId})
+	diskInfo, err := handler.GetDisk(driverIId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if diskType == "" || strings.ToLower(diskType) == "default" {
+		diskType = diskInfo.DiskType
+	}
+	rootDisk, err := isRootDisk(cldConn, diskInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = validateDiskTypeChange(providerName, rootDisk, diskInfo.DiskType, diskType, iops, throughput)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) change disk type
+	info, err := handler.ChangeDiskType(driverIId, diskType, iops, throughput)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	info.IId = getUserIID(cres.IID{NameId: diskIIDInfo.NameId, SystemId: diskIIDInfo.SystemId})
+	if info.Status == cres.DiskAttached {
+		var vmIIdInfo VMIIDInfo
+		err := infostore.GetByContain(&vmIIdInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, info.OwnerVM.SystemId)
+		if err != nil {
+			cblog.Error(err)
+		}
+		info.OwnerVM.NameId = vmIIdInfo.NameId
+	}
+
+	return &info, nil
+}
+
+// isRootDisk checks whether the Disk is the root disk of its OwnerVM.
+// The root disk is attached to the VM, but it is not in the DataDiskIIDs of the VM.
+func isRootDisk(cldConn icon.CloudConnection, diskInfo cres.DiskInfo) (bool, error) {
+	if diskInfo.Status != cres.DiskAttached || diskInfo.OwnerVM.SystemId == "" {
+		return false, nil
+	}
+
+	vmHandler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		return false, err
+	}
+	vmInfo, err := vmHandler.GetVM(diskInfo.OwnerVM)
+	if err != nil {
+		return false, err
+	}
+	for _, dataDiskIID := range vmInfo.DataDiskIIDs {
+		if dataDiskIID.SystemId == diskInfo.IId.SystemId {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// validateDiskTypeChange checks whether the provider can change the Disk Type online('diskchangetype' in cloudos_meta.yaml),
+// the target Disk Type('disktype') and the provisioned performance('diskperformance').
+// A root disk is checked with 'rootdiskchangetype' and 'rootdisktype' instead.
+func validateDiskTypeChange(providerName string, rootDisk bool, srcDiskType string, targetDiskType string, iops string, throughput string) error {
+	cloudOSMetaInfo, err := cim.GetCloudOSMetaInfo(providerName)
+	if err != nil {
+		return err
+	}
+
+	diskChangeType, diskTypeList := cloudOSMetaInfo.DiskChangeType, cloudOSMetaInfo.DiskType
+	if rootDisk {
+		diskChangeType, diskTypeList = cloudOSMetaInfo.RootDiskChangeType, cloudOSMetaInfo.RootDiskType
+		if len(diskChangeType) == 0 || diskChangeType[0] == "" {
+			return fmt.Errorf("%s does not support the online change of the Disk Type of a root disk!", providerName)
+		}
+		// the provisioned performance can be changed only with a root Disk Type which can be changed online
+		if !validateRootDiskType(srcDiskType, diskChangeType) {
+			return fmt.Errorf("%s does not support the online change of the %s root disk!", providerName, srcDiskType)
+		}
+	}
+
+	if len(diskChangeType) == 0 || diskChangeType[0] == "" {
+		return fmt.Errorf("%s does not support the online change of the Disk Type!", providerName)
+	}
+	if !validateRootDiskType(targetDiskType, diskTypeList) {
+		return fmt.Errorf("%s is not a valid Disk Type of %s!", targetDiskType, providerName)
+	}
+
+	if srcDiskType == targetDiskType {
+		if iops == "" && throughput == "" {
+			return fmt.Errorf("The Disk Type is already %s, and there is no IOPS or Throughput to change!", targetDiskType)
+		}
+	} else {
+		for _, diskType := range []string{srcDiskType, targetDiskType} {
+			if !validateRootDiskType(diskType, diskChangeType) {
+				return fmt.Errorf("%s does not support the online change of the Disk Type from %s to %s: %s cannot be changed online!",
+					providerName, srcDiskType, targetDiskType, diskType)
+			}
+		}
+	}
+
+	return validateDiskPerformance(providerName, targetDiskType, iops, throughput)
+}
+
+// validateDiskPerformance checks the provisioned IOPS and Throughput with 'diskperformance' in cloudos_meta.yaml.
+// ex) diskperformance: gp3|3000|16000|125|1000 / io1|100|64000|-1|-1   # -1: not configurable
+func validateDiskPerformance(providerName string, diskType string, iops string, throughput string) error {
+	if iops == "" && throughput == "" {
+		return nil
+	}
+
+	cloudOSMetaInfo, err := cim.GetCloudOSMetaInfo(providerName)
+	if err != nil {
+		return err
+	}
+
+	var ranges []int
+	for _, diskPerformance := range cloudOSMetaInfo.DiskPerformance {
+		fields := strings.Split(diskPerformance, "|")
+		if len(fields) != 5 || strings.TrimSpace(fields[0]) != diskType {
+			continue
+		}
+		ranges = make([]int, 4)
+		for idx, field := range fields[1:] {
+			ranges[idx], err = strconv.Atoi(strings.TrimSpace(field))
+			if err != nil {
+				return fmt.Errorf("%s has an invalid DiskPerformance(%s) in cloudos_meta.yaml: %v", providerName, diskPerformance, err)
+			}
+		}
+		break
+	}
+	if ranges == nil {
+		return fmt.Errorf("The Disk Type %s of %s does not support the provisioned IOPS and Throughput!", diskType, providerName)
+	}
+
+	for _, check := range []struct {
+		name  string
+		value string
+		min   int
+		max   int
+	}{
+		{name: "IOPS", value: iops, min: ranges[0], max: ranges[1]},
+		{name: "Throughput", value: throughput, min: ranges[2], max: ranges[3]},
+	} {
+		if check.value == "" {
+			continue
+		}
+		if check.min == -1 {
+			return fmt.Errorf("The Disk Type %s of %s does not support the provisioned %s!", diskType, providerName, check.name)
+		}
+		value, err := strconv.Atoi(check.value)
+		if err != nil {
+			return fmt.Errorf("The %s(%s) must be a number!", check.name, check.value)
+		}
+		if value < check.min || value > check.max {
+			return fmt.Errorf("The %s(%d) of the Disk Type %s must be between %d and %d!", check.name, value, diskType, check.min, check.max)
+		}
+	}
+	return nil
+}
+
 // (1) check exist(NameID) and VMs
 // (2) attach disk to VM
 // (3) Set ResoureInfo
 func AttachDisk(connectionName string, diskName string, ownerVMName string) (*cres.DiskInfo, error) {
+	return AttachDiskWithContext(context.Background(), connectionName, diskName, ownerVMName)
+}
+
+// AttachDiskWithContext is AttachDisk with a ctx to cancel the request.
+func AttachDiskWithContext(ctx context.Context, connectionName string, diskName string, ownerVMName string) (*cres.DiskInfo, error) {
 	cblog.Info("call AttachDisk()")
 
 	// check empty and trim user inputs
@@ -636,11 +902,12 @@ func AttachDisk(connectionName string, diskName string, ownerVMName string) (*cr
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateDiskHandler()
+	diskHandler, err := cldConn.CreateDiskHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
+	handler := cres.ToDiskHandlerWithContext(diskHandler)
 
 	// (1) check exist(ownerVMName)
 	var vmIIDInfo VMIIDInfo
@@ -666,7 +933,7 @@ func AttachDisk(connectionName string, diskName string, ownerVMName string) (*cr
 	}
 
 	// (2) attach disk to VM
-	info, err := handler.AttachDisk(getDriverIID(cres.IID{NameId: diskIIDInfo.NameId, SystemId: diskIIDInfo.SystemId}),
+	info, err := handler.AttachDiskWithContext(ctx, getDriverIID(cres.IID{NameId: diskIIDInfo.NameId, SystemId: diskIIDInfo.SystemId}),
 		getDriverIID(cres.IID{NameId: vmIIDInfo.NameId, SystemId: vmIIDInfo.SystemId}))
 	if err != nil {
 		cblog.Error(err)
@@ -685,6 +952,11 @@ func AttachDisk(connectionName string, diskName string, ownerVMName string) (*cr
 // (1) check exist(NameID)
 // (2) detach disk from VM
 func DetachDisk(connectionName string, diskName string, ownerVMName string) (bool, error) {
+	return DetachDiskWithContext(context.Background(), connectionName, diskName, ownerVMName)
+}
+
+// DetachDiskWithContext is DetachDisk with a ctx to cancel the request.
+func DetachDiskWithContext(ctx context.Context, connectionName string, diskName string, ownerVMName string) (bool, error) {
 	cblog.Info("call DetachDisk()")
 
 	// check empty and trim user inputs
@@ -732,11 +1004,12 @@ func DetachDisk(connectionName string, diskName string, ownerVMName string) (boo
 		return false, err
 	}
 
-	handler, err := cldConn.CreateDiskHandler()
+	diskHandler, err := cldConn.CreateDiskHandler()
 	if err != nil {
 		cblog.Error(err)
 		return false, err
 	}
+	handler := cres.ToDiskHandlerWithContext(diskHandler)
 
 	// (1) check exist(ownerVMName)
 	var vmIIDInfo VMIIDInfo
@@ -762,7 +1035,7 @@ func DetachDisk(connectionName string, diskName string, ownerVMName string) (boo
 	}
 
 	// (2) detach disk from VM
-	info, err := handler.DetachDisk(getDriverIID(cres.IID{NameId: diskIIDInfo.NameId, SystemId: diskIIDInfo.SystemId}),
+	info, err := handler.DetachDiskWithContext(ctx, getDriverIID(cres.IID{NameId: diskIIDInfo.NameId, SystemId: diskIIDInfo.SystemId}),
 		getDriverIID(cres.IID{NameId: vmIIDInfo.NameId, SystemId: vmIIDInfo.SystemId}))
 	if err != nil {
 		cblog.Error(err)
@@ -0,0 +1,420 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// Common Runtime for DiskSnapshotHandler interface
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// -------- IID Info for DiskSnapshot
+
+// ZoneId is the Zone of the source Disk.
+type DiskSnapshotIIDInfo ZoneLevelIIDInfo
+
+func (DiskSnapshotIIDInfo) TableName() string {
+	return "disk_snapshot_iid_infos"
+}
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	infostore.AutoMigrate(db, &DiskSnapshotIIDInfo{})
+	infostore.Close(db)
+}
+
+// -------- DiskSnapshot Common Runtime
+
+// (1) check exist(NameID)
+// (2) set the driver IID of the source Disk
+// (3) create the DiskSnapshot with a SP-XID in the Zone of the source Disk
+// (4) insert spiderIID
+func CreateDiskSnapshot(connectionName string, rsType string, reqInfo cres.DiskSnapshotInfo, IDTransformMode string) (*cres.DiskSnapshotInfo, error) {
+	cblog.Info("call CreateDiskSnapshot()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	sourceDiskName, err := EmptyCheckAndTrim("SourceDisk", reqInfo.SourceDisk.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	diskSnapshotSPLock.Lock(connectionName, reqInfo.IId.NameId)
+	defer diskSnapshotSPLock.Unlock(connectionName, reqInfo.IId.NameId)
+
+	// (1) check exist(NameID)
+	exist, err := infostore.HasByConditions(&DiskSnapshotIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.IId.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if exist {
+		err := fmt.Errorf("DiskSnapshot %s already exists", reqInfo.IId.NameId)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) set the driver IID of the source Disk
+	diskSPLock.RLock(connectionName, sourceDiskName)
+	defer diskSPLock.RUnlock(connectionName, sourceDiskName)
+
+	var diskIIDInfo DiskIIDInfo
+	err = infostore.GetByConditions(&diskIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, sourceDiskName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	reqInfo.SourceDisk = getDriverIID(cres.IID{NameId: diskIIDInfo.NameId, SystemId: diskIIDInfo.SystemId})
+
+	// (3) create the DiskSnapshot with a SP-XID in the Zone of the source Disk
+	handler, err := getDiskSnapshotHandler(connectionName, diskIIDInfo.ZoneId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	spUUID := reqInfo.IId.NameId
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		spUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	reqNameId := reqInfo.IId.NameId
+	reqInfo.IId = cres.IID{NameId: spUUID, SystemId: ""}
+
+	info, err := handler.CreateDiskSnapshot(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) insert spiderIID: {reqNameID, "driverNameID:driverSystemID"}
+	spiderIId := cres.IID{NameId: reqNameId, SystemId: spUUID + ":" + info.IId.SystemId}
+	err = infostore.Insert(&DiskSnapshotIIDInfo{ConnectionName: connectionName, ZoneId: diskIIDInfo.ZoneId, NameId: spiderIId.NameId,
+		SystemId: spiderIId.SystemId})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteDiskSnapshot(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf("%v, %v", err, err2)
+		}
+		return nil, err
+	}
+
+	info.IId = getUserIID(spiderIId)
+	info.SourceDisk.NameId = sourceDiskName
+	return &info, nil
+}
+
+func ListDiskSnapshot(connectionName string, rsType string) ([]*cres.DiskSnapshotInfo, error) {
+	cblog.Info("call ListDiskSnapshot()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var iidInfoList []*DiskSnapshotIIDInfo
+	err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	infoList := []*cres.DiskSnapshotInfo{}
+	for _, iidInfo := range iidInfoList {
+		handler, err := getDiskSnapshotHandler(connectionName, iidInfo.ZoneId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+
+		diskSnapshotSPLock.RLock(connectionName, iidInfo.NameId)
+		spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+		info, err := handler.GetDiskSnapshot(getDriverIID(spiderIId))
+		diskSnapshotSPLock.RUnlock(connectionName, iidInfo.NameId)
+		if err != nil {
+			cblog.Error(err)
+			if checkNotFoundError(err) {
+				info = cres.DiskSnapshotInfo{IId: spiderIId}
+				infoList = append(infoList, &info)
+				continue
+			}
+			return nil, err
+		}
+		info.IId = getUserIID(spiderIId)
+		setDiskSnapshotSourceDiskName(connectionName, &info)
+		infoList = append(infoList, &info)
+	}
+	return infoList, nil
+}
+
+func GetDiskSnapshot(connectionName string, rsType string, nameID string) (*cres.DiskSnapshotInfo, error) {
+	cblog.Info("call GetDiskSnapshot()")
+
+	iidInfo, err := getDiskSnapshotIIDInfo(connectionName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	handler, err := getDiskSnapshotHandler(iidInfo.ConnectionName, iidInfo.ZoneId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	diskSnapshotSPLock.RLock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer diskSnapshotSPLock.RUnlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+	info, err := handler.GetDiskSnapshot(getDriverIID(spiderIId))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(spiderIId)
+	setDiskSnapshotSourceDiskName(iidInfo.ConnectionName, &info)
+	return &info, nil
+}
+
+// DeleteDiskSnapshot deletes a DiskSnapshot. With force "true", the IID info is deleted even if the CSP fails.
+// The Disks created from the DiskSnapshot are not deleted.
+func DeleteDiskSnapshot(connectionName string, rsType string, nameID string, force string) (bool, error) {
+	cblog.Info("call DeleteDiskSnapshot()")
+
+	iidInfo, err := getDiskSnapshotIIDInfo(connectionName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	handler, err := getDiskSnapshotHandler(iidInfo.ConnectionName, iidInfo.ZoneId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	diskSnapshotSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer diskSnapshotSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	result, err := handler.DeleteDiskSnapshot(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		if checkNotFoundError(err) {
+			// if not found in CSP, continue
+			force = "true"
+		} else if force != "true" {
+			return false, err
+		}
+	}
+	if !result && force != "true" {
+		return false, nil
+	}
+
+	_, err = infostore.DeleteByConditions(&DiskSnapshotIIDInfo{}, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, NAME_ID_COLUMN, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// CreateDiskFromSnapshot creates a new Disk from a DiskSnapshot.
+// The new Disk can be created in any Zone of the Region, and it is managed as a Disk of the connection.
+// (1) check the Zone, the DiskSize and the provisioned performance of the new Disk
+// (2) check exist(NameID) of the new Disk
+// (3) create the Disk with a SP-XID in the target Zone
+// (4) insert spiderIID of the Disk
+func CreateDiskFromSnapshot(connectionName string, snapshotName string, reqInfo cres.DiskInfo, IDTransformMode string) (*cres.DiskInfo, error) {
+	cblog.Info("call CreateDiskFromSnapshot()")
+
+	snapshotIIDInfo, err := getDiskSnapshotIIDInfo(connectionName, snapshotName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	connectionName = snapshotIIDInfo.ConnectionName
+
+	// (1) check the Zone, the DiskSize and the provisioned performance of the new Disk
+	err = validateDiskFromSnapshotReqInfo(connectionName, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	diskSnapshotSPLock.RLock(connectionName, snapshotIIDInfo.NameId)
+	defer diskSnapshotSPLock.RUnlock(connectionName, snapshotIIDInfo.NameId)
+
+	diskSPLock.Lock(connectionName, reqInfo.IId.NameId)
+	defer diskSPLock.Unlock(connectionName, reqInfo.IId.NameId)
+
+	// (2) check exist(NameID) of the new Disk
+	exist, err := infostore.HasByConditions(&DiskIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.IId.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if exist {
+		err := fmt.Errorf("Disk %s already exists", reqInfo.IId.NameId)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) create the Disk with a SP-XID in the target Zone
+	cldConn, err := ccm.GetZoneLevelCloudConnection(connectionName, reqInfo.Zone)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	handler, err := cldConn.CreateDiskSnapshotHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	spUUID := reqInfo.IId.NameId
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		spUUID, err = iidm.New(connectionName, DISK, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	reqNameId := reqInfo.IId.NameId
+	reqInfo.IId = cres.IID{NameId: spUUID, SystemId: ""}
+	if strings.ToLower(reqInfo.DiskType) == "default" {
+		reqInfo.DiskType = ""
+	}
+
+	snapshotIId := getDriverIID(cres.IID{NameId: snapshotIIDInfo.NameId, SystemId: snapshotIIDInfo.SystemId})
+	info, err := handler.CreateDiskFromSnapshot(snapshotIId, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) insert spiderIID of the Disk: {reqNameID, "driverNameID:driverSystemID"}
+	spiderIId := cres.IID{NameId: reqNameId, SystemId: spUUID + ":" + info.IId.SystemId}
+	err = infostore.Insert(&DiskIIDInfo{ConnectionName: connectionName, ZoneId: reqInfo.Zone, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		diskHandler, err2 := cldConn.CreateDiskHandler()
+		if err2 == nil {
+			_, err2 = diskHandler.DeleteDisk(info.IId)
+		}
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf("%v, %v", err, err2)
+		}
+		return nil, err
+	}
+
+	info.IId = getUserIID(spiderIId)
+	return &info, nil
+}
+
+func getDiskSnapshotIIDInfo(connectionName string, nameID string) (*DiskSnapshotIIDInfo, error) {
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		return nil, err
+	}
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		return nil, err
+	}
+
+	var iidInfo DiskSnapshotIIDInfo
+	err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID)
+	if err != nil {
+		return nil, err
+	}
+	return &iidInfo, nil
+}
+
+func getDiskSnapshotHandler(connectionName string, zoneId string) (cres.DiskSnapshotHandler, error) {
+	cldConn, err := ccm.GetZoneLevelCloudConnection(connectionName, zoneId)
+	if err != nil {
+		return nil, err
+	}
+	return cldConn.CreateDiskSnapshotHandler()
+}
+
+// setDiskSnapshotSourceDiskName sets the user NameId of the source Disk of a DiskSnapshot.
+// The source Disk may be deleted after the snapshot, then the NameId is left empty.
+func setDiskSnapshotSourceDiskName(connectionName string, info *cres.DiskSnapshotInfo) {
+	if info.SourceDisk.SystemId == "" {
+		return
+	}
+	var diskIIDInfo DiskIIDInfo
+	err := infostore.GetByContain(&diskIIDInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, info.SourceDisk.SystemId)
+	if err != nil {
+		info.SourceDisk.NameId = ""
+		return
+	}
+	info.SourceDisk.NameId = diskIIDInfo.NameId
+}
+
+// validateDiskFromSnapshotReqInfo checks the Zone, the DiskSize and the provisioned performance of a new Disk from a DiskSnapshot.
+// The Zone and the provisioned performance are checked like CreateDisk.
+func validateDiskFromSnapshotReqInfo(connectionName string, reqInfo cres.DiskInfo) error {
+	// Subnet and Disk can be created in a specific Zone(Zone-Based Control).
+	// but, Some CSPs do not support Zone-Based Control.
+	// if the Zone info is different from defaultZoneId,
+	// check the capability of ZONE_BASED_CONTROL for the CSP
+	// (1) get defaultZoneId with ConnectionName
+	_, defaultZoneId, err := ccm.GetRegionNameByConnectionName(connectionName)
+	if err != nil {
+		return err
+	}
+
+	// (2) check the Zone info and the capability of ZONE_BASED_CONTROL
+	if reqInfo.Zone != "" && reqInfo.Zone != defaultZoneId {
+		err := checkCapability(connectionName, ZONE_BASED_CONTROL)
+		if err != nil {
+			return err
+		}
+	}
+
+	// check the DiskSize, empty or "default": the CSP's default size
+	switch strings.ToLower(reqInfo.DiskSize) {
+	case "", "default":
+	default:
+		size, err := strconv.Atoi(reqInfo.DiskSize)
+		if err != nil || size <= 0 {
+			return fmt.Errorf("%s is not a valid DiskSize: it must be a positive number(GB)!", reqInfo.DiskSize)
+		}
+	}
+
+	// check the provisioned performance(IOPS, Throughput) of the Disk Type
+	if reqInfo.IOPS != "" || reqInfo.Throughput != "" {
+		providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+		if err != nil {
+			return err
+		}
+		if reqInfo.DiskType == "" || strings.ToLower(reqInfo.DiskType) == "default" {
+			return fmt.Errorf("The DiskType must be specified with the IOPS or Throughput!")
+		}
+		return validateDiskPerformance(providerName, reqInfo.DiskType, reqInfo.IOPS, reqInfo.Throughput)
+	}
+	return nil
+}
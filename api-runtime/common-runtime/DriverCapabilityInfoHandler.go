@@ -28,7 +28,19 @@ func GetDriverCapabilityInfo(connectionName string) (ifs.DriverCapabilityInfo, e
 		return ifs.DriverCapabilityInfo{}, err
 	}
 
-	drvCapabilityInfo := cldDriver.GetDriverCapability()
+	return getDriverCapability(cldDriver)
+}
 
-	return drvCapabilityInfo, nil
+// getDriverCapability returns the capability of a driver,
+// and the error of a driver which loads its capability remotely, ex) a driver plugin.
+func getDriverCapability(cldDriver ifs.CloudDriver) (ifs.DriverCapabilityInfo, error) {
+	if loader, ok := cldDriver.(ifs.DriverCapabilityLoader); ok {
+		drvCapabilityInfo, err := loader.LoadDriverCapability()
+		if err != nil {
+			cblog.Error(err)
+			return ifs.DriverCapabilityInfo{}, err
+		}
+		return drvCapabilityInfo, nil
+	}
+	return cldDriver.GetDriverCapability(), nil
 }
@@ -0,0 +1,1073 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
+	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	sshrun "github.com/cloud-barista/cb-spider/cloud-control-manager/vm-ssh"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// An emulated NLB is a proxy VM which is created in the VPC of the backend VMs.
+// The proxy VM runs nginx as a TCP/UDP proxy and a health checker loop,
+// and CB-Spider configures them over SSH whenever the NLB is changed.
+//
+//   - NLB's SystemId: the proxy VM's SystemId
+//   - Listener's IP:  the proxy VM's PublicIP(PUBLIC) or PrivateIP(INTERNAL)
+//   - The proxy VM uses the Image, Subnet and SecurityGroups of the first backend VM,
+//     so the SecurityGroups have to allow SSH(22) and the listener ports.
+//   - The proxy VM's spec is the nlbproxyvmspec of the provider in cloudos_meta.yaml
+//     or the VMSpecName KeyValue of the NLB request.
+
+// EMULATED_NLB_PROVIDER is the pseudo provider name of the emulated NLB.
+// It is used to get the NLB metadata in cloudos_meta.yaml and to store the proxy VMs' private keys.
+const EMULATED_NLB_PROVIDER = "EMULATED_NLB"
+
+const (
+	emulatedNLBVMSuffix  = "-nlb-vm"
+	emulatedNLBKeySuffix = "-nlb-key"
+
+	// KeyValueList key of the NLB request to choose the proxy VM's spec, default: nlbproxyvmspec in cloudos_meta.yaml
+	emulatedNLBVMSpecKey = "VMSpecName"
+
+	defaultEmulatedNLBVMUser = "cb-user"
+)
+
+// ====================================================================
+// type for GORM
+
+// EmulatedNLBInfo keeps the state of an emulated NLB in the MetaDB.
+type EmulatedNLBInfo struct {
+	ConnectionName  string `gorm:"primaryKey"`
+	NameId          string `gorm:"primaryKey"` // driver NameId of the NLB
+	ProxyVMNameId   string
+	ProxyVMSystemId string
+	KeyPairNameId   string
+	KeyPairSystemId string
+	VMUserId        string
+	NLBInfo         string // JSON text of the NLBInfo
+	CreatedTime     time.Time
+}
+
+func (EmulatedNLBInfo) TableName() string {
+	return "emulated_nlb_infos"
+}
+
+//====================================================================
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	infostore.AutoMigrate(db, &EmulatedNLBInfo{})
+	infostore.Close(db)
+}
+
+// isEmulatedNLB returns true if the driver of the connection opts in to the emulated NLB(EMULATED_NLB capability).
+// A driver with a native NLB(NLBHandler capability) always uses it, so its NLBs are not replaced.
+func isEmulatedNLB(connectionName string) (bool, error) {
+	drvCapabilityInfo, err := GetDriverCapabilityInfo(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return drvCapabilityInfo.EMULATED_NLB && !drvCapabilityInfo.NLBHandler, nil
+}
+
+// createNLBHandler returns the emulated NLB handler or the driver's NLB handler of the connection.
+func createNLBHandler(connectionName string, cldConn icon.CloudConnection) (cres.NLBHandler, error) {
+	emulated, err := isEmulatedNLB(connectionName)
+	if err != nil {
+		return nil, err
+	}
+	if !emulated {
+		return cldConn.CreateNLBHandler()
+	}
+	handler, err := NewEmulatedNLBHandler(connectionName, cldConn)
+	if err != nil {
+		return nil, err
+	}
+	handler.ProviderName, err = ccm.GetProviderNameByConnectionName(connectionName)
+	if err != nil {
+		return nil, err
+	}
+	return handler, nil
+}
+
+// getNLBProviderName returns the provider name to get the NLB metadata of the connection.
+func getNLBProviderName(connectionName string) (string, error) {
+	emulated, err := isEmulatedNLB(connectionName)
+	if err != nil {
+		return "", err
+	}
+	if emulated {
+		return EMULATED_NLB_PROVIDER, nil
+	}
+	return ccm.GetProviderNameByConnectionName(connectionName)
+}
+
+// getEmulatedNLBResources returns the proxy VMs(rsType: VM) or KeyPairs(rsType: KEY) of the emulated NLBs in the connection.
+// They are owned by the emulated NLBs, so they are not listed, registered or deleted as a user's VM or KeyPair.
+// return: map[SystemId]NLB's NameId
+func getEmulatedNLBResources(connectionName string, rsType string) (map[string]string, error) {
+	resources := map[string]string{}
+	if rsType != VM && rsType != KEY {
+		return resources, nil
+	}
+
+	var infoList []*EmulatedNLBInfo
+	err := infostore.ListByCondition(&infoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	for _, info := range infoList {
+		if rsType == VM {
+			resources[info.ProxyVMSystemId] = info.NameId
+		} else {
+			resources[info.KeyPairSystemId] = info.NameId
+		}
+	}
+	return resources, nil
+}
+
+// checkNotEmulatedNLBResource returns an error if the systemId is a proxy VM or KeyPair of an emulated NLB.
+func checkNotEmulatedNLBResource(connectionName string, rsType string, systemId string) error {
+	resources, err := getEmulatedNLBResources(connectionName, rsType)
+	if err != nil {
+		return err
+	}
+	if nlbNameId, ok := resources[systemId]; ok {
+		err := fmt.Errorf("%s %s is owned by the emulated NLB %s, use the NLB API", RSTypeString(rsType), systemId, nlbNameId)
+		cblog.Error(err)
+		return err
+	}
+	return nil
+}
+
+//================ Emulated NLB Handler
+
+// EmulatedNLBProxyExecutor runs commands on the proxy VM of an emulated NLB.
+// The default executor uses SSH, and a test can replace it.
+type EmulatedNLBProxyExecutor interface {
+	// WaitReady waits until the proxy VM of the IP can run commands.
+	WaitReady(vmIP string) error
+	// Run runs the cmd on the proxy VM of the IP, and returns the output.
+	Run(vmIP string, userName string, privateKey []byte, cmd string) (string, error)
+}
+
+// sshProxyExecutor runs commands on the proxy VM over SSH.
+type sshProxyExecutor struct{}
+
+func (sshProxyExecutor) WaitReady(vmIP string) error {
+	waiter := NewWaiter(2, 300) // (sleep, timeout)
+	for !checkSSH(vmIP + ":22") {
+		if !waiter.Wait() {
+			return fmt.Errorf("SSH of %s is not ready", vmIP)
+		}
+	}
+	return nil
+}
+
+func (sshProxyExecutor) Run(vmIP string, userName string, privateKey []byte, cmd string) (string, error) {
+	sshInfo := sshrun.SSHInfo{
+		UserName:   userName,
+		PrivateKey: privateKey,
+		ServerPort: vmIP + ":22",
+		Timeout:    30,
+	}
+	return sshrun.SSHRun(sshInfo, cmd)
+}
+
+// EmulatedNLBHandler implements the NLBHandler with a proxy VM.
+type EmulatedNLBHandler struct {
+	ConnectionName string
+	ProviderName   string // provider of the proxy VM's default spec in cloudos_meta.yaml
+	VMHandler      cres.VMHandler
+	KeyPairHandler cres.KeyPairHandler
+	ProxyExecutor  EmulatedNLBProxyExecutor
+}
+
+func NewEmulatedNLBHandler(connectionName string, cldConn icon.CloudConnection) (*EmulatedNLBHandler, error) {
+	vmHandler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	keyPairHandler, err := cldConn.CreateKeyPairHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &EmulatedNLBHandler{
+		ConnectionName: connectionName,
+		VMHandler:      vmHandler,
+		KeyPairHandler: keyPairHandler,
+		ProxyExecutor:  sshProxyExecutor{},
+	}, nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) ListIID() ([]*cres.IID, error) {
+	var infoList []*EmulatedNLBInfo
+	err := infostore.ListByCondition(&infoList, CONNECTION_NAME_COLUMN, nlbHandler.ConnectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	iidList := []*cres.IID{}
+	for _, info := range infoList {
+		iidList = append(iidList, &cres.IID{NameId: info.NameId, SystemId: info.ProxyVMSystemId})
+	}
+	return iidList, nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) CreateNLB(nlbReqInfo cres.NLBInfo) (createInfo cres.NLBInfo, createErr error) {
+	if _, err := nlbHandler.getEmulatedNLBInfo(nlbReqInfo.IId); err == nil {
+		err := fmt.Errorf("emulated NLB %s already exists!", nlbReqInfo.IId.NameId)
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+
+	setListenerList(&nlbReqInfo)
+	for _, binding := range nlbReqInfo.ListenerList {
+		if err := checkEmulatedNLBListenerBinding(binding); err != nil {
+			cblog.Error(err)
+			return cres.NLBInfo{}, err
+		}
+	}
+	if nlbReqInfo.VMGroup.VMs == nil || len(*nlbReqInfo.VMGroup.VMs) == 0 {
+		err := fmt.Errorf("the emulated NLB requires at least one VM to create the proxy VM")
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+	if nlbReqInfo.Type == "" {
+		nlbReqInfo.Type = "PUBLIC"
+	}
+	if nlbReqInfo.Scope == "" {
+		nlbReqInfo.Scope = "REGION"
+	}
+
+	// (1) the proxy VM is created like the first backend VM
+	baseVM, err := nlbHandler.VMHandler.GetVM((*nlbReqInfo.VMGroup.VMs)[0])
+	if err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+	vmSpecName, err := nlbHandler.getProxyVMSpecName(nlbReqInfo.KeyValueList)
+	if err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+	vpcIID := nlbReqInfo.VpcIID
+	if vpcIID.SystemId == "" {
+		vpcIID = baseVM.VpcIID
+	}
+
+	// (2) create the KeyPair of the proxy VM
+	keyInfo, err := nlbHandler.KeyPairHandler.CreateKey(cres.KeyPairReqInfo{IId: cres.IID{NameId: nlbReqInfo.IId.NameId + emulatedNLBKeySuffix}})
+	if err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+	err = cdcom.AddKey(EMULATED_NLB_PROVIDER, nlbHandler.ConnectionName, keyInfo.IId.NameId, keyInfo.PrivateKey)
+	if err != nil {
+		cblog.Error(err)
+		nlbHandler.deleteKey(keyInfo.IId)
+		return cres.NLBInfo{}, err
+	}
+
+	// (3) create the proxy VM
+	vmInfo, err := nlbHandler.VMHandler.StartVM(cres.VMReqInfo{
+		IId:               cres.IID{NameId: nlbReqInfo.IId.NameId + emulatedNLBVMSuffix},
+		ImageType:         baseVM.ImageType,
+		ImageIID:          baseVM.ImageIId,
+		VpcIID:            vpcIID,
+		SubnetIID:         baseVM.SubnetIID,
+		SecurityGroupIIDs: baseVM.SecurityGroupIIds,
+		VMSpecName:        vmSpecName,
+		KeyPairIID:        keyInfo.IId,
+		VMUserId:          defaultEmulatedNLBVMUser,
+	})
+	if err != nil {
+		cblog.Error(err)
+		nlbHandler.deleteKey(keyInfo.IId)
+		return cres.NLBInfo{}, err
+	}
+	vmUserId := vmInfo.VMUserId
+	if vmUserId == "" {
+		vmUserId = defaultEmulatedNLBVMUser
+	}
+	info := EmulatedNLBInfo{
+		ConnectionName:  nlbHandler.ConnectionName,
+		NameId:          nlbReqInfo.IId.NameId,
+		ProxyVMNameId:   vmInfo.IId.NameId,
+		ProxyVMSystemId: vmInfo.IId.SystemId,
+		KeyPairNameId:   keyInfo.IId.NameId,
+		KeyPairSystemId: keyInfo.IId.SystemId,
+		VMUserId:        vmUserId,
+		CreatedTime:     time.Now(),
+	}
+	defer func() {
+		if createErr != nil { // rollback
+			if _, err := infostore.DeleteByConditions(&EmulatedNLBInfo{}, CONNECTION_NAME_COLUMN, info.ConnectionName, NAME_ID_COLUMN, info.NameId); err != nil {
+				cblog.Error(err)
+			}
+			if _, err := nlbHandler.VMHandler.TerminateVM(vmInfo.IId); err != nil {
+				cblog.Error(err)
+			}
+			nlbHandler.deleteKey(keyInfo.IId)
+		}
+	}()
+
+	// (4) wait for the proxy VM, then configure the proxy and health checker
+	if _, err := nlbHandler.waitProxyVM(info); err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+	nlbReqInfo.IId.SystemId = vmInfo.IId.SystemId
+	nlbReqInfo.VpcIID = vpcIID
+	nlbReqInfo.CreatedTime = info.CreatedTime
+	if err := nlbHandler.applyConfig(info, nlbReqInfo); err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+
+	// (5) save the state
+	if err := saveEmulatedNLBInfo(&info, nlbReqInfo); err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+
+	return nlbHandler.GetNLB(nlbReqInfo.IId)
+}
+
+// getProxyVMSpecName returns the VMSpecName KeyValue of the NLB request or the nlbproxyvmspec of the provider.
+func (nlbHandler *EmulatedNLBHandler) getProxyVMSpecName(keyValueList []cres.KeyValue) (string, error) {
+	for _, kv := range keyValueList {
+		if kv.Key == emulatedNLBVMSpecKey && kv.Value != "" {
+			return kv.Value, nil
+		}
+	}
+
+	cloudOSMetaInfo, err := cim.GetCloudOSMetaInfo(nlbHandler.ProviderName)
+	if err != nil {
+		return "", err
+	}
+	if len(cloudOSMetaInfo.NLBProxyVMSpec) == 0 || cloudOSMetaInfo.NLBProxyVMSpec[0] == "" {
+		return "", fmt.Errorf("the VM spec of the emulated NLB's proxy VM is not set: set the nlbproxyvmspec of %s in cloudos_meta.yaml or the %s KeyValue",
+			nlbHandler.ProviderName, emulatedNLBVMSpecKey)
+	}
+	return cloudOSMetaInfo.NLBProxyVMSpec[0], nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) ListNLB() ([]*cres.NLBInfo, error) {
+	iidList, err := nlbHandler.ListIID()
+	if err != nil {
+		return nil, err
+	}
+
+	infoList := []*cres.NLBInfo{}
+	for _, iid := range iidList {
+		info, err := nlbHandler.GetNLB(*iid)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		infoList = append(infoList, &info)
+	}
+	return infoList, nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) GetNLB(nlbIID cres.IID) (cres.NLBInfo, error) {
+	info, err := nlbHandler.getEmulatedNLBInfo(nlbIID)
+	if err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+	nlbInfo, err := info.getNLBInfo()
+	if err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+
+	vmInfo, err := nlbHandler.VMHandler.GetVM(cres.IID{NameId: info.ProxyVMNameId, SystemId: info.ProxyVMSystemId})
+	if err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+	listenerIP := vmInfo.PublicIP
+	if nlbInfo.Type == "INTERNAL" {
+		listenerIP = vmInfo.PrivateIP
+	}
+	for idx := range nlbInfo.ListenerList {
+		nlbInfo.ListenerList[idx].Listener.IP = listenerIP
+		nlbInfo.ListenerList[idx].Listener.CspID = info.ProxyVMSystemId
+		nlbInfo.ListenerList[idx].VMGroup.CspID = info.ProxyVMSystemId
+	}
+	nlbInfo.Listener = nlbInfo.ListenerList[0].Listener
+	nlbInfo.VMGroup.CspID = info.ProxyVMSystemId
+	nlbInfo.HealthChecker.CspID = info.ProxyVMSystemId
+	nlbInfo.KeyValueList = []cres.KeyValue{
+		{Key: "EmulatedNLB", Value: "true"},
+		{Key: "ProxyVM", Value: info.ProxyVMSystemId},
+	}
+	return nlbInfo, nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) DeleteNLB(nlbIID cres.IID) (bool, error) {
+	info, err := nlbHandler.getEmulatedNLBInfo(nlbIID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	_, err = nlbHandler.VMHandler.TerminateVM(cres.IID{NameId: info.ProxyVMNameId, SystemId: info.ProxyVMSystemId})
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	nlbHandler.deleteKey(cres.IID{NameId: info.KeyPairNameId, SystemId: info.KeyPairSystemId})
+
+	_, err = infostore.DeleteByConditions(&EmulatedNLBInfo{}, CONNECTION_NAME_COLUMN, info.ConnectionName, NAME_ID_COLUMN, info.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) GetVMGroupHealthInfo(nlbIID cres.IID) (cres.HealthInfo, error) {
+	info, err := nlbHandler.getEmulatedNLBInfo(nlbIID)
+	if err != nil {
+		cblog.Error(err)
+		return cres.HealthInfo{}, err
+	}
+	nlbInfo, err := info.getNLBInfo()
+	if err != nil {
+		cblog.Error(err)
+		return cres.HealthInfo{}, err
+	}
+
+	allVMs := []cres.IID{}
+	if nlbInfo.VMGroup.VMs != nil {
+		allVMs = append(allVMs, *nlbInfo.VMGroup.VMs...)
+	}
+	healthyVMs := []cres.IID{}
+	unHealthyVMs := []cres.IID{}
+
+	output, err := nlbHandler.runProxyCommand(info, "cat "+emulatedNLBConfDir+"/healthy 2>/dev/null || true")
+	if err != nil {
+		cblog.Error(err)
+		return cres.HealthInfo{}, err
+	}
+	healthyIPs := map[string]bool{}
+	for _, ip := range strings.Fields(output) {
+		healthyIPs[ip] = true
+	}
+	for _, vmIID := range allVMs {
+		vmInfo, err := nlbHandler.VMHandler.GetVM(vmIID)
+		if err == nil && healthyIPs[vmInfo.PrivateIP] {
+			healthyVMs = append(healthyVMs, vmIID)
+		} else {
+			unHealthyVMs = append(unHealthyVMs, vmIID)
+		}
+	}
+
+	return cres.HealthInfo{AllVMs: &allVMs, HealthyVMs: &healthyVMs, UnHealthyVMs: &unHealthyVMs}, nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) AddVMs(nlbIID cres.IID, vmIIDs *[]cres.IID) (cres.VMGroupInfo, error) {
+	nlbInfo, err := nlbHandler.updateNLB(nlbIID, func(nlbInfo *cres.NLBInfo) error {
+		vms := []cres.IID{}
+		if nlbInfo.VMGroup.VMs != nil {
+			vms = append(vms, *nlbInfo.VMGroup.VMs...)
+		}
+		for _, vmIID := range *vmIIDs {
+			if indexOfIID(vms, vmIID) >= 0 {
+				return fmt.Errorf("%s is already a VM of the NLB %s", vmIID.NameId, nlbIID.NameId)
+			}
+			vms = append(vms, vmIID)
+		}
+		nlbInfo.VMGroup.VMs = &vms
+		return nil
+	})
+	if err != nil {
+		return cres.VMGroupInfo{}, err
+	}
+	return nlbInfo.VMGroup, nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) RemoveVMs(nlbIID cres.IID, vmIIDs *[]cres.IID) (bool, error) {
+	_, err := nlbHandler.updateNLB(nlbIID, func(nlbInfo *cres.NLBInfo) error {
+		vms := []cres.IID{}
+		if nlbInfo.VMGroup.VMs != nil {
+			vms = append(vms, *nlbInfo.VMGroup.VMs...)
+		}
+		for _, vmIID := range *vmIIDs {
+			idx := indexOfIID(vms, vmIID)
+			if idx < 0 {
+				return fmt.Errorf("%s is not a VM of the NLB %s", vmIID.NameId, nlbIID.NameId)
+			}
+			vms = append(vms[:idx], vms[idx+1:]...)
+		}
+		nlbInfo.VMGroup.VMs = &vms
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) ChangeListener(nlbIID cres.IID, listener cres.ListenerInfo) (cres.ListenerInfo, error) {
+	nlbInfo, err := nlbHandler.updateNLB(nlbIID, func(nlbInfo *cres.NLBInfo) error {
+		binding := cres.ListenerBindingInfo{Listener: listener, VMGroup: nlbInfo.ListenerList[0].VMGroup}
+		if err := checkEmulatedNLBListenerBinding(binding); err != nil {
+			return err
+		}
+		for _, other := range nlbInfo.ListenerList[1:] {
+			if isSameListener(other.Listener, listener) {
+				return fmt.Errorf("duplicated Listener: %s:%s", listener.Protocol, listener.Port)
+			}
+		}
+		nlbInfo.ListenerList[0].Listener.Protocol = listener.Protocol
+		nlbInfo.ListenerList[0].Listener.Port = listener.Port
+		return nil
+	})
+	if err != nil {
+		return cres.ListenerInfo{}, err
+	}
+	return nlbInfo.Listener, nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) AddListener(nlbIID cres.IID, listenerBinding cres.ListenerBindingInfo) (cres.NLBInfo, error) {
+	return nlbHandler.updateNLB(nlbIID, func(nlbInfo *cres.NLBInfo) error {
+		if err := checkEmulatedNLBListenerBinding(listenerBinding); err != nil {
+			return err
+		}
+		for _, binding := range nlbInfo.ListenerList {
+			if isSameListener(binding.Listener, listenerBinding.Listener) {
+				return fmt.Errorf("duplicated Listener: %s:%s", listenerBinding.Listener.Protocol, listenerBinding.Listener.Port)
+			}
+		}
+		nlbInfo.ListenerList = append(nlbInfo.ListenerList, listenerBinding)
+		return nil
+	})
+}
+
+func (nlbHandler *EmulatedNLBHandler) RemoveListener(nlbIID cres.IID, listener cres.ListenerInfo) (bool, error) {
+	_, err := nlbHandler.updateNLB(nlbIID, func(nlbInfo *cres.NLBInfo) error {
+		for idx, binding := range nlbInfo.ListenerList {
+			if isSameListener(binding.Listener, listener) {
+				if len(nlbInfo.ListenerList) == 1 {
+					return fmt.Errorf("the last Listener %s:%s of the NLB %s cannot be removed", listener.Protocol, listener.Port, nlbIID.NameId)
+				}
+				nlbInfo.ListenerList = append(nlbInfo.ListenerList[:idx], nlbInfo.ListenerList[idx+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("the NLB %s does not have the Listener %s:%s", nlbIID.NameId, listener.Protocol, listener.Port)
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) ChangeVMGroupInfo(nlbIID cres.IID, vmGroup cres.VMGroupInfo) (cres.VMGroupInfo, error) {
+	nlbInfo, err := nlbHandler.updateNLB(nlbIID, func(nlbInfo *cres.NLBInfo) error {
+		binding := cres.ListenerBindingInfo{Listener: nlbInfo.ListenerList[0].Listener, VMGroup: vmGroup}
+		if err := checkEmulatedNLBListenerBinding(binding); err != nil {
+			return err
+		}
+		nlbInfo.ListenerList[0].VMGroup.Protocol = vmGroup.Protocol
+		nlbInfo.ListenerList[0].VMGroup.Port = vmGroup.Port
+		return nil
+	})
+	if err != nil {
+		return cres.VMGroupInfo{}, err
+	}
+	return nlbInfo.VMGroup, nil
+}
+
+func (nlbHandler *EmulatedNLBHandler) ChangeHealthCheckerInfo(nlbIID cres.IID, healthChecker cres.HealthCheckerInfo) (cres.HealthCheckerInfo, error) {
+	nlbInfo, err := nlbHandler.updateNLB(nlbIID, func(nlbInfo *cres.NLBInfo) error {
+		nlbInfo.HealthChecker = healthChecker
+		return nil
+	})
+	if err != nil {
+		return cres.HealthCheckerInfo{}, err
+	}
+	return nlbInfo.HealthChecker, nil
+}
+
+//================ internal functions of the emulated NLB
+
+func (info *EmulatedNLBInfo) getNLBInfo() (cres.NLBInfo, error) {
+	var nlbInfo cres.NLBInfo
+	if err := json.Unmarshal([]byte(info.NLBInfo), &nlbInfo); err != nil {
+		return cres.NLBInfo{}, err
+	}
+	setListenerList(&nlbInfo)
+	nlbInfo.Listener = nlbInfo.ListenerList[0].Listener
+	nlbInfo.VMGroup.Protocol = nlbInfo.ListenerList[0].VMGroup.Protocol
+	nlbInfo.VMGroup.Port = nlbInfo.ListenerList[0].VMGroup.Port
+	return nlbInfo, nil
+}
+
+func saveEmulatedNLBInfo(info *EmulatedNLBInfo, nlbInfo cres.NLBInfo) error {
+	setListenerList(&nlbInfo)
+	nlbInfo.KeyValueList = nil
+	jsonInfo, err := json.Marshal(nlbInfo)
+	if err != nil {
+		return err
+	}
+	info.NLBInfo = string(jsonInfo)
+	return infostore.Insert(info)
+}
+
+// getEmulatedNLBInfo finds the emulated NLB by the NameId, or by the SystemId if the NameId is empty.
+func (nlbHandler *EmulatedNLBHandler) getEmulatedNLBInfo(nlbIID cres.IID) (EmulatedNLBInfo, error) {
+	var infoList []*EmulatedNLBInfo
+	err := infostore.ListByCondition(&infoList, CONNECTION_NAME_COLUMN, nlbHandler.ConnectionName)
+	if err != nil {
+		return EmulatedNLBInfo{}, err
+	}
+	for _, info := range infoList {
+		if (nlbIID.NameId != "" && info.NameId == nlbIID.NameId) ||
+			(nlbIID.NameId == "" && nlbIID.SystemId != "" && info.ProxyVMSystemId == nlbIID.SystemId) {
+			return *info, nil
+		}
+	}
+	name := nlbIID.NameId
+	if name == "" {
+		name = nlbIID.SystemId
+	}
+	return EmulatedNLBInfo{}, fmt.Errorf("the emulated NLB %s does not exist in the connection %s", name, nlbHandler.ConnectionName)
+}
+
+// updateNLB changes the NLBInfo with the changeFunc, applies it to the proxy VM, and then saves it.
+func (nlbHandler *EmulatedNLBHandler) updateNLB(nlbIID cres.IID, changeFunc func(nlbInfo *cres.NLBInfo) error) (cres.NLBInfo, error) {
+	info, err := nlbHandler.getEmulatedNLBInfo(nlbIID)
+	if err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+	nlbInfo, err := info.getNLBInfo()
+	if err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+
+	if err := changeFunc(&nlbInfo); err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+	setListenerList(&nlbInfo)
+
+	if err := nlbHandler.applyConfig(info, nlbInfo); err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+	if err := saveEmulatedNLBInfo(&info, nlbInfo); err != nil {
+		cblog.Error(err)
+		return cres.NLBInfo{}, err
+	}
+	return nlbHandler.GetNLB(cres.IID{NameId: info.NameId, SystemId: info.ProxyVMSystemId})
+}
+
+// waitProxyVM waits until the proxy VM has a PublicIP and SSH is ready.
+func (nlbHandler *EmulatedNLBHandler) waitProxyVM(info EmulatedNLBInfo) (cres.VMInfo, error) {
+	vmIID := cres.IID{NameId: info.ProxyVMNameId, SystemId: info.ProxyVMSystemId}
+
+	var vmInfo cres.VMInfo
+	var err error
+	waiter := NewWaiter(5, 600) // (sleep, timeout)
+	for {
+		vmInfo, err = nlbHandler.VMHandler.GetVM(vmIID)
+		if err == nil && vmInfo.PublicIP != "" { // CB-Spider configures the proxy VM with its PublicIP
+			break
+		}
+		if !waiter.Wait() {
+			return cres.VMInfo{}, fmt.Errorf("failed to get the IP of the proxy VM %s: %v", info.ProxyVMNameId, err)
+		}
+	}
+
+	if err := nlbHandler.ProxyExecutor.WaitReady(vmInfo.PublicIP); err != nil {
+		return cres.VMInfo{}, fmt.Errorf("the proxy VM %s is not ready: %v", info.ProxyVMNameId, err)
+	}
+	return vmInfo, nil
+}
+
+// applyConfig pushes the proxy and health checker configuration of the nlbInfo to the proxy VM.
+func (nlbHandler *EmulatedNLBHandler) applyConfig(info EmulatedNLBInfo, nlbInfo cres.NLBInfo) error {
+	backendIPs := []string{}
+	if nlbInfo.VMGroup.VMs != nil {
+		for _, vmIID := range *nlbInfo.VMGroup.VMs {
+			vmInfo, err := nlbHandler.VMHandler.GetVM(vmIID)
+			if err != nil {
+				return err
+			}
+			if vmInfo.PrivateIP == "" {
+				return fmt.Errorf("the VM %s has no private IP", vmIID.NameId)
+			}
+			backendIPs = append(backendIPs, vmInfo.PrivateIP)
+		}
+	}
+
+	script, err := GenEmulatedNLBSetupScript(nlbInfo, backendIPs)
+	if err != nil {
+		return err
+	}
+	// the cloud-init of a new VM can be still running, so retry for a while
+	waiter := NewWaiter(5, 300) // (sleep, timeout)
+	for {
+		_, err = nlbHandler.runProxyCommand(info, "sudo bash -c "+shellQuote(script))
+		if err == nil {
+			return nil
+		}
+		cblog.Error(err)
+		if !waiter.Wait() {
+			return fmt.Errorf("failed to configure the proxy VM %s: %v", info.ProxyVMNameId, err)
+		}
+	}
+}
+
+func (nlbHandler *EmulatedNLBHandler) runProxyCommand(info EmulatedNLBInfo, cmd string) (string, error) {
+	vmInfo, err := nlbHandler.VMHandler.GetVM(cres.IID{NameId: info.ProxyVMNameId, SystemId: info.ProxyVMSystemId})
+	if err != nil {
+		return "", err
+	}
+	key, err := cdcom.GetKey(EMULATED_NLB_PROVIDER, info.ConnectionName, info.KeyPairNameId)
+	if err != nil {
+		return "", err
+	}
+
+	return nlbHandler.ProxyExecutor.Run(vmInfo.PublicIP, info.VMUserId, []byte(key.Value), cmd)
+}
+
+func (nlbHandler *EmulatedNLBHandler) deleteKey(keyIID cres.IID) {
+	if _, err := nlbHandler.KeyPairHandler.DeleteKey(keyIID); err != nil {
+		cblog.Error(err)
+	}
+	if err := cdcom.DelKey(EMULATED_NLB_PROVIDER, nlbHandler.ConnectionName, keyIID.NameId); err != nil {
+		cblog.Error(err)
+	}
+}
+
+func checkEmulatedNLBListenerBinding(binding cres.ListenerBindingInfo) error {
+	if err := checkListenerBinding(binding); err != nil {
+		return err
+	}
+	for _, protocol := range []string{binding.Listener.Protocol, binding.VMGroup.Protocol} {
+		if protocol != "TCP" && protocol != "UDP" {
+			return fmt.Errorf("the emulated NLB supports only TCP and UDP, but %s is given", protocol)
+		}
+	}
+	if binding.Listener.Protocol != binding.VMGroup.Protocol {
+		return fmt.Errorf("the protocols of the Listener(%s) and VMGroup(%s) must be the same in the emulated NLB",
+			binding.Listener.Protocol, binding.VMGroup.Protocol)
+	}
+	return nil
+}
+
+func indexOfIID(iidList []cres.IID, iid cres.IID) int {
+	for idx, one := range iidList {
+		if (iid.SystemId != "" && one.SystemId == iid.SystemId) || (iid.SystemId == "" && one.NameId == iid.NameId) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// shellQuote quotes a string as a single argument of the shell.
+func shellQuote(str string) string {
+	return "'" + strings.ReplaceAll(str, "'", `'\''`) + "'"
+}
+
+//================ proxy and health checker configuration of the emulated NLB
+
+const emulatedNLBConfDir = "/etc/spider-nlb"
+
+// GenEmulatedNLBSetupScript returns the shell script which sets up the proxy and health checker of the emulated NLB.
+// The script is idempotent, so it is run again to apply every change of the NLB.
+func GenEmulatedNLBSetupScript(nlbInfo cres.NLBInfo, backendIPs []string) (string, error) {
+	setListenerList(&nlbInfo)
+
+	// listeners: "<protocol> <listener port> <backend port> <health check port>" per line
+	// The backends of each Listener are checked at the HealthChecker's port or the Listener's backend port.
+	// A UDP Listener without the HealthChecker's port is not checked("-"), UDP can not be checked by a connection.
+	var listeners strings.Builder
+	for _, binding := range nlbInfo.ListenerList {
+		if err := checkEmulatedNLBListenerBinding(binding); err != nil {
+			return "", err
+		}
+		for _, port := range []string{binding.Listener.Port, binding.VMGroup.Port} {
+			if !isValidPort(port) {
+				return "", fmt.Errorf("invalid port: %s", port)
+			}
+		}
+		healthCheckPort := nlbInfo.HealthChecker.Port
+		if healthCheckPort == "" {
+			healthCheckPort = binding.VMGroup.Port
+			if binding.VMGroup.Protocol == "UDP" {
+				healthCheckPort = "-"
+			}
+		}
+		fmt.Fprintf(&listeners, "%s %s %s %s\n", binding.Listener.Protocol, binding.Listener.Port, binding.VMGroup.Port, healthCheckPort)
+	}
+
+	sortedIPs := append([]string{}, backendIPs...)
+	sort.Strings(sortedIPs)
+	for _, ip := range sortedIPs {
+		if strings.ContainsAny(ip, " \t\r\n'\"") {
+			return "", fmt.Errorf("invalid IP of a backend VM: %s", ip)
+		}
+	}
+
+	healthCheckConf, err := genEmulatedNLBHealthCheckConf(nlbInfo.HealthChecker)
+	if err != nil {
+		return "", err
+	}
+
+	var script strings.Builder
+	script.WriteString(emulatedNLBInstallScript)
+	writeFileScript(&script, emulatedNLBConfDir+"/listeners", listeners.String())
+	writeFileScript(&script, emulatedNLBConfDir+"/backends", strings.Join(sortedIPs, "\n")+"\n")
+	writeFileScript(&script, emulatedNLBConfDir+"/healthcheck.conf", healthCheckConf)
+	writeFileScript(&script, "/usr/local/bin/spider-nlb-healthcheck", emulatedNLBHealthCheckScript)
+	writeFileScript(&script, "/etc/systemd/system/spider-nlb-healthcheck.service", emulatedNLBHealthCheckService)
+	script.WriteString(emulatedNLBStartScript)
+	return script.String(), nil
+}
+
+func genEmulatedNLBHealthCheckConf(healthChecker cres.HealthCheckerInfo) (string, error) {
+	protocol := strings.ToUpper(healthChecker.Protocol)
+	if protocol == "" {
+		protocol = "TCP"
+	}
+	if protocol != "TCP" && protocol != "HTTP" {
+		return "", fmt.Errorf("the emulated NLB supports only TCP and HTTP Health Checkers, but %s is given", healthChecker.Protocol)
+	}
+	if healthChecker.Port != "" && !isValidPort(healthChecker.Port) {
+		return "", fmt.Errorf("invalid port of the Health Checker: %s", healthChecker.Port)
+	}
+	for _, value := range []string{healthChecker.HTTPPath, healthChecker.ExpectedStatusCodes, healthChecker.HostHeader} {
+		if strings.ContainsAny(value, "\r\n") {
+			return "", fmt.Errorf("the options of the Health Checker cannot have a new line")
+		}
+	}
+
+	// not positive values are set to the Spider's default values
+	positive := func(value int, defaultValue int) int {
+		if value > 0 {
+			return value
+		}
+		return defaultValue
+	}
+	httpPath := healthChecker.HTTPPath
+	if httpPath == "" {
+		httpPath = "/"
+	}
+	statusCodes := healthChecker.ExpectedStatusCodes
+	if statusCodes == "" {
+		statusCodes = "200-399"
+	}
+
+	// the port is in the listeners file to check each Listener at its own port
+	conf := fmt.Sprintf("PROTOCOL=%s\nINTERVAL=%d\nTIMEOUT=%d\nTHRESHOLD=%d\nHTTP_PATH=%s\nEXPECTED_STATUS_CODES=%s\nHOST_HEADER=%s\n",
+		protocol, positive(healthChecker.Interval, 10), positive(healthChecker.Timeout, 5), positive(healthChecker.Threshold, 3),
+		shellQuote(httpPath), shellQuote(statusCodes), shellQuote(healthChecker.HostHeader))
+	return conf, nil
+}
+
+func isValidPort(port string) bool {
+	num, err := strconv.Atoi(port)
+	return err == nil && num >= 1 && num <= 65535
+}
+
+// writeFileScript appends the script to write the content to the filePath with a quoted here-document.
+func writeFileScript(script *strings.Builder, filePath string, content string) {
+	fmt.Fprintf(script, "cat > %s <<'SPIDER_NLB_EOF'\n%sSPIDER_NLB_EOF\n", filePath, content)
+}
+
+const emulatedNLBInstallScript = `set -e
+if ! command -v nginx >/dev/null 2>&1; then
+  if command -v apt-get >/dev/null 2>&1; then
+    export DEBIAN_FRONTEND=noninteractive
+    apt-get update -y && apt-get install -y nginx libnginx-mod-stream curl
+  else
+    yum install -y nginx nginx-mod-stream curl
+  fi
+fi
+mkdir -p /etc/spider-nlb /etc/nginx/spider-nlb /var/lib/spider-nlb
+# the default site listens on port 80, which a TCP:80 Listener needs
+rm -f /etc/nginx/sites-enabled/default
+if [ -f /etc/nginx/conf.d/default.conf ]; then mv -f /etc/nginx/conf.d/default.conf /etc/nginx/conf.d/default.conf.disabled; fi
+grep -q "/etc/nginx/spider-nlb/" /etc/nginx/nginx.conf || printf '\nstream {\n    include /etc/nginx/spider-nlb/*.conf;\n}\n' >> /etc/nginx/nginx.conf
+`
+
+const emulatedNLBStartScript = `chmod +x /usr/local/bin/spider-nlb-healthcheck
+systemctl daemon-reload
+systemctl enable nginx spider-nlb-healthcheck
+systemctl start nginx
+/usr/local/bin/spider-nlb-healthcheck --once
+systemctl restart spider-nlb-healthcheck
+`
+
+const emulatedNLBHealthCheckService = `[Unit]
+Description=CB-Spider emulated NLB health checker
+After=network.target nginx.service
+
+[Service]
+ExecStart=/usr/local/bin/spider-nlb-healthcheck
+Restart=always
+
+[Install]
+WantedBy=multi-user.target
+`
+
+// emulatedNLBHealthCheckScript checks the backends of each Listener with the healthcheck.conf,
+// makes the nginx upstream of each Listener with its healthy backends(/etc/spider-nlb/healthy.<n>),
+// and writes the backends healthy in all Listeners to /etc/spider-nlb/healthy.
+const emulatedNLBHealthCheckScript = `#!/bin/bash
+# Health checker of the CB-Spider emulated NLB
+CONF_DIR=/etc/spider-nlb
+STATE_DIR=/var/lib/spider-nlb
+NGINX_CONF=/etc/nginx/spider-nlb/spider-nlb.conf
+
+match_code() {
+  local code=$1 range
+  if [ -z "$code" ] || [ "$code" = "000" ]; then return 1; fi
+  IFS=',' read -ra ranges <<< "$EXPECTED_STATUS_CODES"
+  for range in "${ranges[@]}"; do
+    if [ "${range#*-}" != "$range" ]; then
+      [ "$code" -ge "${range%-*}" ] && [ "$code" -le "${range#*-}" ] && return 0
+    else
+      [ "$code" -eq "$range" ] && return 0
+    fi
+  done
+  return 1
+}
+
+check_backend() {
+  local ip=$1 port=$2 code
+  if [ "$PROTOCOL" = "HTTP" ]; then
+    local args=()
+    [ -n "$HOST_HEADER" ] && args=(-H "Host: $HOST_HEADER")
+    code=$(curl -s -o /dev/null -w '%{http_code}' --max-time "$TIMEOUT" "${args[@]}" "http://$ip:$port$HTTP_PATH")
+    match_code "$code"
+  else
+    timeout "$TIMEOUT" bash -c "</dev/tcp/$ip/$port" >/dev/null 2>&1
+  fi
+}
+
+gen_nginx_conf() {
+  local idx=0 proto lport bport hport ip servers udp
+  : > $NGINX_CONF.tmp
+  while read -r proto lport bport hport; do
+    [ -z "$hport" ] && continue
+    idx=$((idx+1))
+    echo "upstream spider_nlb_$idx {" >> $NGINX_CONF.tmp
+    servers=0
+    while read -r ip; do
+      [ -z "$ip" ] && continue
+      if grep -qxF "$ip" $CONF_DIR/healthy.$idx; then
+        echo "    server $ip:$bport;" >> $NGINX_CONF.tmp
+      else
+        echo "    server $ip:$bport down;" >> $NGINX_CONF.tmp
+      fi
+      servers=$((servers+1))
+    done < $CONF_DIR/backends
+    [ $servers -eq 0 ] && echo "    server 127.0.0.1:$bport down;" >> $NGINX_CONF.tmp
+    echo "}" >> $NGINX_CONF.tmp
+    udp=""
+    [ "$proto" = "UDP" ] && udp=" udp"
+    printf 'server {\n    listen %s%s;\n    proxy_pass spider_nlb_%s;\n}\n' "$lport" "$udp" "$idx" >> $NGINX_CONF.tmp
+  done < $CONF_DIR/listeners
+
+  if cmp -s $NGINX_CONF.tmp $NGINX_CONF; then
+    rm -f $NGINX_CONF.tmp
+  else
+    mv $NGINX_CONF.tmp $NGINX_CONF
+    nginx -s reload 2>/dev/null || systemctl restart nginx
+  fi
+}
+
+# check_port checks ip:port, applies the THRESHOLD to the state of ip:port, and prints the state
+check_port() {
+  local ip=$1 port=$2 status=healthy count=0 result state=$STATE_DIR/$1-$2
+  [ -f "$state" ] && read -r status count < "$state"
+  if check_backend "$ip" "$port"; then result=healthy; else result=unhealthy; fi
+  if [ "$result" = "$status" ]; then
+    count=0
+  else
+    count=$((count+1))
+    if [ $count -ge $THRESHOLD ]; then status=$result; count=0; fi
+  fi
+  echo "$status $count" > "$state"
+  echo "$status"
+}
+
+run_once() {
+  . $CONF_DIR/healthcheck.conf
+  local idx=0 proto lport bport hport ip status
+  local -A checked
+  rm -f $CONF_DIR/healthy.*
+  cp $CONF_DIR/backends $CONF_DIR/healthy.all
+  while read -r proto lport bport hport; do
+    [ -z "$hport" ] && continue
+    idx=$((idx+1))
+    : > $CONF_DIR/healthy.$idx
+    while read -r ip; do
+      [ -z "$ip" ] && continue
+      if [ "$hport" = "-" ]; then
+        status=healthy
+      elif [ -n "${checked[$ip-$hport]}" ]; then
+        status=${checked[$ip-$hport]}
+      else
+        status=$(check_port "$ip" "$hport" </dev/null)
+        checked[$ip-$hport]=$status
+      fi
+      [ "$status" = "healthy" ] && echo "$ip" >> $CONF_DIR/healthy.$idx
+    done < $CONF_DIR/backends
+    # a backend is healthy if it is healthy in all Listeners
+    grep -xF -f $CONF_DIR/healthy.$idx $CONF_DIR/healthy.all > $CONF_DIR/healthy.tmp || true
+    mv $CONF_DIR/healthy.tmp $CONF_DIR/healthy.all
+  done < $CONF_DIR/listeners
+  mv $CONF_DIR/healthy.all $CONF_DIR/healthy
+  gen_nginx_conf
+}
+
+mkdir -p $STATE_DIR
+if [ "$1" = "--once" ]; then
+  ( flock 9; run_once ) 9>/var/lock/spider-nlb.lock
+  exit 0
+fi
+while true; do
+  ( flock 9; run_once ) 9>/var/lock/spider-nlb.lock
+  . $CONF_DIR/healthcheck.conf
+  sleep "$INTERVAL"
+done
+`
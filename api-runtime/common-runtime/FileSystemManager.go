@@ -26,7 +26,7 @@ func init() {
 		cblog.Error(err)
 		return
 	}
-	db.AutoMigrate(&FileSystemIIDInfo{})
+	infostore.AutoMigrate(db, &FileSystemIIDInfo{})
 	infostore.Close(db)
 }
 
@@ -0,0 +1,417 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/xid"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// type for GORM
+
+// JobPhase represents the lifecycle phase of an asynchronous job.
+type JobPhase string
+
+const (
+	JobPending   JobPhase = "Pending"   // queued, waiting for a free worker
+	JobRunning   JobPhase = "Running"   // running on a worker
+	JobSucceeded JobPhase = "Succeeded" // finished without error
+	JobFailed    JobPhase = "Failed"    // finished with error
+	JobCanceling JobPhase = "Canceling" // cancel requested while running
+	JobCanceled  JobPhase = "Canceled"  // canceled by user
+)
+
+// JobInfo keeps the state of an asynchronous job in the MetaDB.
+type JobInfo struct {
+	JobId          string    `gorm:"primaryKey" json:"JobId" example:"cs5nt4bd8d9c73b4d1i0"`
+	Operation      string    `json:"Operation" example:"StartVM"` // ex) StartVM, CreateCluster, CreateNLB, Destroy
+	ConnectionName string    `gorm:"index" json:"ConnectionName" example:"aws-connection"`
+	ResourceType   string    `json:"ResourceType,omitempty" example:"vm"`    // ex) vm, cluster, nlb, "" for Destroy
+	ResourceName   string    `json:"ResourceName,omitempty" example:"vm-01"` // user-given name of the target resource
+	Phase          JobPhase  `json:"Phase" example:"Running"`
+	Progress       int       `json:"Progress" example:"10"` // coarse state, not a measured ratio: 0(Pending), 10(Running), 100(finished)
+	Result         string    `json:"-"`                     // JSON text of the result
+	ErrorMsg       string    `json:"ErrorMsg,omitempty" example:""`
+	CreatedTime    time.Time `json:"CreatedTime"`
+	UpdatedTime    time.Time `json:"UpdatedTime"`
+}
+
+func (JobInfo) TableName() string {
+	return "job_infos"
+}
+
+//====================================================================
+
+// JobFunc is the work executed by a job.
+// The ctx is canceled when a user cancels the job.
+type JobFunc func(ctx context.Context) (interface{}, error)
+
+const defaultJobWorkerNum = 20
+
+// finished jobs are deleted after the retention, and only the latest ones are kept
+//   - SPIDER_JOB_RETENTION_HOURS: retention of a finished job (default: 168)
+//   - SPIDER_JOB_MAX_NUM: max number of finished jobs (default: 1000)
+const (
+	defaultJobRetentionHours = 168
+	defaultJobMaxNum         = 1000
+	jobPruneInterval         = 1 * time.Hour
+)
+
+// ErrJobNotFound is returned when no job has the requested JobId.
+var ErrJobNotFound = errors.New("job does not exist")
+
+var (
+	jobSemaphore chan struct{}
+
+	jobCancelMap  = map[string]context.CancelFunc{}
+	jobCancelLock sync.Mutex
+
+	// serialize the read-modify-write of a job's state
+	jobStateLock sync.Mutex
+)
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	infostore.AutoMigrate(db, &JobInfo{})
+	infostore.Close(db)
+
+	workerNum := getPositiveEnvInt("SPIDER_JOB_WORKER_NUM", defaultJobWorkerNum)
+	jobSemaphore = make(chan struct{}, workerNum)
+}
+
+var startJobManagerOnce sync.Once
+
+// StartJobManager fails the unfinished jobs of a previous server, and prunes the old finished jobs periodically.
+// It is called by the API server after it takes the server lock,
+// so a CLI command or a second server does not touch the jobs of a running server.
+func StartJobManager() {
+	startJobManagerOnce.Do(func() {
+		// jobs of a previous server process can not be resumed
+		markInterruptedJobs()
+
+		retention := time.Duration(getPositiveEnvInt("SPIDER_JOB_RETENTION_HOURS", defaultJobRetentionHours)) * time.Hour
+		maxNum := getPositiveEnvInt("SPIDER_JOB_MAX_NUM", defaultJobMaxNum)
+		go func() {
+			for {
+				if _, err := PruneJobs(retention, maxNum); err != nil {
+					cblog.Error(err)
+				}
+				time.Sleep(jobPruneInterval)
+			}
+		}()
+	})
+}
+
+func getPositiveEnvInt(envName string, defaultValue int) int {
+	strNum := os.Getenv(envName)
+	if strNum == "" {
+		return defaultValue
+	}
+	num, err := strconv.Atoi(strNum)
+	if err != nil || num < 1 {
+		cblog.Errorf("%s(%s) is not a positive number, use default(%d).", envName, strNum, defaultValue)
+		return defaultValue
+	}
+	return num
+}
+
+// PruneJobs deletes the finished jobs updated before the retention,
+// and the oldest finished jobs over maxNum. It returns the number of deleted jobs.
+func PruneJobs(retention time.Duration, maxNum int) (int64, error) {
+	db, err := infostore.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer infostore.Close(db)
+
+	finishedPhases := []JobPhase{JobSucceeded, JobFailed, JobCanceled}
+
+	result := db.Where("phase IN ? AND updated_time < ?", finishedPhases, time.Now().Add(-retention)).Delete(&JobInfo{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	deleted := result.RowsAffected
+
+	var count int64
+	if err := db.Model(&JobInfo{}).Where("phase IN ?", finishedPhases).Count(&count).Error; err != nil {
+		return deleted, err
+	}
+	if count <= int64(maxNum) {
+		return deleted, nil
+	}
+	var oldJobIdList []string
+	err = db.Model(&JobInfo{}).Where("phase IN ?", finishedPhases).Order("updated_time").
+		Limit(int(count-int64(maxNum))).Pluck("job_id", &oldJobIdList).Error
+	if err != nil {
+		return deleted, err
+	}
+	result = db.Where("job_id IN ?", oldJobIdList).Delete(&JobInfo{})
+	return deleted + result.RowsAffected, result.Error
+}
+
+//================ Job Handler
+
+// SubmitJob registers a new job and runs it in the background.
+// It returns the registered JobInfo right away.
+func SubmitJob(operation string, connectionName string, rsType string, rsName string, fn JobFunc) (JobInfo, error) {
+	cblog.Info("call SubmitJob()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return JobInfo{}, err
+	}
+
+	now := time.Now()
+	job := JobInfo{
+		JobId:          xid.New().String(),
+		Operation:      operation,
+		ConnectionName: connectionName,
+		ResourceType:   rsType,
+		ResourceName:   rsName,
+		Phase:          JobPending,
+		Progress:       0,
+		CreatedTime:    now,
+		UpdatedTime:    now,
+	}
+	if err := infostore.Insert(&job); err != nil {
+		cblog.Error(err)
+		return JobInfo{}, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	jobCancelLock.Lock()
+	jobCancelMap[job.JobId] = cancel
+	jobCancelLock.Unlock()
+
+	go runJob(ctx, job.JobId, fn)
+
+	return job, nil
+}
+
+func runJob(ctx context.Context, jobId string, fn JobFunc) {
+	defer func() {
+		jobCancelLock.Lock()
+		if cancel, ok := jobCancelMap[jobId]; ok {
+			cancel()
+			delete(jobCancelMap, jobId)
+		}
+		jobCancelLock.Unlock()
+	}()
+
+	// wait for a free worker
+	select {
+	case jobSemaphore <- struct{}{}:
+	case <-ctx.Done():
+		updateJobState(jobId, func(job *JobInfo) bool {
+			job.Phase = JobCanceled
+			return true
+		})
+		return
+	}
+	defer func() { <-jobSemaphore }()
+
+	started := updateJobState(jobId, func(job *JobInfo) bool {
+		if job.Phase != JobPending { // canceled while waiting
+			return false
+		}
+		job.Phase = JobRunning
+		job.Progress = 10
+		return true
+	})
+	if !started {
+		return
+	}
+
+	result, err := runJobFunc(ctx, fn)
+
+	updateJobState(jobId, func(job *JobInfo) bool {
+		job.Progress = 100
+		if err != nil {
+			if ctx.Err() != nil {
+				job.Phase = JobCanceled
+			} else {
+				job.Phase = JobFailed
+			}
+			job.ErrorMsg = err.Error()
+			return true
+		}
+		// The operation was already completed on the CSP, so the job succeeds
+		// even if a cancellation was requested meanwhile.
+		job.Phase = JobSucceeded
+		jsonResult, jsonErr := json.Marshal(result)
+		if jsonErr != nil {
+			cblog.Error(jsonErr)
+			return true
+		}
+		job.Result = string(jsonResult)
+		return true
+	})
+}
+
+// runJobFunc runs fn and turns a panic into an error,
+// so a faulty job can not stop the server.
+func runJobFunc(ctx context.Context, fn JobFunc) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panic: %v", r)
+			cblog.Error(err)
+		}
+	}()
+	return fn(ctx)
+}
+
+// updateJobState applies update to the stored job and saves it if update returns true.
+// It returns true if the job was changed and saved.
+func updateJobState(jobId string, update func(job *JobInfo) bool) bool {
+	jobStateLock.Lock()
+	defer jobStateLock.Unlock()
+
+	var job JobInfo
+	err := infostore.Get(&job, "job_id", jobId)
+	if err != nil {
+		cblog.Error(err)
+		return false
+	}
+
+	if !update(&job) {
+		return false
+	}
+	job.UpdatedTime = time.Now()
+	if err := infostore.Insert(&job); err != nil {
+		cblog.Error(err)
+		return false
+	}
+	return true
+}
+
+func markInterruptedJobs() {
+	var jobList []*JobInfo
+	if err := infostore.List(&jobList); err != nil {
+		cblog.Error(err)
+		return
+	}
+	for _, job := range jobList {
+		if isJobFinished(job.Phase) {
+			continue
+		}
+		job.Phase = JobFailed
+		job.ErrorMsg = "interrupted by a restart of CB-Spider"
+		job.UpdatedTime = time.Now()
+		if err := infostore.Insert(job); err != nil {
+			cblog.Error(err)
+		}
+	}
+}
+
+func isJobFinished(phase JobPhase) bool {
+	return phase == JobSucceeded || phase == JobFailed || phase == JobCanceled
+}
+
+// GetJob returns the state of a job.
+func GetJob(jobId string) (JobInfo, error) {
+	cblog.Info("call GetJob()")
+
+	jobId, err := EmptyCheckAndTrim("jobId", jobId)
+	if err != nil {
+		cblog.Error(err)
+		return JobInfo{}, err
+	}
+
+	var job JobInfo
+	bool_ret, err := infostore.Has(&job, "job_id", jobId)
+	if err != nil {
+		cblog.Error(err)
+		return JobInfo{}, err
+	}
+	if !bool_ret {
+		err := fmt.Errorf("%s: %w!", jobId, ErrJobNotFound)
+		cblog.Error(err)
+		return JobInfo{}, err
+	}
+	if err := infostore.Get(&job, "job_id", jobId); err != nil {
+		cblog.Error(err)
+		return JobInfo{}, err
+	}
+	return job, nil
+}
+
+// ListJob returns all jobs, or the jobs of a connection if connectionName is not empty.
+func ListJob(connectionName string) ([]*JobInfo, error) {
+	cblog.Info("call ListJob()")
+
+	var jobList []*JobInfo
+	var err error
+	if connectionName == "" {
+		err = infostore.List(&jobList)
+	} else {
+		err = infostore.ListByCondition(&jobList, CONNECTION_NAME_COLUMN, connectionName)
+	}
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	return jobList, nil
+}
+
+// CancelJob requests the cancellation of a job.
+// A pending job is canceled right away. A running job goes to Canceling
+// and ends as Canceled when its operation returns an error.
+func CancelJob(jobId string) (JobInfo, error) {
+	cblog.Info("call CancelJob()")
+
+	job, err := GetJob(jobId)
+	if err != nil {
+		return JobInfo{}, err
+	}
+	if isJobFinished(job.Phase) {
+		err := fmt.Errorf("job %s is already %s!", job.JobId, job.Phase)
+		cblog.Error(err)
+		return JobInfo{}, err
+	}
+
+	jobCancelLock.Lock()
+	cancel, ok := jobCancelMap[job.JobId]
+	jobCancelLock.Unlock()
+	if !ok {
+		err := fmt.Errorf("job %s is not running on this server!", job.JobId)
+		cblog.Error(err)
+		return JobInfo{}, err
+	}
+
+	updateJobState(job.JobId, func(job *JobInfo) bool {
+		switch job.Phase {
+		case JobPending:
+			job.Phase = JobCanceled
+		case JobRunning:
+			job.Phase = JobCanceling
+		default:
+			return false
+		}
+		return true
+	})
+	cancel()
+
+	return GetJob(job.JobId)
+}
@@ -35,7 +35,7 @@ func init() {
 		cblog.Error(err)
 		return
 	}
-	db.AutoMigrate(&KeyIIDInfo{})
+	infostore.AutoMigrate(db, &KeyIIDInfo{})
 	infostore.Close(db)
 }
 
@@ -66,6 +66,10 @@ func RegisterKey(connectionName string, userIID cres.IID) (*cres.KeyPairInfo, er
 
 	rsType := KEY
 
+	if err := checkNotEmulatedNLBResource(connectionName, rsType, userIID.SystemId); err != nil {
+		return nil, err
+	}
+
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
 		cblog.Error(err)
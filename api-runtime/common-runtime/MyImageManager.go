@@ -9,6 +9,7 @@
 package commonruntime
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -35,7 +36,7 @@ func init() {
 		cblog.Error(err)
 		return
 	}
-	db.AutoMigrate(&MyImageIIDInfo{})
+	infostore.AutoMigrate(db, &MyImageIIDInfo{})
 	infostore.Close(db)
 }
 
@@ -138,6 +139,11 @@ func RegisterMyImage(connectionName string, userIID cres.IID) (*cres.MyImageInfo
 // (5) insert spiderIID
 // (6) create userIID
 func SnapshotVM(connectionName string, rsType string, reqInfo cres.MyImageInfo, IDTransformMode string) (*cres.MyImageInfo, error) {
+	return SnapshotVMWithContext(context.Background(), connectionName, rsType, reqInfo, IDTransformMode)
+}
+
+// SnapshotVMWithContext is SnapshotVM with a ctx to cancel the request.
+func SnapshotVMWithContext(ctx context.Context, connectionName string, rsType string, reqInfo cres.MyImageInfo, IDTransformMode string) (*cres.MyImageInfo, error) {
 	cblog.Info("call SnapshotVM()")
 
 	// check empty and trim user inputs
@@ -241,14 +247,15 @@ func SnapshotVM(connectionName string, rsType string, reqInfo cres.MyImageInfo,
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateMyImageHandler()
+	myImageHandler, err := cldConn.CreateMyImageHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
+	handler := cres.ToMyImageHandlerWithContext(myImageHandler)
 
 	// (3) create Resource
-	info, err := handler.SnapshotVM(reqInfo)
+	info, err := handler.SnapshotVMWithContext(ctx, reqInfo)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -0,0 +1,338 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// Common Runtime for NATGatewayHandler interface
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// -------- IID Info for NATGateway
+
+type NATGatewayIIDInfo VPCDependentIIDInfo
+
+func (NATGatewayIIDInfo) TableName() string {
+	return "natgateway_iid_infos"
+}
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	infostore.AutoMigrate(db, &NATGatewayIIDInfo{})
+	infostore.Close(db)
+}
+
+// -------- NATGateway Common Runtime
+
+// (1) check exist(NameID)
+// (2) set the driver IIDs of VPC and Subnet
+// (3) create the NATGateway with a SP-XID
+// (4) insert spiderIID
+func CreateNATGateway(connectionName string, rsType string, vpcName string, reqInfo cres.NATGatewayInfo, IDTransformMode string) (*cres.NATGatewayInfo, error) {
+	cblog.Info("call CreateNATGateway()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	vpcName, err = EmptyCheckAndTrim("vpcName", vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	natGatewaySPLock.Lock(connectionName, reqInfo.IId.NameId)
+	defer natGatewaySPLock.Unlock(connectionName, reqInfo.IId.NameId)
+
+	// (1) check exist(NameID)
+	exist, err := infostore.HasByConditions(&NATGatewayIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.IId.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if exist {
+		err := fmt.Errorf("NATGateway %s already exists", reqInfo.IId.NameId)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) set the driver IIDs of VPC and Subnet
+	reqInfo.VpcIID, err = getVPCDriverIID(connectionName, vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var subnetIIDInfo SubnetIIDInfo
+	err = infostore.GetBy3Conditions(&subnetIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.SubnetIID.NameId,
+		OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	reqInfo.SubnetIID = getDriverIID(cres.IID{NameId: subnetIIDInfo.NameId, SystemId: subnetIIDInfo.SystemId})
+
+	handler, err := getNATGatewayHandler(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) create the NATGateway with a SP-XID
+	spUUID := reqInfo.IId.NameId
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		spUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	reqNameId := reqInfo.IId.NameId
+	reqInfo.IId = cres.IID{NameId: spUUID, SystemId: ""}
+
+	info, err := handler.CreateNATGateway(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) insert spiderIID: {reqNameID, "driverNameID:driverSystemID"}
+	spiderIId := cres.IID{NameId: reqNameId, SystemId: spUUID + ":" + info.IId.SystemId}
+	err = infostore.Insert(&NATGatewayIIDInfo{ConnectionName: connectionName, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId,
+		OwnerVPCName: vpcName})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteNATGateway(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf("%v, %v", err, err2)
+		}
+		return nil, err
+	}
+
+	info.IId = getUserIID(spiderIId)
+	setNATGatewayUserIIDs(connectionName, vpcName, &info)
+	return &info, nil
+}
+
+func ListNATGateway(connectionName string, rsType string, vpcName string) ([]*cres.NATGatewayInfo, error) {
+	cblog.Info("call ListNATGateway()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	vpcName, err = EmptyCheckAndTrim("vpcName", vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var iidInfoList []*NATGatewayIIDInfo
+	err = infostore.ListByConditions(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	infoList := []*cres.NATGatewayInfo{}
+	if len(iidInfoList) == 0 {
+		return infoList, nil
+	}
+
+	handler, err := getNATGatewayHandler(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	for _, iidInfo := range iidInfoList {
+		spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+		info, err := handler.GetNATGateway(getDriverIID(spiderIId))
+		if err != nil {
+			cblog.Error(err)
+			continue
+		}
+		info.IId = getUserIID(spiderIId)
+		setNATGatewayUserIIDs(connectionName, vpcName, &info)
+		infoList = append(infoList, &info)
+	}
+	return infoList, nil
+}
+
+func GetNATGateway(connectionName string, rsType string, vpcName string, nameID string) (*cres.NATGatewayInfo, error) {
+	cblog.Info("call GetNATGateway()")
+
+	iidInfo, err := getNATGatewayIIDInfo(connectionName, vpcName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	handler, err := getNATGatewayHandler(iidInfo.ConnectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+	info, err := handler.GetNATGateway(getDriverIID(spiderIId))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(spiderIId)
+	setNATGatewayUserIIDs(iidInfo.ConnectionName, iidInfo.OwnerVPCName, &info)
+	return &info, nil
+}
+
+// DeleteNATGateway deletes a NATGateway. With force "true", the IID info is deleted even if the CSP fails.
+// The routes to the NATGateway have to be removed before, except with force "true".
+func DeleteNATGateway(connectionName string, rsType string, vpcName string, nameID string, force string) (bool, error) {
+	cblog.Info("call DeleteNATGateway()")
+
+	iidInfo, err := getNATGatewayIIDInfo(connectionName, vpcName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	handler, err := getNATGatewayHandler(iidInfo.ConnectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	natGatewaySPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer natGatewaySPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	if force != "true" {
+		err = checkNATGatewayNotUsedByRoute(iidInfo.ConnectionName, iidInfo.OwnerVPCName, iidInfo.NameId, driverIId.SystemId)
+		if err != nil {
+			cblog.Error(err)
+			return false, err
+		}
+	}
+
+	result, err := handler.DeleteNATGateway(driverIId)
+	if err != nil {
+		cblog.Error(err)
+		if force != "true" {
+			return false, err
+		}
+	}
+	if !result && force != "true" {
+		return false, nil
+	}
+
+	_, err = infostore.DeleteBy3Conditions(&NATGatewayIIDInfo{}, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, NAME_ID_COLUMN, iidInfo.NameId,
+		OWNER_VPC_NAME_COLUMN, iidInfo.OwnerVPCName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func getNATGatewayIIDInfo(connectionName string, vpcName string, nameID string) (*NATGatewayIIDInfo, error) {
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		return nil, err
+	}
+	vpcName, err = EmptyCheckAndTrim("vpcName", vpcName)
+	if err != nil {
+		return nil, err
+	}
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		return nil, err
+	}
+
+	var iidInfo NATGatewayIIDInfo
+	err = infostore.GetBy3Conditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID,
+		OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		return nil, err
+	}
+	return &iidInfo, nil
+}
+
+func getNATGatewayHandler(connectionName string) (cres.NATGatewayHandler, error) {
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return nil, err
+	}
+	return cldConn.CreateNATGatewayHandler()
+}
+
+// checkVPCNotUsedByNATGateway returns an error if a NATGateway was created in the VPC.
+func checkVPCNotUsedByNATGateway(connectionName string, vpcName string) error {
+	var iidInfoList []*NATGatewayIIDInfo
+	err := infostore.ListByConditions(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		return err
+	}
+	if len(iidInfoList) > 0 {
+		nameList := []string{}
+		for _, iidInfo := range iidInfoList {
+			nameList = append(nameList, iidInfo.NameId)
+		}
+		return fmt.Errorf("the VPC '%s' is used by NATGateway(s) %v. Delete them first", vpcName, nameList)
+	}
+	return nil
+}
+
+// checkSubnetNotUsedByNATGateway returns an error if a NATGateway of the VPC is placed in the Subnet.
+// A NATGateway which can not be read fails the check.
+func checkSubnetNotUsedByNATGateway(connectionName string, vpcName string, subnetName string, subnetSystemId string) error {
+	var iidInfoList []*NATGatewayIIDInfo
+	err := infostore.ListByConditions(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		return err
+	}
+	if len(iidInfoList) == 0 {
+		return nil
+	}
+	handler, err := getNATGatewayHandler(connectionName)
+	if err != nil {
+		return err
+	}
+
+	nameList := []string{}
+	for _, iidInfo := range iidInfoList {
+		info, err := handler.GetNATGateway(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		if err != nil {
+			return fmt.Errorf("failed to check the Subnet of the NATGateway '%s': %v", iidInfo.NameId, err)
+		}
+		if info.SubnetIID.SystemId == subnetSystemId {
+			nameList = append(nameList, iidInfo.NameId)
+		}
+	}
+	if len(nameList) > 0 {
+		return fmt.Errorf("the Subnet '%s' is used by NATGateway(s) %v. Delete them first", subnetName, nameList)
+	}
+	return nil
+}
+
+// setNATGatewayUserIIDs sets the user IIDs of VPC and Subnet of a NATGateway.
+func setNATGatewayUserIIDs(connectionName string, vpcName string, info *cres.NATGatewayInfo) {
+	info.VpcIID.NameId = vpcName
+
+	var subnetIIDInfo SubnetIIDInfo
+	err := infostore.GetByConditionsAndContain(&subnetIIDInfo, CONNECTION_NAME_COLUMN, connectionName,
+		OWNER_VPC_NAME_COLUMN, vpcName, SYSTEM_ID_COLUMN, info.SubnetIID.SystemId)
+	if err == nil {
+		info.SubnetIID.NameId = subnetIIDInfo.NameId
+	}
+}
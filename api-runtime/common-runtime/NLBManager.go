@@ -9,14 +9,18 @@
 package commonruntime
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	infostore "github.com/cloud-barista/cb-spider/info-store"
 )
 
@@ -37,7 +41,7 @@ func init() {
 		cblog.Error(err)
 		return
 	}
-	db.AutoMigrate(&NLBIIDInfo{})
+	infostore.AutoMigrate(db, &NLBIIDInfo{})
 	infostore.Close(db)
 }
 
@@ -67,7 +71,7 @@ func GetNLBOwnerVPC(connectionName string, cspID string) (owerVPC cres.IID, err
 		return cres.IID{}, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	handler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return cres.IID{}, err
@@ -203,7 +207,7 @@ func RegisterNLB(connectionName string, vpcUserID string, userIID cres.IID) (*cr
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	handler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -275,6 +279,7 @@ func RegisterNLB(connectionName string, vpcUserID string, userIID cres.IID) (*cr
 
 	// Protocol: to upper
 	transformArgsToUpper(&getInfo)
+	setListenerList(&getInfo)
 
 	// (3) create spiderIID: {UserID, SP-XID:CSP-ID}
 	//     ex) spiderIID {"vpc-01", "vpc-01-9m4e2mr0ui3e8a215n4g:i-0bc7123b7e5cbf79d"}
@@ -319,6 +324,11 @@ func RegisterNLB(connectionName string, vpcUserID string, userIID cres.IID) (*cr
 // (5) insert spiderIID
 // (6) create userIID
 func CreateNLB(connectionName string, rsType string, reqInfo cres.NLBInfo, IDTransformMode string) (*cres.NLBInfo, error) {
+	return CreateNLBWithContext(context.Background(), connectionName, rsType, reqInfo, IDTransformMode)
+}
+
+// CreateNLBWithContext is CreateNLB with a ctx to cancel the request.
+func CreateNLBWithContext(ctx context.Context, connectionName string, rsType string, reqInfo cres.NLBInfo, IDTransformMode string) (*cres.NLBInfo, error) {
 	cblog.Info("call CreateNLB()")
 
 	// check empty and trim user inputs
@@ -343,6 +353,15 @@ func CreateNLB(connectionName string, rsType string, reqInfo cres.NLBInfo, IDTra
 	   }
 	*/
 
+	if reqInfo.VMGroup.VMs == nil {
+		reqInfo.VMGroup.VMs = &[]cres.IID{}
+	}
+	err = setReqListenerList(connectionName, &reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	vpcSPLock.RLock(connectionName, reqInfo.VpcIID.NameId)
 	defer vpcSPLock.RUnlock(connectionName, reqInfo.VpcIID.NameId)
 
@@ -405,11 +424,12 @@ func CreateNLB(connectionName string, rsType string, reqInfo cres.NLBInfo, IDTra
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	nLBHandler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
+	handler := cres.ToNLBHandlerWithContext(nLBHandler)
 
 	// Protocol: to upper
 	transformArgsToUpper(&reqInfo)
@@ -470,17 +490,26 @@ func CreateNLB(connectionName string, rsType string, reqInfo cres.NLBInfo, IDTra
 	driverIId := cres.IID{NameId: spUUID, SystemId: ""}
 	reqInfo.IId = driverIId
 
-	// get Provider Name
-	providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+	// get Provider Name of the NLB metadata
+	providerName, err := getNLBProviderName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = validateHTTPHealthChecker(providerName, reqInfo.HealthChecker)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 	// set default configuration of HealthChecker
-	setDefaultHealthCheckerConfig(providerName, &reqInfo.HealthChecker)
+	err = setDefaultHealthCheckerConfig(providerName, &reqInfo.HealthChecker)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
 
 	// (3) create Resource
-	info, err := handler.CreateNLB(reqInfo)
+	info, err := handler.CreateNLBWithContext(ctx, reqInfo)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -493,6 +522,7 @@ func CreateNLB(connectionName string, rsType string, reqInfo cres.NLBInfo, IDTra
 
 	// set VM's IID with NameId
 	info.VMGroup.VMs = reqInfo.VMGroup.VMs
+	setListenerList(&info)
 
 	// (4) create spiderIID: {reqNameID, "driverNameID:driverSystemID"}
 	//     ex) spiderIID {"seoul-service", "vm-01-9m4e2mr0ui3e8a215n4g:i-0bc7123b7e5cbf79d"}
@@ -521,42 +551,141 @@ func CreateNLB(connectionName string, rsType string, reqInfo cres.NLBInfo, IDTra
 	return &info, nil
 }
 
-func setDefaultHealthCheckerConfig(providerName string, reqInfo *cres.HealthCheckerInfo) {
+// setDefaultHealthCheckerConfig sets up the -1(int) and empty values of the HealthChecker
+// with the provider's defaults defined in cloudos_meta.yaml.
+func setDefaultHealthCheckerConfig(providerName string, reqInfo *cres.HealthCheckerInfo) error {
 
-	// * -1(int) => set up with spider's default value
-	// * Spider's default values for Health Checking
+	// * -1(int) => set up with provider's default value('nlbhealthcheckdefault' in cloudos_meta.yaml)
+	// * Spider's default values for Health Checking, if the provider has no default value
 	//	[TCP]  Interval:10 / Timeout:10 / Threshold:3
-	//	[HTTP] Interval:10 / Timeout:6 (Azure:10) / Threshold:3
-	// * AWS, Azure: disable Timeout Configuration
+	//	[HTTP] Interval:10 / Timeout:6 / Threshold:3
+	// * -1 in cloudos_meta.yaml: determined by CSP, ex) AWS, Azure: disable Timeout Configuration
+
+	cloudOSMetaInfo, err := cim.GetCloudOSMetaInfo(providerName)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
 
-	// (1) TCP
-	if reqInfo.Protocol == "TCP" {
+	var defaults []int
+	switch reqInfo.Protocol {
+	case "TCP":
+		defaults = []int{10, 10, 3}
+	case "HTTP":
+		defaults = []int{10, 6, 3}
+	}
+	for _, healthCheckDefault := range cloudOSMetaInfo.NLBHealthCheckDefault {
+		// ex) "TCP|10|10|3"
+		fields := strings.Split(healthCheckDefault, "|")
+		if len(fields) != 4 || !strings.EqualFold(fields[0], reqInfo.Protocol) {
+			continue
+		}
+		defaults = make([]int, 3)
+		for idx, field := range fields[1:] {
+			defaults[idx], err = strconv.Atoi(strings.TrimSpace(field))
+			if err != nil {
+				err = fmt.Errorf("%s has an invalid NLBHealthCheckDefault(%s) in cloudos_meta.yaml: %v", providerName, healthCheckDefault, err)
+				cblog.Error(err)
+				return err
+			}
+		}
+		break
+	}
+
+	if defaults != nil {
 		if reqInfo.Interval == -1 {
-			reqInfo.Interval = 10
+			reqInfo.Interval = defaults[0]
 		}
 		if reqInfo.Timeout == -1 {
-			if providerName != "AWS" && providerName != "AZURE" {
-				reqInfo.Timeout = 10
-			}
+			reqInfo.Timeout = defaults[1]
 		}
 		if reqInfo.Threshold == -1 {
-			reqInfo.Threshold = 3
+			reqInfo.Threshold = defaults[2]
 		}
 	}
-	// (2) HTTP
+
+	// HTTP options: only the options supported by the provider
 	if reqInfo.Protocol == "HTTP" {
-		if reqInfo.Interval == -1 {
-			reqInfo.Interval = 10
+		for _, option := range cloudOSMetaInfo.NLBHTTPHealthCheckOption {
+			switch option {
+			case httpPathOption:
+				if reqInfo.HTTPPath == "" {
+					reqInfo.HTTPPath = "/"
+				}
+			case expectedStatusCodesOption:
+				if reqInfo.ExpectedStatusCodes == "" && len(cloudOSMetaInfo.NLBHTTPHealthCheckStatus) > 0 {
+					reqInfo.ExpectedStatusCodes = cloudOSMetaInfo.NLBHTTPHealthCheckStatus[0]
+				}
+			}
 		}
-		if reqInfo.Timeout == -1 {
-			if providerName != "AWS" && providerName != "AZURE" {
-				reqInfo.Timeout = 6
+	}
+	return nil
+}
+
+// ex) "200", "200,302", "200-399"
+var expectedStatusCodesRegexp = regexp.MustCompile(`^[1-5][0-9]{2}(-[1-5][0-9]{2})?(,[1-5][0-9]{2}(-[1-5][0-9]{2})?)*$`)
+
+// validateHTTPHealthChecker checks the HTTP options of the HealthChecker
+// and whether the provider supports them('nlbhttphealthcheckoption' in cloudos_meta.yaml).
+func validateHTTPHealthChecker(providerName string, reqInfo cres.HealthCheckerInfo) error {
+	if reqInfo.HTTPPath == "" && reqInfo.ExpectedStatusCodes == "" && reqInfo.HostHeader == "" {
+		return nil
+	}
+
+	protocol := strings.ToUpper(reqInfo.Protocol)
+	if protocol != "HTTP" && protocol != "HTTPS" {
+		return fmt.Errorf("The HTTPPath, ExpectedStatusCodes and HostHeader can be set only in the HTTP Health Checker, but the Protocol is %s!", reqInfo.Protocol)
+	}
+	if reqInfo.HTTPPath != "" && !strings.HasPrefix(reqInfo.HTTPPath, "/") {
+		return fmt.Errorf("The HTTPPath(%s) of the Health Checker must start with '/'!", reqInfo.HTTPPath)
+	}
+	if reqInfo.ExpectedStatusCodes != "" && !expectedStatusCodesRegexp.MatchString(reqInfo.ExpectedStatusCodes) {
+		return fmt.Errorf("The ExpectedStatusCodes(%s) of the Health Checker is invalid! ex) 200, 200,302, 200-399", reqInfo.ExpectedStatusCodes)
+	}
+
+	cloudOSMetaInfo, err := cim.GetCloudOSMetaInfo(providerName)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+	return checkHTTPHealthCheckerOptions(providerName, reqInfo, cloudOSMetaInfo.NLBHTTPHealthCheckOption...)
+}
+
+// Options of the HTTP Health Checker('nlbhttphealthcheckoption' in cloudos_meta.yaml)
+const (
+	httpPathOption            = "HTTPPath"
+	expectedStatusCodesOption = "ExpectedStatusCodes"
+	hostHeaderOption          = "HostHeader"
+)
+
+// checkHTTPHealthCheckerOptions returns an error if the healthChecker has HTTP options
+// which are not included in the supportedOptions of the provider.
+func checkHTTPHealthCheckerOptions(providerName string, healthChecker cres.HealthCheckerInfo, supportedOptions ...string) error {
+	options := []struct {
+		name  string
+		value string
+	}{
+		{httpPathOption, healthChecker.HTTPPath},
+		{expectedStatusCodesOption, healthChecker.ExpectedStatusCodes},
+		{hostHeaderOption, healthChecker.HostHeader},
+	}
+
+	for _, option := range options {
+		if option.value == "" {
+			continue
+		}
+		supported := false
+		for _, supportedOption := range supportedOptions {
+			if supportedOption == option.name {
+				supported = true
+				break
 			}
 		}
-		if reqInfo.Threshold == -1 {
-			reqInfo.Threshold = 3
+		if !supported {
+			return fmt.Errorf("%s does not support the %s of the HTTP Health Checker!", providerName, option.name)
 		}
 	}
+	return nil
 }
 
 func transformArgsToUpper(nlbInfo *cres.NLBInfo) {
@@ -569,6 +698,84 @@ func transformArgsToUpper(nlbInfo *cres.NLBInfo) {
 	nlbInfo.VMGroup.Protocol = strings.ToUpper(nlbInfo.VMGroup.Protocol)
 	// HealthCheckerInfo
 	nlbInfo.HealthChecker.Protocol = strings.ToUpper(nlbInfo.HealthChecker.Protocol)
+	// ListenerBindingInfo
+	for idx := range nlbInfo.ListenerList {
+		nlbInfo.ListenerList[idx].Listener.Protocol = strings.ToUpper(nlbInfo.ListenerList[idx].Listener.Protocol)
+		nlbInfo.ListenerList[idx].VMGroup.Protocol = strings.ToUpper(nlbInfo.ListenerList[idx].VMGroup.Protocol)
+	}
+}
+
+// setListenerList fills the ListenerList with the Listener and VMGroup for drivers with a single listener,
+// and sets the VMs of the NLB's VMGroup to all listener bindings.
+func setListenerList(nlbInfo *cres.NLBInfo) {
+	if len(nlbInfo.ListenerList) == 0 {
+		nlbInfo.ListenerList = []cres.ListenerBindingInfo{{Listener: nlbInfo.Listener, VMGroup: nlbInfo.VMGroup}}
+	}
+	for idx := range nlbInfo.ListenerList {
+		nlbInfo.ListenerList[idx].VMGroup.VMs = nlbInfo.VMGroup.VMs
+	}
+}
+
+// setReqListenerList makes the ListenerList of a request.
+// Listener and VMGroup are the shorthand of a single listener binding,
+// and the first binding of the ListenerList is set to Listener and VMGroup.
+func setReqListenerList(connectionName string, reqInfo *cres.NLBInfo) error {
+	if len(reqInfo.ListenerList) == 0 {
+		reqInfo.ListenerList = []cres.ListenerBindingInfo{{Listener: reqInfo.Listener, VMGroup: reqInfo.VMGroup}}
+	} else if reqInfo.Listener.Protocol != "" || reqInfo.Listener.Port != "" {
+		return fmt.Errorf("Listener and ListenerList cannot be used together, Listener is the shorthand of a single listener")
+	}
+
+	for idx, binding := range reqInfo.ListenerList {
+		if err := checkListenerBinding(binding); err != nil {
+			return err
+		}
+		for _, prevBinding := range reqInfo.ListenerList[:idx] {
+			if isSameListener(prevBinding.Listener, binding.Listener) {
+				return fmt.Errorf("duplicated Listener: %s:%s", binding.Listener.Protocol, binding.Listener.Port)
+			}
+		}
+		reqInfo.ListenerList[idx].VMGroup.VMs = reqInfo.VMGroup.VMs
+	}
+
+	if len(reqInfo.ListenerList) > 1 {
+		if err := checkNLBMultiListener(connectionName); err != nil {
+			return err
+		}
+	}
+
+	reqInfo.Listener = reqInfo.ListenerList[0].Listener
+	reqInfo.VMGroup.Protocol = reqInfo.ListenerList[0].VMGroup.Protocol
+	reqInfo.VMGroup.Port = reqInfo.ListenerList[0].VMGroup.Port
+	return nil
+}
+
+// checkNLBMultiListener returns an error if the NLB of the connection does not support multiple listeners.
+// The emulated NLB always supports multiple listeners.
+func checkNLBMultiListener(connectionName string) error {
+	emulated, err := isEmulatedNLB(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+	if emulated {
+		return nil
+	}
+	return checkCapability(connectionName, NLB_MULTI_LISTENER)
+}
+
+func checkListenerBinding(binding cres.ListenerBindingInfo) error {
+	if binding.Listener.Protocol == "" || binding.Listener.Port == "" {
+		return fmt.Errorf("Listener's Protocol and Port are required")
+	}
+	if binding.VMGroup.Protocol == "" || binding.VMGroup.Port == "" {
+		return fmt.Errorf("VMGroup's Protocol and Port of the %s:%s Listener are required", binding.Listener.Protocol, binding.Listener.Port)
+	}
+	return nil
+}
+
+func isSameListener(a cres.ListenerInfo, b cres.ListenerInfo) bool {
+	return strings.EqualFold(a.Protocol, b.Protocol) && a.Port == b.Port
 }
 
 // (1) get IID:list
@@ -590,7 +797,7 @@ func ListNLB(connectionName string, rsType string) ([]*cres.NLBInfo, error) {
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	handler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -641,6 +848,7 @@ func ListNLB(connectionName string, rsType string) ([]*cres.NLBInfo, error) {
 
 		// Protocol: to upper
 		transformArgsToUpper(&info)
+		setListenerList(&info)
 
 		// (3) set ResourceInfo(IID.NameId)
 		// set ResourceInfo
@@ -727,7 +935,7 @@ func GetNLB(connectionName string, rsType string, nameID string) (*cres.NLBInfo,
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	handler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -775,6 +983,7 @@ func GetNLB(connectionName string, rsType string, nameID string) (*cres.NLBInfo,
 	}
 	// Protocol: to upper
 	transformArgsToUpper(&info)
+	setListenerList(&info)
 
 	// (3) set ResourceInfo(IID.NameId)
 	// set ResourceInfo
@@ -859,7 +1068,7 @@ func AddNLBVMs(connectionName string, nlbName string, vmNames []string) (*cres.N
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	handler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -971,6 +1180,7 @@ func AddNLBVMs(connectionName string, nlbName string, vmNames []string) (*cres.N
 
 	// Protocol: to upper
 	transformArgsToUpper(&info)
+	setListenerList(&info)
 
 	// (4) set ResourceInfo(userIID)
 	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
@@ -1052,7 +1262,7 @@ func RemoveNLBVMs(connectionName string, nlbName string, vmNames []string) (bool
 		return false, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	handler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return false, err
@@ -1171,7 +1381,7 @@ func ChangeListener(connectionName string, nlbName string, listener cres.Listene
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	handler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1212,6 +1422,21 @@ func ChangeListener(connectionName string, nlbName string, listener cres.Listene
 	}
 
 	// (2) change listener
+	// the new Listener must not be used by the other listeners
+	oldInfo, err := handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	setListenerList(&oldInfo)
+	for _, binding := range oldInfo.ListenerList[1:] {
+		if isSameListener(binding.Listener, listener) {
+			err := fmt.Errorf("The %s:%s Listener is already used in the %s '%s'!", listener.Protocol, listener.Port, RSTypeString(NLB), nlbName)
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
 	// driverIID for driver
 	_, err = handler.ChangeListener(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), listener)
 	if err != nil {
@@ -1228,6 +1453,7 @@ func ChangeListener(connectionName string, nlbName string, listener cres.Listene
 
 	// Protocol: to upper
 	transformArgsToUpper(&info)
+	setListenerList(&info)
 
 	// (4) set ResourceInfo(userIID)
 	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
@@ -1285,6 +1511,153 @@ func ChangeListener(connectionName string, nlbName string, listener cres.Listene
 	return &info, nil
 }
 
+// (1) check exist(NameID) and the Listener
+// (2) add the Listener
+// (3) Get NLBInfo
+func AddNLBListener(connectionName string, nlbName string, listenerBinding cres.ListenerBindingInfo) (*cres.NLBInfo, error) {
+	cblog.Info("call AddNLBListener()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	nlbName, err = EmptyCheckAndTrim("nlbName", nlbName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = checkListenerBinding(listenerBinding)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if err := checkNLBMultiListener(connectionName); err != nil {
+		return nil, err
+	}
+
+	err = changeNLBListenerList(connectionName, nlbName, func(handler cres.NLBHandler, driverIID cres.IID, info cres.NLBInfo) error {
+		for _, binding := range info.ListenerList {
+			if isSameListener(binding.Listener, listenerBinding.Listener) {
+				return fmt.Errorf("The %s:%s Listener already exists in the %s '%s'!", listenerBinding.Listener.Protocol,
+					listenerBinding.Listener.Port, RSTypeString(NLB), nlbName)
+			}
+		}
+
+		listenerBinding.Listener.Protocol = strings.ToUpper(listenerBinding.Listener.Protocol)
+		listenerBinding.VMGroup.Protocol = strings.ToUpper(listenerBinding.VMGroup.Protocol)
+		listenerBinding.VMGroup.VMs = info.VMGroup.VMs
+		_, err := handler.AddListener(driverIID, listenerBinding)
+		return err
+	})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return GetNLB(connectionName, NLB, nlbName)
+}
+
+// (1) check exist(NameID) and the Listener
+// (2) remove the Listener, the first Listener can not be removed
+func RemoveNLBListener(connectionName string, nlbName string, listener cres.ListenerInfo) (bool, error) {
+	cblog.Info("call RemoveNLBListener()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	nlbName, err = EmptyCheckAndTrim("nlbName", nlbName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	if listener.Protocol == "" || listener.Port == "" {
+		err := fmt.Errorf("Listener's Protocol and Port are required")
+		cblog.Error(err)
+		return false, err
+	}
+
+	err = changeNLBListenerList(connectionName, nlbName, func(handler cres.NLBHandler, driverIID cres.IID, info cres.NLBInfo) error {
+		listenerIdx := -1
+		for idx, binding := range info.ListenerList {
+			if isSameListener(binding.Listener, listener) {
+				listenerIdx = idx
+				break
+			}
+		}
+		if listenerIdx == -1 {
+			return fmt.Errorf("The %s:%s Listener does not exist in the %s '%s'!", listener.Protocol, listener.Port, RSTypeString(NLB), nlbName)
+		}
+		// the first Listener is the Listener of the NLB with its VMGroup and HealthChecker, CSPs(ex: AWS) can not remove it
+		if listenerIdx == 0 {
+			return fmt.Errorf("The first Listener(%s:%s) of the %s '%s' can not be removed!", listener.Protocol, listener.Port, RSTypeString(NLB), nlbName)
+		}
+
+		listener.Protocol = strings.ToUpper(listener.Protocol)
+		_, err := handler.RemoveListener(driverIID, listener)
+		return err
+	})
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// changeNLBListenerList finds the NLB and calls the changeFunc with the NLBInfo of the driver under the NLB lock.
+func changeNLBListenerList(connectionName string, nlbName string, changeFunc func(handler cres.NLBHandler, driverIID cres.IID, info cres.NLBInfo) error) error {
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return err
+	}
+
+	handler, err := createNLBHandler(connectionName, cldConn)
+	if err != nil {
+		return err
+	}
+
+	nlbSPLock.Lock(connectionName, nlbName)
+	defer nlbSPLock.Unlock(connectionName, nlbName)
+
+	var iidInfoList []*NLBIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+	} else {
+		err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	}
+	if err != nil {
+		return err
+	}
+
+	var iidInfo *NLBIIDInfo
+	for _, OneIIdInfo := range iidInfoList {
+		if OneIIdInfo.NameId == nlbName {
+			iidInfo = OneIIdInfo
+			break
+		}
+	}
+	if iidInfo == nil {
+		return fmt.Errorf("The %s '%s' does not exist!", RSTypeString(NLB), nlbName)
+	}
+
+	driverIID := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	info, err := handler.GetNLB(driverIID)
+	if err != nil {
+		return err
+	}
+	setListenerList(&info)
+
+	return changeFunc(handler, driverIID, info)
+}
+
 // ---------------------------------------------------//
 // @todo  To support or not will be decided later.   //
 // ---------------------------------------------------//
@@ -1326,7 +1699,7 @@ func ChangeVMGroup(connectionName string, nlbName string, vmGroup cres.VMGroupIn
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	handler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1383,6 +1756,7 @@ func ChangeVMGroup(connectionName string, nlbName string, vmGroup cres.VMGroupIn
 
 	// Protocol: to upper
 	transformArgsToUpper(&info)
+	setListenerList(&info)
 
 	// (4) set ResourceInfo(userIID)
 	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
@@ -1465,6 +1839,9 @@ func ChangeHealthChecker(connectionName string, nlbName string, healthChecker cr
 	emptyPermissionList := []string{
 		"resources.IID:SystemId",
 		"resources.HealthCheckerInfo:CspID", // because can be unused in some CSP
+		"resources.HealthCheckerInfo:HTTPPath",
+		"resources.HealthCheckerInfo:ExpectedStatusCodes",
+		"resources.HealthCheckerInfo:HostHeader",
 	}
 	err = ValidateStruct(healthChecker, emptyPermissionList)
 	if err != nil {
@@ -1472,13 +1849,30 @@ func ChangeHealthChecker(connectionName string, nlbName string, healthChecker cr
 		return nil, err
 	}
 
+	healthChecker.Protocol = strings.ToUpper(healthChecker.Protocol)
+	providerName, err := getNLBProviderName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = validateHTTPHealthChecker(providerName, healthChecker)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = setDefaultHealthCheckerConfig(providerName, &healthChecker)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	handler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1535,6 +1929,7 @@ func ChangeHealthChecker(connectionName string, nlbName string, healthChecker cr
 
 	// Protocol: to upper
 	transformArgsToUpper(&info)
+	setListenerList(&info)
 
 	// (4) set ResourceInfo(userIID)
 	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
@@ -1630,7 +2025,7 @@ func GetVMGroupHealthInfo(connectionName string, nlbName string) (*cres.HealthIn
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	handler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1824,7 +2219,7 @@ func DeleteNLB(connectionName string, rsType string, nameID string, force string
 		return false, err
 	}
 
-	handler, err := cldConn.CreateNLBHandler()
+	handler, err := createNLBHandler(connectionName, cldConn)
 	if err != nil {
 		cblog.Error(err)
 		return false, err
@@ -9,6 +9,10 @@
 package commonruntime
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
@@ -102,6 +106,19 @@ func GetPriceInfo(connectionName string, productFamily string, regionName string
 		return "", err
 	}
 
+	// the PricingModel filter is processed by Spider, not by drivers
+	pricingModels, filterList, err := splitPricingModelFilter(filterList)
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+	// a driver without Spot prices would return an empty list for the Spot filter
+	if pricingModels[cres.SpotPricing] {
+		if err := checkCapability(connectionName, SPOT_PRICE); err != nil {
+			return "", fmt.Errorf("Spot pricing not supported by this driver: %v", err)
+		}
+	}
+
 	cspProductFamily := getProviderSpecificPFName(providerName, productFamily)
 	priceInfo, err := handler.GetPriceInfo(cspProductFamily, regionName, filterList, simpleVMSpecInfo)
 	if err != nil {
@@ -109,9 +126,87 @@ func GetPriceInfo(connectionName string, productFamily string, regionName string
 		return "", err
 	}
 
+	if len(pricingModels) == 0 {
+		return priceInfo, nil
+	}
+
+	priceInfo, err = filterPricingModels(priceInfo, pricingModels)
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
 	return priceInfo, nil
 }
 
+// splitPricingModelFilter separates the PricingModel filter from the filters for drivers.
+// ex) {Key: "PricingModel", Value: "OnDemand,Spot"} => [OnDemand, Spot]
+func splitPricingModelFilter(filterList []cres.KeyValue) (map[cres.PricingModel]bool, []cres.KeyValue, error) {
+	pricingModels := map[cres.PricingModel]bool{}
+	var driverFilterList []cres.KeyValue
+
+	for _, filter := range filterList {
+		if !strings.EqualFold(strings.TrimSpace(filter.Key), cres.PricingModelFilterKey) {
+			driverFilterList = append(driverFilterList, filter)
+			continue
+		}
+
+		for _, value := range strings.Split(filter.Value, ",") {
+			value = strings.TrimSpace(value)
+			switch {
+			case value == "":
+				continue
+			case strings.EqualFold(value, string(cres.OnDemandPricing)):
+				pricingModels[cres.OnDemandPricing] = true
+			case strings.EqualFold(value, string(cres.ReservedPricing)):
+				pricingModels[cres.ReservedPricing] = true
+			case strings.EqualFold(value, string(cres.SpotPricing)):
+				pricingModels[cres.SpotPricing] = true
+			default:
+				return nil, nil, fmt.Errorf("invalid %s filter value '%s': must be one of %s, %s, %s", cres.PricingModelFilterKey,
+					value, cres.OnDemandPricing, cres.ReservedPricing, cres.SpotPricing)
+			}
+		}
+	}
+
+	return pricingModels, driverFilterList, nil
+}
+
+// filterPricingModels leaves only the selected pricing models in the driver's price info.
+// Products without any price of the selected pricing models are excluded.
+func filterPricingModels(priceInfo string, pricingModels map[cres.PricingModel]bool) (string, error) {
+	var cloudPrice cres.CloudPrice
+	if err := json.Unmarshal([]byte(priceInfo), &cloudPrice); err != nil {
+		return "", err
+	}
+
+	priceList := []cres.Price{}
+	for _, price := range cloudPrice.PriceList {
+		if !pricingModels[cres.OnDemandPricing] {
+			price.PriceInfo.OnDemand = cres.OnDemand{}
+		}
+		if !pricingModels[cres.ReservedPricing] {
+			price.PriceInfo.Reserved = nil
+		}
+		if !pricingModels[cres.SpotPricing] {
+			price.PriceInfo.Spot = nil
+		}
+
+		if price.PriceInfo.OnDemand.Price == "" && len(price.PriceInfo.Reserved) == 0 && len(price.PriceInfo.Spot) == 0 {
+			continue
+		}
+		priceList = append(priceList, price)
+	}
+	cloudPrice.PriceList = priceList
+
+	result, err := json.MarshalIndent(cloudPrice, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
 func getProviderSpecificPFName(providerName, pfName string) string {
 
 	if pfName != cres.RSTypeString(cres.VM) {
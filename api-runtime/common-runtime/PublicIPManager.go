@@ -0,0 +1,305 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// Common Runtime for PublicIPHandler interface
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// -------- IID Info for PublicIP
+
+type PublicIPIIDInfo FirstIIDInfo
+
+func (PublicIPIIDInfo) TableName() string {
+	return "publicip_iid_infos"
+}
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	infostore.AutoMigrate(db, &PublicIPIIDInfo{})
+	infostore.Close(db)
+}
+
+// -------- PublicIP Common Runtime
+
+// (1) check exist(NameID)
+// (2) create the PublicIP with a SP-XID
+// (3) insert spiderIID
+func CreatePublicIP(connectionName string, rsType string, reqInfo cres.PublicIPInfo, IDTransformMode string) (*cres.PublicIPInfo, error) {
+	cblog.Info("call CreatePublicIP()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	publicIPSPLock.Lock(connectionName, reqInfo.IId.NameId)
+	defer publicIPSPLock.Unlock(connectionName, reqInfo.IId.NameId)
+
+	// (1) check exist(NameID)
+	exist, err := infostore.HasByConditions(&PublicIPIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.IId.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if exist {
+		err := fmt.Errorf("PublicIP %s already exists", reqInfo.IId.NameId)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	handler, err := cldConn.CreatePublicIPHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) create the PublicIP with a SP-XID
+	spUUID := reqInfo.IId.NameId
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		spUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	reqNameId := reqInfo.IId.NameId
+	reqInfo.IId = cres.IID{NameId: spUUID, SystemId: ""}
+
+	info, err := handler.CreatePublicIP(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) insert spiderIID: {reqNameID, "driverNameID:driverSystemID"}
+	spiderIId := cres.IID{NameId: reqNameId, SystemId: spUUID + ":" + info.IId.SystemId}
+	err = infostore.Insert(&PublicIPIIDInfo{ConnectionName: connectionName, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeletePublicIP(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf("%v, %v", err, err2)
+		}
+		return nil, err
+	}
+
+	info.IId = getUserIID(spiderIId)
+	setOwnerVMUserIID(connectionName, &info.OwnerVM)
+	return &info, nil
+}
+
+func ListPublicIP(connectionName string, rsType string) ([]*cres.PublicIPInfo, error) {
+	cblog.Info("call ListPublicIP()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var iidInfoList []*PublicIPIIDInfo
+	err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	infoList := []*cres.PublicIPInfo{}
+	if len(iidInfoList) == 0 {
+		return infoList, nil
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	handler, err := cldConn.CreatePublicIPHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	for _, iidInfo := range iidInfoList {
+		spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+		info, err := handler.GetPublicIP(getDriverIID(spiderIId))
+		if err != nil {
+			cblog.Error(err)
+			continue
+		}
+		info.IId = getUserIID(spiderIId)
+		setOwnerVMUserIID(connectionName, &info.OwnerVM)
+		infoList = append(infoList, &info)
+	}
+	return infoList, nil
+}
+
+func GetPublicIP(connectionName string, rsType string, nameID string) (*cres.PublicIPInfo, error) {
+	cblog.Info("call GetPublicIP()")
+
+	iidInfo, handler, err := getPublicIPIIDInfoAndHandler(connectionName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+	info, err := handler.GetPublicIP(getDriverIID(spiderIId))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(spiderIId)
+	setOwnerVMUserIID(iidInfo.ConnectionName, &info.OwnerVM)
+	return &info, nil
+}
+
+// DeletePublicIP releases a PublicIP. With force "true", the IID info is deleted even if the CSP fails.
+func DeletePublicIP(connectionName string, rsType string, nameID string, force string) (bool, error) {
+	cblog.Info("call DeletePublicIP()")
+
+	iidInfo, handler, err := getPublicIPIIDInfoAndHandler(connectionName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	publicIPSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer publicIPSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	result, err := handler.DeletePublicIP(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		if force != "true" {
+			return false, err
+		}
+	}
+	if !result && force != "true" {
+		return false, nil
+	}
+
+	_, err = infostore.DeleteByConditions(&PublicIPIIDInfo{}, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, NAME_ID_COLUMN, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func AttachPublicIP(connectionName string, publicIPName string, ownerVMName string) (*cres.PublicIPInfo, error) {
+	cblog.Info("call AttachPublicIP()")
+
+	iidInfo, handler, err := getPublicIPIIDInfoAndHandler(connectionName, publicIPName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	vmIIDInfo, err := getOwnerVMIIDInfo(iidInfo.ConnectionName, ownerVMName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	publicIPSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer publicIPSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+	info, err := handler.AttachPublicIP(getDriverIID(spiderIId), getDriverIID(cres.IID{NameId: vmIIDInfo.NameId, SystemId: vmIIDInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(spiderIId)
+	info.OwnerVM = getUserIID(cres.IID{NameId: vmIIDInfo.NameId, SystemId: vmIIDInfo.SystemId})
+	return &info, nil
+}
+
+func DetachPublicIP(connectionName string, publicIPName string, ownerVMName string) (bool, error) {
+	cblog.Info("call DetachPublicIP()")
+
+	iidInfo, handler, err := getPublicIPIIDInfoAndHandler(connectionName, publicIPName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	vmIIDInfo, err := getOwnerVMIIDInfo(iidInfo.ConnectionName, ownerVMName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	publicIPSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer publicIPSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	result, err := handler.DetachPublicIP(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}),
+		getDriverIID(cres.IID{NameId: vmIIDInfo.NameId, SystemId: vmIIDInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return result, nil
+}
+
+func getPublicIPIIDInfoAndHandler(connectionName string, nameID string) (*PublicIPIIDInfo, cres.PublicIPHandler, error) {
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var iidInfo PublicIPIIDInfo
+	err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	handler, err := cldConn.CreatePublicIPHandler()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &iidInfo, handler, nil
+}
+
+// detachPublicIPsFromVM detaches the PublicIPs attached to the VM before the VM is terminated.
+func detachPublicIPsFromVM(connectionName string, vmName string) error {
+	infoList, err := ListPublicIP(connectionName, PUBLICIP)
+	if err != nil {
+		return err
+	}
+	for _, info := range infoList {
+		if info.OwnerVM.NameId != vmName {
+			continue
+		}
+		if _, err := DetachPublicIP(connectionName, info.IId.NameId, vmName); err != nil {
+			return fmt.Errorf("failed to detach the PublicIP '%s' from the VM '%s': %v", info.IId.NameId, vmName, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,578 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// Common Runtime for RouteTableHandler interface
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// -------- IID Info for RouteTable
+
+type RouteTableIIDInfo VPCDependentIIDInfo
+
+func (RouteTableIIDInfo) TableName() string {
+	return "routetable_iid_infos"
+}
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	infostore.AutoMigrate(db, &RouteTableIIDInfo{})
+	infostore.Close(db)
+}
+
+// -------- RouteTable Common Runtime
+
+// (1) check exist(NameID)
+// (2) set the driver IIDs of VPC and route targets
+// (3) create the RouteTable with a SP-XID
+// (4) insert spiderIID
+func CreateRouteTable(connectionName string, rsType string, vpcName string, reqInfo cres.RouteTableInfo, IDTransformMode string) (*cres.RouteTableInfo, error) {
+	cblog.Info("call CreateRouteTable()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	vpcName, err = EmptyCheckAndTrim("vpcName", vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	routeTableSPLock.Lock(connectionName, reqInfo.IId.NameId)
+	defer routeTableSPLock.Unlock(connectionName, reqInfo.IId.NameId)
+
+	// (1) check exist(NameID)
+	exist, err := infostore.HasByConditions(&RouteTableIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.IId.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if exist {
+		err := fmt.Errorf("RouteTable %s already exists", reqInfo.IId.NameId)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) set the driver IIDs of VPC and route targets
+	reqInfo.VpcIID, err = getVPCDriverIID(connectionName, vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = setRouteTargetDriverIIDs(connectionName, vpcName, reqInfo.RouteList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	// subnets are associated by AssociateSubnet()
+	reqInfo.SubnetIIDs = nil
+
+	handler, err := getRouteTableHandler(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) create the RouteTable with a SP-XID
+	spUUID := reqInfo.IId.NameId
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		spUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	reqNameId := reqInfo.IId.NameId
+	reqInfo.IId = cres.IID{NameId: spUUID, SystemId: ""}
+
+	info, err := handler.CreateRouteTable(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) insert spiderIID: {reqNameID, "driverNameID:driverSystemID"}
+	spiderIId := cres.IID{NameId: reqNameId, SystemId: spUUID + ":" + info.IId.SystemId}
+	err = infostore.Insert(&RouteTableIIDInfo{ConnectionName: connectionName, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId,
+		OwnerVPCName: vpcName})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteRouteTable(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf("%v, %v", err, err2)
+		}
+		return nil, err
+	}
+
+	info.IId = getUserIID(spiderIId)
+	setRouteTableUserIIDs(connectionName, vpcName, &info)
+	return &info, nil
+}
+
+func ListRouteTable(connectionName string, rsType string, vpcName string) ([]*cres.RouteTableInfo, error) {
+	cblog.Info("call ListRouteTable()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	vpcName, err = EmptyCheckAndTrim("vpcName", vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var iidInfoList []*RouteTableIIDInfo
+	err = infostore.ListByConditions(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	infoList := []*cres.RouteTableInfo{}
+	if len(iidInfoList) == 0 {
+		return infoList, nil
+	}
+
+	handler, err := getRouteTableHandler(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	for _, iidInfo := range iidInfoList {
+		spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+		info, err := handler.GetRouteTable(getDriverIID(spiderIId))
+		if err != nil {
+			cblog.Error(err)
+			continue
+		}
+		info.IId = getUserIID(spiderIId)
+		setRouteTableUserIIDs(connectionName, vpcName, &info)
+		infoList = append(infoList, &info)
+	}
+	return infoList, nil
+}
+
+func GetRouteTable(connectionName string, rsType string, vpcName string, nameID string) (*cres.RouteTableInfo, error) {
+	cblog.Info("call GetRouteTable()")
+
+	iidInfo, handler, err := getRouteTableIIDInfoAndHandler(connectionName, vpcName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+	info, err := handler.GetRouteTable(getDriverIID(spiderIId))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(spiderIId)
+	setRouteTableUserIIDs(iidInfo.ConnectionName, iidInfo.OwnerVPCName, &info)
+	return &info, nil
+}
+
+// DeleteRouteTable disassociates the subnets of a RouteTable and deletes it.
+// With force "true", the IID info is deleted even if the CSP fails.
+func DeleteRouteTable(connectionName string, rsType string, vpcName string, nameID string, force string) (bool, error) {
+	cblog.Info("call DeleteRouteTable()")
+
+	iidInfo, handler, err := getRouteTableIIDInfoAndHandler(connectionName, vpcName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	routeTableSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer routeTableSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	err = disassociateAllSubnets(handler, driverIId)
+	if err != nil {
+		cblog.Error(err)
+		if force != "true" {
+			return false, err
+		}
+	}
+
+	result, err := handler.DeleteRouteTable(driverIId)
+	if err != nil {
+		cblog.Error(err)
+		if force != "true" {
+			return false, err
+		}
+	}
+	if !result && force != "true" {
+		return false, nil
+	}
+
+	_, err = infostore.DeleteBy3Conditions(&RouteTableIIDInfo{}, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, NAME_ID_COLUMN, iidInfo.NameId,
+		OWNER_VPC_NAME_COLUMN, iidInfo.OwnerVPCName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func AddRoutes(connectionName string, vpcName string, routeTableName string, routeList []cres.RouteInfo) (*cres.RouteTableInfo, error) {
+	cblog.Info("call AddRoutes()")
+
+	iidInfo, handler, err := getRouteTableIIDInfoAndHandler(connectionName, vpcName, routeTableName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = setRouteTargetDriverIIDs(iidInfo.ConnectionName, iidInfo.OwnerVPCName, routeList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	routeTableSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer routeTableSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+	info, err := handler.AddRoutes(getDriverIID(spiderIId), routeList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(spiderIId)
+	setRouteTableUserIIDs(iidInfo.ConnectionName, iidInfo.OwnerVPCName, &info)
+	return &info, nil
+}
+
+func RemoveRoutes(connectionName string, vpcName string, routeTableName string, routeList []cres.RouteInfo) (bool, error) {
+	cblog.Info("call RemoveRoutes()")
+
+	iidInfo, handler, err := getRouteTableIIDInfoAndHandler(connectionName, vpcName, routeTableName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	err = setRouteTargetDriverIIDs(iidInfo.ConnectionName, iidInfo.OwnerVPCName, routeList)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	routeTableSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer routeTableSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	result, err := handler.RemoveRoutes(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), routeList)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return result, nil
+}
+
+func AssociateRouteTableSubnet(connectionName string, vpcName string, routeTableName string, subnetName string) (*cres.RouteTableInfo, error) {
+	cblog.Info("call AssociateRouteTableSubnet()")
+
+	iidInfo, handler, err := getRouteTableIIDInfoAndHandler(connectionName, vpcName, routeTableName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	subnetIID, err := getRouteTableSubnetDriverIID(iidInfo, subnetName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	routeTableSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer routeTableSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+	info, err := handler.AssociateSubnet(getDriverIID(spiderIId), subnetIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(spiderIId)
+	setRouteTableUserIIDs(iidInfo.ConnectionName, iidInfo.OwnerVPCName, &info)
+	return &info, nil
+}
+
+func DisassociateRouteTableSubnet(connectionName string, vpcName string, routeTableName string, subnetName string) (bool, error) {
+	cblog.Info("call DisassociateRouteTableSubnet()")
+
+	iidInfo, handler, err := getRouteTableIIDInfoAndHandler(connectionName, vpcName, routeTableName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	subnetIID, err := getRouteTableSubnetDriverIID(iidInfo, subnetName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	routeTableSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer routeTableSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	result, err := handler.DisassociateSubnet(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), subnetIID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return result, nil
+}
+
+func getRouteTableIIDInfoAndHandler(connectionName string, vpcName string, nameID string) (*RouteTableIIDInfo, cres.RouteTableHandler, error) {
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	vpcName, err = EmptyCheckAndTrim("vpcName", vpcName)
+	if err != nil {
+		return nil, nil, err
+	}
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var iidInfo RouteTableIIDInfo
+	err = infostore.GetBy3Conditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID,
+		OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	handler, err := getRouteTableHandler(connectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &iidInfo, handler, nil
+}
+
+func getRouteTableHandler(connectionName string) (cres.RouteTableHandler, error) {
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return nil, err
+	}
+	return cldConn.CreateRouteTableHandler()
+}
+
+func getRouteTableSubnetDriverIID(iidInfo *RouteTableIIDInfo, subnetName string) (cres.IID, error) {
+	subnetName, err := EmptyCheckAndTrim("subnetName", subnetName)
+	if err != nil {
+		return cres.IID{}, err
+	}
+	var subnetIIDInfo SubnetIIDInfo
+	err = infostore.GetBy3Conditions(&subnetIIDInfo, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, NAME_ID_COLUMN, subnetName,
+		OWNER_VPC_NAME_COLUMN, iidInfo.OwnerVPCName)
+	if err != nil {
+		return cres.IID{}, err
+	}
+	return getDriverIID(cres.IID{NameId: subnetIIDInfo.NameId, SystemId: subnetIIDInfo.SystemId}), nil
+}
+
+// disassociateAllSubnets disassociates all subnets from a RouteTable before deleting it.
+func disassociateAllSubnets(handler cres.RouteTableHandler, routeTableIID cres.IID) error {
+	info, err := handler.GetRouteTable(routeTableIID)
+	if err != nil {
+		return err
+	}
+	for _, subnetIID := range info.SubnetIIDs {
+		if _, err := handler.DisassociateSubnet(routeTableIID, subnetIID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setRouteTargetDriverIIDs sets the driver IIDs of the route targets given by their names.
+// Local and InternetGateway routes have no target.
+func setRouteTargetDriverIIDs(connectionName string, vpcName string, routeList []cres.RouteInfo) error {
+	for i, route := range routeList {
+		switch route.TargetType {
+		case cres.RouteTargetNATGateway:
+			iidInfo, err := getNATGatewayIIDInfo(connectionName, vpcName, route.TargetIID.NameId)
+			if err != nil {
+				return err
+			}
+			routeList[i].TargetIID = getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		case cres.RouteTargetVPCPeering:
+			iidInfo, err := getVPCPeeringIIDInfo(connectionName, route.TargetIID.NameId)
+			if err != nil {
+				return err
+			}
+			routeList[i].TargetIID = getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		case cres.RouteTargetVNic:
+			var iidInfo VNicIIDInfo
+			err := infostore.GetBy3Conditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, route.TargetIID.NameId,
+				OWNER_VPC_NAME_COLUMN, vpcName)
+			if err != nil {
+				return err
+			}
+			routeList[i].TargetIID = getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		case cres.RouteTargetLocal, cres.RouteTargetInternetGateway:
+			routeList[i].TargetIID = cres.IID{}
+		default:
+			return fmt.Errorf("%s is not a valid route target type", route.TargetType)
+		}
+	}
+	return nil
+}
+
+// checkNATGatewayNotUsedByRoute returns an error if a route of the VPC's route tables targets the NATGateway.
+// All route tables of the CSP are checked, including the main route table and the ones created outside of CB-Spider.
+func checkNATGatewayNotUsedByRoute(connectionName string, vpcName string, natGatewayName string, natGatewaySystemId string) error {
+	handler, err := getRouteTableHandler(connectionName)
+	if err != nil {
+		return err
+	}
+	infoList, err := handler.ListRouteTable()
+	if err != nil {
+		return err
+	}
+
+	nameList := []string{}
+	for _, info := range infoList {
+		for _, route := range info.RouteList {
+			if route.TargetType != cres.RouteTargetNATGateway || route.TargetIID.SystemId != natGatewaySystemId {
+				continue
+			}
+			var iidInfo RouteTableIIDInfo
+			err := infostore.GetByConditionsAndContain(&iidInfo, CONNECTION_NAME_COLUMN, connectionName,
+				OWNER_VPC_NAME_COLUMN, vpcName, SYSTEM_ID_COLUMN, info.IId.SystemId)
+			if err == nil {
+				nameList = append(nameList, iidInfo.NameId)
+			} else {
+				nameList = append(nameList, info.IId.SystemId)
+			}
+			break
+		}
+	}
+	if len(nameList) > 0 {
+		return fmt.Errorf("the NATGateway '%s' is the target of the routes of RouteTable(s) %v. Remove the routes first", natGatewayName, nameList)
+	}
+	return nil
+}
+
+// checkVPCNotUsedByRouteTable returns an error if a RouteTable was created in the VPC.
+func checkVPCNotUsedByRouteTable(connectionName string, vpcName string) error {
+	var iidInfoList []*RouteTableIIDInfo
+	err := infostore.ListByConditions(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		return err
+	}
+	if len(iidInfoList) > 0 {
+		nameList := []string{}
+		for _, iidInfo := range iidInfoList {
+			nameList = append(nameList, iidInfo.NameId)
+		}
+		return fmt.Errorf("the VPC '%s' is used by RouteTable(s) %v. Delete them first", vpcName, nameList)
+	}
+	return nil
+}
+
+// checkSubnetNotUsedByRouteTable returns an error if the Subnet is associated with a RouteTable of the VPC.
+// A RouteTable which can not be read fails the check.
+func checkSubnetNotUsedByRouteTable(connectionName string, vpcName string, subnetName string, subnetSystemId string) error {
+	var iidInfoList []*RouteTableIIDInfo
+	err := infostore.ListByConditions(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		return err
+	}
+	if len(iidInfoList) == 0 {
+		return nil
+	}
+	handler, err := getRouteTableHandler(connectionName)
+	if err != nil {
+		return err
+	}
+
+	nameList := []string{}
+	for _, iidInfo := range iidInfoList {
+		info, err := handler.GetRouteTable(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		if err != nil {
+			return fmt.Errorf("failed to check the subnet associations of the RouteTable '%s': %v", iidInfo.NameId, err)
+		}
+		for _, subnetIID := range info.SubnetIIDs {
+			if subnetIID.SystemId == subnetSystemId {
+				nameList = append(nameList, iidInfo.NameId)
+				break
+			}
+		}
+	}
+	if len(nameList) > 0 {
+		return fmt.Errorf("the Subnet '%s' is associated with RouteTable(s) %v. Disassociate it first", subnetName, nameList)
+	}
+	return nil
+}
+
+// setRouteTableUserIIDs sets the user IIDs of VPC, Subnets and route targets of a RouteTable.
+func setRouteTableUserIIDs(connectionName string, vpcName string, info *cres.RouteTableInfo) {
+	info.VpcIID.NameId = vpcName
+
+	for i, subnetIID := range info.SubnetIIDs {
+		var subnetIIDInfo SubnetIIDInfo
+		err := infostore.GetByConditionsAndContain(&subnetIIDInfo, CONNECTION_NAME_COLUMN, connectionName,
+			OWNER_VPC_NAME_COLUMN, vpcName, SYSTEM_ID_COLUMN, subnetIID.SystemId)
+		if err == nil {
+			info.SubnetIIDs[i].NameId = subnetIIDInfo.NameId
+		}
+	}
+
+	for i, route := range info.RouteList {
+		if route.TargetIID.SystemId == "" {
+			continue
+		}
+		nameId, err := getRouteTargetNameId(connectionName, vpcName, route)
+		if err != nil {
+			// the target may be created outside of CB-Spider.
+			cblog.Info(err)
+			continue
+		}
+		info.RouteList[i].TargetIID.NameId = nameId
+	}
+}
+
+// getRouteTargetNameId returns the NameId of the route target, which the driver returns with the CSP's ID.
+func getRouteTargetNameId(connectionName string, vpcName string, route cres.RouteInfo) (string, error) {
+	switch route.TargetType {
+	case cres.RouteTargetNATGateway:
+		var iidInfo NATGatewayIIDInfo
+		err := infostore.GetByConditionsAndContain(&iidInfo, CONNECTION_NAME_COLUMN, connectionName,
+			OWNER_VPC_NAME_COLUMN, vpcName, SYSTEM_ID_COLUMN, route.TargetIID.SystemId)
+		return iidInfo.NameId, err
+	case cres.RouteTargetVPCPeering:
+		var iidInfo VPCPeeringIIDInfo
+		err := infostore.GetByContain(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, route.TargetIID.SystemId)
+		if err != nil {
+			// accepted by the connection
+			err = infostore.GetByContain(&iidInfo, PEER_CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, route.TargetIID.SystemId)
+		}
+		return iidInfo.NameId, err
+	case cres.RouteTargetVNic:
+		var iidInfo VNicIIDInfo
+		err := infostore.GetByConditionsAndContain(&iidInfo, CONNECTION_NAME_COLUMN, connectionName,
+			OWNER_VPC_NAME_COLUMN, vpcName, SYSTEM_ID_COLUMN, route.TargetIID.SystemId)
+		return iidInfo.NameId, err
+	default:
+		return route.TargetIID.NameId, nil
+	}
+}
@@ -41,7 +41,7 @@ func init() {
 		cblog.Error(err)
 		return
 	}
-	db.AutoMigrate(&S3BucketIIDInfo{})
+	infostore.AutoMigrate(db, &S3BucketIIDInfo{})
 	infostore.Close(db)
 }
 
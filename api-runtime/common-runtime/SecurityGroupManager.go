@@ -10,7 +10,9 @@ package commonruntime
 
 import (
 	"fmt"
+	"net"
 	"os"
+	"strconv"
 	"strings"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
@@ -36,7 +38,7 @@ func init() {
 		cblog.Error(err)
 		return
 	}
-	db.AutoMigrate(&SGIIDInfo{})
+	infostore.AutoMigrate(db, &SGIIDInfo{})
 	infostore.Close(db)
 }
 
@@ -274,6 +276,7 @@ func RegisterSecurity(connectionName string, vpcUserID string, userIID cres.IID)
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(getInfo.SecurityRules)
+	setRemoteSGUserIIDs(connectionName, getInfo.SecurityRules)
 
 	// (3) create spiderIID: {UserID, SP-XID:CSP-ID}
 	//     ex) spiderIID {"vpc-01", "vpc-01-9m4e2mr0ui3e8a215n4g:i-0bc7123b7e5cbf79d"}
@@ -385,11 +388,24 @@ func CreateSecurity(connectionName string, rsType string, reqInfo cres.SecurityR
 		return nil, err
 	}
 
+	// RemoteSecurityGroup: NameId => driver IID
+	err = setRemoteSGDriverIIDs(connectionName, vpcIIDInfo.NameId, reqInfo.SecurityRules)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	// Direction: to lower
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(reqInfo.SecurityRules)
 
+	err = validateRules(connectionName, reqInfo.SecurityRules)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	sgSPLock.Lock(connectionName, reqInfo.IId.NameId)
 	defer sgSPLock.Unlock(connectionName, reqInfo.IId.NameId)
 	// (1) check exist(NameID)
@@ -455,6 +471,7 @@ func CreateSecurity(connectionName string, rsType string, reqInfo cres.SecurityR
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(info.SecurityRules)
+	setRemoteSGUserIIDs(connectionName, info.SecurityRules)
 
 	// set VPC NameId
 	info.VpcIID.NameId = reqInfo.VpcIID.NameId
@@ -495,10 +512,146 @@ func transformArgs(ruleList *[]cres.SecurityRuleInfo) {
 		(*ruleList)[n].Direction = strings.ToLower((*ruleList)[n].Direction)
 		// IPProtocol: to upper => ALL | TCP | UDP | ICMP
 		(*ruleList)[n].IPProtocol = strings.ToUpper((*ruleList)[n].IPProtocol)
-		// no CIDR, set default ("0.0.0.0/0")
-		if (*ruleList)[n].CIDR == "" {
+		// no CIDR and no remote SecurityGroup, set default ("0.0.0.0/0")
+		if (*ruleList)[n].CIDR == "" && (*ruleList)[n].RemoteSecurityGroupIID == nil {
 			(*ruleList)[n].CIDR = "0.0.0.0/0"
 		}
+		// Action: to lower => allow | deny, no Action, set default ("allow")
+		(*ruleList)[n].Action = strings.ToLower(strings.TrimSpace((*ruleList)[n].Action))
+		if (*ruleList)[n].Action == "" {
+			(*ruleList)[n].Action = "allow"
+		}
+		(*ruleList)[n].Priority = strings.TrimSpace((*ruleList)[n].Priority)
+	}
+}
+
+// validateRules checks the IPv6 CIDR, Action and Priority of rules.
+// An IPv6 CIDR and a deny rule are allowed only for the CSPs that support them,
+// and a Priority can be used only once in the same direction of the rules.
+func validateRules(connectionName string, ruleList *[]cres.SecurityRuleInfo) error {
+	if ruleList == nil {
+		return nil
+	}
+
+	checked := false
+	ipv6Checked := false
+	for _, rule := range *ruleList {
+		// IPv6 CIDR
+		if strings.Contains(rule.CIDR, ":") {
+			if ip, _, err := net.ParseCIDR(rule.CIDR); err != nil || ip.To4() != nil {
+				return fmt.Errorf("invalid IPv6 CIDR(%s) of a rule", rule.CIDR)
+			}
+			if !ipv6Checked {
+				if err := checkCapability(connectionName, IPV6_DUAL_STACK); err != nil {
+					return err
+				}
+				ipv6Checked = true
+			}
+		}
+		if rule.Priority != "" {
+			if _, err := strconv.Atoi(rule.Priority); err != nil {
+				return fmt.Errorf("invalid Priority(%s) of a rule: it should be a number", rule.Priority)
+			}
+		}
+		switch rule.Action {
+		case "allow":
+		case "deny":
+			if !checked {
+				if err := checkCapability(connectionName, SG_DENY_RULE); err != nil {
+					return err
+				}
+				checked = true
+			}
+		default:
+			return fmt.Errorf("invalid Action(%s) of a rule: it should be allow or deny", rule.Action)
+		}
+	}
+	return checkDuplicatePriority(*ruleList)
+}
+
+// isSameRule checks whether two normalized rules are the same rule.
+// The Priority is compared only when both have it, and the Description is not compared.
+// The CIDR of rules with a remote SecurityGroup is not compared, some drivers(ex: AWS) set it to the GroupId.
+func isSameRule(a cres.SecurityRuleInfo, b cres.SecurityRuleInfo) bool {
+	if a.Direction != b.Direction || a.IPProtocol != b.IPProtocol || a.FromPort != b.FromPort || a.ToPort != b.ToPort {
+		return false
+	}
+	if a.Action != b.Action {
+		return false
+	}
+	if (a.RemoteSecurityGroupIID == nil) != (b.RemoteSecurityGroupIID == nil) {
+		return false
+	}
+	if a.RemoteSecurityGroupIID != nil {
+		if a.RemoteSecurityGroupIID.SystemId != b.RemoteSecurityGroupIID.SystemId {
+			return false
+		}
+	} else if a.CIDR != b.CIDR {
+		return false
+	}
+	if a.Priority != "" && b.Priority != "" && a.Priority != b.Priority {
+		return false
+	}
+	return true
+}
+
+// setRemoteSGDriverIIDs translates the NameId of remote SecurityGroups in rules to the driver IID.
+// A rule can have a CIDR or a remote SecurityGroup, not both,
+// and the remote SecurityGroup has to be in the same connection and VPC as the SecurityGroup of the rule.
+func setRemoteSGDriverIIDs(connectionName string, vpcName string, ruleList *[]cres.SecurityRuleInfo) error {
+	if ruleList == nil {
+		return nil
+	}
+
+	checked := false
+	for n, rule := range *ruleList {
+		if rule.RemoteSecurityGroupIID == nil {
+			continue
+		}
+		if rule.CIDR != "" {
+			return fmt.Errorf("a rule can not have both CIDR(%s) and RemoteSecurityGroup(%s)", rule.CIDR, rule.RemoteSecurityGroupIID.NameId)
+		}
+		if !checked {
+			if err := checkCapability(connectionName, SG_REFERENCE_RULE); err != nil {
+				return err
+			}
+			checked = true
+		}
+
+		var iidInfo SGIIDInfo
+		err := infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, rule.RemoteSecurityGroupIID.NameId)
+		if err != nil {
+			return fmt.Errorf("The %s '%s' does not exist!", RSTypeString(SG), rule.RemoteSecurityGroupIID.NameId)
+		}
+		if iidInfo.OwnerVPCName != vpcName {
+			return fmt.Errorf("The remote %s '%s' is in the VPC '%s', not in the VPC '%s' of the rule!", RSTypeString(SG),
+				rule.RemoteSecurityGroupIID.NameId, iidInfo.OwnerVPCName, vpcName)
+		}
+		driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		(*ruleList)[n].RemoteSecurityGroupIID = &driverIId
+	}
+	return nil
+}
+
+// setRemoteSGUserIIDs sets the user IIDs of remote SecurityGroups in rules returned by a driver.
+func setRemoteSGUserIIDs(connectionName string, ruleList *[]cres.SecurityRuleInfo) {
+	if ruleList == nil {
+		return
+	}
+
+	for n, rule := range *ruleList {
+		if rule.RemoteSecurityGroupIID == nil || rule.RemoteSecurityGroupIID.SystemId == "" {
+			continue
+		}
+		var iidInfo SGIIDInfo
+		err := infostore.GetByContain(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, ":"+rule.RemoteSecurityGroupIID.SystemId)
+		if err != nil {
+			// not managed by Spider
+			(*ruleList)[n].RemoteSecurityGroupIID = &cres.IID{NameId: "", SystemId: rule.RemoteSecurityGroupIID.SystemId}
+			continue
+		}
+		userIId := getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		(*ruleList)[n].RemoteSecurityGroupIID = &userIId
 	}
 }
 
@@ -572,6 +725,7 @@ func ListSecurity(connectionName string, rsType string) ([]*cres.SecurityInfo, e
 		// IPProtocol: to upper
 		// no CIDR: "0.0.0.0/0"
 		transformArgs(info.SecurityRules)
+		setRemoteSGUserIIDs(connectionName, info.SecurityRules)
 
 		// (3) set ResourceInfo(IID.NameId)
 		// set ResourceInfo
@@ -701,6 +855,7 @@ func ListVpcSecurity(connectionName, rsType, vpcName string) ([]*cres.SecurityIn
 
 		//Transform security rules
 		transformArgs(info.SecurityRules)
+		setRemoteSGUserIIDs(connectionName, info.SecurityRules)
 
 		// Set resource info
 		info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
@@ -795,6 +950,7 @@ func GetSecurity(connectionName string, rsType string, nameID string) (*cres.Sec
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(info.SecurityRules)
+	setRemoteSGUserIIDs(connectionName, info.SecurityRules)
 
 	// (3) set ResourceInfo(IID.NameId)
 	// set ResourceInfo
@@ -862,6 +1018,12 @@ func AddRules(connectionName string, sgName string, reqInfoList []cres.SecurityR
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(&reqInfoList)
 
+	err = validateRules(connectionName, &reqInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	sgSPLock.Lock(connectionName, sgName)
 	defer sgSPLock.Unlock(connectionName, sgName)
 
@@ -914,7 +1076,37 @@ func AddRules(connectionName string, sgName string, reqInfoList []cres.SecurityR
 		}
 	}
 
+	// RemoteSecurityGroup: NameId => driver IID
+	err = setRemoteSGDriverIIDs(connectionName, iidInfo.OwnerVPCName, &reqInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	// (2) add Rules
+	// a Priority can not be used twice in the same direction
+	curInfo, err := handler.GetSecurity(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if curInfo.SecurityRules != nil {
+		transformArgs(curInfo.SecurityRules)
+		for _, reqRule := range reqInfoList {
+			if reqRule.Priority == "" {
+				continue
+			}
+			for _, curRule := range *curInfo.SecurityRules {
+				if curRule.Direction == reqRule.Direction && curRule.Priority == reqRule.Priority {
+					err := fmt.Errorf("The %s '%s' already has a %s rule with the Priority %s!", RSTypeString(SG), sgName,
+						reqRule.Direction, reqRule.Priority)
+					cblog.Error(err)
+					return nil, err
+				}
+			}
+		}
+	}
+
 	// driverIID for driver
 	info, err := handler.AddRules(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), &reqInfoList)
 	if err != nil {
@@ -925,6 +1117,7 @@ func AddRules(connectionName string, sgName string, reqInfoList []cres.SecurityR
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(info.SecurityRules)
+	setRemoteSGUserIIDs(connectionName, info.SecurityRules)
 
 	// (3) set ResourceInfo(userIID)
 	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
@@ -1043,7 +1236,32 @@ func RemoveRules(connectionName string, sgName string, reqRuleInfoList []cres.Se
 		}
 	}
 
+	// RemoteSecurityGroup: NameId => driver IID
+	err = setRemoteSGDriverIIDs(connectionName, iidInfo.OwnerVPCName, &reqRuleInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
 	// (2) remove Rules
+	// use the matched current rules, which have the Priority and Description
+	curInfo, err := handler.GetSecurity(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	if curInfo.SecurityRules != nil {
+		transformArgs(curInfo.SecurityRules)
+		for n, reqRule := range reqRuleInfoList {
+			for _, curRule := range *curInfo.SecurityRules {
+				if isSameRule(reqRule, curRule) {
+					reqRuleInfoList[n] = curRule
+					break
+				}
+			}
+		}
+	}
+
 	// driverIID for driver
 	result, err := handler.RemoveRules(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), &reqRuleInfoList)
 	if err != nil {
@@ -1054,6 +1272,237 @@ func RemoveRules(connectionName string, sgName string, reqRuleInfoList []cres.Se
 	return result, nil
 }
 
+// SecurityRuleDiffInfo is the diff between the current and the desired rules of a SecurityGroup.
+type SecurityRuleDiffInfo struct {
+	DryRun       bool                    `json:"DryRun" validate:"required" example:"false"`
+	AddedRules   []cres.SecurityRuleInfo `json:"AddedRules" validate:"required" description:"Rules to add, or added if not DryRun"`
+	RemovedRules []cres.SecurityRuleInfo `json:"RemovedRules" validate:"required" description:"Rules to remove, or removed if not DryRun"`
+}
+
+// (1) normalize the desired rules
+// (2) get the current rules
+// (3) compute and validate the diff
+// (4) remove and add Rules, if not dryRun
+// The Description is not compared, so a rule with only a changed Description is not updated.
+// If adding fails, the removed rules are added again, and the error tells what is applied.
+func SyncRules(connectionName string, sgName string, desiredRuleList []cres.SecurityRuleInfo, dryRun bool) (*SecurityRuleDiffInfo, error) {
+	cblog.Info("call SyncRules()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	sgName, err = EmptyCheckAndTrim("sgName", sgName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateSecurityHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (1) normalize the desired rules
+	// Direction: to lower
+	// IPProtocol: to upper
+	// no CIDR: "0.0.0.0/0"
+	transformArgs(&desiredRuleList)
+
+	err = validateRules(connectionName, &desiredRuleList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	sgSPLock.Lock(connectionName, sgName)
+	defer sgSPLock.Unlock(connectionName, sgName)
+
+	var iidInfo SGIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var iidInfoList []*SGIIDInfo
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, sgName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		iidInfo = *castedIIDInfo.(*SGIIDInfo)
+	} else {
+		err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, sgName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	// RemoteSecurityGroup: NameId => driver IID
+	err = setRemoteSGDriverIIDs(connectionName, iidInfo.OwnerVPCName, &desiredRuleList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get the current rules
+	curInfo, err := handler.GetSecurity(driverIId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	curRuleList := []cres.SecurityRuleInfo{}
+	if curInfo.SecurityRules != nil {
+		curRuleList = *curInfo.SecurityRules
+	}
+	transformArgs(&curRuleList)
+
+	// (3) compute and validate the diff
+	diffInfo := SecurityRuleDiffInfo{DryRun: dryRun, AddedRules: []cres.SecurityRuleInfo{}, RemovedRules: []cres.SecurityRuleInfo{}}
+	syncedRuleList := []cres.SecurityRuleInfo{}
+	for _, curRule := range curRuleList {
+		if containsRule(desiredRuleList, curRule) {
+			syncedRuleList = append(syncedRuleList, curRule)
+		} else {
+			diffInfo.RemovedRules = append(diffInfo.RemovedRules, curRule)
+		}
+	}
+	for _, desiredRule := range desiredRuleList {
+		if !containsRule(curRuleList, desiredRule) && !containsRule(diffInfo.AddedRules, desiredRule) {
+			diffInfo.AddedRules = append(diffInfo.AddedRules, desiredRule)
+		}
+	}
+	syncedRuleList = append(syncedRuleList, diffInfo.AddedRules...)
+
+	// a Priority can not be used twice in the same direction
+	err = checkDuplicatePriority(syncedRuleList)
+	if err != nil {
+		err = fmt.Errorf("The %s '%s' can not be synced: %v", RSTypeString(SG), sgName, err)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) remove and add Rules, if not dryRun
+	if !dryRun {
+		err = applyRuleDiff(handler, driverIId, &diffInfo)
+		if err != nil {
+			err = fmt.Errorf("The %s '%s' is not synced: %v", RSTypeString(SG), sgName, err)
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	// RemoteSecurityGroup: driver IID => user IID
+	setRemoteSGUserIIDs(connectionName, &diffInfo.AddedRules)
+	setRemoteSGUserIIDs(connectionName, &diffInfo.RemovedRules)
+
+	return &diffInfo, nil
+}
+
+// applyRuleDiff removes and then adds the rules of the diff.
+// It removes first, because some CSPs do not allow the same rule with another Priority.
+// If adding fails, the removed rules are added again.
+func applyRuleDiff(handler cres.SecurityHandler, driverIId cres.IID, diffInfo *SecurityRuleDiffInfo) error {
+	if len(diffInfo.RemovedRules) > 0 {
+		removeRuleList := append([]cres.SecurityRuleInfo{}, diffInfo.RemovedRules...)
+		_, err := handler.RemoveRules(driverIId, &removeRuleList)
+		if err != nil {
+			return fmt.Errorf("failed to remove %d rules, no rule is added: %v", len(diffInfo.RemovedRules), err)
+		}
+	}
+	if len(diffInfo.AddedRules) > 0 {
+		addRuleList := append([]cres.SecurityRuleInfo{}, diffInfo.AddedRules...)
+		_, err := handler.AddRules(driverIId, &addRuleList)
+		if err != nil {
+			if len(diffInfo.RemovedRules) == 0 {
+				return fmt.Errorf("failed to add %d rules, no rule is removed: %v", len(diffInfo.AddedRules), err)
+			}
+			restoreRuleList := append([]cres.SecurityRuleInfo{}, diffInfo.RemovedRules...)
+			_, restoreErr := handler.AddRules(driverIId, &restoreRuleList)
+			if restoreErr != nil {
+				return fmt.Errorf("failed to add %d rules: %v, and failed to restore the %d removed rules, which are missing now: %v",
+					len(diffInfo.AddedRules), err, len(diffInfo.RemovedRules), restoreErr)
+			}
+			return fmt.Errorf("failed to add %d rules, the %d removed rules are restored: %v",
+				len(diffInfo.AddedRules), len(diffInfo.RemovedRules), err)
+		}
+	}
+	return nil
+}
+
+// checkDuplicatePriority checks that a Priority is used only once in the same direction.
+func checkDuplicatePriority(ruleList []cres.SecurityRuleInfo) error {
+	usedPriorities := map[string]bool{}
+	for _, rule := range ruleList {
+		if rule.Priority == "" {
+			continue
+		}
+		key := rule.Direction + "/" + rule.Priority
+		if usedPriorities[key] {
+			return fmt.Errorf("the Priority %s is used by two %s rules", rule.Priority, rule.Direction)
+		}
+		usedPriorities[key] = true
+	}
+	return nil
+}
+
+// checkSecurityGroupNotReferenced checks that no rule of other SecurityGroups in the same VPC
+// references the SecurityGroup as a RemoteSecurityGroup.
+func checkSecurityGroupNotReferenced(handler cres.SecurityHandler, iidInfo SGIIDInfo, sgSystemId string) error {
+	var iidInfoList []*SGIIDInfo
+	err := infostore.ListByConditions(&iidInfoList, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, OWNER_VPC_NAME_COLUMN, iidInfo.OwnerVPCName)
+	if err != nil {
+		return err
+	}
+
+	nameList := []string{}
+	for _, one := range iidInfoList {
+		if one.NameId == iidInfo.NameId {
+			continue
+		}
+		info, err := handler.GetSecurity(getDriverIID(cres.IID{NameId: one.NameId, SystemId: one.SystemId}))
+		if err != nil {
+			return fmt.Errorf("failed to check the rules of the %s '%s': %v", RSTypeString(SG), one.NameId, err)
+		}
+		if info.SecurityRules == nil {
+			continue
+		}
+		for _, rule := range *info.SecurityRules {
+			if rule.RemoteSecurityGroupIID != nil && rule.RemoteSecurityGroupIID.SystemId == sgSystemId {
+				nameList = append(nameList, one.NameId)
+				break
+			}
+		}
+	}
+	if len(nameList) > 0 {
+		return fmt.Errorf("the SecurityGroup '%s' is referenced by the rules of SecurityGroup(s) %v. Remove the rules first", iidInfo.NameId, nameList)
+	}
+	return nil
+}
+
+// containsRule checks whether the rule list has the same rule.
+func containsRule(ruleList []cres.SecurityRuleInfo, rule cres.SecurityRuleInfo) bool {
+	for _, one := range ruleList {
+		if isSameRule(one, rule) {
+			return true
+		}
+	}
+	return false
+}
+
 // (1) get spiderIID
 // (2) delete Resource(SystemId)
 // (3) delete IID
@@ -1111,8 +1560,23 @@ func DeleteSecurity(connectionName string, rsType string, nameID string, force s
 		}
 	}
 
-	// (2) delete Resource(SystemId)
+	// VNics using the SecurityGroup have to be deleted before the SecurityGroup
+	// the rules of other SecurityGroups referencing the SecurityGroup have to be removed before the SecurityGroup
 	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	if force != "true" {
+		err = checkSecurityGroupNotUsedByVNic(iidInfo.ConnectionName, iidInfo.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return false, err
+		}
+		err = checkSecurityGroupNotReferenced(handler, iidInfo, driverIId.SystemId)
+		if err != nil {
+			cblog.Error(err)
+			return false, err
+		}
+	}
+
+	// (2) delete Resource(SystemId)
 	result, err := handler.(cres.SecurityHandler).DeleteSecurity(driverIId)
 	if err != nil {
 		cblog.Error(err)
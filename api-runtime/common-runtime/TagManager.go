@@ -405,7 +405,10 @@ func checkTagSupported(connectionName string, resType cres.RSType) error {
 	}
 
 	// Get the capabilities of the cloud driver
-	driverCapability := cloudDriver.GetDriverCapability()
+	driverCapability, err := getDriverCapability(cloudDriver)
+	if err != nil {
+		return fmt.Errorf("failed to get the capability of the cloud driver for provider %s: %v", providerName, err)
+	}
 
 	// Define a common error message format for unsupported tagging
 	errMsg := fmt.Sprintf("[TAG_NOT_SUPPORTED] Tagging is not supported for the resource: %s-%s", providerName, resType)
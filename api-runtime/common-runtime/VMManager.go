@@ -9,6 +9,7 @@
 package commonruntime
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -17,6 +18,7 @@ import (
 	"sync"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
 	ccon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
@@ -47,7 +49,7 @@ func init() {
 		cblog.Error(err)
 		return
 	}
-	db.AutoMigrate(&VMIIDInfo{})
+	infostore.AutoMigrate(db, &VMIIDInfo{})
 	infostore.Close(db)
 }
 
@@ -283,6 +285,10 @@ func RegisterVM(connectionName string, userIID cres.IID) (*cres.VMInfo, error) {
 
 	rsType := VM
 
+	if err := checkNotEmulatedNLBResource(connectionName, rsType, userIID.SystemId); err != nil {
+		return nil, err
+	}
+
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
 		cblog.Error(err)
@@ -401,6 +407,13 @@ func RegisterVM(connectionName string, userIID cres.IID) (*cres.VMInfo, error) {
 // (6) insert spiderIID
 // (7) create userIID
 func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTransformMode string) (*cres.VMInfo, error) {
+	return StartVMWithContext(context.Background(), connectionName, rsType, reqInfo, IDTransformMode)
+}
+
+// StartVMWithContext is StartVM with a ctx to cancel the request.
+// If ctx is done before the VM is created, no VM is created.
+// If ctx is done after the VM is created, it stops waiting for the VM and registers the VM.
+func StartVMWithContext(ctx context.Context, connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTransformMode string) (*cres.VMInfo, error) {
 	cblog.Info("call StartVM()")
 
 	if os.Getenv("CALL_COUNT") != "" {
@@ -422,8 +435,10 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 		"resources.VMReqInfo:RootDiskSize", // because can be set without disk size
 		// "resources.VMReqInfo:KeyPairName",  // because can be set without KeyPair for Windows
 		//	"resources.IID:NameId",
-		"resources.VMReqInfo:VMUserId",     // because can be set without VM User
-		"resources.VMReqInfo:VMUserPasswd", // because can be set without VM PW
+		"resources.VMReqInfo:VMUserId",        // because can be set without VM User
+		"resources.VMReqInfo:VMUserPasswd",    // because can be set without VM PW
+		"resources.VMReqInfo:UserData",        // because can be set without UserData
+		"resources.VMPurchaseOption:MaxPrice", // because can be set without Spot max price
 	}
 
 	err = ValidateStruct(reqInfo, emptyPermissionList)
@@ -438,6 +453,12 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 		return nil, err
 	}
 
+	err = checkPurchaseOption(connectionName, &reqInfo.PurchaseOption)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	vmSPLock.Lock(connectionName, reqInfo.IId.NameId)
 	defer vmSPLock.Unlock(connectionName, reqInfo.IId.NameId)
 
@@ -499,11 +520,12 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateVMHandler()
+	vmHandler, err := cldConn.CreateVMHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
+	handler := cres.ToVMHandlerWithContext(vmHandler)
 
 	bool_ret = false
 	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
@@ -552,6 +574,13 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 		return nil, err
 	}
 
+	// check user's UserData(script or cloud-config) with the provider's meta info.
+	err = validateUserData(providerName, reqInfo.UserData)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	// (2) clone and translate the reqInfo with DriverIID
 	var reqInfoForDriver cres.VMReqInfo
 
@@ -575,6 +604,12 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 
 	if isWindowsOS {
 		rsType = "windowsvm" // be used for NCP and NCP in IIDManager.New()
+
+		if reqInfo.UserData != "" {
+			err := fmt.Errorf("UserData is not supported for Windows GuestOS yet!")
+			cblog.Error(err)
+			return nil, err
+		}
 	}
 
 	spUUID := ""
@@ -628,7 +663,7 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 	start := call.Start()
 
 	// (4) create Resource
-	info, err := handler.StartVM(reqInfoForDriver)
+	info, err := handler.StartVMWithContext(ctx, reqInfoForDriver)
 	if err != nil {
 		cblog.Error(err)
 		callInfo.ErrorMSG = err.Error()
@@ -646,9 +681,14 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 	waiter := NewWaiter(15, 600) // (sleep, timeout)
 	var publicIP string
 	for {
-		vmInfo, err := handler.GetVM(info.IId)
+		vmInfo, err := handler.GetVMWithContext(ctx, info.IId)
 		if err != nil {
 			cblog.Error(err)
+			if ctx.Err() != nil { // stop waiting, but register the created VM
+				checkError.Flag = true
+				checkError.MSG = fmt.Sprintf("[%s] Stopped waiting for VM %s when getting PublicIP. (%v)", connectionName, reqIId.NameId, ctx.Err())
+				break
+			}
 			if checkNotFoundError(err) { // VM is not created yet.
 				continue
 			}
@@ -664,10 +704,13 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 			break
 		}
 
-		if !waiter.Wait() {
+		if !waiter.WaitContext(ctx) {
 			//handler.TerminateVM(info.IId)
 			checkError.Flag = true
 			checkError.MSG = fmt.Sprintf("[%s] Failed to Start VM %s when getting PublicIP. (Timeout=%v)", connectionName, reqIId.NameId, waiter.Timeout)
+			if ctx.Err() != nil {
+				checkError.MSG = fmt.Sprintf("[%s] Stopped waiting for VM %s when getting PublicIP. (%v)", connectionName, reqIId.NameId, ctx.Err())
+			}
 			break
 		}
 	}
@@ -681,10 +724,13 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 				break
 			}
 
-			if !waiter2.Wait() {
+			if !waiter2.WaitContext(ctx) {
 				//handler.TerminateVM(info.IId)
 				checkError.Flag = true
 				checkError.MSG = fmt.Sprintf("[%s] Failed to Start VM %s when checking SSHD Daemon. (Timeout=%v)", connectionName, reqIId.NameId, waiter2.Timeout)
+				if ctx.Err() != nil {
+					checkError.MSG = fmt.Sprintf("[%s] Stopped waiting for VM %s when checking SSHD Daemon. (%v)", connectionName, reqIId.NameId, ctx.Err())
+				}
 				break
 			}
 		}
@@ -779,6 +825,52 @@ func checkImageType(reqInfo *cres.VMReqInfo) error {
 	return nil
 }
 
+// check the purchase option and set the defaults.
+// Spot is rejected before calling the driver, if the driver does not support it.
+func checkPurchaseOption(connectionName string, option *cres.VMPurchaseOption) error {
+
+	switch strings.ToLower(strings.TrimSpace(string(option.PurchaseType))) {
+	case "", strings.ToLower(string(cres.PurchaseOnDemand)):
+		option.PurchaseType = cres.PurchaseOnDemand
+	case strings.ToLower(string(cres.PurchaseSpot)):
+		option.PurchaseType = cres.PurchaseSpot
+	default:
+		return fmt.Errorf("invalid PurchaseType '%s', it should be one of %s or %s", option.PurchaseType, cres.PurchaseOnDemand, cres.PurchaseSpot)
+	}
+
+	option.MaxPrice = strings.TrimSpace(option.MaxPrice)
+	if option.PurchaseType == cres.PurchaseOnDemand {
+		if option.MaxPrice != "" || option.InterruptionBehavior != "" {
+			return errors.New("MaxPrice and InterruptionBehavior are only for the Spot PurchaseType!!")
+		}
+		return nil
+	}
+
+	if err := checkCapability(connectionName, SPOT_VM); err != nil {
+		return err
+	}
+
+	if option.MaxPrice != "" {
+		price, err := strconv.ParseFloat(option.MaxPrice, 64)
+		if err != nil || price <= 0 {
+			return fmt.Errorf("invalid MaxPrice '%s', it should be a positive number", option.MaxPrice)
+		}
+	}
+
+	switch strings.ToLower(strings.TrimSpace(string(option.InterruptionBehavior))) {
+	case "", strings.ToLower(string(cres.InterruptionTerminate)):
+		option.InterruptionBehavior = cres.InterruptionTerminate
+	case strings.ToLower(string(cres.InterruptionStop)):
+		option.InterruptionBehavior = cres.InterruptionStop
+	case strings.ToLower(string(cres.InterruptionHibernate)):
+		option.InterruptionBehavior = cres.InterruptionHibernate
+	default:
+		return fmt.Errorf("invalid InterruptionBehavior '%s', it should be one of %s, %s or %s", option.InterruptionBehavior,
+			cres.InterruptionTerminate, cres.InterruptionStop, cres.InterruptionHibernate)
+	}
+	return nil
+}
+
 func checkImageWindowsOS(cldConn ccon.CloudConnection, imageType cres.ImageType, imageIID cres.IID) (bool, error) {
 
 	if imageType == cres.PublicImage {
@@ -1104,6 +1196,27 @@ func translateRootDiskSetupInfo(providerName string, reqInfo *cres.VMReqInfo) er
 	return nil
 }
 
+// UserData: a script starting with '#!', a cloud-init YAML starting with '#cloud-config'
+// or a boothook starting with '#cloud-boothook'.
+// The max size of UserData is defined by 'userdatamaxsize' in cloudos_meta.yaml.
+// The drivers which merge their own init data into a MIME document check the size of the merged UserData again.
+func validateUserData(providerName string, userData string) error {
+	if userData == "" { // bypass
+		return nil
+	}
+
+	err := cdcom.ValidateUserDataSize(providerName, userData)
+	if err != nil {
+		return err
+	}
+
+	if cdcom.GetUserDataContentType(userData) == "text/plain" {
+		return fmt.Errorf("UserData must start with one of %s: a plain script, a cloud-init YAML or a boothook!",
+			strings.Join(cdcom.GetUserDataHeaderList(), ", "))
+	}
+	return nil
+}
+
 func validateRootDiskType(diskType string, diskTypeList []string) bool {
 	for _, v := range diskTypeList {
 		if diskType == v {
@@ -1124,6 +1237,19 @@ func setNameId(ConnectionName string, vmInfo *cres.VMInfo, reqInfo *cres.VMReqIn
 	if reqInfo.ImageType != "" {
 		vmInfo.ImageType = reqInfo.ImageType
 	}
+
+	// set PurchaseOption, if the driver does not report it
+	if vmInfo.PurchaseOption.PurchaseType == "" {
+		vmInfo.PurchaseOption.PurchaseType = reqInfo.PurchaseOption.PurchaseType
+	}
+	if vmInfo.PurchaseOption.PurchaseType == reqInfo.PurchaseOption.PurchaseType {
+		if vmInfo.PurchaseOption.MaxPrice == "" {
+			vmInfo.PurchaseOption.MaxPrice = reqInfo.PurchaseOption.MaxPrice
+		}
+		if vmInfo.PurchaseOption.InterruptionBehavior == "" {
+			vmInfo.PurchaseOption.InterruptionBehavior = reqInfo.PurchaseOption.InterruptionBehavior
+		}
+	}
 	if reqInfo.ImageIID.NameId != "" {
 		vmInfo.ImageIId.NameId = reqInfo.ImageIID.NameId
 	}
@@ -1383,6 +1509,11 @@ func getSetNameId(ConnectionName string, vmInfo *cres.VMInfo) error {
 	// find Image.SystemId in MyImage to get ImageType
 	// default imagetype is Public
 	vmInfo.ImageType = cres.PublicImage
+
+	// default PurchaseType is OnDemand, if the driver does not report it
+	if vmInfo.PurchaseOption.PurchaseType == "" {
+		vmInfo.PurchaseOption.PurchaseType = cres.PurchaseOnDemand
+	}
 	if vmInfo.ImageIId.SystemId != "" {
 		// get MyImage's NameId
 		var imageIIdInfo MyImageIIDInfo
@@ -1979,6 +2110,229 @@ func ControlVM(connectionName string, rsType string, nameID string, action strin
 	return info, nil
 }
 
+// (1) get IID(NameId)
+// (2) check the VMSpec with the VMSpec list
+// (3) suspend CSP:VM(SystemId), if it is running
+// (4) change the VMSpec of CSP:VM(SystemId)
+// (5) restore the previous power state
+func ChangeVMSpec(connectionName string, rsType string, nameID string, specName string) (*cres.VMInfo, error) {
+	cblog.Info("call ChangeVMSpec()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	specName, err = EmptyCheckAndTrim("specName", specName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// the VM is suspended to change the VMSpec, so check the driver before suspending it
+	if err := checkCapability(connectionName, VM_SPEC_CHANGE); err != nil {
+		return nil, err
+	}
+
+	vmSPLock.Lock(connectionName, nameID)
+	defer vmSPLock.Unlock(connectionName, nameID)
+
+	// (1) get IID(NameId)
+	var iidInfo VMIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var iidInfoList []*VMIIDInfo
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, nameID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		iidInfo = *castedIIDInfo.(*VMIIDInfo)
+	} else {
+		err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	cldConn, err := ccm.GetZoneLevelCloudConnection(connectionName, iidInfo.ZoneId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vmIID := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	// (2) check the VMSpec with the VMSpec list
+	specHandler, err := cldConn.CreateVMSpecHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	specInfoList, err := specHandler.ListVMSpec()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	found := false
+	for _, specInfo := range specInfoList {
+		if specInfo.Name == specName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		err := fmt.Errorf("The VMSpec '%s' is not available in the connection '%s'!", specName, connectionName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vmInfo, err := handler.GetVM(vmIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if vmInfo.VMSpecName == specName {
+		err := fmt.Errorf("The VM '%s' already has the VMSpec '%s'!", nameID, specName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) suspend CSP:VM(SystemId), if it is running
+	prevStatus, err := handler.GetVMStatus(vmIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	switch prevStatus {
+	case cres.Running:
+		_, err = handler.SuspendVM(vmIID)
+		if err == nil {
+			err = waitVMStatus(handler, vmIID, cres.Suspended)
+		}
+		if err != nil {
+			cblog.Error(err)
+			// the suspending can be done after the error, so resume the VM again
+			if resumeErr := resumeVMAfterSpecChange(handler, vmIID); resumeErr != nil {
+				return nil, fmt.Errorf("%v, %v", err, resumeErr)
+			}
+			return nil, err
+		}
+	case cres.Suspended:
+	default:
+		err := fmt.Errorf("The VMSpec of the VM '%s' can not be changed in the '%s' status!", nameID, prevStatus)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) change the VMSpec of CSP:VM(SystemId)
+	_, changeErr := handler.ChangeVMSpec(vmIID, specName)
+	if changeErr != nil {
+		cblog.Error(changeErr)
+	}
+
+	// (5) restore the previous power state
+	if prevStatus == cres.Running {
+		err = resumeVMAfterSpecChange(handler, vmIID)
+		if err != nil {
+			if changeErr != nil {
+				return nil, fmt.Errorf("%v, %v", changeErr, err)
+			}
+			return nil, err
+		}
+	}
+	if changeErr != nil {
+		return nil, changeErr
+	}
+
+	info, err := handler.GetVM(vmIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	err = getSetNameId(iidInfo.ConnectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if info.Platform == cres.WINDOWS {
+		info.VMUserId = "Administrator"
+		info.SSHAccessPoint = info.PublicIP + ":3389"
+	} else {
+		info.VMUserId = "cb-user"
+		if info.SSHAccessPoint == "" {
+			info.SSHAccessPoint = info.PublicIP + ":22"
+		}
+	}
+
+	return &info, nil
+}
+
+// wait until the VM is in the target status
+// resumeVMAfterSpecChange resumes the VM suspended by ChangeVMSpec().
+// The VM can be still suspending after an error, so it waits until the VM is suspended.
+func resumeVMAfterSpecChange(handler cres.VMHandler, vmIID cres.IID) error {
+	status, err := handler.GetVMStatus(vmIID)
+	if err == nil && status == cres.Suspending {
+		err = waitVMStatus(handler, vmIID, cres.Suspended)
+		status = cres.Suspended
+	}
+	if err == nil && status != cres.Running && status != cres.Resuming {
+		_, err = handler.ResumeVM(vmIID)
+	}
+	if err == nil {
+		err = waitVMStatus(handler, vmIID, cres.Running)
+	}
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+	return nil
+}
+
+func waitVMStatus(handler cres.VMHandler, vmIID cres.IID, target cres.VMStatus) error {
+	waiter := NewWaiter(5, 600) // 5 seconds sleep, 600 seconds timeout
+
+	for {
+		status, err := handler.GetVMStatus(vmIID)
+		if err != nil {
+			return err
+		}
+		if status == target {
+			return nil
+		}
+		if status == cres.Failed || status == cres.Terminating || status == cres.Terminated || status == cres.NotExist {
+			return fmt.Errorf("The VM '%s' is in the '%s' status!", vmIID.NameId, status)
+		}
+
+		if !waiter.Wait() {
+			return fmt.Errorf("Failed to wait until the VM '%s' is %s. Timeout after %v seconds", vmIID.NameId, target, waiter.Timeout)
+		}
+	}
+}
+
 func DeleteVM(connectionName string, rsType string, nameID string, force string) (bool, cres.VMStatus, error) {
 	cblog.Info("call DeleteVM()")
 
@@ -2039,6 +2393,18 @@ func DeleteVM(connectionName string, rsType string, nameID string, force string)
 		return false, "", err
 	}
 
+	// detach the VNics and PublicIPs attached by CB-Spider, which remain after the VM is terminated
+	err = detachVNicsFromVM(iidInfo.ConnectionName, iidInfo.NameId)
+	if err == nil {
+		err = detachPublicIPsFromVM(iidInfo.ConnectionName, iidInfo.NameId)
+	}
+	if err != nil {
+		cblog.Error(err)
+		if force != "true" {
+			return false, "", err
+		}
+	}
+
 	// (2) delete Resource(SystemId)
 	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
 	var vmStatus cres.VMStatus
@@ -0,0 +1,375 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// VMSpecRequirement is the minimum requirements of VM specs to recommend.
+// Empty or zero fields are not checked.
+type VMSpecRequirement struct {
+	MinVCpu       int    `json:"MinVCpu,omitempty" example:"2"`
+	MinMemSizeMiB int    `json:"MinMemSizeMiB,omitempty" example:"4096"`
+	GpuModel      string `json:"GpuModel,omitempty" example:"V100"` // matched with a part of the GPU model name
+	MinGpuCount   int    `json:"MinGpuCount,omitempty" example:"1"`
+	Architecture  string `json:"Architecture,omitempty" example:"x86_64"` // x86_64(amd64), arm64(aarch64)
+}
+
+// VMSpecRecommendation is a VM spec matched with the requirements and joined with its OnDemand price.
+type VMSpecRecommendation struct {
+	ConnectionName string          `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ProviderName   string          `json:"ProviderName" validate:"required" example:"AWS"`
+	RegionName     string          `json:"RegionName" validate:"required" example:"us-east-1"`
+	VMSpecInfo     cres.VMSpecInfo `json:"VMSpecInfo" validate:"required"`
+	Price          string          `json:"Price,omitempty" example:"0.0116"` // empty when the price is not available
+	Currency       string          `json:"Currency,omitempty" example:"USD"`
+	Unit           string          `json:"Unit,omitempty" example:"Hour"`
+}
+
+// VMSpecRecommendError is the error of a connection while recommending VM specs.
+type VMSpecRecommendError struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"gcp-connection"`
+	ErrorMsg       string `json:"ErrorMsg" validate:"required"`
+}
+
+// VMSpecRecommendResult is the ranked list of recommendations, the cheapest spec first.
+// Specs without a price are ranked after all priced specs.
+type VMSpecRecommendResult struct {
+	RecommendationList []*VMSpecRecommendation `json:"RecommendationList" validate:"required"`
+	ErrorList          []*VMSpecRecommendError `json:"ErrorList" validate:"required"`
+}
+
+// ================ VMSpec Recommend Handler
+// RecommendVMSpec finds the VM specs matched with the requirements in all connections,
+// and returns them ranked by the price.
+// The errors of each connection are reported in ErrorList without failing the whole call.
+func RecommendVMSpec(connectionNames []string, requirement VMSpecRequirement) (*VMSpecRecommendResult, error) {
+	cblog.Info("call RecommendVMSpec()")
+
+	if len(connectionNames) == 0 {
+		err := fmt.Errorf("ConnectionNames is empty!")
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if err := checkVMSpecRequirement(requirement); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	result := &VMSpecRecommendResult{
+		RecommendationList: []*VMSpecRecommendation{},
+		ErrorList:          []*VMSpecRecommendError{},
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	checkedConnections := map[string]bool{}
+	for _, connectionName := range connectionNames {
+		connectionName = strings.TrimSpace(connectionName)
+		if connectionName == "" || checkedConnections[connectionName] {
+			continue
+		}
+		checkedConnections[connectionName] = true
+
+		wg.Add(1)
+		go func(connectionName string) {
+			defer wg.Done()
+
+			recommendationList, errList := recommendVMSpecInConnection(connectionName, requirement)
+
+			mu.Lock()
+			defer mu.Unlock()
+			result.RecommendationList = append(result.RecommendationList, recommendationList...)
+			for _, err := range errList {
+				result.ErrorList = append(result.ErrorList, &VMSpecRecommendError{
+					ConnectionName: connectionName,
+					ErrorMsg:       err.Error(),
+				})
+			}
+		}(connectionName)
+	}
+
+	wg.Wait()
+
+	SortVMSpecRecommendations(result.RecommendationList)
+	sort.SliceStable(result.ErrorList, func(i, j int) bool {
+		return result.ErrorList[i].ConnectionName < result.ErrorList[j].ConnectionName
+	})
+
+	return result, nil
+}
+
+func checkVMSpecRequirement(requirement VMSpecRequirement) error {
+	if requirement.MinVCpu < 0 || requirement.MinMemSizeMiB < 0 || requirement.MinGpuCount < 0 {
+		return fmt.Errorf("MinVCpu, MinMemSizeMiB and MinGpuCount must not be negative!")
+	}
+	if requirement.Architecture != "" && normalizeArchitecture(requirement.Architecture) == "" {
+		return fmt.Errorf("invalid Architecture '%s': must be one of x86_64(amd64), arm64(aarch64)", requirement.Architecture)
+	}
+	return nil
+}
+
+// recommendVMSpecInConnection returns the matched specs of a connection.
+// The specs are returned without prices if the price info of the connection is not available.
+func recommendVMSpecInConnection(connectionName string, requirement VMSpecRequirement) ([]*VMSpecRecommendation, []error) {
+	providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, []error{err}
+	}
+
+	regionName, _, err := ccm.GetRegionNameByConnectionName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, []error{err}
+	}
+
+	specList, err := ListVMSpec(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, []error{err}
+	}
+
+	recommendationList := []*VMSpecRecommendation{}
+	for _, spec := range specList {
+		if spec == nil || !MatchVMSpec(*spec, requirement) {
+			continue
+		}
+		recommendationList = append(recommendationList, &VMSpecRecommendation{
+			ConnectionName: connectionName,
+			ProviderName:   providerName,
+			RegionName:     regionName,
+			VMSpecInfo:     *spec,
+		})
+	}
+
+	if len(recommendationList) == 0 {
+		return recommendationList, nil
+	}
+
+	priceMap, err := getVMSpecOnDemandPriceMap(connectionName, regionName)
+	if err != nil {
+		cblog.Error(err)
+		return recommendationList, []error{fmt.Errorf("failed to get the price info, the specs are not priced: %v", err)}
+	}
+
+	for _, recommendation := range recommendationList {
+		if price, ok := priceMap[recommendation.VMSpecInfo.Name]; ok {
+			recommendation.Price = price.Price
+			recommendation.Currency = price.Currency
+			recommendation.Unit = price.Unit
+		}
+	}
+
+	return recommendationList, nil
+}
+
+// the price catalog of a region is large and changes rarely,
+// so the OnDemand prices are cached by the connection and the region.
+const vmSpecPriceCacheTTL = 30 * time.Minute
+
+type vmSpecPriceCacheEntry struct {
+	priceMap  map[string]cres.OnDemand
+	expiredAt time.Time
+}
+
+var vmSpecPriceCache = struct {
+	sync.Mutex
+	entryMap map[string]*vmSpecPriceCacheEntry // key: connectionName/regionName
+}{entryMap: map[string]*vmSpecPriceCacheEntry{}}
+
+// getVMSpecOnDemandPriceMap returns the cheapest OnDemand price of each VM spec, map[VMSpecName]OnDemand
+// The map is shared with the other calls of the cache, so do not modify it.
+func getVMSpecOnDemandPriceMap(connectionName string, regionName string) (map[string]cres.OnDemand, error) {
+	cacheKey := connectionName + "/" + regionName
+
+	vmSpecPriceCache.Lock()
+	entry, ok := vmSpecPriceCache.entryMap[cacheKey]
+	vmSpecPriceCache.Unlock()
+	if ok && time.Now().Before(entry.expiredAt) {
+		return entry.priceMap, nil
+	}
+
+	priceMap, err := fetchVMSpecOnDemandPriceMap(connectionName, regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	vmSpecPriceCache.Lock()
+	vmSpecPriceCache.entryMap[cacheKey] = &vmSpecPriceCacheEntry{priceMap: priceMap, expiredAt: time.Now().Add(vmSpecPriceCacheTTL)}
+	vmSpecPriceCache.Unlock()
+
+	return priceMap, nil
+}
+
+func fetchVMSpecOnDemandPriceMap(connectionName string, regionName string) (map[string]cres.OnDemand, error) {
+	priceInfo, err := GetPriceInfo(connectionName, cres.RSTypeString(cres.VM), regionName,
+		[]cres.KeyValue{{Key: cres.PricingModelFilterKey, Value: string(cres.OnDemandPricing)}}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var cloudPrice cres.CloudPrice
+	if err := json.Unmarshal([]byte(priceInfo), &cloudPrice); err != nil {
+		return nil, err
+	}
+
+	priceMap := map[string]cres.OnDemand{}
+	for _, price := range cloudPrice.PriceList {
+		specName := price.ProductInfo.VMSpecName
+		if specName == "" && price.ProductInfo.VMSpecInfo != nil {
+			specName = price.ProductInfo.VMSpecInfo.Name
+		}
+		newPrice, err := strconv.ParseFloat(price.PriceInfo.OnDemand.Price, 64)
+		if specName == "" || err != nil {
+			continue
+		}
+
+		if oldOnDemand, ok := priceMap[specName]; ok {
+			oldPrice, _ := strconv.ParseFloat(oldOnDemand.Price, 64)
+			if oldPrice <= newPrice {
+				continue
+			}
+		}
+		priceMap[specName] = price.PriceInfo.OnDemand
+	}
+
+	return priceMap, nil
+}
+
+// MatchVMSpec checks whether the VM spec satisfies the requirements.
+// A spec with unknown values("-1" or empty) does not satisfy the requirements of the values.
+func MatchVMSpec(spec cres.VMSpecInfo, requirement VMSpecRequirement) bool {
+	if requirement.MinVCpu > 0 {
+		vcpu, err := strconv.Atoi(spec.VCpu.Count)
+		if err != nil || vcpu < requirement.MinVCpu {
+			return false
+		}
+	}
+
+	if requirement.MinMemSizeMiB > 0 {
+		memSize, err := strconv.Atoi(spec.MemSizeMiB)
+		if err != nil || memSize < requirement.MinMemSizeMiB {
+			return false
+		}
+	}
+
+	if requirement.GpuModel != "" || requirement.MinGpuCount > 0 {
+		gpuCount := 0
+		for _, gpu := range spec.Gpu {
+			if requirement.GpuModel != "" && !strings.Contains(strings.ToLower(gpu.Model), strings.ToLower(requirement.GpuModel)) {
+				continue
+			}
+			count, err := strconv.Atoi(gpu.Count)
+			if err != nil || count < 0 {
+				continue
+			}
+			gpuCount += count
+		}
+
+		minGpuCount := requirement.MinGpuCount
+		if minGpuCount == 0 {
+			minGpuCount = 1
+		}
+		if gpuCount < minGpuCount {
+			return false
+		}
+	}
+
+	if requirement.Architecture != "" {
+		if !hasArchitecture(spec, normalizeArchitecture(requirement.Architecture)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SortVMSpecRecommendations sorts the recommendations, the cheapest spec first.
+// Specs without a price are sorted after all priced specs by vCPU count and memory size.
+func SortVMSpecRecommendations(recommendationList []*VMSpecRecommendation) {
+	sort.SliceStable(recommendationList, func(i, j int) bool {
+		a, b := recommendationList[i], recommendationList[j]
+
+		aPrice, aErr := strconv.ParseFloat(a.Price, 64)
+		bPrice, bErr := strconv.ParseFloat(b.Price, 64)
+		switch {
+		case aErr == nil && bErr != nil:
+			return true
+		case aErr != nil && bErr == nil:
+			return false
+		case aErr == nil && bErr == nil && aPrice != bPrice:
+			return aPrice < bPrice
+		}
+
+		aVCpu, _ := strconv.Atoi(a.VMSpecInfo.VCpu.Count)
+		bVCpu, _ := strconv.Atoi(b.VMSpecInfo.VCpu.Count)
+		if aVCpu != bVCpu {
+			return aVCpu < bVCpu
+		}
+		aMem, _ := strconv.Atoi(a.VMSpecInfo.MemSizeMiB)
+		bMem, _ := strconv.Atoi(b.VMSpecInfo.MemSizeMiB)
+		if aMem != bMem {
+			return aMem < bMem
+		}
+		if a.ConnectionName != b.ConnectionName {
+			return a.ConnectionName < b.ConnectionName
+		}
+		return a.VMSpecInfo.Name < b.VMSpecInfo.Name
+	})
+}
+
+// normalizeArchitecture returns x86_64 or arm64, or empty string for unknown architectures.
+func normalizeArchitecture(arch string) string {
+	switch strings.ToLower(strings.TrimSpace(arch)) {
+	case "x86_64", "amd64", "x86-64", "x64":
+		return "x86_64"
+	case "arm64", "aarch64", "arm64_mac":
+		return "arm64"
+	default:
+		return ""
+	}
+}
+
+// hasArchitecture finds the architectures in the KeyValueList of the spec.
+// ex) {Key: "Architecture", Value: "x86_64"}, {Key: "ProcessorInfo", Value: "{SupportedArchitectures:[arm64],...}"}
+func hasArchitecture(spec cres.VMSpecInfo, arch string) bool {
+	for _, kv := range spec.KeyValueList {
+		value := kv.Value
+		if !strings.Contains(strings.ToLower(kv.Key), "architecture") {
+			idx := strings.Index(value, "Architectures:[")
+			if idx < 0 {
+				continue
+			}
+			value = value[idx+len("Architectures:["):]
+			if end := strings.Index(value, "]"); end >= 0 {
+				value = value[:end]
+			}
+		}
+
+		for _, v := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' || r == ' ' }) {
+			if normalizeArchitecture(v) == arch {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,489 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// Common Runtime for VNicHandler interface
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// -------- IID Info for VNic
+
+type VNicIIDInfo VPCDependentIIDInfo
+
+func (VNicIIDInfo) TableName() string {
+	return "vnic_iid_infos"
+}
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	infostore.AutoMigrate(db, &VNicIIDInfo{})
+	infostore.Close(db)
+}
+
+// -------- VNic Common Runtime
+
+// (1) check exist(NameID)
+// (2) set the driver IIDs of VPC, Subnet and SecurityGroups
+// (3) create the VNic with a SP-XID
+// (4) insert spiderIID
+func CreateVNic(connectionName string, rsType string, reqInfo cres.VNicInfo, IDTransformMode string) (*cres.VNicInfo, error) {
+	cblog.Info("call CreateVNic()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	reqInfo.IId.NameId, err = EmptyCheckAndTrim("IId.NameId", reqInfo.IId.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	vpcName, err := EmptyCheckAndTrim("VpcIID.NameId", reqInfo.VpcIID.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vNicSPLock.Lock(connectionName, reqInfo.IId.NameId)
+	defer vNicSPLock.Unlock(connectionName, reqInfo.IId.NameId)
+
+	// (1) check exist(NameID)
+	exist, err := infostore.HasByConditions(&VNicIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.IId.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if exist {
+		err := fmt.Errorf("VNic %s already exists", reqInfo.IId.NameId)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) set the driver IIDs of VPC, Subnet and SecurityGroups
+	var vpcIIDInfo VPCIIDInfo
+	err = infostore.GetByConditions(&vpcIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	reqInfo.VpcIID = getDriverIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
+
+	var subnetIIDInfo SubnetIIDInfo
+	err = infostore.GetBy3Conditions(&subnetIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.SubnetIID.NameId,
+		OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	reqInfo.SubnetIID = getDriverIID(cres.IID{NameId: subnetIIDInfo.NameId, SystemId: subnetIIDInfo.SystemId})
+
+	sgIIDs := []cres.IID{}
+	for _, sgIID := range reqInfo.SecurityGroupIIDs {
+		var sgIIDInfo SGIIDInfo
+		err = infostore.GetBy3Conditions(&sgIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, sgIID.NameId,
+			OWNER_VPC_NAME_COLUMN, vpcName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		sgIIDs = append(sgIIDs, getDriverIID(cres.IID{NameId: sgIIDInfo.NameId, SystemId: sgIIDInfo.SystemId}))
+	}
+	reqInfo.SecurityGroupIIDs = sgIIDs
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	handler, err := cldConn.CreateVNicHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) create the VNic with a SP-XID
+	spUUID := reqInfo.IId.NameId
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		spUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	reqNameId := reqInfo.IId.NameId
+	reqInfo.IId = cres.IID{NameId: spUUID, SystemId: ""}
+
+	info, err := handler.CreateVNic(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) insert spiderIID: {reqNameID, "driverNameID:driverSystemID"}
+	spiderIId := cres.IID{NameId: reqNameId, SystemId: spUUID + ":" + info.IId.SystemId}
+	err = infostore.Insert(&VNicIIDInfo{ConnectionName: connectionName, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId,
+		OwnerVPCName: vpcName})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteVNic(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf("%v, %v", err, err2)
+		}
+		return nil, err
+	}
+
+	info.IId = getUserIID(spiderIId)
+	setVNicUserIIDs(connectionName, vpcName, &info)
+	return &info, nil
+}
+
+func ListVNic(connectionName string, rsType string) ([]*cres.VNicInfo, error) {
+	cblog.Info("call ListVNic()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var iidInfoList []*VNicIIDInfo
+	err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	infoList := []*cres.VNicInfo{}
+	if len(iidInfoList) == 0 {
+		return infoList, nil
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	handler, err := cldConn.CreateVNicHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	for _, iidInfo := range iidInfoList {
+		spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+		info, err := handler.GetVNic(getDriverIID(spiderIId))
+		if err != nil {
+			cblog.Error(err)
+			continue
+		}
+		info.IId = getUserIID(spiderIId)
+		setVNicUserIIDs(connectionName, iidInfo.OwnerVPCName, &info)
+		infoList = append(infoList, &info)
+	}
+	return infoList, nil
+}
+
+func GetVNic(connectionName string, rsType string, nameID string) (*cres.VNicInfo, error) {
+	cblog.Info("call GetVNic()")
+
+	iidInfo, handler, err := getVNicIIDInfoAndHandler(connectionName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+	info, err := handler.GetVNic(getDriverIID(spiderIId))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(spiderIId)
+	setVNicUserIIDs(iidInfo.ConnectionName, iidInfo.OwnerVPCName, &info)
+	return &info, nil
+}
+
+// DeleteVNic deletes a VNic. With force "true", the IID info is deleted even if the CSP fails.
+func DeleteVNic(connectionName string, rsType string, nameID string, force string) (bool, error) {
+	cblog.Info("call DeleteVNic()")
+
+	iidInfo, handler, err := getVNicIIDInfoAndHandler(connectionName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	vNicSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer vNicSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	result, err := handler.DeleteVNic(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		if force != "true" {
+			return false, err
+		}
+	}
+	if !result && force != "true" {
+		return false, nil
+	}
+
+	_, err = infostore.DeleteByConditions(&VNicIIDInfo{}, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, NAME_ID_COLUMN, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func AttachVNic(connectionName string, vNicName string, ownerVMName string) (*cres.VNicInfo, error) {
+	cblog.Info("call AttachVNic()")
+
+	iidInfo, handler, err := getVNicIIDInfoAndHandler(connectionName, vNicName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	vmIIDInfo, err := getOwnerVMIIDInfo(iidInfo.ConnectionName, ownerVMName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vNicSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer vNicSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+	info, err := handler.AttachVNic(getDriverIID(spiderIId), getDriverIID(cres.IID{NameId: vmIIDInfo.NameId, SystemId: vmIIDInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(spiderIId)
+	setVNicUserIIDs(iidInfo.ConnectionName, iidInfo.OwnerVPCName, &info)
+	return &info, nil
+}
+
+func DetachVNic(connectionName string, vNicName string, ownerVMName string) (bool, error) {
+	cblog.Info("call DetachVNic()")
+
+	iidInfo, handler, err := getVNicIIDInfoAndHandler(connectionName, vNicName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	vmIIDInfo, err := getOwnerVMIIDInfo(iidInfo.ConnectionName, ownerVMName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	vNicSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer vNicSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	result, err := handler.DetachVNic(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}),
+		getDriverIID(cres.IID{NameId: vmIIDInfo.NameId, SystemId: vmIIDInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return result, nil
+}
+
+func getVNicIIDInfoAndHandler(connectionName string, nameID string) (*VNicIIDInfo, cres.VNicHandler, error) {
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var iidInfo VNicIIDInfo
+	err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	handler, err := cldConn.CreateVNicHandler()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &iidInfo, handler, nil
+}
+
+// getOwnerVMIIDInfo returns the IID info of the VM to attach a VNic or a PublicIP.
+func getOwnerVMIIDInfo(connectionName string, ownerVMName string) (*VMIIDInfo, error) {
+	ownerVMName, err := EmptyCheckAndTrim("ownerVMName", ownerVMName)
+	if err != nil {
+		return nil, err
+	}
+	var vmIIDInfo VMIIDInfo
+	err = infostore.GetByConditions(&vmIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, ownerVMName)
+	if err != nil {
+		return nil, err
+	}
+	return &vmIIDInfo, nil
+}
+
+// setOwnerVMUserIID sets the NameId of the OwnerVM, which the driver returns with the CSP's ID.
+func setOwnerVMUserIID(connectionName string, ownerVM *cres.IID) {
+	if ownerVM.SystemId == "" {
+		return
+	}
+	var vmIIDInfo VMIIDInfo
+	err := infostore.GetByContain(&vmIIDInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, ownerVM.SystemId)
+	if err != nil {
+		// the VM may be created outside of CB-Spider.
+		cblog.Info(err)
+		return
+	}
+	*ownerVM = getUserIID(cres.IID{NameId: vmIIDInfo.NameId, SystemId: vmIIDInfo.SystemId})
+}
+
+// setVNicUserIIDs sets the user IIDs of VPC, Subnet, SecurityGroups and OwnerVM of a VNic.
+func setVNicUserIIDs(connectionName string, vpcName string, info *cres.VNicInfo) {
+	info.VpcIID.NameId = vpcName
+
+	var subnetIIDInfo SubnetIIDInfo
+	err := infostore.GetByConditionsAndContain(&subnetIIDInfo, CONNECTION_NAME_COLUMN, connectionName,
+		OWNER_VPC_NAME_COLUMN, vpcName, SYSTEM_ID_COLUMN, info.SubnetIID.SystemId)
+	if err == nil {
+		info.SubnetIID.NameId = subnetIIDInfo.NameId
+	}
+
+	for i, sgIID := range info.SecurityGroupIIDs {
+		var sgIIDInfo SGIIDInfo
+		err := infostore.GetByConditionsAndContain(&sgIIDInfo, CONNECTION_NAME_COLUMN, connectionName,
+			OWNER_VPC_NAME_COLUMN, vpcName, SYSTEM_ID_COLUMN, sgIID.SystemId)
+		if err != nil {
+			// SecurityGroups may be attached from other sources.
+			cblog.Info(err)
+			continue
+		}
+		info.SecurityGroupIIDs[i].NameId = sgIIDInfo.NameId
+	}
+
+	setOwnerVMUserIID(connectionName, &info.OwnerVM)
+}
+
+// listVNicNamesBy returns the names of the VNics in a connection that match the condition.
+// Unlike ListVNic, it fails if a VNic can not be looked up, not to miss a VNic in the dependency checks.
+func listVNicNamesBy(connectionName string, match func(info *cres.VNicInfo) bool) ([]string, error) {
+	var iidInfoList []*VNicIIDInfo
+	err := infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		return nil, err
+	}
+	nameList := []string{}
+	if len(iidInfoList) == 0 {
+		return nameList, nil
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return nil, err
+	}
+	handler, err := cldConn.CreateVNicHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, iidInfo := range iidInfoList {
+		spiderIId := cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}
+		info, err := handler.GetVNic(getDriverIID(spiderIId))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the VNic '%s': %v", iidInfo.NameId, err)
+		}
+		info.IId = getUserIID(spiderIId)
+		setVNicUserIIDs(connectionName, iidInfo.OwnerVPCName, &info)
+		if match(&info) {
+			nameList = append(nameList, info.IId.NameId)
+		}
+	}
+	return nameList, nil
+}
+
+// checkVPCNotUsedByVNic returns an error if a VNic was created in the VPC.
+func checkVPCNotUsedByVNic(connectionName string, vpcName string) error {
+	var iidInfoList []*VNicIIDInfo
+	err := infostore.ListByConditions(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		return err
+	}
+	if len(iidInfoList) > 0 {
+		nameList := []string{}
+		for _, iidInfo := range iidInfoList {
+			nameList = append(nameList, iidInfo.NameId)
+		}
+		return fmt.Errorf("the VPC '%s' is used by VNic(s) %v. Delete them first", vpcName, nameList)
+	}
+	return nil
+}
+
+// checkSubnetNotUsedByVNic returns an error if a VNic is in the Subnet.
+func checkSubnetNotUsedByVNic(connectionName string, vpcName string, subnetName string) error {
+	nameList, err := listVNicNamesBy(connectionName, func(info *cres.VNicInfo) bool {
+		return info.VpcIID.NameId == vpcName && info.SubnetIID.NameId == subnetName
+	})
+	if err != nil {
+		return err
+	}
+	if len(nameList) > 0 {
+		return fmt.Errorf("the Subnet '%s' is used by VNic(s) %v. Delete them first", subnetName, nameList)
+	}
+	return nil
+}
+
+// checkSecurityGroupNotUsedByVNic returns an error if a VNic uses the SecurityGroup.
+func checkSecurityGroupNotUsedByVNic(connectionName string, sgName string) error {
+	nameList, err := listVNicNamesBy(connectionName, func(info *cres.VNicInfo) bool {
+		for _, sgIID := range info.SecurityGroupIIDs {
+			if sgIID.NameId == sgName {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if len(nameList) > 0 {
+		return fmt.Errorf("the SecurityGroup '%s' is used by VNic(s) %v. Delete them first", sgName, nameList)
+	}
+	return nil
+}
+
+// detachVNicsFromVM detaches the VNics attached to the VM before the VM is terminated.
+func detachVNicsFromVM(connectionName string, vmName string) error {
+	nameList, err := listVNicNamesBy(connectionName, func(info *cres.VNicInfo) bool {
+		return info.OwnerVM.NameId == vmName
+	})
+	if err != nil {
+		return err
+	}
+	for _, vNicName := range nameList {
+		if _, err := DetachVNic(connectionName, vNicName, vmName); err != nil {
+			return fmt.Errorf("failed to detach the VNic '%s' from the VM '%s': %v", vNicName, vmName, err)
+		}
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ package commonruntime
 import (
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 	"strings"
@@ -44,8 +45,8 @@ func init() {
 		cblog.Error(err)
 		return
 	}
-	db.AutoMigrate(&VPCIIDInfo{})
-	db.AutoMigrate(&SubnetIIDInfo{})
+	infostore.AutoMigrate(db, &VPCIIDInfo{})
+	infostore.AutoMigrate(db, &SubnetIIDInfo{})
 	infostore.Close(db)
 }
 
@@ -448,6 +449,7 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo, ID
 		"resources.IID:SystemId",
 		"resources.VPCReqInfo:IPv4_CIDR", // because can be unused in some VPC
 		"resources.SubnetInfo:Zone",      // because can be unused in some Zone
+		"resources.SubnetInfo:IPv6_CIDR", // because IPv4 only or assigned by the CSP
 		"resources.KeyValue:Key",         // because unusing key-value list
 		"resources.KeyValue:Value",       // because unusing key-value list
 	}
@@ -480,6 +482,30 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo, ID
 		}
 	}
 
+	// check the IPv6 CIDRs and the capability of IPV6_DUAL_STACK
+	if reqInfo.EnableIPv6 {
+		err := checkCapability(connectionName, IPV6_DUAL_STACK)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	for _, subnetInfo := range reqInfo.SubnetInfoList {
+		if subnetInfo.IPv6_CIDR == "" {
+			continue
+		}
+		if !reqInfo.EnableIPv6 {
+			err := fmt.Errorf("Subnet %s has an IPv6 CIDR, but the VPC %s is not dual-stack", subnetInfo.IId.NameId, reqInfo.IId.NameId)
+			cblog.Error(err)
+			return nil, err
+		}
+		err := checkIPv6SubnetCIDR(subnetInfo.IPv6_CIDR)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
 		cblog.Error(err)
@@ -522,7 +548,12 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo, ID
 		cblog.Error(err)
 		return nil, err
 	}
-	if drv.GetDriverCapability().SINGLE_VPC {
+	drvCapabilityInfo, err := getDriverCapability(drv)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if drvCapabilityInfo.SINGLE_VPC {
 		var vpcIIDInfoList []*VPCIIDInfo
 		err := infostore.ListByCondition(&vpcIIDInfoList, CONNECTION_NAME_COLUMN, connectionName)
 		if err != nil {
@@ -694,6 +725,34 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo, ID
 	return &info, nil
 }
 
+// checkIPv6SubnetCIDR checks that the CIDR is an IPv6 /64 block.
+func checkIPv6SubnetCIDR(cidr string) error {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil || ip.To4() != nil {
+		return fmt.Errorf("invalid IPv6 CIDR: %s", cidr)
+	}
+	if prefixLen, _ := ipNet.Mask.Size(); prefixLen != 64 {
+		return fmt.Errorf("the IPv6 CIDR %s of a Subnet should be a /64 block", cidr)
+	}
+	return nil
+}
+
+// checkIPv6SubnetInVPC checks that the VPC is dual-stack and has the IPv6 CIDR of the Subnet.
+func checkIPv6SubnetInVPC(vpcName string, vpcIPv6CIDR string, subnetIPv6CIDR string) error {
+	if vpcIPv6CIDR == "" {
+		return fmt.Errorf("the Subnet has an IPv6 CIDR, but the VPC %s is not dual-stack", vpcName)
+	}
+	_, vpcNet, err := net.ParseCIDR(vpcIPv6CIDR)
+	if err != nil {
+		return fmt.Errorf("invalid IPv6 CIDR of the VPC %s: %s", vpcName, vpcIPv6CIDR)
+	}
+	subnetIP, _, _ := net.ParseCIDR(subnetIPv6CIDR)
+	if !vpcNet.Contains(subnetIP) {
+		return fmt.Errorf("the IPv6 CIDR %s is not in the IPv6 CIDR %s of the VPC %s", subnetIPv6CIDR, vpcIPv6CIDR, vpcName)
+	}
+	return nil
+}
+
 // Get reqNameId from reqIIdZoneList whith driver NameId
 func getSubnetReqNameId(reqIIdZoneList []SubnetReqZoneInfo, driverNameId string) string {
 	for _, reqInfo := range reqIIdZoneList {
@@ -987,6 +1046,20 @@ func AddSubnet(connectionName string, rsType string, vpcName string, reqInfo cre
 		}
 	}
 
+	// check the IPv6 CIDR and the capability of IPV6_DUAL_STACK
+	if reqInfo.IPv6_CIDR != "" {
+		err := checkCapability(connectionName, IPV6_DUAL_STACK)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		err = checkIPv6SubnetCIDR(reqInfo.IPv6_CIDR)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
 	vpcName, err = EmptyCheckAndTrim("vpcName", vpcName)
 	if err != nil {
 		cblog.Error(err)
@@ -1068,6 +1141,20 @@ func AddSubnet(connectionName string, rsType string, vpcName string, reqInfo cre
 		}
 	}
 
+	// an IPv6 Subnet can be added only to a dual-stack VPC
+	if reqInfo.IPv6_CIDR != "" {
+		vpcInfo, err := handler.GetVPC(getDriverIID(cres.IID{NameId: iidVPCInfo.NameId, SystemId: iidVPCInfo.SystemId}))
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		err = checkIPv6SubnetInVPC(vpcName, vpcInfo.IPv6_CIDR, reqInfo.IPv6_CIDR)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
 	subnetUUID := ""
 	if GetID_MGMT(IDTransformMode) == "ON" { // Use IID Management
 		subnetUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
@@ -1330,6 +1417,22 @@ func RemoveSubnet(connectionName string, vpcName string, nameID string, force st
 		}
 	}
 
+	// VNics and NATGateways in the Subnet have to be deleted and the RouteTables disassociated before the Subnet
+	if force != "true" {
+		subnetSystemId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}).SystemId
+		err = checkSubnetNotUsedByVNic(iidInfo.ConnectionName, vpcName, iidInfo.NameId)
+		if err == nil {
+			err = checkSubnetNotUsedByNATGateway(iidInfo.ConnectionName, vpcName, iidInfo.NameId, subnetSystemId)
+		}
+		if err == nil {
+			err = checkSubnetNotUsedByRouteTable(iidInfo.ConnectionName, vpcName, iidInfo.NameId, subnetSystemId)
+		}
+		if err != nil {
+			cblog.Error(err)
+			return false, err
+		}
+	}
+
 	// (2) delete Resource(SystemId)
 	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
 	result := false
@@ -1509,6 +1612,24 @@ func DeleteVPC(connectionName string, rsType string, nameID string, force string
 		}
 	}
 
+	// VNics, VPCPeerings, RouteTables and NATGateways of the VPC have to be deleted before the VPC
+	if force != "true" {
+		err = checkVPCNotUsedByVNic(iidInfo.ConnectionName, iidInfo.NameId)
+		if err == nil {
+			err = checkVPCNotUsedByVPCPeering(iidInfo.ConnectionName, iidInfo.NameId)
+		}
+		if err == nil {
+			err = checkVPCNotUsedByRouteTable(iidInfo.ConnectionName, iidInfo.NameId)
+		}
+		if err == nil {
+			err = checkVPCNotUsedByNATGateway(iidInfo.ConnectionName, iidInfo.NameId)
+		}
+		if err != nil {
+			cblog.Error(err)
+			return false, err
+		}
+	}
+
 	// (2) delete Resource(SystemId)
 	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
 	result := false
@@ -1545,6 +1666,15 @@ func DeleteVPC(connectionName string, rsType string, nameID string, force string
 			return false, err
 		}
 	}
+	// for RouteTable and NATGateway list, left only by a forced delete
+	_, err = infostore.DeleteByConditions(&RouteTableIIDInfo{}, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, OWNER_VPC_NAME_COLUMN, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+	}
+	_, err = infostore.DeleteByConditions(&NATGatewayIIDInfo{}, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, OWNER_VPC_NAME_COLUMN, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+	}
 	return result, nil
 }
 
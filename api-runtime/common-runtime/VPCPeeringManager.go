@@ -0,0 +1,577 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// Common Runtime for VPCPeeringHandler interface
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+	"sort"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// -------- IID Info for VPCPeering
+
+const PEER_CONNECTION_NAME_COLUMN = "peer_connection_name"
+const PEER_VPC_NAME_COLUMN = "peer_vpc_name"
+
+// A VPCPeering is owned by the requester's connection.
+// The accepter's connection is the same as the requester's one when both VPCs are in a connection.
+type VPCPeeringIIDInfo struct {
+	ConnectionName     string `gorm:"primaryKey"` // requester's connection, ex) "aws-seoul-config"
+	NameId             string `gorm:"primaryKey"` // ex) "my_peering"
+	SystemId           string // ID in CSP, ex) "pcx-0a1b2c3d"
+	OwnerVPCName       string // requester's VPC, ex) "my_vpc"
+	PeerConnectionName string // accepter's connection, ex) "aws-tokyo-config"
+	PeerVPCName        string // accepter's VPC, ex) "peer_vpc"
+}
+
+func (VPCPeeringIIDInfo) TableName() string {
+	return "vpcpeering_iid_infos"
+}
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	infostore.AutoMigrate(db, &VPCPeeringIIDInfo{})
+	infostore.Close(db)
+}
+
+// -------- VPCPeering Common Runtime
+
+// (1) check exist(NameID) and the CSPs of both connections
+// (2) set the driver IIDs of the requester and the accepter VPCs
+// (3) set the account and the region of the accepter if they are different
+// (4) request the peering with a SP-XID
+// (5) insert spiderIID
+func RequestVPCPeering(connectionName string, rsType string, reqInfo cres.VPCPeeringInfo, peerConnectionName string,
+	IDTransformMode string) (*cres.VPCPeeringInfo, error) {
+	cblog.Info("call RequestVPCPeering()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if peerConnectionName == "" {
+		peerConnectionName = connectionName
+	}
+	vpcName, err := EmptyCheckAndTrim("RequesterVpcIID.NameId", reqInfo.RequesterVpcIID.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	peerVPCName, err := EmptyCheckAndTrim("AccepterVpcIID.NameId", reqInfo.AccepterVpcIID.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// the name is locked in both connections, because a peering is found by its name in both connections
+	unlockName := lockInOrder(vpcPeeringSPLock.Lock, vpcPeeringSPLock.Unlock,
+		[2]string{connectionName, reqInfo.IId.NameId}, [2]string{peerConnectionName, reqInfo.IId.NameId})
+	defer unlockName()
+
+	// both VPCs can not be deleted during the request
+	unlockVPCs := lockInOrder(vpcSPLock.RLock, vpcSPLock.RUnlock,
+		[2]string{connectionName, vpcName}, [2]string{peerConnectionName, peerVPCName})
+	defer unlockVPCs()
+
+	// (1) check exist(NameID) in both connections and the CSPs of both connections
+	for _, connName := range []string{connectionName, peerConnectionName} {
+		exist, err := hasVPCPeeringName(connName, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		if exist {
+			err := fmt.Errorf("VPCPeering %s already exists in %s", reqInfo.IId.NameId, connName)
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	if peerConnectionName != connectionName {
+		providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		peerProviderName, err := ccm.GetProviderNameByConnectionName(peerConnectionName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		if providerName != peerProviderName {
+			err := fmt.Errorf("VPCPeering between %s and %s is not supported, both connections must be of the same CSP",
+				providerName, peerProviderName)
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	// (2) set the driver IIDs of the requester and the accepter VPCs
+	var vpcIIDInfo VPCIIDInfo
+	err = infostore.GetByConditions(&vpcIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	var peerVPCIIDInfo VPCIIDInfo
+	err = infostore.GetByConditions(&peerVPCIIDInfo, CONNECTION_NAME_COLUMN, peerConnectionName, NAME_ID_COLUMN, peerVPCName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	reqInfo.RequesterVpcIID = getDriverIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
+	reqInfo.AccepterVpcIID = getDriverIID(cres.IID{NameId: peerVPCIIDInfo.NameId, SystemId: peerVPCIIDInfo.SystemId})
+
+	handler, err := getVPCPeeringHandler(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set the account and the region of the accepter if they are different
+	reqInfo.AccepterAccountId = ""
+	reqInfo.AccepterRegion = ""
+	if peerConnectionName != connectionName {
+		peerHandler, err := getVPCPeeringHandler(peerConnectionName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		accountId, err := handler.GetOwnerAccountId()
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		peerAccountId, err := peerHandler.GetOwnerAccountId()
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		if peerAccountId != accountId {
+			reqInfo.AccepterAccountId = peerAccountId
+		}
+
+		regionName, _, err := ccm.GetRegionNameByConnectionName(connectionName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		peerRegionName, _, err := ccm.GetRegionNameByConnectionName(peerConnectionName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		if peerRegionName != regionName {
+			reqInfo.AccepterRegion = peerRegionName
+		}
+	}
+
+	// (4) request the peering with a SP-XID
+	spUUID := reqInfo.IId.NameId
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		spUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	reqNameId := reqInfo.IId.NameId
+	reqInfo.IId = cres.IID{NameId: spUUID, SystemId: ""}
+
+	info, err := handler.RequestVPCPeering(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (5) insert spiderIID: {reqNameID, "driverNameID:driverSystemID"}
+	iidInfo := VPCPeeringIIDInfo{ConnectionName: connectionName, NameId: reqNameId, SystemId: spUUID + ":" + info.IId.SystemId,
+		OwnerVPCName: vpcName, PeerConnectionName: peerConnectionName, PeerVPCName: peerVPCName}
+	err = infostore.Insert(&iidInfo)
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteVPCPeering(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf("%v, %v", err, err2)
+		}
+		return nil, err
+	}
+
+	setVPCPeeringUserIIDs(&iidInfo, &info)
+	return &info, nil
+}
+
+// AcceptVPCPeering accepts a requested peering with the accepter's connection.
+func AcceptVPCPeering(connectionName string, peeringName string) (*cres.VPCPeeringInfo, error) {
+	cblog.Info("call AcceptVPCPeering()")
+
+	iidInfo, err := getVPCPeeringIIDInfo(connectionName, peeringName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vpcPeeringSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer vpcPeeringSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	unlockVPCs := rLockVPCPeeringVPCs(iidInfo)
+	defer unlockVPCs()
+
+	peerHandler, err := getVPCPeeringHandler(iidInfo.PeerConnectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info, err := peerHandler.AcceptVPCPeering(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	setVPCPeeringUserIIDs(iidInfo, &info)
+	return &info, nil
+}
+
+// ListVPCPeering returns the peerings requested or accepted by the connection.
+func ListVPCPeering(connectionName string, rsType string) ([]*cres.VPCPeeringInfo, error) {
+	cblog.Info("call ListVPCPeering()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var iidInfoList []*VPCPeeringIIDInfo
+	err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	var peerIIDInfoList []*VPCPeeringIIDInfo
+	err = infostore.ListByCondition(&peerIIDInfoList, PEER_CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	for _, iidInfo := range peerIIDInfoList {
+		if iidInfo.ConnectionName != connectionName {
+			iidInfoList = append(iidInfoList, iidInfo)
+		}
+	}
+
+	infoList := []*cres.VPCPeeringInfo{}
+	for _, iidInfo := range iidInfoList {
+		handler, err := getVPCPeeringHandler(iidInfo.ConnectionName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		info, err := handler.GetVPCPeering(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		if err != nil {
+			cblog.Error(err)
+			continue
+		}
+		setVPCPeeringUserIIDs(iidInfo, &info)
+		infoList = append(infoList, &info)
+	}
+	return infoList, nil
+}
+
+func GetVPCPeering(connectionName string, rsType string, peeringName string) (*cres.VPCPeeringInfo, error) {
+	cblog.Info("call GetVPCPeering()")
+
+	iidInfo, err := getVPCPeeringIIDInfo(connectionName, peeringName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := getVPCPeeringHandler(iidInfo.ConnectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info, err := handler.GetVPCPeering(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	setVPCPeeringUserIIDs(iidInfo, &info)
+	return &info, nil
+}
+
+// DeleteVPCPeering deletes a peering. With force "true", the IID info is deleted even if the CSP fails.
+func DeleteVPCPeering(connectionName string, rsType string, peeringName string, force string) (bool, error) {
+	cblog.Info("call DeleteVPCPeering()")
+
+	iidInfo, err := getVPCPeeringIIDInfo(connectionName, peeringName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	vpcPeeringSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer vpcPeeringSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	unlockVPCs := rLockVPCPeeringVPCs(iidInfo)
+	defer unlockVPCs()
+
+	handler, err := getVPCPeeringHandler(iidInfo.ConnectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	result, err := handler.DeleteVPCPeering(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		if force != "true" {
+			return false, err
+		}
+	}
+	if !result && force != "true" {
+		return false, nil
+	}
+
+	_, err = infostore.DeleteByConditions(&VPCPeeringIIDInfo{}, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, NAME_ID_COLUMN, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// PropagateVPCPeeringRoutes adds the routes to the peer VPC into the route tables of both VPCs.
+func PropagateVPCPeeringRoutes(connectionName string, peeringName string) (*cres.VPCPeeringInfo, error) {
+	cblog.Info("call PropagateVPCPeeringRoutes()")
+
+	iidInfo, err := getVPCPeeringIIDInfo(connectionName, peeringName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vpcPeeringSPLock.Lock(iidInfo.ConnectionName, iidInfo.NameId)
+	defer vpcPeeringSPLock.Unlock(iidInfo.ConnectionName, iidInfo.NameId)
+
+	unlockVPCs := rLockVPCPeeringVPCs(iidInfo)
+	defer unlockVPCs()
+
+	peeringIID := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	// requester side
+	handler, err := getVPCPeeringHandler(iidInfo.ConnectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	vpcIID, err := getVPCDriverIID(iidInfo.ConnectionName, iidInfo.OwnerVPCName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info, err := handler.PropagateRoutes(peeringIID, vpcIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// accepter side
+	peerHandler, err := getVPCPeeringHandler(iidInfo.PeerConnectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	peerVPCIID, err := getVPCDriverIID(iidInfo.PeerConnectionName, iidInfo.PeerVPCName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	peerInfo, err := peerHandler.PropagateRoutes(peeringIID, peerVPCIID)
+	if err != nil {
+		err = fmt.Errorf("the routes are propagated to the requester VPC %s, but failed to propagate to the accepter VPC %s: %v",
+			iidInfo.OwnerVPCName, iidInfo.PeerVPCName, err)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// each side reports only the propagation of its own VPC
+	info.AccepterRoutePropagated = peerInfo.AccepterRoutePropagated
+	info.Status = peerInfo.Status
+
+	setVPCPeeringUserIIDs(iidInfo, &info)
+	return &info, nil
+}
+
+// getVPCPeeringIIDInfo finds a peering requested or accepted by the connection.
+func getVPCPeeringIIDInfo(connectionName string, peeringName string) (*VPCPeeringIIDInfo, error) {
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		return nil, err
+	}
+	peeringName, err = EmptyCheckAndTrim("peeringName", peeringName)
+	if err != nil {
+		return nil, err
+	}
+
+	var iidInfo VPCPeeringIIDInfo
+	err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, peeringName)
+	if err == nil {
+		return &iidInfo, nil
+	}
+
+	// accepted by the connection, requested by any connection
+	var iidInfoList []*VPCPeeringIIDInfo
+	err2 := infostore.ListByConditions(&iidInfoList, PEER_CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, peeringName)
+	if err2 != nil {
+		return nil, err2
+	}
+	switch len(iidInfoList) {
+	case 0:
+		return nil, err
+	case 1:
+		return iidInfoList[0], nil
+	default:
+		requesters := []string{}
+		for _, info := range iidInfoList {
+			requesters = append(requesters, info.ConnectionName)
+		}
+		return nil, fmt.Errorf("VPCPeering %s accepted by %s is requested by several connections %v, use the requester's connection",
+			peeringName, connectionName, requesters)
+	}
+}
+
+// hasVPCPeeringName checks whether a peering requested or accepted by the connection has the name.
+func hasVPCPeeringName(connectionName string, peeringName string) (bool, error) {
+	exist, err := infostore.HasByConditions(&VPCPeeringIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, peeringName)
+	if err != nil || exist {
+		return exist, err
+	}
+	return infostore.HasByConditions(&VPCPeeringIIDInfo{}, PEER_CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, peeringName)
+}
+
+// listVPCPeeringNames returns the names of the peerings requested or accepted by the connection.
+func listVPCPeeringNames(connectionName string) ([]string, error) {
+	var iidInfoList []*VPCPeeringIIDInfo
+	err := infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		return nil, err
+	}
+	var peerIIDInfoList []*VPCPeeringIIDInfo
+	err = infostore.ListByCondition(&peerIIDInfoList, PEER_CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	nameList := []string{}
+	for _, iidInfo := range iidInfoList {
+		nameList = append(nameList, iidInfo.NameId)
+	}
+	for _, iidInfo := range peerIIDInfoList {
+		if iidInfo.ConnectionName != connectionName {
+			nameList = append(nameList, iidInfo.NameId)
+		}
+	}
+	return nameList, nil
+}
+
+// checkVPCNotUsedByVPCPeering returns an error if the VPC is the requester or the accepter of a peering.
+func checkVPCNotUsedByVPCPeering(connectionName string, vpcName string) error {
+	var iidInfoList []*VPCPeeringIIDInfo
+	err := infostore.ListByConditions(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		return err
+	}
+	var peerIIDInfoList []*VPCPeeringIIDInfo
+	err = infostore.ListByConditions(&peerIIDInfoList, PEER_CONNECTION_NAME_COLUMN, connectionName, PEER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		return err
+	}
+	iidInfoList = append(iidInfoList, peerIIDInfoList...)
+	if len(iidInfoList) > 0 {
+		nameList := []string{}
+		for _, iidInfo := range iidInfoList {
+			nameList = append(nameList, iidInfo.NameId)
+		}
+		return fmt.Errorf("the VPC '%s' is used by VPCPeering(s) %v. Delete them first", vpcName, nameList)
+	}
+	return nil
+}
+
+// rLockVPCPeeringVPCs read-locks both VPCs of a peering, so that they are not deleted during the peering operation.
+func rLockVPCPeeringVPCs(iidInfo *VPCPeeringIIDInfo) func() {
+	return lockInOrder(vpcSPLock.RLock, vpcSPLock.RUnlock,
+		[2]string{iidInfo.ConnectionName, iidInfo.OwnerVPCName}, [2]string{iidInfo.PeerConnectionName, iidInfo.PeerVPCName})
+}
+
+// lockInOrder locks the {connection, id} keys in a fixed order to avoid a deadlock, and returns the unlock function.
+// The same key is locked only once.
+func lockInOrder(lock func(conn string, id string), unlock func(conn string, id string), keys ...[2]string) func() {
+	sorted := [][2]string{}
+	for _, key := range keys {
+		if !containsKey(sorted, key) {
+			sorted = append(sorted, key)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i][0] != sorted[j][0] {
+			return sorted[i][0] < sorted[j][0]
+		}
+		return sorted[i][1] < sorted[j][1]
+	})
+	for _, key := range sorted {
+		lock(key[0], key[1])
+	}
+	return func() {
+		for i := len(sorted) - 1; i >= 0; i-- {
+			unlock(sorted[i][0], sorted[i][1])
+		}
+	}
+}
+
+func containsKey(keys [][2]string, key [2]string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func getVPCPeeringHandler(connectionName string) (cres.VPCPeeringHandler, error) {
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return nil, err
+	}
+	return cldConn.CreateVPCPeeringHandler()
+}
+
+func getVPCDriverIID(connectionName string, vpcName string) (cres.IID, error) {
+	var vpcIIDInfo VPCIIDInfo
+	err := infostore.GetByConditions(&vpcIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, vpcName)
+	if err != nil {
+		return cres.IID{}, err
+	}
+	return getDriverIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId}), nil
+}
+
+// setVPCPeeringUserIIDs sets the user IIDs of the peering and both VPCs.
+func setVPCPeeringUserIIDs(iidInfo *VPCPeeringIIDInfo, info *cres.VPCPeeringInfo) {
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	info.RequesterVpcIID.NameId = iidInfo.OwnerVPCName
+	info.AccepterVpcIID.NameId = iidInfo.PeerVPCName
+}
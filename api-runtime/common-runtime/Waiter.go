@@ -8,6 +8,7 @@
 package commonruntime
 
 import (
+	"context"
 	"time"
 )
 
@@ -46,3 +47,18 @@ func (waiter *WAITER)Wait() bool {
 	}
 	return false // stop waiting
 }
+
+// WaitContext is like Wait, but stops waiting as soon as ctx is done.
+func (waiter *WAITER)WaitContext(ctx context.Context) bool {
+	elapsed := time.Since(waiter.start)
+
+	if int(elapsed.Seconds()) < waiter.Timeout {
+		select {
+		case <-time.After(time.Duration(waiter.Sleep) * time.Second):
+			return true // more waiting
+		case <-ctx.Done():
+			return false // canceled
+		}
+	}
+	return false // stop waiting
+}
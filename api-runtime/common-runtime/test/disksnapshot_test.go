@@ -0,0 +1,137 @@
+// DiskSnapshot Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func TestDiskSnapshotCSPResource(t *testing.T) {
+	const mockName = "snapshot-csp-test"
+	connName := setupMockConnection(t, mockName)
+
+	if _, err := cmrt.CreateDisk(connName, cmrt.DISK, cres.DiskInfo{IId: cres.IID{NameId: "disk-01"}, DiskSize: "100"}, "ON"); err != nil {
+		t.Fatal(err)
+	}
+	snapshotInfo, err := cmrt.CreateDiskSnapshot(connName, cmrt.DISKSNAPSHOT, cres.DiskSnapshotInfo{
+		IId:        cres.IID{NameId: "snapshot-01"},
+		SourceDisk: cres.IID{NameId: "disk-01"},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a DiskSnapshot created only in the CSP
+	cloudConn, err := (&mockdrv.MockDriver{}).ConnectCloud(idrv.ConnectionInfo{CredentialInfo: idrv.CredentialInfo{MockName: mockName}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshotHandler, err := cloudConn.CreateDiskSnapshotHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cspSnapshot, err := snapshotHandler.CreateDiskSnapshot(cres.DiskSnapshotInfo{
+		IId:        cres.IID{NameId: "csp-snapshot"},
+		SourceDisk: cres.IID{NameId: snapshotInfo.SourceDisk.SystemId, SystemId: snapshotInfo.SourceDisk.SystemId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	allResList, err := cmrt.ListAllResource(connName, cmrt.DISKSNAPSHOT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(allResList.AllList.MappedList) != 1 || allResList.AllList.MappedList[0].NameId != "snapshot-01" {
+		t.Errorf("snapshot-01 should be mapped: %v", allResList.AllList.MappedList)
+	}
+	if len(allResList.AllList.OnlyCSPList) != 1 || allResList.AllList.OnlyCSPList[0].SystemId != cspSnapshot.IId.SystemId {
+		t.Errorf("csp-snapshot should be only in the CSP: %v", allResList.AllList.OnlyCSPList)
+	}
+
+	result, err := cmrt.GetCSPResourceInfo(connName, cmrt.DISKSNAPSHOT, cspSnapshot.IId.SystemId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var info cres.DiskSnapshotInfo
+	if err := json.Unmarshal(result, &info); err != nil {
+		t.Fatal(err)
+	}
+	if info.IId.SystemId != cspSnapshot.IId.SystemId {
+		t.Errorf("GetCSPResourceInfo() returned %s, want %s", info.IId.SystemId, cspSnapshot.IId.SystemId)
+	}
+
+	if _, _, err := cmrt.DeleteCSPResource(connName, cmrt.DISKSNAPSHOT, cspSnapshot.IId.SystemId); err != nil {
+		t.Fatal(err)
+	}
+	allResList, err = cmrt.ListAllResource(connName, cmrt.DISKSNAPSHOT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(allResList.AllList.OnlyCSPList) != 0 {
+		t.Errorf("csp-snapshot should be deleted: %v", allResList.AllList.OnlyCSPList)
+	}
+}
+
+func TestCreateDiskFromSnapshotValidation(t *testing.T) {
+	connName := setupMockConnection(t, "snapshot-restore-test")
+
+	if _, err := cmrt.CreateDisk(connName, cmrt.DISK, cres.DiskInfo{IId: cres.IID{NameId: "disk-01"}, DiskSize: "100"}, "ON"); err != nil {
+		t.Fatal(err)
+	}
+	_, err := cmrt.CreateDiskSnapshot(connName, cmrt.DISKSNAPSHOT, cres.DiskSnapshotInfo{
+		IId:        cres.IID{NameId: "snapshot-01"},
+		SourceDisk: cres.IID{NameId: "disk-01"},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the DiskSize of a new Disk from a DiskSnapshot
+	for _, reqInfo := range []cres.DiskInfo{
+		{IId: cres.IID{NameId: "disk-02"}, DiskSize: "large"},
+		{IId: cres.IID{NameId: "disk-02"}, DiskSize: "-1"},
+	} {
+		if _, err := cmrt.CreateDiskFromSnapshot(connName, "snapshot-01", reqInfo, "ON"); err == nil {
+			t.Errorf("CreateDiskFromSnapshot() should fail: %+v", reqInfo)
+			cmrt.DeleteDisk(connName, cmrt.DISK, "disk-02", "true")
+		}
+	}
+
+	// the same checks as CreateDisk
+	for _, reqInfo := range []cres.DiskInfo{
+		{IId: cres.IID{NameId: "disk-02"}, IOPS: "4000"},                   // IOPS without the DiskType
+		{IId: cres.IID{NameId: "disk-02"}, DiskType: "HDD", IOPS: "4000"},  // HDD has no provisioned IOPS
+		{IId: cres.IID{NameId: "disk-02"}, DiskType: "SSD", IOPS: "99999"}, // out of range
+	} {
+		if _, err := cmrt.CreateDisk(connName, cmrt.DISK, reqInfo, "ON"); err == nil {
+			t.Errorf("CreateDisk() should fail: %+v", reqInfo)
+			cmrt.DeleteDisk(connName, cmrt.DISK, "disk-02", "true")
+		}
+		if _, err := cmrt.CreateDiskFromSnapshot(connName, "snapshot-01", reqInfo, "ON"); err == nil {
+			t.Errorf("CreateDiskFromSnapshot() should fail like CreateDisk(): %+v", reqInfo)
+			cmrt.DeleteDisk(connName, cmrt.DISK, "disk-02", "true")
+		}
+	}
+
+	diskInfo, err := cmrt.CreateDiskFromSnapshot(connName, "snapshot-01",
+		cres.DiskInfo{IId: cres.IID{NameId: "disk-02"}, DiskType: "SSD", DiskSize: "200", IOPS: "4000"}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diskInfo.DiskSize != "200" {
+		t.Errorf("the DiskSize should be 200: %s", diskInfo.DiskSize)
+	}
+}
@@ -0,0 +1,136 @@
+// Disk Type Change Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// the MOCK values in cloudos_meta.yaml:
+// disktype: SSD / HDD / MEM, diskchangetype: SSD / HDD, diskperformance: SSD|3000|16000|125|1000
+// rootdisktype: SSD / HDD / MEM, rootdiskchangetype: SSD / HDD
+func TestChangeDiskType(t *testing.T) {
+	const mockName = "disktype-test"
+	connName := setupMockConnection(t, mockName)
+
+	for _, reqInfo := range []cres.DiskInfo{
+		{IId: cres.IID{NameId: "disk-01"}, DiskType: "HDD"},
+		{IId: cres.IID{NameId: "disk-02"}, DiskType: "MEM"},
+		{IId: cres.IID{NameId: "root-disk-01"}, DiskType: "HDD"},
+		{IId: cres.IID{NameId: "root-disk-02"}, DiskType: "MEM"},
+	} {
+		if _, err := cmrt.CreateDisk(connName, cmrt.DISK, reqInfo, "ON"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	attachRootDisks(t, connName, mockName, "root-disk-01", "root-disk-02")
+
+	tests := []struct {
+		name       string
+		diskName   string
+		diskType   string
+		iops       string
+		throughput string
+		valid      bool
+	}{
+		{"same type without IOPS and Throughput", "disk-01", "HDD", "", "", false},
+		{"unknown target type", "disk-01", "NVMe", "", "", false},
+		{"target type not changeable online", "disk-01", "MEM", "", "", false},
+		{"source type not changeable online", "disk-02", "SSD", "", "", false},
+		{"supported change with IOPS out of range", "disk-01", "SSD", "20000", "", false},
+		{"supported change with IOPS", "disk-01", "SSD", "3000", "125", true},
+		{"same type with IOPS", "disk-01", "SSD", "4000", "", true},
+		{"current type with Throughput", "disk-01", "", "", "250", true},
+		{"supported change", "disk-01", "HDD", "", "", true},
+		{"root disk type not changeable online", "root-disk-02", "SSD", "", "", false},
+		{"root disk to an unknown type", "root-disk-01", "NVMe", "", "", false},
+		{"root disk change", "root-disk-01", "SSD", "", "", true},
+		{"root disk with IOPS", "root-disk-01", "SSD", "4000", "", true},
+	}
+	for _, tt := range tests {
+		info, err := cmrt.ChangeDiskType(connName, tt.diskName, tt.diskType, tt.iops, tt.throughput)
+		if tt.valid && err != nil {
+			t.Errorf("%s: should be valid: %v", tt.name, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("%s: should be invalid: %+v", tt.name, info)
+		}
+	}
+
+	diskInfo, err := cmrt.GetDisk(connName, cmrt.DISK, "root-disk-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diskInfo.DiskType != "SSD" || diskInfo.IOPS != "4000" {
+		t.Errorf("the root disk should be changed to SSD with 4000 IOPS: %+v", diskInfo)
+	}
+}
+
+// attachRootDisks attaches the Disks to a VM like root disks,
+// which are attached to the VM but not in the DataDiskIIDs of the VM.
+func attachRootDisks(t *testing.T, connName string, mockName string, diskNames ...string) {
+	_, err := cmrt.CreateVPC(connName, cmrt.VPC, cres.VPCReqInfo{
+		IId:            cres.IID{NameId: "vpc-01"},
+		IPv4_CIDR:      "10.0.0.0/16",
+		SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.1.0/24"}},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cmrt.CreateSecurity(connName, cmrt.SG, cres.SecurityReqInfo{
+		IId:           cres.IID{NameId: "sg-01"},
+		VpcIID:        cres.IID{NameId: "vpc-01"},
+		SecurityRules: &[]cres.SecurityRuleInfo{{FromPort: "22", ToPort: "22", IPProtocol: "tcp", Direction: "inbound", CIDR: "0.0.0.0/0"}},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cmrt.CreateKey(connName, cmrt.KEY, cres.KeyPairReqInfo{IId: cres.IID{NameId: "key-01"}}, "ON"); err != nil {
+		t.Fatal(err)
+	}
+	vmInfo, err := cmrt.StartVM(connName, cmrt.VM, cres.VMReqInfo{
+		IId:               cres.IID{NameId: "vm-01"},
+		ImageType:         cres.PublicImage,
+		ImageIID:          cres.IID{NameId: "mock-vmimage-01"},
+		VpcIID:            cres.IID{NameId: "vpc-01"},
+		SubnetIID:         cres.IID{NameId: "subnet-01"},
+		SecurityGroupIIDs: []cres.IID{{NameId: "sg-01"}},
+		VMSpecName:        "mock-vmspec-01",
+		KeyPairIID:        cres.IID{NameId: "key-01"},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cloudConn, err := (&mockdrv.MockDriver{}).ConnectCloud(idrv.ConnectionInfo{CredentialInfo: idrv.CredentialInfo{MockName: mockName}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	diskHandler, err := cloudConn.CreateDiskHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ownerVM := cres.IID{NameId: vmInfo.IId.SystemId, SystemId: vmInfo.IId.SystemId}
+	for _, diskName := range diskNames {
+		diskInfo, err := cmrt.GetDisk(connName, cmrt.DISK, diskName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the Mock lists the attached Disk in the DataDiskIIDs with the given SystemId
+		diskIID := cres.IID{NameId: diskInfo.IId.SystemId, SystemId: "root-of-" + diskInfo.IId.SystemId}
+		if _, err := diskHandler.AttachDisk(diskIID, ownerVM); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+// Emulated NLB Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func TestGenEmulatedNLBSetupScript(t *testing.T) {
+	nlbInfo := cres.NLBInfo{
+		VMGroup: cres.VMGroupInfo{VMs: &[]cres.IID{{NameId: "vm-01"}, {NameId: "vm-02"}}},
+		ListenerList: []cres.ListenerBindingInfo{
+			{Listener: cres.ListenerInfo{Protocol: "TCP", Port: "80"}, VMGroup: cres.VMGroupInfo{Protocol: "TCP", Port: "8080"}},
+			{Listener: cres.ListenerInfo{Protocol: "UDP", Port: "53"}, VMGroup: cres.VMGroupInfo{Protocol: "UDP", Port: "5353"}},
+		},
+		HealthChecker: cres.HealthCheckerInfo{Protocol: "HTTP", Port: "8080", Interval: 10, Timeout: -1, Threshold: 3,
+			HTTPPath: "/health", ExpectedStatusCodes: "200,302", HostHeader: "it's.example.com"},
+	}
+
+	script, err := cmrt.GenEmulatedNLBSetupScript(nlbInfo, []string{"10.0.0.12", "10.0.0.11"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, expected := range []string{
+		"TCP 80 8080 8080\nUDP 53 5353 8080\n",
+		"10.0.0.11\n10.0.0.12\n",
+		"PROTOCOL=HTTP\nINTERVAL=10\nTIMEOUT=5\nTHRESHOLD=3\n",
+		"HTTP_PATH='/health'\nEXPECTED_STATUS_CODES='200,302'\nHOST_HEADER='it'\\''s.example.com'\n",
+	} {
+		if !strings.Contains(script, expected) {
+			t.Errorf("the setup script does not have %q", expected)
+		}
+	}
+
+	// the default site of nginx is disabled before the stream block, so that it does not hold the Listener's port 80
+	streamIdx := strings.Index(script, "stream {")
+	for _, disable := range []string{"rm -f /etc/nginx/sites-enabled/default", "mv -f /etc/nginx/conf.d/default.conf"} {
+		idx := strings.Index(script, disable)
+		if idx < 0 || streamIdx < 0 || idx > streamIdx {
+			t.Errorf("the setup script should run %q before enabling the stream block", disable)
+		}
+	}
+
+	// without the HealthChecker's port, each Listener is checked at its own backend port, and a UDP Listener is not checked
+	nlbInfo.HealthChecker.Port = ""
+	script, err = cmrt.GenEmulatedNLBSetupScript(nlbInfo, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !strings.Contains(script, "TCP 80 8080 8080\nUDP 53 5353 -\n") {
+		t.Errorf("each Listener should be checked at its own port: %s", script)
+	}
+
+	// the emulated NLB supports only TCP and UDP Listeners
+	nlbInfo.ListenerList[0].Listener.Protocol = "HTTP"
+	if _, err := cmrt.GenEmulatedNLBSetupScript(nlbInfo, nil); err == nil {
+		t.Error("GenEmulatedNLBSetupScript() with an HTTP Listener should fail")
+	}
+	nlbInfo.ListenerList[0].Listener.Protocol = "TCP"
+
+	nlbInfo.HealthChecker.HTTPPath = "/health\nrm -rf /"
+	if _, err := cmrt.GenEmulatedNLBSetupScript(nlbInfo, nil); err == nil {
+		t.Error("GenEmulatedNLBSetupScript() with a new line in the HTTPPath should fail")
+	}
+}
+
+// fakeProxyExecutor runs the commands of the emulated NLB on the last applied configuration instead of the proxy VM.
+type fakeProxyExecutor struct {
+	readyIPs     []string
+	backends     []string // backends of the last setup script
+	unhealthyIPs map[string]bool
+}
+
+func (executor *fakeProxyExecutor) WaitReady(vmIP string) error {
+	executor.readyIPs = append(executor.readyIPs, vmIP)
+	return nil
+}
+
+func (executor *fakeProxyExecutor) Run(vmIP string, userName string, privateKey []byte, cmd string) (string, error) {
+	if userName == "" || len(privateKey) == 0 {
+		return "", fmt.Errorf("no user or private key to run a command on %s", vmIP)
+	}
+	if strings.HasPrefix(cmd, "cat /etc/spider-nlb/healthy") {
+		healthy := []string{}
+		for _, ip := range executor.backends {
+			if !executor.unhealthyIPs[ip] {
+				healthy = append(healthy, ip)
+			}
+		}
+		return strings.Join(healthy, "\n"), nil
+	}
+
+	// the setup script is run with "sudo bash -c '<quoted script>'"
+	cmd = strings.ReplaceAll(cmd, `'\''`, "'")
+	const backendsHeader = "cat > /etc/spider-nlb/backends <<'SPIDER_NLB_EOF'\n"
+	start := strings.Index(cmd, backendsHeader)
+	if start < 0 {
+		return "", fmt.Errorf("unexpected command: %s", cmd)
+	}
+	backends := cmd[start+len(backendsHeader):]
+	backends = backends[:strings.Index(backends, "SPIDER_NLB_EOF")]
+	executor.backends = strings.Fields(backends)
+	return "", nil
+}
+
+func TestEmulatedNLBHandlerWithMock(t *testing.T) {
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{MockName: "MockDriver-emulated-nlb"},
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, err := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// backend VMs
+	vpcHandler, _ := cloudConn.CreateVPCHandler()
+	securityHandler, _ := cloudConn.CreateSecurityHandler()
+	keyPairHandler, _ := cloudConn.CreateKeyPairHandler()
+	vmHandler, _ := cloudConn.CreateVMHandler()
+	vpcHandler.CreateVPC(cres.VPCReqInfo{
+		IId:            cres.IID{NameId: "enlb-vpc"},
+		IPv4_CIDR:      "10.0.0.0/16",
+		SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: "enlb-subnet"}, IPv4_CIDR: "10.0.1.0/24"}},
+	})
+	securityHandler.CreateSecurity(cres.SecurityReqInfo{
+		IId:           cres.IID{NameId: "enlb-sg"},
+		VpcIID:        cres.IID{NameId: "enlb-vpc"},
+		SecurityRules: &[]cres.SecurityRuleInfo{{FromPort: "1", ToPort: "65535", IPProtocol: "tcp", Direction: "inbound"}},
+	})
+	keyPairHandler.CreateKey(cres.KeyPairReqInfo{IId: cres.IID{NameId: "enlb-key"}})
+	vmIIDs := []cres.IID{}
+	for _, vmName := range []string{"enlb-vm-01", "enlb-vm-02"} {
+		vmInfo, err := vmHandler.StartVM(cres.VMReqInfo{
+			IId:               cres.IID{NameId: vmName},
+			ImageType:         cres.PublicImage,
+			ImageIID:          cres.IID{NameId: "mock-vmimage-01"},
+			VpcIID:            cres.IID{NameId: "enlb-vpc"},
+			SubnetIID:         cres.IID{NameId: "enlb-subnet"},
+			SecurityGroupIIDs: []cres.IID{{NameId: "enlb-sg"}},
+			VMSpecName:        "mock-vmspec-01",
+			KeyPairIID:        cres.IID{NameId: "enlb-key"},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		vmIIDs = append(vmIIDs, vmInfo.IId)
+	}
+
+	handler, err := cmrt.NewEmulatedNLBHandler("emulated-nlb-test-conn", cloudConn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	executor := &fakeProxyExecutor{unhealthyIPs: map[string]bool{}}
+	handler.ProxyExecutor = executor
+	handler.ProviderName = "MOCK"
+	nlbInfo, err := handler.CreateNLB(cres.NLBInfo{
+		IId:           cres.IID{NameId: "enlb-01"},
+		VpcIID:        cres.IID{NameId: "enlb-vpc"},
+		Type:          "PUBLIC",
+		Scope:         "REGION",
+		Listener:      cres.ListenerInfo{Protocol: "TCP", Port: "80"},
+		VMGroup:       cres.VMGroupInfo{Protocol: "TCP", Port: "8080", VMs: &[]cres.IID{vmIIDs[0]}},
+		HealthChecker: cres.HealthCheckerInfo{Protocol: "TCP", Port: "8080", Interval: 10, Timeout: 5, Threshold: 3},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer handler.DeleteNLB(nlbInfo.IId)
+
+	if nlbInfo.IId.SystemId != "enlb-01-nlb-vm" || nlbInfo.Listener.IP == "" || len(nlbInfo.ListenerList) != 1 {
+		t.Errorf("unexpected NLBInfo: %#v", nlbInfo)
+	}
+	proxyVM, err := vmHandler.GetVM(cres.IID{NameId: "enlb-01-nlb-vm"})
+	if err != nil {
+		t.Fatalf("the proxy VM does not exist: %v", err)
+	}
+	// the MOCK nlbproxyvmspec in cloudos_meta.yaml: mock-vmspec-04, not the backend VM's spec
+	if proxyVM.VMSpecName != "mock-vmspec-04" {
+		t.Errorf("the proxy VM should have the default spec mock-vmspec-04: %s", proxyVM.VMSpecName)
+	}
+	if len(executor.readyIPs) != 1 || executor.readyIPs[0] != proxyVM.PublicIP {
+		t.Errorf("the proxy VM %s was not waited: %v", proxyVM.PublicIP, executor.readyIPs)
+	}
+	if len(executor.backends) != 1 {
+		t.Errorf("unexpected backends of the proxy: %v", executor.backends)
+	}
+
+	// backend VMs
+	if _, err := handler.AddVMs(nlbInfo.IId, &[]cres.IID{vmIIDs[1]}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := handler.AddVMs(nlbInfo.IId, &[]cres.IID{vmIIDs[1]}); err == nil {
+		t.Error("AddVMs() with a duplicated VM should fail")
+	}
+	if len(executor.backends) != 2 {
+		t.Errorf("unexpected backends of the proxy: %v", executor.backends)
+	}
+	vm01, err := vmHandler.GetVM(vmIIDs[0])
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	executor.unhealthyIPs[vm01.PrivateIP] = true
+	healthInfo, err := handler.GetVMGroupHealthInfo(nlbInfo.IId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(*healthInfo.AllVMs) != 2 || len(*healthInfo.HealthyVMs) != 1 || len(*healthInfo.UnHealthyVMs) != 1 ||
+		(*healthInfo.UnHealthyVMs)[0].NameId != "enlb-vm-01" {
+		t.Errorf("unexpected HealthInfo: %#v", healthInfo)
+	}
+	if _, err := handler.RemoveVMs(nlbInfo.IId, &[]cres.IID{vmIIDs[0]}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// listeners
+	if _, err := handler.AddListener(nlbInfo.IId, cres.ListenerBindingInfo{
+		Listener: cres.ListenerInfo{Protocol: "UDP", Port: "53"}, VMGroup: cres.VMGroupInfo{Protocol: "UDP", Port: "5353"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := handler.ChangeListener(nlbInfo.IId, cres.ListenerInfo{Protocol: "TCP", Port: "8000"}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := handler.ChangeHealthCheckerInfo(nlbInfo.IId, cres.HealthCheckerInfo{Protocol: "HTTP", Port: "8080",
+		Interval: 30, Timeout: 5, Threshold: 2, HTTPPath: "/health"}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	nlbInfo, err = handler.GetNLB(nlbInfo.IId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(*nlbInfo.VMGroup.VMs) != 1 || (*nlbInfo.VMGroup.VMs)[0].NameId != "enlb-vm-02" {
+		t.Errorf("unexpected VMs: %#v", *nlbInfo.VMGroup.VMs)
+	}
+	if nlbInfo.Listener.Port != "8000" || len(nlbInfo.ListenerList) != 2 || len(*nlbInfo.ListenerList[1].VMGroup.VMs) != 1 {
+		t.Errorf("unexpected Listeners: %#v", nlbInfo.ListenerList)
+	}
+	if nlbInfo.HealthChecker.HTTPPath != "/health" || nlbInfo.HealthChecker.Interval != 30 {
+		t.Errorf("unexpected HealthChecker: %#v", nlbInfo.HealthChecker)
+	}
+
+	// delete
+	if _, err := handler.DeleteNLB(nlbInfo.IId); err != nil {
+		t.Fatal(err.Error())
+	}
+	iidList, err := handler.ListIID()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(iidList) != 0 {
+		t.Errorf("the emulated NLB remains after DeleteNLB(): %#v", iidList)
+	}
+	if _, err := vmHandler.GetVM(cres.IID{NameId: "enlb-01-nlb-vm"}); err == nil {
+		t.Error("the proxy VM remains after DeleteNLB()")
+	}
+
+	// the VMSpecName KeyValue overrides the proxy VM's default spec
+	nlbInfo, err = handler.CreateNLB(cres.NLBInfo{
+		IId:           cres.IID{NameId: "enlb-02"},
+		VpcIID:        cres.IID{NameId: "enlb-vpc"},
+		Listener:      cres.ListenerInfo{Protocol: "TCP", Port: "80"},
+		VMGroup:       cres.VMGroupInfo{Protocol: "TCP", Port: "8080", VMs: &[]cres.IID{vmIIDs[1]}},
+		HealthChecker: cres.HealthCheckerInfo{Protocol: "TCP", Port: "8080", Interval: 10, Timeout: 5, Threshold: 3},
+		KeyValueList:  []cres.KeyValue{{Key: "VMSpecName", Value: "mock-vmspec-03"}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer handler.DeleteNLB(nlbInfo.IId)
+	proxyVM, err = vmHandler.GetVM(cres.IID{NameId: "enlb-02-nlb-vm"})
+	if err != nil {
+		t.Fatalf("the proxy VM does not exist: %v", err)
+	}
+	if proxyVM.VMSpecName != "mock-vmspec-03" {
+		t.Errorf("the proxy VM should have the spec of the VMSpecName KeyValue: %s", proxyVM.VMSpecName)
+	}
+}
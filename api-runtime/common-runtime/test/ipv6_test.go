@@ -0,0 +1,70 @@
+// IPv6 Dual-Stack Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func TestAddIPv6Subnet(t *testing.T) {
+	connName := setupMockConnection(t, "ipv6-subnet-test")
+
+	for _, reqInfo := range []cres.VPCReqInfo{
+		{IId: cres.IID{NameId: "ipv4-vpc"}, IPv4_CIDR: "10.0.0.0/16",
+			SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: "ipv4-subnet-01"}, IPv4_CIDR: "10.0.1.0/24"}}},
+		{IId: cres.IID{NameId: "dual-stack-vpc"}, IPv4_CIDR: "10.1.0.0/16", EnableIPv6: true,
+			SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: "dual-stack-subnet-01"}, IPv4_CIDR: "10.1.1.0/24"}}},
+	} {
+		if _, err := cmrt.CreateVPC(connName, cmrt.VPC, reqInfo, "ON"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Cleanup(func() { cmrt.Destroy(connName) })
+
+	// an IPv4 only VPC
+	_, err := cmrt.AddSubnet(connName, cmrt.SUBNET, "ipv4-vpc", cres.SubnetInfo{IId: cres.IID{NameId: "ipv6-subnet"},
+		IPv4_CIDR: "10.0.2.0/24", IPv6_CIDR: "fd00:1:2:3::/64"}, "ON")
+	if err == nil || !strings.Contains(err.Error(), "not dual-stack") {
+		t.Errorf("AddSubnet() with an IPv6 CIDR to an IPv4 only VPC should fail: %v", err)
+	}
+
+	// a dual-stack VPC: the IPv6 CIDR should be in the VPC IPv6 CIDR
+	vpcInfo, err := cmrt.GetVPC(connName, cmrt.VPC, "dual-stack-vpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cmrt.AddSubnet(connName, cmrt.SUBNET, "dual-stack-vpc", cres.SubnetInfo{IId: cres.IID{NameId: "outside-subnet"},
+		IPv4_CIDR: "10.1.2.0/24", IPv6_CIDR: "2600:1f18:abcd:1200::/64"}, "ON")
+	if err == nil || !strings.Contains(err.Error(), vpcInfo.IPv6_CIDR) {
+		t.Errorf("AddSubnet() with an IPv6 CIDR out of the VPC %s should fail: %v", vpcInfo.IPv6_CIDR, err)
+	}
+
+	// the 6th /64 block of the VPC /56 block
+	_, vpcNet, err := net.ParseCIDR(vpcInfo.IPv6_CIDR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpcNet.IP[7] = 5
+	subnetIPv6CIDR := (&net.IPNet{IP: vpcNet.IP, Mask: net.CIDRMask(64, 128)}).String()
+	vpcInfo, err = cmrt.AddSubnet(connName, cmrt.SUBNET, "dual-stack-vpc", cres.SubnetInfo{IId: cres.IID{NameId: "dual-stack-subnet-02"},
+		IPv4_CIDR: "10.1.2.0/24", IPv6_CIDR: subnetIPv6CIDR}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, subnetInfo := range vpcInfo.SubnetInfoList {
+		if subnetInfo.IId.NameId == "dual-stack-subnet-02" && subnetInfo.IPv6_CIDR != subnetIPv6CIDR {
+			t.Errorf("the IPv6 CIDR of the Subnet is %s, but expected %s", subnetInfo.IPv6_CIDR, subnetIPv6CIDR)
+		}
+	}
+}
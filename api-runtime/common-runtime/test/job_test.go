@@ -0,0 +1,133 @@
+// Job Manager Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+func waitJobFinished(t *testing.T, jobId string) cmrt.JobInfo {
+	for i := 0; i < 100; i++ {
+		job, err := cmrt.GetJob(jobId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch job.Phase {
+		case cmrt.JobSucceeded, cmrt.JobFailed, cmrt.JobCanceled:
+			return job
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatalf("job %s is not finished in time", jobId)
+	return cmrt.JobInfo{}
+}
+
+func TestJobSucceeded(t *testing.T) {
+	job, err := cmrt.SubmitJob("TestJob", "test-connection", "vm", "vm-01", func(ctx context.Context) (interface{}, error) {
+		return map[string]string{"Name": "vm-01"}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job = waitJobFinished(t, job.JobId)
+	if job.Phase != cmrt.JobSucceeded || job.Progress != 100 {
+		t.Fatalf("unexpected job state: %s, %d", job.Phase, job.Progress)
+	}
+	if job.Result != `{"Name":"vm-01"}` {
+		t.Fatalf("unexpected job result: %s", job.Result)
+	}
+}
+
+func TestJobCancel(t *testing.T) {
+	job, err := cmrt.SubmitJob("TestJob", "test-connection", "vm", "vm-02", func(ctx context.Context) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cmrt.CancelJob(job.JobId); err != nil {
+		t.Fatal(err)
+	}
+
+	job = waitJobFinished(t, job.JobId)
+	if job.Phase != cmrt.JobCanceled {
+		t.Fatalf("unexpected job phase: %s", job.Phase)
+	}
+
+	// a finished job can not be canceled
+	if _, err := cmrt.CancelJob(job.JobId); err == nil {
+		t.Fatal("canceling a finished job should fail")
+	}
+}
+
+func TestJobNotFound(t *testing.T) {
+	if _, err := cmrt.GetJob("not-exist-job"); !errors.Is(err, cmrt.ErrJobNotFound) {
+		t.Fatalf("GetJob() of an unknown JobId should return ErrJobNotFound, but got: %v", err)
+	}
+	if _, err := cmrt.CancelJob("not-exist-job"); !errors.Is(err, cmrt.ErrJobNotFound) {
+		t.Fatalf("CancelJob() of an unknown JobId should return ErrJobNotFound, but got: %v", err)
+	}
+}
+
+func TestPruneJobs(t *testing.T) {
+	jobIdList := []string{}
+	for _, name := range []string{"vm-11", "vm-12", "vm-13"} {
+		job, err := cmrt.SubmitJob("TestJob", "prune-connection", "vm", name, func(ctx context.Context) (interface{}, error) {
+			return nil, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		waitJobFinished(t, job.JobId)
+		jobIdList = append(jobIdList, job.JobId)
+	}
+	running, err := cmrt.SubmitJob("TestJob", "prune-connection", "vm", "vm-14", func(ctx context.Context) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cmrt.CancelJob(running.JobId)
+
+	// keep only the latest finished job
+	if _, err := cmrt.PruneJobs(time.Hour, 1); err != nil {
+		t.Fatal(err)
+	}
+	for _, jobId := range jobIdList[:2] {
+		if _, err := cmrt.GetJob(jobId); !errors.Is(err, cmrt.ErrJobNotFound) {
+			t.Fatalf("old job %s should be pruned: %v", jobId, err)
+		}
+	}
+	if _, err := cmrt.GetJob(jobIdList[2]); err != nil {
+		t.Fatalf("the latest job should be kept: %v", err)
+	}
+	if _, err := cmrt.GetJob(running.JobId); err != nil {
+		t.Fatalf("an unfinished job should not be pruned: %v", err)
+	}
+
+	// the retention prunes all the finished jobs
+	if _, err := cmrt.PruneJobs(0, 1000); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cmrt.GetJob(jobIdList[2]); !errors.Is(err, cmrt.ErrJobNotFound) {
+		t.Fatalf("a job over the retention should be pruned: %v", err)
+	}
+	if _, err := cmrt.GetJob(running.JobId); err != nil {
+		t.Fatalf("an unfinished job should not be pruned: %v", err)
+	}
+}
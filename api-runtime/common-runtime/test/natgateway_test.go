@@ -0,0 +1,142 @@
+// NATGateway Dependency Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"strings"
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func TestNATGatewayRouteDependency(t *testing.T) {
+	connName := setupMockConnection(t, "natgw-dep-test")
+
+	_, err := cmrt.CreateVPC(connName, cmrt.VPC, cres.VPCReqInfo{
+		IId:       cres.IID{NameId: "vpc-01"},
+		IPv4_CIDR: "10.0.0.0/16",
+		SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: "public-subnet"}, IPv4_CIDR: "10.0.1.0/24"},
+			{IId: cres.IID{NameId: "private-subnet"}, IPv4_CIDR: "10.0.2.0/24"}},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cmrt.CreateNATGateway(connName, cmrt.NATGATEWAY, "vpc-01", cres.NATGatewayInfo{
+		IId:       cres.IID{NameId: "natgw-01"},
+		SubnetIID: cres.IID{NameId: "public-subnet"},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	natRoute := cres.RouteInfo{DestinationCIDR: "0.0.0.0/0", TargetType: cres.RouteTargetNATGateway, TargetIID: cres.IID{NameId: "natgw-01"}}
+	_, err = cmrt.CreateRouteTable(connName, cmrt.ROUTETABLE, "vpc-01", cres.RouteTableInfo{
+		IId:        cres.IID{NameId: "private-rt"},
+		RouteList:  []cres.RouteInfo{natRoute},
+		SubnetIIDs: []cres.IID{{NameId: "private-subnet"}},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the NATGateway targeted by a route can not be deleted
+	_, err = cmrt.DeleteNATGateway(connName, cmrt.NATGATEWAY, "vpc-01", "natgw-01", "false")
+	if err == nil {
+		t.Fatal("DeleteNATGateway() with a route to the NATGateway should fail")
+	}
+	if !strings.Contains(err.Error(), "private-rt") {
+		t.Errorf("the error should tell the RouteTable with the route: %v", err)
+	}
+
+	if _, err := cmrt.RemoveRoutes(connName, "vpc-01", "private-rt", []cres.RouteInfo{natRoute}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cmrt.DeleteNATGateway(connName, cmrt.NATGATEWAY, "vpc-01", "natgw-01", "false"); err != nil {
+		t.Fatalf("DeleteNATGateway() without routes to the NATGateway should succeed: %v", err)
+	}
+
+	destroyedInfo, err := cmrt.Destroy(connName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !destroyedInfo.IsAllDestroyed {
+		t.Fatal("Destroy() should delete all resources")
+	}
+}
+
+func TestVPCSubnetRouteTableNATGatewayDependency(t *testing.T) {
+	connName := setupMockConnection(t, "vpc-rt-dep-test")
+
+	_, err := cmrt.CreateVPC(connName, cmrt.VPC, cres.VPCReqInfo{
+		IId:       cres.IID{NameId: "vpc-01"},
+		IPv4_CIDR: "10.0.0.0/16",
+		SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: "public-subnet"}, IPv4_CIDR: "10.0.1.0/24"},
+			{IId: cres.IID{NameId: "private-subnet"}, IPv4_CIDR: "10.0.2.0/24"}},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cmrt.CreateNATGateway(connName, cmrt.NATGATEWAY, "vpc-01", cres.NATGatewayInfo{
+		IId:       cres.IID{NameId: "natgw-01"},
+		SubnetIID: cres.IID{NameId: "public-subnet"},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cmrt.CreateRouteTable(connName, cmrt.ROUTETABLE, "vpc-01", cres.RouteTableInfo{IId: cres.IID{NameId: "private-rt"}}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cmrt.AssociateRouteTableSubnet(connName, "vpc-01", "private-rt", "private-subnet"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the Subnet of the NATGateway and the Subnet associated with the RouteTable can not be removed
+	_, err = cmrt.RemoveSubnet(connName, "vpc-01", "public-subnet", "false")
+	if err == nil || !strings.Contains(err.Error(), "natgw-01") {
+		t.Errorf("RemoveSubnet() with a NATGateway should fail with the NATGateway name: %v", err)
+	}
+	_, err = cmrt.RemoveSubnet(connName, "vpc-01", "private-subnet", "false")
+	if err == nil || !strings.Contains(err.Error(), "private-rt") {
+		t.Errorf("RemoveSubnet() with a RouteTable association should fail with the RouteTable name: %v", err)
+	}
+	if _, err := cmrt.DisassociateRouteTableSubnet(connName, "vpc-01", "private-rt", "private-subnet"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cmrt.RemoveSubnet(connName, "vpc-01", "private-subnet", "false"); err != nil {
+		t.Errorf("RemoveSubnet() without a RouteTable association should succeed: %v", err)
+	}
+
+	// the VPC with a RouteTable or a NATGateway can not be deleted
+	_, err = cmrt.DeleteVPC(connName, cmrt.VPC, "vpc-01", "false")
+	if err == nil || !strings.Contains(err.Error(), "private-rt") {
+		t.Errorf("DeleteVPC() with a RouteTable should fail with the RouteTable name: %v", err)
+	}
+	if _, err := cmrt.DeleteRouteTable(connName, cmrt.ROUTETABLE, "vpc-01", "private-rt", "false"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = cmrt.DeleteVPC(connName, cmrt.VPC, "vpc-01", "false")
+	if err == nil || !strings.Contains(err.Error(), "natgw-01") {
+		t.Errorf("DeleteVPC() with a NATGateway should fail with the NATGateway name: %v", err)
+	}
+
+	// the forced delete leaves no IID info of the VPC's NATGateways
+	if _, err := cmrt.DeleteVPC(connName, cmrt.VPC, "vpc-01", "true"); err != nil {
+		t.Fatal(err)
+	}
+	for _, rsType := range []string{cmrt.NATGATEWAY, cmrt.ROUTETABLE, cmrt.VPC} {
+		nameList, err := cmrt.ListResourceName(connName, rsType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(nameList) != 0 {
+			t.Errorf("%s %v remains after the forced DeleteVPC()", rsType, nameList)
+		}
+	}
+}
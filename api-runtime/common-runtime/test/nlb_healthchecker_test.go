@@ -0,0 +1,119 @@
+// NLB HealthChecker Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// createHealthCheckerNLB creates an NLB with the HealthChecker in the VPC vpc-01.
+func createHealthCheckerNLB(t *testing.T, connName string, nlbName string, healthChecker cres.HealthCheckerInfo) (*cres.NLBInfo, error) {
+	if _, err := cmrt.GetVPC(connName, cmrt.VPC, "vpc-01"); err != nil {
+		_, err := cmrt.CreateVPC(connName, cmrt.VPC, cres.VPCReqInfo{
+			IId:            cres.IID{NameId: "vpc-01"},
+			IPv4_CIDR:      "10.0.0.0/16",
+			SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.1.0/24"}},
+		}, "ON")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return cmrt.CreateNLB(connName, cmrt.NLB, cres.NLBInfo{
+		IId:           cres.IID{NameId: nlbName},
+		VpcIID:        cres.IID{NameId: "vpc-01"},
+		Type:          "PUBLIC",
+		Scope:         "REGION",
+		Listener:      cres.ListenerInfo{Protocol: "TCP", Port: "80"},
+		VMGroup:       cres.VMGroupInfo{Protocol: "TCP", Port: "8080"},
+		HealthChecker: healthChecker,
+	}, "ON")
+}
+
+// the MOCK defaults in cloudos_meta.yaml: TCP|10|10|3 / HTTP|10|6|3, nlbhttphealthcheckstatus: 200
+func TestNLBHealthCheckerDefault(t *testing.T) {
+	tests := []struct {
+		nlbName string
+		req     cres.HealthCheckerInfo
+		want    cres.HealthCheckerInfo
+	}{
+		{"tcp-defaults",
+			cres.HealthCheckerInfo{Protocol: "TCP", Port: "8080", Interval: -1, Timeout: -1, Threshold: -1},
+			cres.HealthCheckerInfo{Protocol: "TCP", Port: "8080", Interval: 10, Timeout: 10, Threshold: 3}},
+		{"http-defaults",
+			cres.HealthCheckerInfo{Protocol: "HTTP", Port: "8080", Interval: -1, Timeout: -1, Threshold: -1},
+			cres.HealthCheckerInfo{Protocol: "HTTP", Port: "8080", Interval: 10, Timeout: 6, Threshold: 3, HTTPPath: "/", ExpectedStatusCodes: "200"}},
+		{"http-values",
+			cres.HealthCheckerInfo{Protocol: "HTTP", Port: "8080", Interval: 30, Timeout: -1, Threshold: 5, HTTPPath: "/health", ExpectedStatusCodes: "200-299"},
+			cres.HealthCheckerInfo{Protocol: "HTTP", Port: "8080", Interval: 30, Timeout: 6, Threshold: 5, HTTPPath: "/health", ExpectedStatusCodes: "200-299"}},
+	}
+	connName := setupMockConnection(t, "nlb-hc-default-test")
+	for _, tt := range tests {
+		info, err := createHealthCheckerNLB(t, connName, tt.nlbName, tt.req)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.nlbName, err)
+		}
+		got := info.HealthChecker
+		if got.Interval != tt.want.Interval || got.Timeout != tt.want.Timeout || got.Threshold != tt.want.Threshold ||
+			got.HTTPPath != tt.want.HTTPPath || got.ExpectedStatusCodes != tt.want.ExpectedStatusCodes {
+			t.Errorf("%s: got %+v, want %+v", tt.nlbName, got, tt.want)
+		}
+	}
+}
+
+func TestNLBHealthCheckerValidation(t *testing.T) {
+	connName := setupMockConnection(t, "nlb-hc-validation-test")
+	if _, err := createHealthCheckerNLB(t, connName, "nlb-01", cres.HealthCheckerInfo{Protocol: "TCP", Port: "8080", Interval: -1, Timeout: -1, Threshold: -1}); err != nil {
+		t.Fatal(err)
+	}
+	httpHealthChecker := func(httpPath, expectedStatusCodes string) cres.HealthCheckerInfo {
+		return cres.HealthCheckerInfo{Protocol: "HTTP", Port: "8080", Interval: -1, Timeout: -1, Threshold: -1,
+			HTTPPath: httpPath, ExpectedStatusCodes: expectedStatusCodes}
+	}
+
+	for _, tt := range []struct {
+		expectedStatusCodes string
+		valid               bool
+	}{
+		{"200", true},
+		{"200,302", true},
+		{"200-399", true},
+		{"200-299,404", true},
+		{"599", true},
+		{"600", false},
+		{"099", false},
+		{"20", false},
+		{"2000", false},
+		{"OK", false},
+		{"200-", false},
+		{"200,,302", false},
+		{"200, 302", false},
+		{"200-399-499", false},
+	} {
+		_, err := cmrt.ChangeHealthChecker(connName, "nlb-01", httpHealthChecker("/", tt.expectedStatusCodes))
+		if tt.valid && err != nil {
+			t.Errorf("ExpectedStatusCodes '%s' should be valid: %v", tt.expectedStatusCodes, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("ExpectedStatusCodes '%s' should be invalid", tt.expectedStatusCodes)
+		}
+	}
+
+	// the HTTPPath must start with '/'
+	if _, err := cmrt.ChangeHealthChecker(connName, "nlb-01", httpHealthChecker("health", "")); err == nil {
+		t.Error("the HTTPPath without '/' should be invalid")
+	}
+	// the HTTP options only in the HTTP HealthChecker
+	if _, err := cmrt.ChangeHealthChecker(connName, "nlb-01", cres.HealthCheckerInfo{Protocol: "TCP", Port: "8080",
+		Interval: -1, Timeout: -1, Threshold: -1, HTTPPath: "/health"}); err == nil {
+		t.Error("the HTTPPath of the TCP HealthChecker should be invalid")
+	}
+}
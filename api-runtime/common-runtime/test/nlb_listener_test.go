@@ -0,0 +1,120 @@
+// NLB ListenerList Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func listenerBinding(protocol, port, vmPort string) cres.ListenerBindingInfo {
+	return cres.ListenerBindingInfo{
+		Listener: cres.ListenerInfo{Protocol: protocol, Port: port},
+		VMGroup:  cres.VMGroupInfo{Protocol: protocol, Port: vmPort},
+	}
+}
+
+func TestNLBListenerList(t *testing.T) {
+	connName := setupMockConnection(t, "nlb-listener-test")
+
+	_, err := cmrt.CreateVPC(connName, cmrt.VPC, cres.VPCReqInfo{
+		IId:            cres.IID{NameId: "vpc-01"},
+		IPv4_CIDR:      "10.0.0.0/16",
+		SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.1.0/24"}},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nlbReqInfo := func(name string) cres.NLBInfo {
+		return cres.NLBInfo{
+			IId:           cres.IID{NameId: name},
+			VpcIID:        cres.IID{NameId: "vpc-01"},
+			Type:          "PUBLIC",
+			Scope:         "REGION",
+			HealthChecker: cres.HealthCheckerInfo{Protocol: "TCP", Port: "8080"},
+		}
+	}
+
+	// invalid requests
+	for _, tt := range []struct {
+		name         string
+		listener     cres.ListenerInfo
+		listenerList []cres.ListenerBindingInfo
+	}{
+		{"Listener with ListenerList", cres.ListenerInfo{Protocol: "TCP", Port: "80"},
+			[]cres.ListenerBindingInfo{listenerBinding("TCP", "443", "8443")}},
+		{"duplicated Listener", cres.ListenerInfo{},
+			[]cres.ListenerBindingInfo{listenerBinding("TCP", "80", "8080"), listenerBinding("tcp", "80", "8081")}},
+		{"without the VMGroup Port", cres.ListenerInfo{},
+			[]cres.ListenerBindingInfo{listenerBinding("TCP", "80", "8080"), listenerBinding("UDP", "53", "")}},
+		{"without the Listener Port", cres.ListenerInfo{},
+			[]cres.ListenerBindingInfo{listenerBinding("TCP", "", "8080")}},
+	} {
+		reqInfo := nlbReqInfo("nlb-invalid")
+		reqInfo.Listener = tt.listener
+		reqInfo.ListenerList = tt.listenerList
+		if _, err := cmrt.CreateNLB(connName, cmrt.NLB, reqInfo, "ON"); err == nil {
+			t.Errorf("CreateNLB() %s should fail", tt.name)
+			cmrt.DeleteNLB(connName, cmrt.NLB, "nlb-invalid", "true")
+		}
+	}
+
+	// the Listener and VMGroup: the shorthand of a single listener
+	reqInfo := nlbReqInfo("nlb-01")
+	reqInfo.Listener = cres.ListenerInfo{Protocol: "tcp", Port: "80"}
+	reqInfo.VMGroup = cres.VMGroupInfo{Protocol: "tcp", Port: "8080"}
+	info, err := cmrt.CreateNLB(connName, cmrt.NLB, reqInfo, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.ListenerList) != 1 || info.ListenerList[0].Listener.Protocol != "TCP" || info.ListenerList[0].Listener.Port != "80" ||
+		info.ListenerList[0].VMGroup.Port != "8080" {
+		t.Errorf("the ListenerList should have the Listener: %+v", info.ListenerList)
+	}
+
+	// the ListenerList: the first binding is the Listener and VMGroup
+	reqInfo = nlbReqInfo("nlb-02")
+	reqInfo.ListenerList = []cres.ListenerBindingInfo{listenerBinding("TCP", "80", "8080"), listenerBinding("UDP", "53", "5353")}
+	info, err = cmrt.CreateNLB(connName, cmrt.NLB, reqInfo, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.ListenerList) != 2 || info.ListenerList[1].Listener.Port != "53" || info.ListenerList[1].VMGroup.Port != "5353" {
+		t.Errorf("the ListenerList should have 2 listeners: %+v", info.ListenerList)
+	}
+	if info.Listener.Port != "80" || info.VMGroup.Port != "8080" {
+		t.Errorf("the Listener and VMGroup should be the first binding: %+v, %+v", info.Listener, info.VMGroup)
+	}
+
+	// add and remove a listener
+	info, err = cmrt.AddNLBListener(connName, "nlb-01", listenerBinding("udp", "53", "5353"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.ListenerList) != 2 || info.ListenerList[1].Listener.Protocol != "UDP" {
+		t.Errorf("the UDP:53 listener should be added: %+v", info.ListenerList)
+	}
+	if _, err := cmrt.AddNLBListener(connName, "nlb-01", listenerBinding("UDP", "53", "5354")); err == nil {
+		t.Error("AddNLBListener() with an existing listener should fail")
+	}
+	if _, err := cmrt.RemoveNLBListener(connName, "nlb-01", cres.ListenerInfo{Protocol: "UDP", Port: "53"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cmrt.RemoveNLBListener(connName, "nlb-01", cres.ListenerInfo{Protocol: "TCP", Port: "80"}); err == nil {
+		t.Error("RemoveNLBListener() of the last listener should fail")
+	}
+	if _, err := cmrt.RemoveNLBListener(connName, "nlb-02", cres.ListenerInfo{Protocol: "TCP", Port: "80"}); err == nil {
+		t.Error("RemoveNLBListener() of the first listener should fail")
+	}
+	if _, err := cmrt.RemoveNLBListener(connName, "nlb-02", cres.ListenerInfo{Protocol: "UDP", Port: "53"}); err != nil {
+		t.Error(err)
+	}
+}
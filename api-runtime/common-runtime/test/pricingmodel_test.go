@@ -0,0 +1,75 @@
+// PricingModel Filter Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func pricingModelFilter(value string) []cres.KeyValue {
+	return []cres.KeyValue{{Key: cres.PricingModelFilterKey, Value: value}}
+}
+
+func TestGetPriceInfoWithPricingModel(t *testing.T) {
+	connName := setupMockConnection(t, "pricing-model-test")
+
+	tests := []struct {
+		value    string
+		onDemand bool
+		reserved bool
+		spot     bool
+	}{
+		{"", true, true, true},
+		{"OnDemand", true, false, false},
+		{"reserved, SPOT", false, true, true},
+		{"Spot", false, false, true},
+	}
+	for _, tt := range tests {
+		result, err := cmrt.GetPriceInfo(connName, "VM", "mercury", pricingModelFilter(tt.value), true)
+		if err != nil {
+			t.Fatalf("PricingModel '%s': %v", tt.value, err)
+		}
+		var cloudPrice cres.CloudPrice
+		if err := json.Unmarshal([]byte(result), &cloudPrice); err != nil {
+			t.Fatal(err)
+		}
+		if len(cloudPrice.PriceList) == 0 {
+			t.Fatalf("PricingModel '%s': the PriceList should not be empty", tt.value)
+		}
+		for _, price := range cloudPrice.PriceList {
+			priceInfo := price.PriceInfo
+			if (priceInfo.OnDemand.Price != "") != tt.onDemand || (len(priceInfo.Reserved) > 0) != tt.reserved ||
+				(len(priceInfo.Spot) > 0) != tt.spot {
+				t.Errorf("PricingModel '%s' of %s: OnDemand %v, Reserved %d, Spot %d", tt.value,
+					price.ProductInfo.ProductId, priceInfo.OnDemand.Price != "", len(priceInfo.Reserved), len(priceInfo.Spot))
+			}
+		}
+	}
+
+	// the storages have no Spot price
+	result, err := cmrt.GetPriceInfo(connName, "Storage", "mercury", pricingModelFilter("Spot"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cloudPrice cres.CloudPrice
+	if err := json.Unmarshal([]byte(result), &cloudPrice); err != nil {
+		t.Fatal(err)
+	}
+	if len(cloudPrice.PriceList) != 0 {
+		t.Errorf("the storages without Spot prices should be excluded: %v", cloudPrice.PriceList)
+	}
+
+	if _, err := cmrt.GetPriceInfo(connName, "VM", "mercury", pricingModelFilter("OnDemand,Preemptible"), true); err == nil {
+		t.Error("GetPriceInfo() with an invalid PricingModel should fail")
+	}
+}
@@ -0,0 +1,86 @@
+// Remote SecurityGroup Rule Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func TestRemoteSecurityGroupRule(t *testing.T) {
+	connName := setupMockConnection(t, "remote-sg-test")
+
+	for i, vpcName := range []string{"vpc-01", "vpc-02"} {
+		_, err := cmrt.CreateVPC(connName, cmrt.VPC, cres.VPCReqInfo{
+			IId:       cres.IID{NameId: vpcName},
+			IPv4_CIDR: []string{"10.0.0.0/16", "10.1.0.0/16"}[i],
+			SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: vpcName + "-subnet"},
+				IPv4_CIDR: []string{"10.0.1.0/24", "10.1.1.0/24"}[i]}},
+		}, "ON")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, sg := range []struct{ name, vpcName string }{{"app-sg", "vpc-01"}, {"db-sg", "vpc-01"}, {"other-sg", "vpc-02"}} {
+		_, err := cmrt.CreateSecurity(connName, cmrt.SG, cres.SecurityReqInfo{
+			IId:           cres.IID{NameId: sg.name},
+			VpcIID:        cres.IID{NameId: sg.vpcName},
+			SecurityRules: &[]cres.SecurityRuleInfo{inboundRule("22", "")},
+		}, "ON")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	remoteRule := func(remoteSGName string) cres.SecurityRuleInfo {
+		return cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "5432", ToPort: "5432",
+			RemoteSecurityGroupIID: &cres.IID{NameId: remoteSGName}}
+	}
+
+	// the remote SecurityGroup in another VPC
+	if _, err := cmrt.AddRules(connName, "db-sg", []cres.SecurityRuleInfo{remoteRule("other-sg")}); err == nil {
+		t.Error("AddRules() with a remote SecurityGroup in another VPC should fail")
+	}
+	_, err := cmrt.CreateSecurity(connName, cmrt.SG, cres.SecurityReqInfo{
+		IId:           cres.IID{NameId: "new-sg"},
+		VpcIID:        cres.IID{NameId: "vpc-02"},
+		SecurityRules: &[]cres.SecurityRuleInfo{remoteRule("app-sg")},
+	}, "ON")
+	if err == nil {
+		t.Error("CreateSecurity() with a remote SecurityGroup in another VPC should fail")
+	}
+
+	// the remote SecurityGroup in the same VPC
+	info, err := cmrt.AddRules(connName, "db-sg", []cres.SecurityRuleInfo{remoteRule("app-sg")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, rule := range *info.SecurityRules {
+		if rule.RemoteSecurityGroupIID != nil && rule.RemoteSecurityGroupIID.NameId == "app-sg" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("the rule should have the remote SecurityGroup app-sg: %v", *info.SecurityRules)
+	}
+
+	// the SecurityGroup referenced by the rules of another SecurityGroup
+	if _, err := cmrt.DeleteSecurity(connName, cmrt.SG, "app-sg", "false"); err == nil {
+		t.Error("DeleteSecurity() of a SecurityGroup referenced by db-sg should fail")
+	}
+	if _, err := cmrt.RemoveRules(connName, "db-sg", []cres.SecurityRuleInfo{remoteRule("app-sg")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cmrt.DeleteSecurity(connName, cmrt.SG, "app-sg", "false"); err != nil {
+		t.Error(err)
+	}
+}
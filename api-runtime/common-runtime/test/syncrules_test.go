@@ -0,0 +1,132 @@
+// SecurityGroup SyncRules Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"strings"
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func inboundRule(port string, priority string) cres.SecurityRuleInfo {
+	return cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: port, ToPort: port, CIDR: "0.0.0.0/0", Priority: priority}
+}
+
+// rulePorts returns the FromPort of the current rules of the SecurityGroup.
+func rulePorts(t *testing.T, connName string, sgName string) []string {
+	info, err := cmrt.GetSecurity(connName, cmrt.SG, sgName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ports := []string{}
+	for _, rule := range *info.SecurityRules {
+		ports = append(ports, rule.FromPort)
+	}
+	return ports
+}
+
+func TestSyncRules(t *testing.T) {
+	const mockName = "sync-rules-test"
+	connName := setupMockConnection(t, mockName)
+
+	_, err := cmrt.CreateVPC(connName, cmrt.VPC, cres.VPCReqInfo{
+		IId:            cres.IID{NameId: "vpc-01"},
+		IPv4_CIDR:      "10.0.0.0/16",
+		SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.1.0/24"}},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sgName := range []string{"sg-01", "remote-sg"} {
+		_, err = cmrt.CreateSecurity(connName, cmrt.SG, cres.SecurityReqInfo{
+			IId:           cres.IID{NameId: sgName},
+			VpcIID:        cres.IID{NameId: "vpc-01"},
+			SecurityRules: &[]cres.SecurityRuleInfo{inboundRule("22", ""), inboundRule("80", "100")},
+		}, "ON")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// (1) dry run: only the diff
+	diffInfo, err := cmrt.SyncRules(connName, "sg-01", []cres.SecurityRuleInfo{inboundRule("22", ""), inboundRule("443", "")}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffInfo.AddedRules) != 1 || diffInfo.AddedRules[0].FromPort != "443" {
+		t.Errorf("443 should be added: %v", diffInfo.AddedRules)
+	}
+	if len(diffInfo.RemovedRules) != 1 || diffInfo.RemovedRules[0].FromPort != "80" {
+		t.Errorf("80 should be removed: %v", diffInfo.RemovedRules)
+	}
+	if ports := strings.Join(rulePorts(t, connName, "sg-01"), ","); ports != "22,80" {
+		t.Errorf("a dry run should not change the rules: %s", ports)
+	}
+
+	// (2) a Priority used twice in the target rules
+	for _, desired := range [][]cres.SecurityRuleInfo{
+		{inboundRule("22", ""), inboundRule("443", "200"), inboundRule("8080", "200")},
+		{inboundRule("22", ""), inboundRule("80", "100"), inboundRule("443", "100")}, // with a kept rule
+	} {
+		if _, err := cmrt.SyncRules(connName, "sg-01", desired, true); err == nil {
+			t.Errorf("SyncRules() with a duplicated Priority should fail: %v", desired)
+		}
+	}
+	for _, added := range [][]cres.SecurityRuleInfo{
+		{inboundRule("443", "200"), inboundRule("8080", "200")},
+		{inboundRule("443", "100")}, // with an existing rule
+	} {
+		if _, err := cmrt.AddRules(connName, "sg-01", added); err == nil {
+			t.Errorf("AddRules() with a duplicated Priority should fail: %v", added)
+		}
+	}
+
+	// (3) a failed add: the removed rules are restored
+	// the remote SecurityGroup is deleted only in the CSP, so the driver fails to add the rule
+	cloudConn, err := (&mockdrv.MockDriver{}).ConnectCloud(idrv.ConnectionInfo{CredentialInfo: idrv.CredentialInfo{MockName: mockName}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	remoteSGInfo, err := cmrt.GetSecurity(connName, cmrt.SG, "remote-sg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sgHandler, _ := cloudConn.CreateSecurityHandler()
+	if _, err := sgHandler.DeleteSecurity(remoteSGInfo.IId); err != nil {
+		t.Fatal(err)
+	}
+	remoteRule := cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "3306", ToPort: "3306",
+		RemoteSecurityGroupIID: &cres.IID{NameId: "remote-sg"}}
+	_, err = cmrt.SyncRules(connName, "sg-01", []cres.SecurityRuleInfo{inboundRule("22", ""), remoteRule}, false)
+	if err == nil {
+		t.Fatal("SyncRules() should fail when the driver fails to add a rule")
+	}
+	if !strings.Contains(err.Error(), "restored") {
+		t.Errorf("the error should tell the removed rules are restored: %v", err)
+	}
+	if ports := strings.Join(rulePorts(t, connName, "sg-01"), ","); ports != "22,80" {
+		t.Errorf("the removed rule should be restored: %s", ports)
+	}
+
+	// (4) sync
+	diffInfo, err = cmrt.SyncRules(connName, "sg-01", []cres.SecurityRuleInfo{inboundRule("22", ""), inboundRule("443", "")}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diffInfo.DryRun {
+		t.Error("DryRun should be false")
+	}
+	if ports := strings.Join(rulePorts(t, connName, "sg-01"), ","); ports != "22,443" {
+		t.Errorf("the rules should be synced: %s", ports)
+	}
+}
@@ -0,0 +1,126 @@
+// VMSpec Recommend Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var recommendTestSpecs = []cres.VMSpecInfo{
+	{Name: "spec-gpu", VCpu: cres.VCpuInfo{Count: "4"}, MemSizeMiB: "32768",
+		Gpu:          []cres.GpuInfo{{Count: "2", Mfr: "NVIDIA", Model: "Tesla V100"}},
+		KeyValueList: []cres.KeyValue{{Key: "Architecture", Value: "x86_64"}}},
+	{Name: "spec-arm", VCpu: cres.VCpuInfo{Count: "8"}, MemSizeMiB: "16384",
+		KeyValueList: []cres.KeyValue{{Key: "ProcessorInfo", Value: "{SupportedArchitectures:[arm64],SustainedClockSpeedInGhz:2.50}"}}},
+	{Name: "spec-unknown", VCpu: cres.VCpuInfo{Count: "-1"}, MemSizeMiB: "-1"},
+}
+
+func matchedSpecNames(requirement cmrt.VMSpecRequirement) []string {
+	names := []string{}
+	for _, spec := range recommendTestSpecs {
+		if cmrt.MatchVMSpec(spec, requirement) {
+			names = append(names, spec.Name)
+		}
+	}
+	return names
+}
+
+func TestMatchVMSpec(t *testing.T) {
+	testCases := []struct {
+		requirement cmrt.VMSpecRequirement
+		expected    []string
+	}{
+		{cmrt.VMSpecRequirement{}, []string{"spec-gpu", "spec-arm", "spec-unknown"}},
+		{cmrt.VMSpecRequirement{MinVCpu: 8}, []string{"spec-arm"}},
+		{cmrt.VMSpecRequirement{MinMemSizeMiB: 20000}, []string{"spec-gpu"}},
+		{cmrt.VMSpecRequirement{GpuModel: "v100"}, []string{"spec-gpu"}},
+		{cmrt.VMSpecRequirement{GpuModel: "V100", MinGpuCount: 4}, []string{}},
+		{cmrt.VMSpecRequirement{Architecture: "amd64"}, []string{"spec-gpu"}},
+		{cmrt.VMSpecRequirement{Architecture: "aarch64"}, []string{"spec-arm"}},
+	}
+
+	for _, tc := range testCases {
+		names := matchedSpecNames(tc.requirement)
+		if len(names) != len(tc.expected) {
+			t.Errorf("%+v: matched %v, but expected %v", tc.requirement, names, tc.expected)
+			continue
+		}
+		for i := range names {
+			if names[i] != tc.expected[i] {
+				t.Errorf("%+v: matched %v, but expected %v", tc.requirement, names, tc.expected)
+				break
+			}
+		}
+	}
+}
+
+func TestSortVMSpecRecommendations(t *testing.T) {
+	list := []*cmrt.VMSpecRecommendation{
+		{ConnectionName: "conn-a", VMSpecInfo: cres.VMSpecInfo{Name: "unpriced", VCpu: cres.VCpuInfo{Count: "2"}}},
+		{ConnectionName: "conn-a", VMSpecInfo: cres.VMSpecInfo{Name: "expensive"}, Price: "0.5"},
+		{ConnectionName: "conn-b", VMSpecInfo: cres.VMSpecInfo{Name: "cheap"}, Price: "0.05"},
+		{ConnectionName: "conn-b", VMSpecInfo: cres.VMSpecInfo{Name: "unpriced-small", VCpu: cres.VCpuInfo{Count: "1"}}},
+	}
+
+	cmrt.SortVMSpecRecommendations(list)
+
+	expected := []string{"cheap", "expensive", "unpriced-small", "unpriced"}
+	for i, recommendation := range list {
+		if recommendation.VMSpecInfo.Name != expected[i] {
+			t.Fatalf("rank %d is %s, but expected %s", i, recommendation.VMSpecInfo.Name, expected[i])
+		}
+	}
+}
+
+func TestRecommendVMSpecWithConnectionError(t *testing.T) {
+	if _, err := cmrt.RecommendVMSpec(nil, cmrt.VMSpecRequirement{}); err == nil {
+		t.Error("RecommendVMSpec() without connections should fail")
+	}
+	if _, err := cmrt.RecommendVMSpec([]string{"conn-a"}, cmrt.VMSpecRequirement{Architecture: "sparc"}); err == nil {
+		t.Error("RecommendVMSpec() with an unknown architecture should fail")
+	}
+
+	result, err := cmrt.RecommendVMSpec([]string{"not-exist-connection-01", "not-exist-connection-02"}, cmrt.VMSpecRequirement{MinVCpu: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.RecommendationList) != 0 || len(result.ErrorList) != 2 {
+		t.Fatalf("unexpected result: %d recommendations, %d errors", len(result.RecommendationList), len(result.ErrorList))
+	}
+}
+
+func TestRecommendVMSpecJoinsPrice(t *testing.T) {
+	connName := setupMockConnection(t, "recommend-price-test")
+
+	for i := 0; i < 2; i++ { // the second call uses the cached prices
+		result, err := cmrt.RecommendVMSpec([]string{connName}, cmrt.VMSpecRequirement{MinVCpu: 4, Architecture: "x86_64"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.ErrorList) != 0 {
+			t.Fatalf("unexpected error: %s", result.ErrorList[0].ErrorMsg)
+		}
+
+		expected := []string{"mock-vmspec-03:0.5", "mock-vmspec-02:0.7", "mock-vmspec-01:0.9"}
+		if len(result.RecommendationList) != len(expected) {
+			t.Fatalf("%d recommendations, but expected %d", len(result.RecommendationList), len(expected))
+		}
+		for rank, recommendation := range result.RecommendationList {
+			if got := recommendation.VMSpecInfo.Name + ":" + recommendation.Price; got != expected[rank] {
+				t.Errorf("rank %d is %s, but expected %s", rank, got, expected[rank])
+			}
+			if recommendation.Currency != "USD" || recommendation.Unit != "Hour" {
+				t.Errorf("%s is not joined with the price unit: %s/%s", recommendation.VMSpecInfo.Name, recommendation.Currency, recommendation.Unit)
+			}
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// VNic and PublicIP Dependency Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
+	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+)
+
+// setupMockConnection registers a MOCK connection config with its own mock name.
+func setupMockConnection(t *testing.T, name string) string {
+	if _, err := dim.RegisterCloudDriver(name+"-driver", "MOCK", "mock-driver-v1.0.so"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cim.RegisterCredential(name+"-credential", "MOCK", []cres.KeyValue{{Key: "MockName", Value: name}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rim.RegisterRegion(name+"-region", "MOCK", []cres.KeyValue{{Key: "Region", Value: "default"}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ccim.CreateConnectionConfig(name+"-config", "MOCK", name+"-driver", name+"-credential", name+"-region"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		ccim.DeleteConnectionConfig(name + "-config")
+		rim.UnRegisterRegion(name + "-region")
+		cim.UnRegisterCredential(name + "-credential")
+		dim.UnRegisterCloudDriver(name + "-driver")
+	})
+	return name + "-config"
+}
+
+func TestVNicDependency(t *testing.T) {
+	connName := setupMockConnection(t, "vnic-dep-test")
+
+	_, err := cmrt.CreateVPC(connName, cmrt.VPC, cres.VPCReqInfo{
+		IId:            cres.IID{NameId: "vpc-01"},
+		IPv4_CIDR:      "10.0.0.0/16",
+		SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.1.0/24"}},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cmrt.CreateSecurity(connName, cmrt.SG, cres.SecurityReqInfo{
+		IId:           cres.IID{NameId: "sg-01"},
+		VpcIID:        cres.IID{NameId: "vpc-01"},
+		SecurityRules: &[]cres.SecurityRuleInfo{{FromPort: "22", ToPort: "22", IPProtocol: "tcp", Direction: "inbound", CIDR: "0.0.0.0/0"}},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cmrt.CreateKey(connName, cmrt.KEY, cres.KeyPairReqInfo{IId: cres.IID{NameId: "key-01"}}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cmrt.StartVM(connName, cmrt.VM, cres.VMReqInfo{
+		IId:               cres.IID{NameId: "vm-01"},
+		ImageType:         cres.PublicImage,
+		ImageIID:          cres.IID{NameId: "mock-vmimage-01"},
+		VpcIID:            cres.IID{NameId: "vpc-01"},
+		SubnetIID:         cres.IID{NameId: "subnet-01"},
+		SecurityGroupIIDs: []cres.IID{{NameId: "sg-01"}},
+		VMSpecName:        "mock-vmspec-01",
+		KeyPairIID:        cres.IID{NameId: "key-01"},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cmrt.CreateVNic(connName, cmrt.VNIC, cres.VNicInfo{
+		IId:               cres.IID{NameId: "vnic-01"},
+		VpcIID:            cres.IID{NameId: "vpc-01"},
+		SubnetIID:         cres.IID{NameId: "subnet-01"},
+		SecurityGroupIIDs: []cres.IID{{NameId: "sg-01"}},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cmrt.AttachVNic(connName, "vnic-01", "vm-01"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cmrt.CreatePublicIP(connName, cmrt.PUBLICIP, cres.PublicIPInfo{IId: cres.IID{NameId: "publicip-01"}}, "ON"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cmrt.AttachPublicIP(connName, "publicip-01", "vm-01"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the VPC, Subnet and SecurityGroup used by the VNic can not be deleted
+	if _, err := cmrt.DeleteVPC(connName, cmrt.VPC, "vpc-01", "false"); err == nil {
+		t.Error("DeleteVPC() with a VNic should fail")
+	}
+	if _, err := cmrt.RemoveSubnet(connName, "vpc-01", "subnet-01", "false"); err == nil {
+		t.Error("RemoveSubnet() with a VNic should fail")
+	}
+	if _, err := cmrt.DeleteSecurity(connName, cmrt.SG, "sg-01", "false"); err == nil {
+		t.Error("DeleteSecurity() with a VNic should fail")
+	}
+
+	// Destroy detaches the VNic and the PublicIP from the VM and deletes them before the SecurityGroup and the VPC
+	destroyedInfo, err := cmrt.Destroy(connName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !destroyedInfo.IsAllDestroyed {
+		for _, deletedList := range destroyedInfo.DestroyedList {
+			for _, remained := range deletedList.RemainedErrorInfoList {
+				t.Errorf("%s %s remains: %s", deletedList.ResourceType, remained.Name, remained.ErrorMsg)
+			}
+		}
+		t.Fatal("Destroy() should delete all resources")
+	}
+	for _, rsType := range []string{cmrt.VNIC, cmrt.PUBLICIP, cmrt.VM, cmrt.SG, cmrt.VPC} {
+		nameList, err := cmrt.ListResourceName(connName, rsType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(nameList) != 0 {
+			t.Errorf("%s %v remains after Destroy()", rsType, nameList)
+		}
+	}
+}
+
+func TestVNicDependencyWithLookupFailure(t *testing.T) {
+	const mockName = "vnic-lookup-test"
+	connName := setupMockConnection(t, mockName)
+
+	_, err := cmrt.CreateVPC(connName, cmrt.VPC, cres.VPCReqInfo{
+		IId:            cres.IID{NameId: "vpc-01"},
+		IPv4_CIDR:      "10.0.0.0/16",
+		SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.1.0/24"}},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a VNic without a name can not be created
+	_, err = cmrt.CreateVNic(connName, cmrt.VNIC, cres.VNicInfo{
+		IId:       cres.IID{NameId: ""},
+		VpcIID:    cres.IID{NameId: "vpc-01"},
+		SubnetIID: cres.IID{NameId: "subnet-01"},
+	}, "ON")
+	if err == nil {
+		t.Error("CreateVNic() without a name should fail")
+	}
+
+	vNicInfo, err := cmrt.CreateVNic(connName, cmrt.VNIC, cres.VNicInfo{
+		IId:       cres.IID{NameId: "vnic-01"},
+		VpcIID:    cres.IID{NameId: "vpc-01"},
+		SubnetIID: cres.IID{NameId: "subnet-01"},
+	}, "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// delete the VNic only in the CSP, so that the VNic can not be looked up
+	cloudConn, err := (&mockdrv.MockDriver{}).ConnectCloud(idrv.ConnectionInfo{CredentialInfo: idrv.CredentialInfo{MockName: mockName}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vNicHandler, err := cloudConn.CreateVNicHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = vNicHandler.DeleteVNic(cres.IID{NameId: vNicInfo.IId.SystemId, SystemId: vNicInfo.IId.SystemId}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the dependency check fails rather than missing the VNic
+	if _, err := cmrt.RemoveSubnet(connName, "vpc-01", "subnet-01", "false"); err == nil {
+		t.Error("RemoveSubnet() with a VNic that can not be looked up should fail")
+	}
+
+	if _, err := cmrt.DeleteVNic(connName, cmrt.VNIC, "vnic-01", "true"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cmrt.DeleteVPC(connName, cmrt.VPC, "vpc-01", "false"); err != nil {
+		t.Error(err)
+	}
+}
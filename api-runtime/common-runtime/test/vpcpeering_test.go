@@ -0,0 +1,78 @@
+// VPCPeering Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func TestVPCPeeringDependency(t *testing.T) {
+	connName := setupMockConnection(t, "peering-dep-test")
+
+	for i, vpcName := range []string{"vpc-01", "vpc-02"} {
+		_, err := cmrt.CreateVPC(connName, cmrt.VPC, cres.VPCReqInfo{
+			IId:       cres.IID{NameId: vpcName},
+			IPv4_CIDR: []string{"10.0.0.0/16", "10.1.0.0/16"}[i],
+			SubnetInfoList: []cres.SubnetInfo{{IId: cres.IID{NameId: vpcName + "-subnet"},
+				IPv4_CIDR: []string{"10.0.1.0/24", "10.1.1.0/24"}[i]}},
+		}, "ON")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := cmrt.RequestVPCPeering(connName, cmrt.VPCPEERING, cres.VPCPeeringInfo{
+		IId:             cres.IID{NameId: "peering-01"},
+		RequesterVpcIID: cres.IID{NameId: "vpc-01"},
+		AccepterVpcIID:  cres.IID{NameId: "vpc-02"},
+	}, "", "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cmrt.AcceptVPCPeering(connName, "peering-01"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the result has the propagation of both sides
+	info, err := cmrt.PropagateVPCPeeringRoutes(connName, "peering-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.RequesterRoutePropagated || !info.AccepterRoutePropagated {
+		t.Errorf("the routes should be propagated to both VPCs: requester %v, accepter %v",
+			info.RequesterRoutePropagated, info.AccepterRoutePropagated)
+	}
+
+	// both VPCs of the peering can not be deleted
+	for _, vpcName := range []string{"vpc-01", "vpc-02"} {
+		if _, err := cmrt.DeleteVPC(connName, cmrt.VPC, vpcName, "false"); err == nil {
+			t.Errorf("DeleteVPC(%s) with a VPCPeering should fail", vpcName)
+		}
+	}
+
+	destroyedInfo, err := cmrt.Destroy(connName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !destroyedInfo.IsAllDestroyed {
+		t.Fatal("Destroy() should delete the VPCPeering and both VPCs")
+	}
+	for _, rsType := range []string{cmrt.VPCPEERING, cmrt.VPC} {
+		nameList, err := cmrt.ListResourceName(connName, rsType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(nameList) != 0 {
+			t.Errorf("%s %v remains after Destroy()", rsType, nameList)
+		}
+	}
+}
@@ -10,15 +10,18 @@ package restruntime
 
 import (
 	"bytes"
+	"context"
 	"crypto/subtle"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"net/http"
@@ -213,6 +216,7 @@ func getRoutes() []route {
 		//----------SystemStatsInfo Handler
 		{"GET", "/sysstats/system", FetchSystemInfo},
 		{"GET", "/sysstats/usage", FetchResourceUsage},
+		{"GET", "/sysstats/connectioncache", FetchConnectionCacheStats},
 
 		//----------CloudOS
 		{"GET", "/cloudos", ListCloudOS},
@@ -234,18 +238,21 @@ func getRoutes() []route {
 		{"POST", "/credential", RegisterCredential},
 		{"GET", "/credential", ListCredential},
 		{"GET", "/credential/:CredentialName", GetCredential},
+		{"PUT", "/credential/:CredentialName", UpdateCredential},
 		{"DELETE", "/credential/:CredentialName", UnRegisterCredential},
 
 		//----------RegionInfo
 		{"POST", "/region", RegisterRegion},
 		{"GET", "/region", ListRegion},
 		{"GET", "/region/:RegionName", GetRegion},
+		{"PUT", "/region/:RegionName", UpdateRegion},
 		{"DELETE", "/region/:RegionName", UnRegisterRegion},
 
 		//----------ConnectionConfigInfo
 		{"POST", "/connectionconfig", CreateConnectionConfig},
 		{"GET", "/connectionconfig", ListConnectionConfig},
 		{"GET", "/connectionconfig/:ConfigName", GetConnectionConfig},
+		{"PUT", "/connectionconfig/:ConfigName", UpdateConnectionConfig},
 		{"DELETE", "/connectionconfig/:ConfigName", DeleteConnectionConfig},
 		//-- for dashboard
 		{"GET", "/countconnectionconfig", CountAllConnections},
@@ -275,6 +282,7 @@ func getRoutes() []route {
 		//----------VMSpec Handler
 		{"GET", "/vmspec", ListVMSpec},
 		{"GET", "/vmspec/:Name", GetVMSpec},
+		{"POST", "/vmspec/recommend", RecommendVMSpec},
 		{"GET", "/vmorgspec", ListOrgVMSpec},
 		{"GET", "/vmorgspec/:Name", GetOrgVMSpec},
 
@@ -317,6 +325,7 @@ func getRoutes() []route {
 		//-- for rule
 		{"POST", "/securitygroup/:SGName/rules", AddRules},
 		{"DELETE", "/securitygroup/:SGName/rules", RemoveRules}, // no force option
+		{"PUT", "/securitygroup/:SGName/rules", SyncRules},
 		// no CSP Option, {"DELETE", "/securitygroup/:SGName/csprules", RemoveCSPRules},
 		//-- for management
 		{"GET", "/allsecuritygroup", ListAllSecurity},
@@ -341,19 +350,51 @@ func getRoutes() []route {
 		//-- for dashboard
 		{"GET", "/countkeypair", CountAllKeys},
 		{"GET", "/countkeypair/:ConnectionName", CountKeysByConnection},
-		/*
-			//----------VNic Handler
-			{"POST", "/vnic", createVNic},
-			{"GET", "/vnic", listVNic},
-			{"GET", "/vnic/:VNicId", getVNic},
-			{"DELETE", "/vnic/:VNicId", deleteVNic},
-
-			//----------PublicIP Handler
-			{"POST", "/publicip", createPublicIP},
-			{"GET", "/publicip", listPublicIP},
-			{"GET", "/publicip/:PublicIPId", getPublicIP},
-			{"DELETE", "/publicip/:PublicIPId", deletePublicIP},
-		*/
+		//----------VNic Handler
+		{"POST", "/vnic", CreateVNic},
+		{"GET", "/vnic", ListVNic},
+		{"GET", "/vnic/:Name", GetVNic},
+		{"DELETE", "/vnic/:Name", DeleteVNic},
+		//-- for attachment
+		{"PUT", "/vnic/:Name/attach", AttachVNic},
+		{"PUT", "/vnic/:Name/detach", DetachVNic},
+
+		//----------PublicIP Handler
+		{"POST", "/publicip", CreatePublicIP},
+		{"GET", "/publicip", ListPublicIP},
+		{"GET", "/publicip/:Name", GetPublicIP},
+		{"DELETE", "/publicip/:Name", DeletePublicIP},
+		//-- for attachment
+		{"PUT", "/publicip/:Name/attach", AttachPublicIP},
+		{"PUT", "/publicip/:Name/detach", DetachPublicIP},
+
+		//----------VPCPeering Handler
+		{"POST", "/vpcpeering", RequestVPCPeering},
+		{"GET", "/vpcpeering", ListVPCPeering},
+		{"GET", "/vpcpeering/:Name", GetVPCPeering},
+		{"DELETE", "/vpcpeering/:Name", DeleteVPCPeering},
+		{"PUT", "/vpcpeering/:Name/accept", AcceptVPCPeering},
+		//-- for route propagation
+		{"PUT", "/vpcpeering/:Name/routes", PropagateVPCPeeringRoutes},
+
+		//----------RouteTable Handler
+		{"POST", "/vpc/:VPCName/routetable", CreateRouteTable},
+		{"GET", "/vpc/:VPCName/routetable", ListRouteTable},
+		{"GET", "/vpc/:VPCName/routetable/:Name", GetRouteTable},
+		{"DELETE", "/vpc/:VPCName/routetable/:Name", DeleteRouteTable},
+		//-- for routes
+		{"POST", "/vpc/:VPCName/routetable/:Name/routes", AddRoutes},
+		{"DELETE", "/vpc/:VPCName/routetable/:Name/routes", RemoveRoutes},
+		//-- for subnet association
+		{"PUT", "/vpc/:VPCName/routetable/:Name/subnet/:SubnetName", AssociateRouteTableSubnet},
+		{"DELETE", "/vpc/:VPCName/routetable/:Name/subnet/:SubnetName", DisassociateRouteTableSubnet},
+
+		//----------NATGateway Handler
+		{"POST", "/vpc/:VPCName/natgateway", CreateNATGateway},
+		{"GET", "/vpc/:VPCName/natgateway", ListNATGateway},
+		{"GET", "/vpc/:VPCName/natgateway/:Name", GetNATGateway},
+		{"DELETE", "/vpc/:VPCName/natgateway/:Name", DeleteNATGateway},
+
 		//----------VM Handler
 		{"GET", "/getvmusingresources", GetVMUsingRS},
 		{"POST", "/getvmusingresources", GetVMUsingRS},
@@ -364,6 +405,7 @@ func getRoutes() []route {
 		{"GET", "/vm", ListVM},
 		{"GET", "/vm/:Name", GetVM},
 		{"DELETE", "/vm/:Name", TerminateVM},
+		{"PUT", "/vm/:Name/spec", ChangeVMSpec},
 
 		{"GET", "/vmstatus", ListVMStatus},
 		{"GET", "/vmstatus/:Name", GetVMStatus},
@@ -393,6 +435,8 @@ func getRoutes() []route {
 		//-- for vm
 		{"POST", "/nlb/:Name/vms", AddNLBVMs},
 		{"DELETE", "/nlb/:Name/vms", RemoveNLBVMs}, // no force option
+		{"POST", "/nlb/:Name/listeners", AddNLBListener},
+		{"DELETE", "/nlb/:Name/listeners", RemoveNLBListener},
 		{"PUT", "/nlb/:Name/listener", ChangeListener},
 		{"PUT", "/nlb/:Name/vmgroup", ChangeVMGroup},
 		{"PUT", "/nlb/:Name/healthchecker", ChangeHealthChecker},
@@ -414,6 +458,7 @@ func getRoutes() []route {
 		{"GET", "/disk", ListDisk},
 		{"GET", "/disk/:Name", GetDisk},
 		{"PUT", "/disk/:Name/size", IncreaseDiskSize},
+		{"PUT", "/disk/:Name/type", ChangeDiskType},
 		{"DELETE", "/disk/:Name", DeleteDisk},
 		//-- for vm
 		{"PUT", "/disk/:Name/attach", AttachDisk},
@@ -444,6 +489,18 @@ func getRoutes() []route {
 		{"GET", "/countmyimage", CountAllMyImages},
 		{"GET", "/countmyimage/:ConnectionName", CountMyImagesByConnection},
 
+		//----------DiskSnapshot Handler
+		{"POST", "/disksnapshot", CreateDiskSnapshot},
+		{"GET", "/disksnapshot", ListDiskSnapshot},
+		{"GET", "/disksnapshot/:Name", GetDiskSnapshot},
+		{"DELETE", "/disksnapshot/:Name", DeleteDiskSnapshot},
+		//-- for restore
+		{"POST", "/disksnapshot/:Name/disk", CreateDiskFromSnapshot},
+
+		//-- for management
+		{"GET", "/alldisksnapshot", ListAllDiskSnapshot},
+		{"DELETE", "/cspdisksnapshot/:Id", DeleteCSPDiskSnapshot},
+
 		//----------Cluster Handler
 		{"GET", "/getclusterowner", GetClusterOwnerVPC},
 		{"POST", "/getclusterowner", GetClusterOwnerVPC},
@@ -493,6 +550,11 @@ func getRoutes() []route {
 		//----------Destory All Resources in a Connection
 		{"DELETE", "/destroy", Destroy},
 
+		//----------Job Handler for async=true requests
+		{"GET", "/job", ListJob},
+		{"GET", "/job/:JobId", GetJob},
+		{"DELETE", "/job/:JobId", CancelJob},
+
 		//----------checking TCP and UDP ports for NLB
 		{"GET", "/check/tcp", CheckTCPPort},
 		{"GET", "/check/udp", CheckUDPPort},
@@ -632,6 +694,44 @@ func getRoutes() []route {
 	return routes
 }
 
+// running servers, ShutdownServers() stops them
+var (
+	runningServers     []*http.Server
+	serversShutdown    bool
+	runningServersLock sync.Mutex
+)
+
+// addRunningServer closes the server if ShutdownServers() is already called,
+// then the server returns http.ErrServerClosed at its start.
+func addRunningServer(server *http.Server) {
+	runningServersLock.Lock()
+	defer runningServersLock.Unlock()
+	if serversShutdown {
+		server.Close()
+		return
+	}
+	runningServers = append(runningServers, server)
+}
+
+// ShutdownServers stops the REST and TLS servers gracefully,
+// it waits for the running requests until ctx is done.
+// RunServer() and RunTLSServer() return after this.
+func ShutdownServers(ctx context.Context) error {
+	runningServersLock.Lock()
+	servers := runningServers
+	runningServers = nil
+	serversShutdown = true
+	runningServersLock.Unlock()
+
+	var errList []error
+	for _, server := range servers {
+		if err := server.Shutdown(ctx); err != nil {
+			errList = append(errList, err)
+		}
+	}
+	return errors.Join(errList...)
+}
+
 func RunServer() {
 	//======================================= setup routes
 	routes := getRoutes()
@@ -682,8 +782,9 @@ func RunTLSServer(certFile, keyFile, caCertFile string, port int) {
 	fmt.Printf("[CB-Spider] TLS server running... https://%s\n", address)
 
 	// Start TLS server
+	addRunningServer(server)
 	err = server.ListenAndServeTLS(certFile, keyFile)
-	if err != nil {
+	if err != nil && err != http.ErrServerClosed {
 		fmt.Printf("[CB-Spider] Failed to start TLS server: %v\n", err)
 	}
 }
@@ -821,7 +922,8 @@ func ApiServer(routes []route) {
 		ErrorLog:       log.New(os.Stderr, "HTTP SERVER ERROR: ", log.LstdFlags),
 	}
 
-	if err := e.StartServer(server); err != nil {
+	addRunningServer(server)
+	if err := e.StartServer(server); err != nil && err != http.ErrServerClosed {
 		cblog.Fatalf("Failed to start the server: %v", err)
 	}
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -342,6 +343,39 @@ func GetCredential(c echo.Context) error {
 	return c.JSON(http.StatusOK, &crdinfo)
 }
 
+// updateCredential godoc
+// @ID update-credential
+// @Summary Update Credential
+// @Description Update the KeyValueInfoList of a specific Credential. <br> The ProviderName can not be changed. <br> The Connections using this Credential reconnect with the new values.
+// @Tags [Cloud Info Management] Credential Info
+// @Accept  json
+// @Produce  json
+// @Param CredentialName path string true "The name of the Credential"
+// @Param CredentialInfo body cim.CredentialInfo true "Request body for updating a Credential"
+// @Success 200 {object} cim.CredentialInfo "Details of the updated Credential"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /credential/{CredentialName} [put]
+func UpdateCredential(c echo.Context) error {
+	cblog.Info("call UpdateCredential()")
+
+	req := &cim.CredentialInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := setNameFromPath(&req.CredentialName, c.Param("CredentialName")); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	crdinfo, err := cim.UpdateCredentialInfo(*req)
+	if err != nil {
+		return echo.NewHTTPError(updateErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &crdinfo)
+}
+
 // unregisterCredential godoc
 // @ID unregister-credential
 // @Summary Unregister Credential
@@ -466,6 +500,39 @@ func GetRegion(c echo.Context) error {
 	return c.JSON(http.StatusOK, &crdinfo)
 }
 
+// updateRegion godoc
+// @ID update-region
+// @Summary Update Region
+// @Description Update the KeyValueInfoList and AvailableZoneList of a specific Region. <br> The ProviderName can not be changed. <br> The Zone in the KeyValueInfoList must be one of the AvailableZoneList. <br> The Connections using this Region reconnect with the new values.
+// @Tags [Cloud Info Management] Region Info
+// @Accept  json
+// @Produce  json
+// @Param RegionName path string true "The name of the Region"
+// @Param RegionInfo body rim.RegionInfo true "Request body for updating a Region"
+// @Success 200 {object} rim.RegionInfo "Details of the updated Region"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /region/{RegionName} [put]
+func UpdateRegion(c echo.Context) error {
+	cblog.Info("call UpdateRegion()")
+
+	req := &rim.RegionInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := setNameFromPath(&req.RegionName, c.Param("RegionName")); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	rgninfo, err := rim.UpdateRegionInfo(*req)
+	if err != nil {
+		return echo.NewHTTPError(updateErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &rgninfo)
+}
+
 // unregisterRegion godoc
 // @ID unregister-region
 // @Summary Unregister Region
@@ -574,6 +641,58 @@ func GetConnectionConfig(c echo.Context) error {
 	return c.JSON(http.StatusOK, &crdinfo)
 }
 
+// updateConnectionConfig godoc
+// @ID update-connection-config
+// @Summary Update Connection Config
+// @Description Update a specific Connection Config, ex) to use another Credential. <br> The Driver, Credential and Region must exist for the ProviderName. <br> The Connection reconnects with the new Config.
+// @Tags [Cloud Info Management] Connection Info
+// @Accept  json
+// @Produce  json
+// @Param ConfigName path string true "The name of the Connection Config"
+// @Param ConnectionConfigInfo body ccim.ConnectionConfigInfo true "Request body for updating a Connection Config"
+// @Success 200 {object} ccim.ConnectionConfigInfo "Details of the updated Connection Config"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /connectionconfig/{ConfigName} [put]
+func UpdateConnectionConfig(c echo.Context) error {
+	cblog.Info("call UpdateConnectionConfig()")
+
+	req := &ccim.ConnectionConfigInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := setNameFromPath(&req.ConfigName, c.Param("ConfigName")); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ccinfo, err := ccim.UpdateConnectionConfigInfo(*req)
+	if err != nil {
+		return echo.NewHTTPError(updateErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &ccinfo)
+}
+
+// updateErrorStatus returns 404 for a not existing Credential, Region or Connection Config, otherwise 500.
+func updateErrorStatus(err error) int {
+	if errors.Is(err, cim.ErrCredentialNotFound) || errors.Is(err, rim.ErrRegionNotFound) ||
+		errors.Is(err, ccim.ErrConnectionConfigNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// setNameFromPath sets the name in a request body to the name in the path.
+// A different name in the body is an error, renaming is not supported.
+func setNameFromPath(bodyName *string, pathName string) error {
+	if *bodyName != "" && *bodyName != pathName {
+		return fmt.Errorf("the name in the body(%s) is different from the name in the path(%s), renaming is not supported", *bodyName, pathName)
+	}
+	*bodyName = pathName
+	return nil
+}
+
 // deleteConnectionConfig godoc
 // @ID delete-connection-config
 // @Summary Delete Connection Config
@@ -9,6 +9,8 @@
 package restruntime
 
 import (
+	"context"
+
 	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
@@ -178,7 +180,9 @@ type ClusterNodeGroupRequest struct {
 // @Accept  json
 // @Produce  json
 // @Param ClusterCreateRequest body restruntime.ClusterCreateRequest true "Request body for creating a Cluster"
+// @Param async query bool false "If true, run as an asynchronous job and return the Job right away"
 // @Success 200 {object} cres.ClusterInfo "Details of the created Cluster"
+// @Success 202 {object} JobResponse "Job started, when async=true"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
@@ -205,8 +209,14 @@ func CreateCluster(c echo.Context) error {
 		TagList:       req.ReqInfo.TagList,
 	}
 
+	if isAsyncRequest(c) {
+		return submitJob(c, "CreateCluster", req.ConnectionName, CLUSTER, req.ReqInfo.Name, func(ctx context.Context) (interface{}, error) {
+			return cmrt.CreateClusterWithContext(ctx, req.ConnectionName, CLUSTER, reqInfo, req.IDTransformMode)
+		})
+	}
+
 	// Call common-runtime API
-	result, err := cmrt.CreateCluster(req.ConnectionName, CLUSTER, reqInfo, req.IDTransformMode)
+	result, err := cmrt.CreateClusterWithContext(c.Request().Context(), req.ConnectionName, CLUSTER, reqInfo, req.IDTransformMode)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
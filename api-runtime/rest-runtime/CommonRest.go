@@ -9,6 +9,7 @@
 package restruntime
 
 import (
+	"context"
 	"net"
 	"strconv"
 	"time"
@@ -38,6 +39,14 @@ const (
 	MYIMAGE   string = string(cres.MYIMAGE)
 	CLUSTER   string = string(cres.CLUSTER)
 	NODEGROUP string = string(cres.NODEGROUP)
+	VNIC      string = string(cres.VNIC)
+	PUBLICIP  string = string(cres.PUBLICIP)
+
+	VPCPEERING string = string(cres.VPCPEERING)
+	ROUTETABLE string = string(cres.ROUTETABLE)
+	NATGATEWAY string = string(cres.NATGATEWAY)
+
+	DISKSNAPSHOT string = string(cres.DISKSNAPSHOT)
 )
 
 //================ Common Request & Response
@@ -173,6 +182,10 @@ func GetCSPResourceInfo(c echo.Context) error {
 		var Result cres.ClusterInfo
 		json.Unmarshal(result, &Result)
 		return c.JSON(http.StatusOK, Result)
+	case DISKSNAPSHOT:
+		var Result cres.DiskSnapshotInfo
+		json.Unmarshal(result, &Result)
+		return c.JSON(http.StatusOK, Result)
 	default:
 		return fmt.Errorf(req.ResourceType + " is not supported Resource!!")
 	}
@@ -189,7 +202,9 @@ func GetCSPResourceInfo(c echo.Context) error {
 // @Accept  json
 // @Produce  json
 // @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting all resources"
+// @Param async query bool false "If true, run as an asynchronous job and return the Job right away"
 // @Success 200 {object} cmrt.DestroyedInfo "Details of the destroyed resources"
+// @Success 202 {object} JobResponse "Job started, when async=true"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to missing parameters"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
 // @Router /destroy [delete]
@@ -202,8 +217,14 @@ func Destroy(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	if isAsyncRequest(c) {
+		return submitJob(c, "Destroy", req.ConnectionName, "", "", func(ctx context.Context) (interface{}, error) {
+			return cmrt.DestroyWithContext(ctx, req.ConnectionName)
+		})
+	}
+
 	// Call common-runtime API
-	result, err := cmrt.Destroy(req.ConnectionName)
+	result, err := cmrt.DestroyWithContext(c.Request().Context(), req.ConnectionName)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
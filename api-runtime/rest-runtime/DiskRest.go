@@ -112,6 +112,9 @@ type DiskCreateRequest struct {
 		DiskType string          `json:"DiskType" validate:"required" example:"gp2"`               // gp2 or default, if not specified, default is used
 		DiskSize string          `json:"DiskSize" validate:"required" example:"100"`               // 100 or default, if not specified, default is used (unit is GB)
 		TagList  []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+
+		IOPS       string `json:"IOPS,omitempty" validate:"omitempty" example:"3000"`      // provisioned IOPS, only for the DiskTypes with provisioned performance
+		Throughput string `json:"Throughput,omitempty" validate:"omitempty" example:"125"` // provisioned throughput, unit is MB/s
 	} `json:"ReqInfo" validate:"required"`
 }
 
@@ -144,10 +147,13 @@ func CreateDisk(c echo.Context) error {
 		DiskType: req.ReqInfo.DiskType,
 		DiskSize: req.ReqInfo.DiskSize,
 		TagList:  req.ReqInfo.TagList,
+
+		IOPS:       req.ReqInfo.IOPS,
+		Throughput: req.ReqInfo.Throughput,
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.CreateDisk(req.ConnectionName, DISK, reqInfo, req.IDTransformMode)
+	result, err := cmrt.CreateDiskWithContext(c.Request().Context(), req.ConnectionName, DISK, reqInfo, req.IDTransformMode)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -334,6 +340,48 @@ func IncreaseDiskSize(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
+// DiskTypeChangeRequest represents the request body for changing the type and the provisioned performance of a Disk.
+type DiskTypeChangeRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		DiskType   string `json:"DiskType" validate:"required" example:"gp3"`              // gp3 or default, if default, the current DiskType is kept
+		IOPS       string `json:"IOPS,omitempty" validate:"omitempty" example:"6000"`      // if not specified, the current value or the CSP default is used
+		Throughput string `json:"Throughput,omitempty" validate:"omitempty" example:"250"` // if not specified, the current value or the CSP default is used (unit is MB/s)
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// changeDiskType godoc
+// @ID change-disk-type
+// @Summary Change Disk Type
+// @Description Change the type and the provisioned performance(IOPS, Throughput) of an existing disk online. <br> The DiskType, IOPS and Throughput are validated with the cloudos_meta.yaml of the CSP.
+// @Tags [Disk Management]
+// @Accept  json
+// @Produce  json
+// @Param DiskTypeChangeRequest body restruntime.DiskTypeChangeRequest true "Request body for changing the Disk type"
+// @Param Name path string true "The name of the Disk to change the type for"
+// @Success 200 {object} cres.DiskInfo "Details of the changed Disk"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /disk/{Name}/type [put]
+func ChangeDiskType(c echo.Context) error {
+	cblog.Info("call ChangeDiskType()")
+
+	var req DiskTypeChangeRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ChangeDiskType(req.ConnectionName, c.Param("Name"), req.ReqInfo.DiskType, req.ReqInfo.IOPS, req.ReqInfo.Throughput)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // deleteDisk godoc
 // @ID delete-disk
 // @Summary Delete Disk
@@ -439,7 +487,7 @@ func AttachDisk(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.AttachDisk(req.ConnectionName, c.Param("Name"), req.ReqInfo.VMName)
+	result, err := cmrt.AttachDiskWithContext(c.Request().Context(), req.ConnectionName, c.Param("Name"), req.ReqInfo.VMName)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -479,7 +527,7 @@ func DetachDisk(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.DetachDisk(req.ConnectionName, c.Param("Name"), req.ReqInfo.VMName)
+	result, err := cmrt.DetachDiskWithContext(c.Request().Context(), req.ConnectionName, c.Param("Name"), req.ReqInfo.VMName)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
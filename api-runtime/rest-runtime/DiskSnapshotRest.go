@@ -0,0 +1,317 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	// REST API (echo)
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"strconv"
+)
+
+//================ DiskSnapshot Handler
+
+// DiskSnapshotCreateRequest represents the request body for creating a DiskSnapshot.
+type DiskSnapshotCreateRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
+	ReqInfo         struct {
+		Name           string          `json:"Name" validate:"required" example:"disk-01-snapshot"`
+		SourceDiskName string          `json:"SourceDiskName" validate:"required" example:"disk-01"`
+		TagList        []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// createDiskSnapshot godoc
+// @ID create-disksnapshot
+// @Summary Create DiskSnapshot
+// @Description Create a new DiskSnapshot of a Disk. <br> The snapshot is taken in the Zone of the source Disk.
+// @Tags [DiskSnapshot Management]
+// @Accept  json
+// @Produce  json
+// @Param DiskSnapshotCreateRequest body restruntime.DiskSnapshotCreateRequest true "Request body for creating a DiskSnapshot"
+// @Success 200 {object} cres.DiskSnapshotInfo "Details of the created DiskSnapshot"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /disksnapshot [post]
+func CreateDiskSnapshot(c echo.Context) error {
+	cblog.Info("call CreateDiskSnapshot()")
+
+	req := DiskSnapshotCreateRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.DiskSnapshotInfo{
+		IId:        cres.IID{NameId: req.ReqInfo.Name, SystemId: ""},
+		SourceDisk: cres.IID{NameId: req.ReqInfo.SourceDiskName, SystemId: ""},
+		TagList:    req.ReqInfo.TagList,
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateDiskSnapshot(req.ConnectionName, DISKSNAPSHOT, reqInfo, req.IDTransformMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// DiskSnapshotListResponse represents the response body for listing DiskSnapshots.
+type DiskSnapshotListResponse struct {
+	Result []*cres.DiskSnapshotInfo `json:"disksnapshot" validate:"required" description:"A list of DiskSnapshot information"`
+}
+
+// listDiskSnapshot godoc
+// @ID list-disksnapshot
+// @Summary List DiskSnapshots
+// @Description Retrieve a list of DiskSnapshots associated with a specific connection.
+// @Tags [DiskSnapshot Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string true "The name of the Connection to list DiskSnapshots for"
+// @Success 200 {object} DiskSnapshotListResponse "List of DiskSnapshots"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /disksnapshot [get]
+func ListDiskSnapshot(c echo.Context) error {
+	cblog.Info("call ListDiskSnapshot()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListDiskSnapshot(req.ConnectionName, DISKSNAPSHOT)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	jsonResult := DiskSnapshotListResponse{
+		Result: result,
+	}
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// getDiskSnapshot godoc
+// @ID get-disksnapshot
+// @Summary Get DiskSnapshot
+// @Description Retrieve details of a specific DiskSnapshot.
+// @Tags [DiskSnapshot Management]
+// @Accept  json
+// @Produce  json
+// @Param Name path string true "The name of the DiskSnapshot to retrieve"
+// @Param ConnectionName query string true "The name of the Connection to get a DiskSnapshot for"
+// @Success 200 {object} cres.DiskSnapshotInfo "Details of the DiskSnapshot"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /disksnapshot/{Name} [get]
+func GetDiskSnapshot(c echo.Context) error {
+	cblog.Info("call GetDiskSnapshot()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetDiskSnapshot(req.ConnectionName, DISKSNAPSHOT, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// deleteDiskSnapshot godoc
+// @ID delete-disksnapshot
+// @Summary Delete DiskSnapshot
+// @Description Delete a specified DiskSnapshot. The Disks created from the DiskSnapshot are not deleted.
+// @Tags [DiskSnapshot Management]
+// @Accept  json
+// @Produce  json
+// @Param Name path string true "The name of the DiskSnapshot to delete"
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting a DiskSnapshot"
+// @Param force query string false "Force delete the DiskSnapshot. ex) true or false(default: false)"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /disksnapshot/{Name} [delete]
+func DeleteDiskSnapshot(c echo.Context) error {
+	cblog.Info("call DeleteDiskSnapshot()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteDiskSnapshot(req.ConnectionName, DISKSNAPSHOT, c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// listAllDiskSnapshot godoc
+// @ID list-all-disksnapshot
+// @Summary List All DiskSnapshots in a Connection
+// @Description Retrieve a comprehensive list of all DiskSnapshots associated with a specific connection, <br> including those mapped between CB-Spider and the CSP, <br> only registered in CB-Spider's metadata, <br> and only existing in the CSP.
+// @Tags [DiskSnapshot Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string true "The name of the Connection to list DiskSnapshots for"
+// @Success 200 {object} AllResourceListResponse "List of all DiskSnapshots within the specified connection, including DiskSnapshots in CB-Spider only, CSP only, and mapped between both."
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /alldisksnapshot [get]
+func ListAllDiskSnapshot(c echo.Context) error {
+	cblog.Info("call ListAllDiskSnapshot()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, DISKSNAPSHOT)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &allResourceList)
+}
+
+// deleteCSPDiskSnapshot godoc
+// @ID delete-csp-disksnapshot
+// @Summary Delete CSP DiskSnapshot
+// @Description Delete a specified CSP DiskSnapshot.
+// @Tags [DiskSnapshot Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting a CSP DiskSnapshot"
+// @Param Id path string true "The CSP DiskSnapshot ID to delete"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /cspdisksnapshot/{Id} [delete]
+func DeleteCSPDiskSnapshot(c echo.Context) error {
+	cblog.Info("call DeleteCSPDiskSnapshot()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, DISKSNAPSHOT, c.Param("Id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// DiskFromSnapshotCreateRequest represents the request body for creating a Disk from a DiskSnapshot.
+type DiskFromSnapshotCreateRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
+	ReqInfo         struct {
+		Name     string          `json:"Name" validate:"required" example:"disk-02"`
+		Zone     string          `json:"Zone,omitempty" validate:"omitempty" example:"us-east-1c"` // target zone for the disk, if not specified, it will be created in the same zone as the Connection.
+		DiskType string          `json:"DiskType,omitempty" validate:"omitempty" example:"gp2"`    // gp2 or default, if not specified, default is used
+		DiskSize string          `json:"DiskSize,omitempty" validate:"omitempty" example:"200"`    // 200 or default, if not specified, the size of the DiskSnapshot is used (unit is GB)
+		TagList  []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// createDiskFromSnapshot godoc
+// @ID create-disk-from-snapshot
+// @Summary Create Disk from DiskSnapshot
+// @Description Create a new Disk from a DiskSnapshot in any Zone of the Region. <br> The DiskSize cannot be smaller than the size of the DiskSnapshot.
+// @Tags [DiskSnapshot Management]
+// @Accept  json
+// @Produce  json
+// @Param Name path string true "The name of the DiskSnapshot to restore"
+// @Param DiskFromSnapshotCreateRequest body restruntime.DiskFromSnapshotCreateRequest true "Request body for creating a Disk from a DiskSnapshot"
+// @Success 200 {object} cres.DiskInfo "Details of the created Disk"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /disksnapshot/{Name}/disk [post]
+func CreateDiskFromSnapshot(c echo.Context) error {
+	cblog.Info("call CreateDiskFromSnapshot()")
+
+	req := DiskFromSnapshotCreateRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.DiskInfo{
+		IId:      cres.IID{NameId: req.ReqInfo.Name, SystemId: ""},
+		Zone:     req.ReqInfo.Zone,
+		DiskType: req.ReqInfo.DiskType,
+		DiskSize: req.ReqInfo.DiskSize,
+		TagList:  req.ReqInfo.TagList,
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateDiskFromSnapshot(req.ConnectionName, c.Param("Name"), reqInfo, req.IDTransformMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,150 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+//================ Job Handler
+
+// JobResponse represents the state of an asynchronous job.
+type JobResponse struct {
+	cmrt.JobInfo
+	Result json.RawMessage `json:"Result,omitempty" swaggertype:"object"` // result of the operation, ex) VMInfo for StartVM
+}
+
+// JobListResponse represents the response body structure for listing jobs.
+type JobListResponse struct {
+	Jobs []*JobResponse `json:"job" validate:"required"`
+}
+
+func toJobResponse(job cmrt.JobInfo) *JobResponse {
+	res := &JobResponse{JobInfo: job}
+	if job.Result != "" {
+		res.Result = json.RawMessage(job.Result)
+	}
+	return res
+}
+
+// isAsyncRequest returns true if the request has the query parameter async=true.
+func isAsyncRequest(c echo.Context) bool {
+	async, err := strconv.ParseBool(c.QueryParam("async"))
+	if err != nil {
+		return false
+	}
+	return async
+}
+
+// jobErrorStatus returns 404 for an unknown JobId, otherwise 500.
+func jobErrorStatus(err error) int {
+	if errors.Is(err, cmrt.ErrJobNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// submitJob runs fn as an asynchronous job and returns the registered job with 202 Accepted.
+func submitJob(c echo.Context, operation string, connectionName string, rsType string, rsName string, fn cmrt.JobFunc) error {
+	job, err := cmrt.SubmitJob(operation, connectionName, rsType, rsName, fn)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusAccepted, toJobResponse(job))
+}
+
+// getJob godoc
+// @ID get-job
+// @Summary Get Job
+// @Description Retrieve the state of an asynchronous job. <br> Progress is a coarse state: 0(Pending), 10(Running), 100(finished). <br> Jobs are created by calling StartVM, CreateCluster, CreateNLB or Destroy with the query parameter async=true.
+// @Tags [Job Management]
+// @Accept  json
+// @Produce  json
+// @Param JobId path string true "The ID of the Job to retrieve"
+// @Success 200 {object} JobResponse "State of the Job"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid path parameter"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /job/{JobId} [get]
+func GetJob(c echo.Context) error {
+	cblog.Info("call GetJob()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetJob(c.Param("JobId"))
+	if err != nil {
+		return echo.NewHTTPError(jobErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, toJobResponse(result))
+}
+
+// listJob godoc
+// @ID list-job
+// @Summary List Jobs
+// @Description Retrieve a list of asynchronous jobs. <br> If ConnectionName is given, only the jobs of the connection are listed.
+// @Tags [Job Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string false "The name of the Connection to list Jobs for"
+// @Success 200 {object} JobListResponse "List of Jobs"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /job [get]
+func ListJob(c echo.Context) error {
+	cblog.Info("call ListJob()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListJob(c.QueryParam("ConnectionName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	jsonResult := JobListResponse{
+		Jobs: []*JobResponse{},
+	}
+	for _, job := range result {
+		jsonResult.Jobs = append(jsonResult.Jobs, toJobResponse(*job))
+	}
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// cancelJob godoc
+// @ID cancel-job
+// @Summary Cancel Job
+// @Description Cancel an asynchronous job. <br> A Pending job is canceled right away. <br> A Running job goes to Canceling and ends as Canceled when its operation is stopped.
+// @Tags [Job Management]
+// @Accept  json
+// @Produce  json
+// @Param JobId path string true "The ID of the Job to cancel"
+// @Success 200 {object} JobResponse "State of the Job after the cancel request"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid path parameter"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /job/{JobId} [delete]
+func CancelJob(c echo.Context) error {
+	cblog.Info("call CancelJob()")
+
+	// Call common-runtime API
+	result, err := cmrt.CancelJob(c.Param("JobId"))
+	if err != nil {
+		return echo.NewHTTPError(jobErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, toJobResponse(result))
+}
@@ -142,7 +142,7 @@ func SnapshotVM(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.SnapshotVM(req.ConnectionName, MYIMAGE, reqInfo, req.IDTransformMode)
+	result, err := cmrt.SnapshotVMWithContext(c.Request().Context(), req.ConnectionName, MYIMAGE, reqInfo, req.IDTransformMode)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
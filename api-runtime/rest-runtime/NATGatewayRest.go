@@ -0,0 +1,195 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	// REST API (echo)
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"strconv"
+)
+
+//================ NATGateway Handler
+
+// NATGatewayCreateRequest represents the request body for creating a NATGateway.
+type NATGatewayCreateRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
+	ReqInfo         struct {
+		Name       string          `json:"Name" validate:"required" example:"nat-01"`
+		SubnetName string          `json:"SubnetName" validate:"required" example:"public-subnet-01"`
+		TagList    []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// createNATGateway godoc
+// @ID create-natgateway
+// @Summary Create NATGateway
+// @Description Create a new NATGateway in a public Subnet of a VPC. <br> Private Subnets get outbound access with a route to it.
+// @Tags [NATGateway Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param NATGatewayCreateRequest body restruntime.NATGatewayCreateRequest true "Request body for creating a NATGateway"
+// @Success 200 {object} cres.NATGatewayInfo "Details of the created NATGateway"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/natgateway [post]
+func CreateNATGateway(c echo.Context) error {
+	cblog.Info("call CreateNATGateway()")
+
+	req := NATGatewayCreateRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.NATGatewayInfo{
+		IId:       cres.IID{NameId: req.ReqInfo.Name, SystemId: ""},
+		SubnetIID: cres.IID{NameId: req.ReqInfo.SubnetName, SystemId: ""},
+		TagList:   req.ReqInfo.TagList,
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateNATGateway(req.ConnectionName, NATGATEWAY, c.Param("VPCName"), reqInfo, req.IDTransformMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// NATGatewayListResponse represents the response body for listing NATGateways.
+type NATGatewayListResponse struct {
+	Result []*cres.NATGatewayInfo `json:"natgateway" validate:"required" description:"A list of NATGateway information"`
+}
+
+// listNATGateway godoc
+// @ID list-natgateway
+// @Summary List NATGateways
+// @Description Retrieve a list of NATGateways in a VPC.
+// @Tags [NATGateway Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param ConnectionName query string true "The name of the Connection to list NATGateways for"
+// @Success 200 {object} NATGatewayListResponse "List of NATGateways"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/natgateway [get]
+func ListNATGateway(c echo.Context) error {
+	cblog.Info("call ListNATGateway()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListNATGateway(req.ConnectionName, NATGATEWAY, c.Param("VPCName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	jsonResult := NATGatewayListResponse{
+		Result: result,
+	}
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// getNATGateway godoc
+// @ID get-natgateway
+// @Summary Get NATGateway
+// @Description Retrieve details of a specific NATGateway.
+// @Tags [NATGateway Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param Name path string true "The name of the NATGateway to retrieve"
+// @Param ConnectionName query string true "The name of the Connection to get a NATGateway for"
+// @Success 200 {object} cres.NATGatewayInfo "Details of the NATGateway"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/natgateway/{Name} [get]
+func GetNATGateway(c echo.Context) error {
+	cblog.Info("call GetNATGateway()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetNATGateway(req.ConnectionName, NATGATEWAY, c.Param("VPCName"), c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// deleteNATGateway godoc
+// @ID delete-natgateway
+// @Summary Delete NATGateway
+// @Description Delete a specified NATGateway. The routes to the NATGateway should be removed before.
+// @Tags [NATGateway Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param Name path string true "The name of the NATGateway to delete"
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting a NATGateway"
+// @Param force query string false "Force delete the NATGateway. ex) true or false(default: false)"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/natgateway/{Name} [delete]
+func DeleteNATGateway(c echo.Context) error {
+	cblog.Info("call DeleteNATGateway()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteNATGateway(req.ConnectionName, NATGATEWAY, c.Param("VPCName"), c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
@@ -9,6 +9,8 @@
 package restruntime
 
 import (
+	"context"
+
 	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
@@ -147,17 +149,41 @@ type NLBCreateRequest struct {
 	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
 	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
 	ReqInfo         struct {
-		Name          string                   `json:"Name" validate:"required" example:"nlb-01"`
-		VPCName       string                   `json:"VPCName" validate:"required" example:"vpc-01"`
-		Type          string                   `json:"Type" validate:"required" example:"PUBLIC"`  // PUBLIC(V) | INTERNAL
-		Scope         string                   `json:"Scope" validate:"required" example:"REGION"` // REGION(V) | GLOBAL
-		Listener      NLBListenerCreateRequest `json:"Listener" validate:"required"`
-		VMGroup       NLBVMGroupRequest        `json:"VMGroup" validate:"required"`
-		HealthChecker NLBHealthCheckerRequest  `json:"HealthChecker" validate:"required"`
-		TagList       []cres.KeyValue          `json:"TagList,omitempty" validate:"omitempty"`
+		Name          string                      `json:"Name" validate:"required" example:"nlb-01"`
+		VPCName       string                      `json:"VPCName" validate:"required" example:"vpc-01"`
+		Type          string                      `json:"Type" validate:"required" example:"PUBLIC"`  // PUBLIC(V) | INTERNAL
+		Scope         string                      `json:"Scope" validate:"required" example:"REGION"` // REGION(V) | GLOBAL
+		Listener      NLBListenerCreateRequest    `json:"Listener" validate:"omitempty"`              // shorthand of a single listener, not used with ListenerList
+		VMGroup       NLBVMGroupRequest           `json:"VMGroup" validate:"required"`                // VMs of all listeners, Protocol and Port are not used with ListenerList
+		HealthChecker NLBHealthCheckerRequest     `json:"HealthChecker" validate:"required"`
+		ListenerList  []NLBListenerBindingRequest `json:"ListenerList,omitempty" validate:"omitempty"`
+		TagList       []cres.KeyValue             `json:"TagList,omitempty" validate:"omitempty"`
+		// options of the NLB, ex) {"Key": "VMSpecName", "Value": "t3.small"}: the proxy VM's spec of the emulated NLB,
+		// default: nlbproxyvmspec in cloudos_meta.yaml
+		KeyValueList []cres.KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
 	} `json:"ReqInfo" validate:"required"`
 }
 
+// NLBListenerBindingRequest represents the request body for a listener bound to the backend port of the VM group.
+type NLBListenerBindingRequest struct {
+	Listener NLBListenerCreateRequest `json:"Listener" validate:"required"`
+	VMGroup  NLBBackendRequest        `json:"VMGroup" validate:"required"`
+}
+
+// NLBBackendRequest represents the request body for the backend Protocol and Port of a listener.
+type NLBBackendRequest struct {
+	Protocol string `json:"Protocol" validate:"required" example:"TCP"` // TCP|UDP
+	Port     string `json:"Port" validate:"required" example:"8443"`    // 1-65535
+}
+
+// convertListenerBindingInfo converts an NLBListenerBindingRequest to ListenerBindingInfo.
+func convertListenerBindingInfo(bindingReq NLBListenerBindingRequest) cres.ListenerBindingInfo {
+	return cres.ListenerBindingInfo{
+		Listener: convertListenerInfo(bindingReq.Listener),
+		VMGroup:  cres.VMGroupInfo{Protocol: bindingReq.VMGroup.Protocol, Port: bindingReq.VMGroup.Port},
+	}
+}
+
 // NLBListenerCreateRequest represents the request body for the listener configuration in an NLB.
 type NLBListenerCreateRequest struct {
 	Protocol string `json:"Protocol" validate:"required" example:"TCP"` // TCP|UDP
@@ -168,11 +194,14 @@ type NLBListenerCreateRequest struct {
 // @ID create-nlb
 // @Summary Create NLB
 // @Description Create a new Network Load Balancer (NLB) with specified configurations. 🕷️ [[Concept Guide](https://github.com/cloud-barista/cb-spider/wiki/Network-Load-Balancer-and-Driver-API)]
+// @Description For the emulated NLB, the KeyValue 'VMSpecName' sets the spec of the proxy VM, default: nlbproxyvmspec of the provider in cloudos_meta.yaml.
 // @Tags [NLB Management]
 // @Accept  json
 // @Produce  json
 // @Param NLBCreateRequest body restruntime.NLBCreateRequest true "Request body for creating an NLB"
+// @Param async query bool false "If true, run as an asynchronous job and return the Job right away"
 // @Success 200 {object} cres.NLBInfo "Details of the created NLB"
+// @Success 202 {object} JobResponse "Job started, when async=true"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
@@ -195,16 +224,27 @@ func CreateNLB(c echo.Context) error {
 		Listener: convertListenerInfo(req.ReqInfo.Listener),
 		VMGroup:  convertVMGroupInfo(req.ReqInfo.VMGroup),
 		TagList:  req.ReqInfo.TagList,
+
+		KeyValueList: req.ReqInfo.KeyValueList,
 		//HealthChecker: below
 	}
+	for _, bindingReq := range req.ReqInfo.ListenerList {
+		reqInfo.ListenerList = append(reqInfo.ListenerList, convertListenerBindingInfo(bindingReq))
+	}
 	healthChecker, err := convertHealthCheckerInfo(req.ReqInfo.HealthChecker)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 	reqInfo.HealthChecker = healthChecker
 
+	if isAsyncRequest(c) {
+		return submitJob(c, "CreateNLB", req.ConnectionName, NLB, req.ReqInfo.Name, func(ctx context.Context) (interface{}, error) {
+			return cmrt.CreateNLBWithContext(ctx, req.ConnectionName, NLB, reqInfo, req.IDTransformMode)
+		})
+	}
+
 	// Call common-runtime API
-	result, err := cmrt.CreateNLB(req.ConnectionName, NLB, reqInfo, req.IDTransformMode)
+	result, err := cmrt.CreateNLBWithContext(c.Request().Context(), req.ConnectionName, NLB, reqInfo, req.IDTransformMode)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -242,6 +282,10 @@ type NLBHealthCheckerRequest struct {
 	Interval  string `json:"Interval,omitempty" validate:"omitempty" example:"default"`  // secs, if not specified, treated as "default", determined by CSP
 	Timeout   string `json:"Timeout,omitempty" validate:"omitempty" example:"default"`   // secs, if not specified, treated as "default", determined by CSP
 	Threshold string `json:"Threshold,omitempty" validate:"omitempty" example:"default"` // num, if not specified, treated as "default", determined by CSP
+
+	HTTPPath            string `json:"HTTPPath,omitempty" validate:"omitempty" example:"/health"`            // HTTP only, if not specified, determined by CSP
+	ExpectedStatusCodes string `json:"ExpectedStatusCodes,omitempty" validate:"omitempty" example:"200-299"` // HTTP only, if not specified, determined by CSP
+	HostHeader          string `json:"HostHeader,omitempty" validate:"omitempty" example:"www.example.com"`  // HTTP only, if not specified, no Host header
 }
 
 func convertHealthCheckerInfo(hcInfo NLBHealthCheckerRequest) (cres.HealthCheckerInfo, error) {
@@ -287,7 +331,16 @@ func convertHealthCheckerInfo(hcInfo NLBHealthCheckerRequest) (cres.HealthChecke
 		}
 	}
 
-	return cres.HealthCheckerInfo{hcInfo.Protocol, hcInfo.Port, interval, timeout, threshold, "", nil}, nil
+	return cres.HealthCheckerInfo{
+		Protocol:            hcInfo.Protocol,
+		Port:                hcInfo.Port,
+		Interval:            interval,
+		Timeout:             timeout,
+		Threshold:           threshold,
+		HTTPPath:            hcInfo.HTTPPath,
+		ExpectedStatusCodes: hcInfo.ExpectedStatusCodes,
+		HostHeader:          hcInfo.HostHeader,
+	}, nil
 }
 
 // NLBListResponse represents the response body for listing NLBs.
@@ -506,6 +559,86 @@ func RemoveNLBVMs(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
+// NLBAddListenerRequest represents the request body for adding a listener to an NLB.
+type NLBAddListenerRequest struct {
+	ConnectionName string                    `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        NLBListenerBindingRequest `json:"ReqInfo" validate:"required"`
+}
+
+// addNLBListener godoc
+// @ID add-nlb-listener
+// @Summary Add Listener to NLB
+// @Description Add a new Listener bound to a backend port of the VM group to an existing Network Load Balancer (NLB). The VMs of the VM group serve all listeners.
+// @Tags [NLB Management]
+// @Accept  json
+// @Produce  json
+// @Param Name path string true "The name of the NLB to add a Listener to"
+// @Param NLBAddListenerRequest body restruntime.NLBAddListenerRequest true "Request body for adding a Listener to an NLB"
+// @Success 200 {object} cres.NLBInfo "Details of the NLB including the added Listener"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /nlb/{Name}/listeners [post]
+func AddNLBListener(c echo.Context) error {
+	cblog.Info("call AddNLBListener()")
+
+	var req NLBAddListenerRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.AddNLBListener(req.ConnectionName, c.Param("Name"), convertListenerBindingInfo(req.ReqInfo))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// NLBRemoveListenerRequest represents the request body for removing a listener from an NLB.
+type NLBRemoveListenerRequest struct {
+	ConnectionName string                   `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        NLBListenerCreateRequest `json:"ReqInfo" validate:"required"`
+}
+
+// removeNLBListener godoc
+// @ID remove-nlb-listener
+// @Summary Remove Listener from NLB
+// @Description Remove a Listener(Protocol and Port) from an existing Network Load Balancer (NLB). The first Listener, which is the Listener of the NLB, can not be removed.
+// @Tags [NLB Management]
+// @Accept  json
+// @Produce  json
+// @Param Name path string true "The name of the NLB to remove a Listener from"
+// @Param NLBRemoveListenerRequest body restruntime.NLBRemoveListenerRequest true "Request body for removing a Listener from an NLB"
+// @Success 200 {object} BooleanInfo "Result of the remove operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /nlb/{Name}/listeners [delete]
+func RemoveNLBListener(c echo.Context) error {
+	cblog.Info("call RemoveNLBListener()")
+
+	var req NLBRemoveListenerRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.RemoveNLBListener(req.ConnectionName, c.Param("Name"), convertListenerInfo(req.ReqInfo))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
 // NLBChangeListenerRequest represents the request body for changing the listener of an NLB.
 type NLBChangeListenerRequest struct {
 	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
@@ -615,6 +748,10 @@ type NLBChangeHealthCheckerRequest struct {
 		Interval  string `json:"Interval" validate:"required" example:"30"`
 		Timeout   string `json:"Timeout" validate:"required" example:"5"`
 		Threshold string `json:"Threshold" validate:"required" example:"3"`
+
+		HTTPPath            string `json:"HTTPPath,omitempty" validate:"omitempty" example:"/health"`
+		ExpectedStatusCodes string `json:"ExpectedStatusCodes,omitempty" validate:"omitempty" example:"200-299"`
+		HostHeader          string `json:"HostHeader,omitempty" validate:"omitempty" example:"www.example.com"`
 	} `json:"ReqInfo" validate:"required"`
 }
 
@@ -655,6 +792,10 @@ func ChangeHealthChecker(c echo.Context) error {
 		Interval:  interval,
 		Timeout:   timeout,
 		Threshold: threshold,
+
+		HTTPPath:            req.ReqInfo.HTTPPath,
+		ExpectedStatusCodes: req.ReqInfo.ExpectedStatusCodes,
+		HostHeader:          req.ReqInfo.HostHeader,
 	}
 
 	// Call common-runtime API
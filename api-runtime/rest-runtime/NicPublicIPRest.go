@@ -8,266 +8,512 @@
 
 package restruntime
 
+import (
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
-/****************************
-//================ VNic Handler
-func createVNic(c echo.Context) error {
-	cblog.Info("call createVNic()")
+	// REST API (echo)
+	"net/http"
 
-        var req struct {
-                ConnectionName string
-                ReqInfo cres.VNicReqInfo
-        }
+	"github.com/labstack/echo/v4"
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+	"strconv"
+)
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
-	if err != nil {
+//================ VNic Handler
+
+// VNicCreateRequest represents the request body for creating a VNic.
+type VNicCreateRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
+	ReqInfo         struct {
+		Name               string          `json:"Name" validate:"required" example:"vnic-01"`
+		VPCName            string          `json:"VPCName" validate:"required" example:"vpc-01"`
+		SubnetName         string          `json:"SubnetName" validate:"required" example:"subnet-01"`
+		SecurityGroupNames []string        `json:"SecurityGroupNames,omitempty" validate:"omitempty" example:"sg-01"`
+		PrivateIP          string          `json:"PrivateIP,omitempty" validate:"omitempty" example:"10.0.1.10"` // empty: assigned by the CSP
+		TagList            []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// createVNic godoc
+// @ID create-vnic
+// @Summary Create VNic
+// @Description Create a new virtual network interface(VNic) in a Subnet.
+// @Tags [VNic Management]
+// @Accept  json
+// @Produce  json
+// @Param VNicCreateRequest body restruntime.VNicCreateRequest true "Request body for creating a VNic"
+// @Success 200 {object} cres.VNicInfo "Details of the created VNic"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vnic [post]
+func CreateVNic(c echo.Context) error {
+	cblog.Info("call CreateVNic()")
+
+	req := VNicCreateRequest{}
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreateVNicHandler()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	// Rest RegInfo => Driver ReqInfo
+	sgIIDList := []cres.IID{}
+	for _, sgName := range req.ReqInfo.SecurityGroupNames {
+		sgIIDList = append(sgIIDList, cres.IID{NameId: sgName, SystemId: ""})
+	}
+	reqInfo := cres.VNicInfo{
+		IId:               cres.IID{NameId: req.ReqInfo.Name, SystemId: ""},
+		VpcIID:            cres.IID{NameId: req.ReqInfo.VPCName, SystemId: ""},
+		SubnetIID:         cres.IID{NameId: req.ReqInfo.SubnetName, SystemId: ""},
+		SecurityGroupIIDs: sgIIDList,
+		PrivateIP:         req.ReqInfo.PrivateIP,
+		TagList:           req.ReqInfo.TagList,
 	}
 
-	info, err := handler.CreateVNic(req.ReqInfo)
+	// Call common-runtime API
+	result, err := cmrt.CreateVNic(req.ConnectionName, VNIC, reqInfo, req.IDTransformMode)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	return c.JSON(http.StatusOK, &info)
+	return c.JSON(http.StatusOK, result)
 }
 
-func listVNic(c echo.Context) error {
-	cblog.Info("call listVNic()")
-
-        var req struct {
-                ConnectionName string
-        }
-
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+// VNicListResponse represents the response body for listing VNics.
+type VNicListResponse struct {
+	Result []*cres.VNicInfo `json:"vnic" validate:"required" description:"A list of VNic information"`
+}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
-	if err != nil {
+// listVNic godoc
+// @ID list-vnic
+// @Summary List VNics
+// @Description Retrieve a list of VNics associated with a specific connection.
+// @Tags [VNic Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string true "The name of the Connection to list VNics for"
+// @Success 200 {object} VNicListResponse "List of VNics"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vnic [get]
+func ListVNic(c echo.Context) error {
+	cblog.Info("call ListVNic()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreateVNicHandler()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
 	}
 
-	infoList, err := handler.ListVNic()
+	// Call common-runtime API
+	result, err := cmrt.ListVNic(req.ConnectionName, VNIC)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-        var jsonResult struct {
-                Result []*cres.VNicInfo `json:"vnic"`
-        }
-        if infoList == nil {
-                infoList = []*cres.VNicInfo{}
-        }
-        jsonResult.Result = infoList
-        return c.JSON(http.StatusOK, &jsonResult)
-}
+	jsonResult := VNicListResponse{
+		Result: result,
+	}
 
-func getVNic(c echo.Context) error {
-	cblog.Info("call getVNic()")
+	return c.JSON(http.StatusOK, &jsonResult)
+}
 
-        var req struct {
-                ConnectionName string
-        }
+// getVNic godoc
+// @ID get-vnic
+// @Summary Get VNic
+// @Description Retrieve details of a specific VNic.
+// @Tags [VNic Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string true "The name of the Connection to get a VNic for"
+// @Param Name path string true "The name of the VNic to retrieve"
+// @Success 200 {object} cres.VNicInfo "Details of the VNic"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vnic/{Name} [get]
+func GetVNic(c echo.Context) error {
+	cblog.Info("call GetVNic()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
+	// Call common-runtime API
+	result, err := cmrt.GetVNic(req.ConnectionName, VNIC, c.Param("Name"))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreateVNicHandler()
-	if err != nil {
+	return c.JSON(http.StatusOK, result)
+}
+
+// deleteVNic godoc
+// @ID delete-vnic
+// @Summary Delete VNic
+// @Description Delete a specified VNic. An attached VNic must be detached before deletion.
+// @Tags [VNic Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting a VNic"
+// @Param Name path string true "The name of the VNic to delete"
+// @Param force query string false "Force delete the VNic. ex) true or false(default: false)"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vnic/{Name} [delete]
+func DeleteVNic(c echo.Context) error {
+	cblog.Info("call DeleteVNic()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	info, err := handler.GetVNic(c.Param("VNicId"))
+	// Call common-runtime API
+	result, err := cmrt.DeleteVNic(req.ConnectionName, VNIC, c.Param("Name"), c.QueryParam("force"))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	return c.JSON(http.StatusOK, &info)
-}
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
 
-func deleteVNic(c echo.Context) error {
-	cblog.Info("call deleteVNic()")
+	return c.JSON(http.StatusOK, &resultInfo)
+}
 
-        var req struct {
-                ConnectionName string
-        }
+// VNicAttachRequest represents the request body for attaching a VNic to a VM or detaching it.
+type VNicAttachRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		VMName string `json:"VMName" validate:"required" example:"vm-01"`
+	} `json:"ReqInfo" validate:"required"`
+}
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+// attachVNic godoc
+// @ID attach-vnic
+// @Summary Attach VNic
+// @Description Attach an existing VNic to a VM as a secondary network interface.
+// @Tags [VNic Management]
+// @Accept  json
+// @Produce  json
+// @Param VNicAttachRequest body restruntime.VNicAttachRequest true "Request body for attaching a VNic to a VM"
+// @Param Name path string true "The name of the VNic to attach"
+// @Success 200 {object} cres.VNicInfo "Details of the attached VNic"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vnic/{Name}/attach [put]
+func AttachVNic(c echo.Context) error {
+	cblog.Info("call AttachVNic()")
+
+	var req VNicAttachRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
+	// Call common-runtime API
+	result, err := cmrt.AttachVNic(req.ConnectionName, c.Param("Name"), req.ReqInfo.VMName)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreateVNicHandler()
-	if err != nil {
+	return c.JSON(http.StatusOK, result)
+}
+
+// detachVNic godoc
+// @ID detach-vnic
+// @Summary Detach VNic
+// @Description Detach a VNic from a VM.
+// @Tags [VNic Management]
+// @Accept  json
+// @Produce  json
+// @Param VNicAttachRequest body restruntime.VNicAttachRequest true "Request body for detaching a VNic from a VM"
+// @Param Name path string true "The name of the VNic to detach"
+// @Success 200 {object} BooleanInfo "Result of the detach operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vnic/{Name}/detach [put]
+func DetachVNic(c echo.Context) error {
+	cblog.Info("call DetachVNic()")
+
+	var req VNicAttachRequest
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	result, err := handler.DeleteVNic(c.Param("VNicId"))
+	// Call common-runtime API
+	result, err := cmrt.DetachVNic(req.ConnectionName, c.Param("Name"), req.ReqInfo.VMName)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
-
-        resultInfo := BooleanInfo{
-                Result: strconv.FormatBool(result),
-        }
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
 
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
 //================ PublicIP Handler
-func createPublicIP(c echo.Context) error {
-	cblog.Info("call createPublicIP()")
 
-        var req struct {
-                ConnectionName string
-                ReqInfo cres.PublicIPReqInfo
-        }
-
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+// PublicIPCreateRequest represents the request body for creating a PublicIP.
+type PublicIPCreateRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
+	ReqInfo         struct {
+		Name    string          `json:"Name" validate:"required" example:"publicip-01"`
+		TagList []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
-	if err != nil {
+// createPublicIP godoc
+// @ID create-publicip
+// @Summary Create PublicIP
+// @Description Allocate a new static(reserved) PublicIP.
+// @Tags [PublicIP Management]
+// @Accept  json
+// @Produce  json
+// @Param PublicIPCreateRequest body restruntime.PublicIPCreateRequest true "Request body for creating a PublicIP"
+// @Success 200 {object} cres.PublicIPInfo "Details of the created PublicIP"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /publicip [post]
+func CreatePublicIP(c echo.Context) error {
+	cblog.Info("call CreatePublicIP()")
+
+	req := PublicIPCreateRequest{}
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreatePublicIPHandler()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.PublicIPInfo{
+		IId:     cres.IID{NameId: req.ReqInfo.Name, SystemId: ""},
+		TagList: req.ReqInfo.TagList,
 	}
 
-	info, err := handler.CreatePublicIP(req.ReqInfo)
+	// Call common-runtime API
+	result, err := cmrt.CreatePublicIP(req.ConnectionName, PUBLICIP, reqInfo, req.IDTransformMode)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	return c.JSON(http.StatusOK, &info)
+	return c.JSON(http.StatusOK, result)
 }
 
-func listPublicIP(c echo.Context) error {
-	cblog.Info("call listPublicIP()")
-
-        var req struct {
-                ConnectionName string
-        }
-
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+// PublicIPListResponse represents the response body for listing PublicIPs.
+type PublicIPListResponse struct {
+	Result []*cres.PublicIPInfo `json:"publicip" validate:"required" description:"A list of PublicIP information"`
+}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
-	if err != nil {
+// listPublicIP godoc
+// @ID list-publicip
+// @Summary List PublicIPs
+// @Description Retrieve a list of PublicIPs associated with a specific connection.
+// @Tags [PublicIP Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string true "The name of the Connection to list PublicIPs for"
+// @Success 200 {object} PublicIPListResponse "List of PublicIPs"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /publicip [get]
+func ListPublicIP(c echo.Context) error {
+	cblog.Info("call ListPublicIP()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreatePublicIPHandler()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
 	}
 
-	infoList, err := handler.ListPublicIP()
+	// Call common-runtime API
+	result, err := cmrt.ListPublicIP(req.ConnectionName, PUBLICIP)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-        var jsonResult struct {
-                Result []*cres.PublicIPInfo `json:"publicip"`
-        }
-        if infoList == nil {
-                infoList = []*cres.PublicIPInfo{}
-        }
-        jsonResult.Result = infoList
-        return c.JSON(http.StatusOK, &jsonResult)
-}
+	jsonResult := PublicIPListResponse{
+		Result: result,
+	}
 
-func getPublicIP(c echo.Context) error {
-	cblog.Info("call getPublicIP()")
+	return c.JSON(http.StatusOK, &jsonResult)
+}
 
-        var req struct {
-                ConnectionName string
-        }
+// getPublicIP godoc
+// @ID get-publicip
+// @Summary Get PublicIP
+// @Description Retrieve details of a specific PublicIP.
+// @Tags [PublicIP Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string true "The name of the Connection to get a PublicIP for"
+// @Param Name path string true "The name of the PublicIP to retrieve"
+// @Success 200 {object} cres.PublicIPInfo "Details of the PublicIP"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /publicip/{Name} [get]
+func GetPublicIP(c echo.Context) error {
+	cblog.Info("call GetPublicIP()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
+	// Call common-runtime API
+	result, err := cmrt.GetPublicIP(req.ConnectionName, PUBLICIP, c.Param("Name"))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreatePublicIPHandler()
-	if err != nil {
+	return c.JSON(http.StatusOK, result)
+}
+
+// deletePublicIP godoc
+// @ID delete-publicip
+// @Summary Delete PublicIP
+// @Description Release a specified PublicIP. An attached PublicIP must be detached before deletion.
+// @Tags [PublicIP Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting a PublicIP"
+// @Param Name path string true "The name of the PublicIP to delete"
+// @Param force query string false "Force delete the PublicIP. ex) true or false(default: false)"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /publicip/{Name} [delete]
+func DeletePublicIP(c echo.Context) error {
+	cblog.Info("call DeletePublicIP()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	info, err := handler.GetPublicIP(c.Param("PublicIPId"))
+	// Call common-runtime API
+	result, err := cmrt.DeletePublicIP(req.ConnectionName, PUBLICIP, c.Param("Name"), c.QueryParam("force"))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	return c.JSON(http.StatusOK, &info)
-}
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
 
-func deletePublicIP(c echo.Context) error {
-	cblog.Info("call deletePublicIP()")
+	return c.JSON(http.StatusOK, &resultInfo)
+}
 
-        var req struct {
-                ConnectionName string
-        }
+// PublicIPAttachRequest represents the request body for attaching a PublicIP to a VM or detaching it.
+type PublicIPAttachRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		VMName string `json:"VMName" validate:"required" example:"vm-01"`
+	} `json:"ReqInfo" validate:"required"`
+}
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+// attachPublicIP godoc
+// @ID attach-publicip
+// @Summary Attach PublicIP
+// @Description Attach an existing PublicIP to the primary network interface of a VM.
+// @Tags [PublicIP Management]
+// @Accept  json
+// @Produce  json
+// @Param PublicIPAttachRequest body restruntime.PublicIPAttachRequest true "Request body for attaching a PublicIP to a VM"
+// @Param Name path string true "The name of the PublicIP to attach"
+// @Success 200 {object} cres.PublicIPInfo "Details of the attached PublicIP"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /publicip/{Name}/attach [put]
+func AttachPublicIP(c echo.Context) error {
+	cblog.Info("call AttachPublicIP()")
+
+	var req PublicIPAttachRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
+	// Call common-runtime API
+	result, err := cmrt.AttachPublicIP(req.ConnectionName, c.Param("Name"), req.ReqInfo.VMName)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreatePublicIPHandler()
-	if err != nil {
+	return c.JSON(http.StatusOK, result)
+}
+
+// detachPublicIP godoc
+// @ID detach-publicip
+// @Summary Detach PublicIP
+// @Description Detach a PublicIP from a VM.
+// @Tags [PublicIP Management]
+// @Accept  json
+// @Produce  json
+// @Param PublicIPAttachRequest body restruntime.PublicIPAttachRequest true "Request body for detaching a PublicIP from a VM"
+// @Param Name path string true "The name of the PublicIP to detach"
+// @Success 200 {object} BooleanInfo "Result of the detach operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /publicip/{Name}/detach [put]
+func DetachPublicIP(c echo.Context) error {
+	cblog.Info("call DetachPublicIP()")
+
+	var req PublicIPAttachRequest
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	result, err := handler.DeletePublicIP(c.Param("PublicIPId"))
+	// Call common-runtime API
+	result, err := cmrt.DetachPublicIP(req.ConnectionName, c.Param("Name"), req.ReqInfo.VMName)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
-
-        resultInfo := BooleanInfo{
-                Result: strconv.FormatBool(result),
-        }
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
 
 	return c.JSON(http.StatusOK, &resultInfo)
 }
-****************************/
-
-
-
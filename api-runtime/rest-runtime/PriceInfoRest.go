@@ -77,7 +77,7 @@ type PriceInfoResponse struct {
 // getVMPriceInfo godoc
 // @ID get-vmprice-info
 // @Summary Get VM Price Information
-// @Description Retrieve VM Price Information for a specific connection and region. 🕷️ [[User Guide](https://github.com/cloud-barista/cb-spider/wiki/VM-Price-Info-Guide)] <br> * example body: {"connectionName":"aws-connection","FilterList":[{"Key":"instanceType","Value":"t2.micro"}]}
+// @Description Retrieve VM Price Information for a specific connection and region. 🕷️ [[User Guide](https://github.com/cloud-barista/cb-spider/wiki/VM-Price-Info-Guide)] <br> * example body: {"connectionName":"aws-connection","FilterList":[{"Key":"instanceType","Value":"t2.micro"}]} <br> * PricingModel filter: {"Key":"PricingModel","Value":"OnDemand,Reserved,Spot"} selects the pricing details to return, Spot requires the SPOT_PRICE capability
 // @Tags [Cloud Metadata] VM Price Info
 // @Accept  json
 // @Produce  json
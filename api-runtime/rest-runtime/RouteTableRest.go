@@ -0,0 +1,364 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	// REST API (echo)
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"strconv"
+)
+
+//================ RouteTable Handler
+
+// RouteReqInfo represents a route in the requests of a RouteTable.
+type RouteReqInfo struct {
+	DestinationCIDR string `json:"DestinationCIDR" validate:"required" example:"0.0.0.0/0"`
+	TargetType      string `json:"TargetType" validate:"required" example:"NATGateway"`        // InternetGateway, NATGateway, VPCPeering or VNic
+	TargetName      string `json:"TargetName,omitempty" validate:"omitempty" example:"nat-01"` // empty for InternetGateway
+}
+
+func toRouteInfoList(reqList []RouteReqInfo) []cres.RouteInfo {
+	routeList := []cres.RouteInfo{}
+	for _, route := range reqList {
+		routeList = append(routeList, cres.RouteInfo{
+			DestinationCIDR: route.DestinationCIDR,
+			TargetType:      cres.RouteTargetType(route.TargetType),
+			TargetIID:       cres.IID{NameId: route.TargetName, SystemId: ""},
+		})
+	}
+	return routeList
+}
+
+// RouteTableCreateRequest represents the request body for creating a RouteTable.
+type RouteTableCreateRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
+	ReqInfo         struct {
+		Name      string          `json:"Name" validate:"required" example:"rt-01"`
+		RouteList []RouteReqInfo  `json:"RouteList,omitempty" validate:"omitempty"`
+		TagList   []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// createRouteTable godoc
+// @ID create-routetable
+// @Summary Create RouteTable
+// @Description Create a new RouteTable in a VPC. <br> The Local route of the VPC is added by the CSP.
+// @Tags [RouteTable Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param RouteTableCreateRequest body restruntime.RouteTableCreateRequest true "Request body for creating a RouteTable"
+// @Success 200 {object} cres.RouteTableInfo "Details of the created RouteTable"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/routetable [post]
+func CreateRouteTable(c echo.Context) error {
+	cblog.Info("call CreateRouteTable()")
+
+	req := RouteTableCreateRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.RouteTableInfo{
+		IId:       cres.IID{NameId: req.ReqInfo.Name, SystemId: ""},
+		RouteList: toRouteInfoList(req.ReqInfo.RouteList),
+		TagList:   req.ReqInfo.TagList,
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateRouteTable(req.ConnectionName, ROUTETABLE, c.Param("VPCName"), reqInfo, req.IDTransformMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RouteTableListResponse represents the response body for listing RouteTables.
+type RouteTableListResponse struct {
+	Result []*cres.RouteTableInfo `json:"routetable" validate:"required" description:"A list of RouteTable information"`
+}
+
+// listRouteTable godoc
+// @ID list-routetable
+// @Summary List RouteTables
+// @Description Retrieve a list of RouteTables in a VPC.
+// @Tags [RouteTable Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param ConnectionName query string true "The name of the Connection to list RouteTables for"
+// @Success 200 {object} RouteTableListResponse "List of RouteTables"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/routetable [get]
+func ListRouteTable(c echo.Context) error {
+	cblog.Info("call ListRouteTable()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListRouteTable(req.ConnectionName, ROUTETABLE, c.Param("VPCName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	jsonResult := RouteTableListResponse{
+		Result: result,
+	}
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// getRouteTable godoc
+// @ID get-routetable
+// @Summary Get RouteTable
+// @Description Retrieve details of a specific RouteTable.
+// @Tags [RouteTable Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param Name path string true "The name of the RouteTable to retrieve"
+// @Param ConnectionName query string true "The name of the Connection to get a RouteTable for"
+// @Success 200 {object} cres.RouteTableInfo "Details of the RouteTable"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/routetable/{Name} [get]
+func GetRouteTable(c echo.Context) error {
+	cblog.Info("call GetRouteTable()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetRouteTable(req.ConnectionName, ROUTETABLE, c.Param("VPCName"), c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// deleteRouteTable godoc
+// @ID delete-routetable
+// @Summary Delete RouteTable
+// @Description Disassociate the Subnets of a RouteTable and delete it.
+// @Tags [RouteTable Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param Name path string true "The name of the RouteTable to delete"
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting a RouteTable"
+// @Param force query string false "Force delete the RouteTable. ex) true or false(default: false)"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/routetable/{Name} [delete]
+func DeleteRouteTable(c echo.Context) error {
+	cblog.Info("call DeleteRouteTable()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteRouteTable(req.ConnectionName, ROUTETABLE, c.Param("VPCName"), c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// RouteControlRequest represents the request body for controlling routes in a RouteTable.
+type RouteControlRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		RouteList []RouteReqInfo `json:"RouteList" validate:"required"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// addRoutes godoc
+// @ID add-routes
+// @Summary Add Routes
+// @Description Add new routes to a RouteTable.
+// @Tags [RouteTable Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param Name path string true "The name of the RouteTable"
+// @Param RouteControlRequest body restruntime.RouteControlRequest true "Request body for adding routes"
+// @Success 200 {object} cres.RouteTableInfo "Details of the RouteTable after adding routes"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/routetable/{Name}/routes [post]
+func AddRoutes(c echo.Context) error {
+	cblog.Info("call AddRoutes()")
+
+	req := RouteControlRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.AddRoutes(req.ConnectionName, c.Param("VPCName"), c.Param("Name"), toRouteInfoList(req.ReqInfo.RouteList))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// removeRoutes godoc
+// @ID remove-routes
+// @Summary Remove Routes
+// @Description Remove routes from a RouteTable. The routes are matched by the DestinationCIDR.
+// @Tags [RouteTable Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param Name path string true "The name of the RouteTable"
+// @Param RouteControlRequest body restruntime.RouteControlRequest true "Request body for removing routes"
+// @Success 200 {object} BooleanInfo "Result of the remove operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/routetable/{Name}/routes [delete]
+func RemoveRoutes(c echo.Context) error {
+	cblog.Info("call RemoveRoutes()")
+
+	req := RouteControlRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.RemoveRoutes(req.ConnectionName, c.Param("VPCName"), c.Param("Name"), toRouteInfoList(req.ReqInfo.RouteList))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// associateRouteTableSubnet godoc
+// @ID associate-routetable-subnet
+// @Summary Associate Subnet with RouteTable
+// @Description Associate a Subnet with a RouteTable. The Subnet is moved from the RouteTable associated before.
+// @Tags [RouteTable Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param Name path string true "The name of the RouteTable"
+// @Param SubnetName path string true "The name of the Subnet to associate"
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for associating a Subnet"
+// @Success 200 {object} cres.RouteTableInfo "Details of the RouteTable after associating the Subnet"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/routetable/{Name}/subnet/{SubnetName} [put]
+func AssociateRouteTableSubnet(c echo.Context) error {
+	cblog.Info("call AssociateRouteTableSubnet()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.AssociateRouteTableSubnet(req.ConnectionName, c.Param("VPCName"), c.Param("Name"), c.Param("SubnetName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// disassociateRouteTableSubnet godoc
+// @ID disassociate-routetable-subnet
+// @Summary Disassociate Subnet from RouteTable
+// @Description Disassociate a Subnet from a RouteTable. The Subnet uses the main RouteTable of the VPC after that.
+// @Tags [RouteTable Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCName path string true "The name of the VPC"
+// @Param Name path string true "The name of the RouteTable"
+// @Param SubnetName path string true "The name of the Subnet to disassociate"
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for disassociating a Subnet"
+// @Success 200 {object} BooleanInfo "Result of the disassociate operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpc/{VPCName}/routetable/{Name}/subnet/{SubnetName} [delete]
+func DisassociateRouteTableSubnet(c echo.Context) error {
+	cblog.Info("call DisassociateRouteTableSubnet()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.DisassociateRouteTableSubnet(req.ConnectionName, c.Param("VPCName"), c.Param("Name"), c.Param("SubnetName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
@@ -409,10 +409,39 @@ type RuleControlRequest struct {
 			FromPort   string `json:"FromPort" validate:"required" example:"22"`
 			ToPort     string `json:"ToPort" validate:"required" example:"22"`
 			CIDR       string `json:"CIDR,omitempty" validate:"omitempty" example:"0.0.0.0/0(default)"`
+
+			RemoteSecurityGroupIID *cres.IID `json:"RemoteSecurityGroupIID,omitempty" validate:"omitempty"` // {NameId} of a SecurityGroup in the same VPC, an alternative to CIDR
+
+			Description string `json:"Description,omitempty" validate:"omitempty" example:"allow ssh"`
+			Priority    string `json:"Priority,omitempty" validate:"omitempty" example:"100"`
+			Action      string `json:"Action,omitempty" validate:"omitempty" example:"allow(default)"` // allow or deny
 		} `json:"RuleInfoList" validate:"required"`
 	} `json:"ReqInfo" validate:"required"`
 }
 
+// toSecurityRuleInfoList converts the rules of a RuleControlRequest to driver rules.
+func toSecurityRuleInfoList(req RuleControlRequest) []cres.SecurityRuleInfo {
+	reqRuleInfoList := []cres.SecurityRuleInfo{}
+	for _, info := range req.ReqInfo.RuleInfoList {
+		ruleInfo := cres.SecurityRuleInfo{
+			Direction:  info.Direction,
+			IPProtocol: info.IPProtocol,
+			FromPort:   info.FromPort,
+			ToPort:     info.ToPort,
+			CIDR:       info.CIDR,
+
+			Description: info.Description,
+			Priority:    info.Priority,
+			Action:      info.Action,
+		}
+		if info.RemoteSecurityGroupIID != nil {
+			ruleInfo.RemoteSecurityGroupIID = &cres.IID{NameId: info.RemoteSecurityGroupIID.NameId, SystemId: ""}
+		}
+		reqRuleInfoList = append(reqRuleInfoList, ruleInfo)
+	}
+	return reqRuleInfoList
+}
+
 // addRules godoc
 // @ID add-rule
 // @Summary Add Rules to SecurityGroup
@@ -436,17 +465,7 @@ func AddRules(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	reqRuleInfoList := []cres.SecurityRuleInfo{}
-	for _, info := range req.ReqInfo.RuleInfoList {
-		ruleInfo := cres.SecurityRuleInfo{
-			Direction:  info.Direction,
-			IPProtocol: info.IPProtocol,
-			FromPort:   info.FromPort,
-			ToPort:     info.ToPort,
-			CIDR:       info.CIDR,
-		}
-		reqRuleInfoList = append(reqRuleInfoList, ruleInfo)
-	}
+	reqRuleInfoList := toSecurityRuleInfoList(req)
 
 	result, err := cmrt.AddRules(req.ConnectionName, c.Param("SGName"), reqRuleInfoList)
 	if err != nil {
@@ -479,17 +498,7 @@ func RemoveRules(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	reqRuleInfoList := []cres.SecurityRuleInfo{}
-	for _, info := range req.ReqInfo.RuleInfoList {
-		ruleInfo := cres.SecurityRuleInfo{
-			Direction:  info.Direction,
-			IPProtocol: info.IPProtocol,
-			FromPort:   info.FromPort,
-			ToPort:     info.ToPort,
-			CIDR:       info.CIDR,
-		}
-		reqRuleInfoList = append(reqRuleInfoList, ruleInfo)
-	}
+	reqRuleInfoList := toSecurityRuleInfoList(req)
 
 	result, err := cmrt.RemoveRules(req.ConnectionName, c.Param("SGName"), reqRuleInfoList)
 	if err != nil {
@@ -551,3 +560,37 @@ func CountSecurityGroupsByConnection(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, jsonResult)
 }
+
+// syncRules godoc
+// @ID sync-rule
+// @Summary Sync Rules of SecurityGroup
+// @Description Replace the rules of a Security Group with the complete desired rule list. <br> The rules to add and remove are computed against the current rules and applied. <br> With dryrun=true, only the diff is returned without any change.
+// @Tags [SecurityGroup Management]
+// @Accept  json
+// @Produce  json
+// @Param SGName path string true "The name of the SecurityGroup to sync rules of"
+// @Param RuleControlRequest body restruntime.RuleControlRequest true "Request body with the complete desired rules"
+// @Param dryrun query string false "Return the diff without any change. ex) true or false(default: false)"
+// @Success 200 {object} cmrt.SecurityRuleDiffInfo "The diff of rules applied, or to be applied if dryrun"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /securitygroup/{SGName}/rules [put]
+func SyncRules(c echo.Context) error {
+	cblog.Info("call SyncRules()")
+
+	req := RuleControlRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	reqRuleInfoList := toSecurityRuleInfoList(req)
+
+	result, err := cmrt.SyncRules(req.ConnectionName, c.Param("SGName"), reqRuleInfoList, c.QueryParam("dryrun") == "true")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
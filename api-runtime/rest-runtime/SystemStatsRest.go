@@ -12,6 +12,7 @@ import (
 	"os"
 
 	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
 	"github.com/labstack/echo/v4"
 )
 
@@ -126,3 +127,18 @@ func FetchResourceUsage(c echo.Context) error {
 	// Default response in JSON format
 	return c.JSON(http.StatusOK, resourceUsage)
 }
+
+// FetchConnectionCacheStats godoc
+// @ID fetch-connection-cache-stats
+// @Summary Fetch Connection Cache Statistics
+// @Description Retrieve the statistics of the cloud connection cache, such as the size, hits, misses and evictions.
+// @Description The TTL of the cache is set by SPIDER_CONNECTION_CACHE_TTL(seconds, 0: disabled).
+// @Tags [Utility]
+// @Produce json
+// @Success 200 {object} ccm.ConnectionCacheStats "Connection Cache Statistics"
+// @Router /sysstats/connectioncache [get]
+func FetchConnectionCacheStats(c echo.Context) error {
+	cblog.Info("call FetchConnectionCacheStats()")
+
+	return c.JSON(http.StatusOK, ccm.GetConnectionCacheStats())
+}
@@ -9,6 +9,8 @@
 package restruntime
 
 import (
+	"context"
+
 	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
@@ -167,6 +169,10 @@ type VMStartRequest struct {
 		VMUserId     string `json:"VMUserId,omitempty" validate:"omitempty" example:"Administrator"`    // Administrator, Windows Only
 		VMUserPasswd string `json:"VMUserPasswd,omitempty" validate:"omitempty" example:"password1234"` // Windows Only
 
+		UserData string `json:"UserData,omitempty" validate:"omitempty" example:"#!/bin/bash\napt-get update"` // Script, cloud-config or cloud-boothook, plain text, Linux Only
+
+		PurchaseOption cres.VMPurchaseOption `json:"PurchaseOption,omitempty" validate:"omitempty"` // OnDemand(default) or Spot with MaxPrice and InterruptionBehavior
+
 		TagList []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
 	} `json:"ReqInfo" validate:"required"`
 }
@@ -179,7 +185,9 @@ type VMStartRequest struct {
 // @Accept  json
 // @Produce  json
 // @Param VMStartRequest body restruntime.VMStartRequest true "Request body for starting a VM"
+// @Param async query bool false "If true, run as an asynchronous job and return the Job right away"
 // @Success 200 {object} cres.VMInfo "Details of the started VM"
+// @Success 202 {object} JobResponse "Job started, when async=true"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
@@ -228,11 +236,21 @@ func StartVM(c echo.Context) error {
 		VMUserId:     req.ReqInfo.VMUserId,
 		VMUserPasswd: req.ReqInfo.VMUserPasswd,
 
+		UserData: req.ReqInfo.UserData,
+
+		PurchaseOption: req.ReqInfo.PurchaseOption,
+
 		TagList: req.ReqInfo.TagList,
 	}
 
+	if isAsyncRequest(c) {
+		return submitJob(c, "StartVM", req.ConnectionName, VM, req.ReqInfo.Name, func(ctx context.Context) (interface{}, error) {
+			return cmrt.StartVMWithContext(ctx, req.ConnectionName, VM, reqInfo, req.IDTransformMode)
+		})
+	}
+
 	// Call common-runtime API
-	result, err := cmrt.StartVM(req.ConnectionName, VM, reqInfo, req.IDTransformMode)
+	result, err := cmrt.StartVMWithContext(c.Request().Context(), req.ConnectionName, VM, reqInfo, req.IDTransformMode)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -615,6 +633,46 @@ func ControlVM(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
+// VMSpecChangeRequest represents the request body for changing the VMSpec of a VM.
+type VMSpecChangeRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		VMSpecName string `json:"VMSpecName" validate:"required" example:"t3.medium"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// changeVMSpec godoc
+// @ID change-vm-spec
+// @Summary Change VM Spec
+// @Description Change the VMSpec of a Virtual Machine (VM). <br> A running VM is suspended, resized and resumed.
+// @Tags [VM Management]
+// @Accept  json
+// @Produce  json
+// @Param Name path string true "The name of the VM to change the VMSpec"
+// @Param VMSpecChangeRequest body restruntime.VMSpecChangeRequest true "Request body for changing the VMSpec of a VM"
+// @Success 200 {object} cres.VMInfo "Details of the VM with the new VMSpec"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vm/{Name}/spec [put]
+func ChangeVMSpec(c echo.Context) error {
+	cblog.Info("call ChangeVMSpec()")
+
+	var req VMSpecChangeRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ChangeVMSpec(req.ConnectionName, VM, c.Param("Name"), req.ReqInfo.VMSpecName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // countAllVMs godoc
 // @ID count-all-vm
 // @Summary Count All VMs
@@ -187,3 +187,39 @@ func GetOrgVMSpec(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, map[string]interface{}{"VMSpecInfo": resultInterface})
 }
+
+// VMSpecRecommendRequest represents the request body structure for the RecommendVMSpec API.
+type VMSpecRecommendRequest struct {
+	ConnectionNames []string               `json:"ConnectionNames" validate:"required" example:"aws-connection,gcp-connection"`
+	Requirement     cmrt.VMSpecRequirement `json:"Requirement" validate:"required"`
+}
+
+// recommendVMSpec godoc
+// @ID recommend-vm-spec
+// @Summary Recommend VM Specs
+// @Description Find the VM specs satisfying the requirements in the connections, and return them ranked by the OnDemand price, the cheapest first. <br> * Specs without a price are ranked after all priced specs. <br> * The errors of each connection are reported in ErrorList without failing the whole call.
+// @Tags [Cloud Metadata] VM Spec
+// @Accept  json
+// @Produce  json
+// @Param VMSpecRecommendRequest body VMSpecRecommendRequest true "The connections and the requirements of VM specs"
+// @Success 200 {object} cmrt.VMSpecRecommendResult "Ranked list of VM specs and the errors of each connection"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vmspec/recommend [post]
+func RecommendVMSpec(c echo.Context) error {
+	cblog.Info("call RecommendVMSpec()")
+
+	var req VMSpecRecommendRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.RecommendVMSpec(req.ConnectionNames, req.Requirement)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -199,11 +199,13 @@ type VPCCreateRequest struct {
 	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
 	ReqInfo         struct {
 		Name           string `json:"Name" validate:"required" example:"vpc-01"`
-		IPv4_CIDR      string `json:"IPv4_CIDR" validate:"required" example:"10.0.0.0/16"` // Some CSPs unsupported VPC CIDR
+		IPv4_CIDR      string `json:"IPv4_CIDR" validate:"required" example:"10.0.0.0/16"`       // Some CSPs unsupported VPC CIDR
+		EnableIPv6     bool   `json:"EnableIPv6,omitempty" validate:"omitempty" example:"false"` // dual-stack VPC, the CSP assigns an IPv6 CIDR
 		SubnetInfoList []struct {
 			Name      string          `json:"Name" validate:"required" example:"subnet-01"`
 			Zone      string          `json:"Zone,omitempty" validate:"omitempty" example:"us-east-1b"` // target zone for the subnet, if not specified, it will be created in the same zone as the Connection.
 			IPv4_CIDR string          `json:"IPv4_CIDR" validate:"required" example:"10.0.8.0/22"`
+			IPv6_CIDR string          `json:"IPv6_CIDR,omitempty" validate:"omitempty" example:"2600:1f18:abcd:1201::/64"` // in a dual-stack VPC, assigned automatically if not specified
 			TagList   []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
 		} `json:"SubnetInfoList" validate:"required"`
 		TagList []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
@@ -236,13 +238,14 @@ func CreateVPC(c echo.Context) error {
 	// (1) create SubnetInfo List
 	subnetInfoList := []cres.SubnetInfo{}
 	for _, info := range req.ReqInfo.SubnetInfoList {
-		subnetInfo := cres.SubnetInfo{IId: cres.IID{info.Name, ""}, IPv4_CIDR: info.IPv4_CIDR, Zone: info.Zone, TagList: info.TagList}
+		subnetInfo := cres.SubnetInfo{IId: cres.IID{info.Name, ""}, IPv4_CIDR: info.IPv4_CIDR, IPv6_CIDR: info.IPv6_CIDR, Zone: info.Zone, TagList: info.TagList}
 		subnetInfoList = append(subnetInfoList, subnetInfo)
 	}
 	// (2) create VPCReqInfo with SubnetInfo List
 	reqInfo := cres.VPCReqInfo{
 		IId:            cres.IID{req.ReqInfo.Name, ""},
 		IPv4_CIDR:      req.ReqInfo.IPv4_CIDR,
+		EnableIPv6:     req.ReqInfo.EnableIPv6,
 		SubnetInfoList: subnetInfoList,
 		TagList:        req.ReqInfo.TagList,
 	}
@@ -359,6 +362,7 @@ type SubnetAddRequest struct {
 		Name      string          `json:"Name" validate:"required" example:"subnet-01"`
 		Zone      string          `json:"Zone,omitempty" validate:"omitempty" example:"us-east-1b"` // target zone for the subnet, if not specified, it will be created in the same zone as the Connection.
 		IPv4_CIDR string          `json:"IPv4_CIDR" validate:"required" example:"10.0.12.0/22"`
+		IPv6_CIDR string          `json:"IPv6_CIDR,omitempty" validate:"omitempty" example:"2600:1f18:abcd:1202::/64"` // in a dual-stack VPC, assigned automatically if not specified
 		TagList   []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
 	} `json:"ReqInfo" validate:"required"`
 }
@@ -387,7 +391,8 @@ func AddSubnet(c echo.Context) error {
 	}
 
 	// Rest RegInfo => Driver ReqInfo
-	reqSubnetInfo := cres.SubnetInfo{IId: cres.IID{req.ReqInfo.Name, ""}, IPv4_CIDR: req.ReqInfo.IPv4_CIDR, Zone: req.ReqInfo.Zone, TagList: req.ReqInfo.TagList}
+	reqSubnetInfo := cres.SubnetInfo{IId: cres.IID{req.ReqInfo.Name, ""}, IPv4_CIDR: req.ReqInfo.IPv4_CIDR, IPv6_CIDR: req.ReqInfo.IPv6_CIDR,
+		Zone: req.ReqInfo.Zone, TagList: req.ReqInfo.TagList}
 
 	// Call common-runtime API
 	result, err := cmrt.AddSubnet(req.ConnectionName, SUBNET, c.Param("VPCName"), reqSubnetInfo, req.IDTransformMode)
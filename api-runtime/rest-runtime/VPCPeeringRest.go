@@ -0,0 +1,258 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	// REST API (echo)
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"strconv"
+)
+
+//================ VPCPeering Handler
+
+// VPCPeeringRequest represents the request body for requesting a VPCPeering.
+type VPCPeeringRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
+	ReqInfo         struct {
+		Name               string          `json:"Name" validate:"required" example:"peering-01"`
+		VPCName            string          `json:"VPCName" validate:"required" example:"vpc-01"`
+		PeerConnectionName string          `json:"PeerConnectionName,omitempty" validate:"omitempty" example:"aws-tokyo-connection"` // if not specified, the same as the ConnectionName
+		PeerVPCName        string          `json:"PeerVPCName" validate:"required" example:"vpc-02"`
+		TagList            []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// requestVPCPeering godoc
+// @ID request-vpcpeering
+// @Summary Request VPCPeering
+// @Description Request a peering from a VPC of the Connection to a VPC of the same or another Connection of the same CSP. <br> The peering is active after it is accepted.
+// @Tags [VPCPeering Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCPeeringRequest body restruntime.VPCPeeringRequest true "Request body for requesting a VPCPeering"
+// @Success 200 {object} cres.VPCPeeringInfo "Details of the requested VPCPeering"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering [post]
+func RequestVPCPeering(c echo.Context) error {
+	cblog.Info("call RequestVPCPeering()")
+
+	req := VPCPeeringRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.VPCPeeringInfo{
+		IId:             cres.IID{NameId: req.ReqInfo.Name, SystemId: ""},
+		RequesterVpcIID: cres.IID{NameId: req.ReqInfo.VPCName, SystemId: ""},
+		AccepterVpcIID:  cres.IID{NameId: req.ReqInfo.PeerVPCName, SystemId: ""},
+		TagList:         req.ReqInfo.TagList,
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.RequestVPCPeering(req.ConnectionName, VPCPEERING, reqInfo, req.ReqInfo.PeerConnectionName, req.IDTransformMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// acceptVPCPeering godoc
+// @ID accept-vpcpeering
+// @Summary Accept VPCPeering
+// @Description Accept a requested VPCPeering with the Connection of the peer VPC.
+// @Tags [VPCPeering Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body with the requester's or the accepter's Connection"
+// @Param Name path string true "The name of the VPCPeering to accept"
+// @Success 200 {object} cres.VPCPeeringInfo "Details of the accepted VPCPeering"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering/{Name}/accept [put]
+func AcceptVPCPeering(c echo.Context) error {
+	cblog.Info("call AcceptVPCPeering()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.AcceptVPCPeering(req.ConnectionName, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// VPCPeeringListResponse represents the response body for listing VPCPeerings.
+type VPCPeeringListResponse struct {
+	Result []*cres.VPCPeeringInfo `json:"vpcpeering" validate:"required" description:"A list of VPCPeering information"`
+}
+
+// listVPCPeering godoc
+// @ID list-vpcpeering
+// @Summary List VPCPeerings
+// @Description Retrieve a list of VPCPeerings requested or accepted by a specific connection.
+// @Tags [VPCPeering Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string true "The name of the Connection to list VPCPeerings for"
+// @Success 200 {object} VPCPeeringListResponse "List of VPCPeerings"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering [get]
+func ListVPCPeering(c echo.Context) error {
+	cblog.Info("call ListVPCPeering()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListVPCPeering(req.ConnectionName, VPCPEERING)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	jsonResult := VPCPeeringListResponse{
+		Result: result,
+	}
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// getVPCPeering godoc
+// @ID get-vpcpeering
+// @Summary Get VPCPeering
+// @Description Retrieve details of a specific VPCPeering.
+// @Tags [VPCPeering Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string true "The name of the requester's or the accepter's Connection"
+// @Param Name path string true "The name of the VPCPeering to retrieve"
+// @Success 200 {object} cres.VPCPeeringInfo "Details of the VPCPeering"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering/{Name} [get]
+func GetVPCPeering(c echo.Context) error {
+	cblog.Info("call GetVPCPeering()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetVPCPeering(req.ConnectionName, VPCPEERING, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// deleteVPCPeering godoc
+// @ID delete-vpcpeering
+// @Summary Delete VPCPeering
+// @Description Delete a specified VPCPeering.
+// @Tags [VPCPeering Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting a VPCPeering"
+// @Param Name path string true "The name of the VPCPeering to delete"
+// @Param force query string false "Force delete the VPCPeering. ex) true or false(default: false)"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering/{Name} [delete]
+func DeleteVPCPeering(c echo.Context) error {
+	cblog.Info("call DeleteVPCPeering()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteVPCPeering(req.ConnectionName, VPCPEERING, c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// propagateVPCPeeringRoutes godoc
+// @ID propagate-vpcpeering-routes
+// @Summary Propagate VPCPeering Routes
+// @Description Add the routes to the peer VPC's CIDR into the route tables of both VPCs of an active VPCPeering.
+// @Tags [VPCPeering Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body with the requester's or the accepter's Connection"
+// @Param Name path string true "The name of the VPCPeering"
+// @Success 200 {object} cres.VPCPeeringInfo "Details of the VPCPeering"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering/{Name}/routes [put]
+func PropagateVPCPeeringRoutes(c echo.Context) error {
+	cblog.Info("call PropagateVPCPeeringRoutes()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.PropagateVPCPeeringRoutes(req.ConnectionName, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -50,7 +50,7 @@ func GetCloudDriver(cloudConnectName string) (idrv.CloudDriver, error) {
 		return nil, err
 	}
 
-	return getCloudDriver(*cldDrvInfo)
+	return loadCloudDriver(*cldDrvInfo)
 }
 
 // 1. get the driver info
@@ -61,7 +61,7 @@ func getCloudDriverByDriverName(driverName string) (idrv.CloudDriver, error) {
 		return nil, err
 	}
 
-	return getCloudDriver(*cldDrvInfo)
+	return loadCloudDriver(*cldDrvInfo)
 }
 
 func GetProviderNameByDriverName(driverName string) (string, error) {
@@ -79,7 +79,7 @@ func GetCloudConnection(cloudConnectName string) (icon.CloudConnection, error) {
 	var err error
 
 	for i := 0; i < 3; i++ {
-		conn, err = commonGetCloudConnection(cloudConnectName, "")
+		conn, err = getCachedCloudConnection(cloudConnectName, "")
 		if err == nil {
 			return conn, nil
 		}
@@ -98,7 +98,7 @@ func GetZoneLevelCloudConnection(cloudConnectName string, targetZoneName string)
 	var err error
 
 	for i := 0; i < 3; i++ {
-		conn, err = commonGetCloudConnection(cloudConnectName, targetZoneName)
+		conn, err = getCachedCloudConnection(cloudConnectName, targetZoneName)
 		if err == nil {
 			return conn, nil
 		}
@@ -328,7 +328,7 @@ func CreateCloudConnection(connectName string) (icon.CloudConnection, error) {
 	}
 
 	// Get the CloudDriver based on DriverName
-	cloudDriver, err := getCloudDriver(cloudDriverInfo)
+	cloudDriver, err := loadCloudDriver(cloudDriverInfo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get CloudDriver: %v", err)
 	}
@@ -0,0 +1,70 @@
+// Cloud Driver Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Out-of-process driver plugins, see cloud-driver/driver-plugin.
+//
+// by CB-Spider Team, 2026.10.
+
+package clouddriverhandler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	driverplugin "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/driver-plugin"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
+)
+
+// DriverLibFileName of a gRPC driver plugin, ex) "grpc:mock-driver-v1.0-grpc"
+const GRPC_PLUGIN_PREFIX = "grpc:"
+
+// loadCloudDriver returns the gRPC plugin driver for a "grpc:" DriverLibFileName,
+// and the built-in(static) or shared library(dyna) driver for others.
+func loadCloudDriver(cldDrvInfo dim.CloudDriverInfo) (idrv.CloudDriver, error) {
+	if !strings.HasPrefix(cldDrvInfo.DriverLibFileName, GRPC_PLUGIN_PREFIX) {
+		return getCloudDriver(cldDrvInfo)
+	}
+
+	pluginPath, err := getPluginPath(cldDrvInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	cblog.Info(cldDrvInfo.DriverName + ": driver plugin path - " + pluginPath)
+
+	pluginDriver, err := driverplugin.GetPluginDriver(pluginPath)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	return pluginDriver, nil
+}
+
+// ShutdownCloudDrivers stops the driver plugin processes, it is called when CB-Spider stops.
+func ShutdownCloudDrivers() {
+	driverplugin.ShutdownPlugins()
+}
+
+// getPluginPath returns the path of a plugin executable.
+// A relative path is in $CBSPIDER_ROOT/cloud-driver-libs.
+func getPluginPath(cldDrvInfo dim.CloudDriverInfo) (string, error) {
+	pluginFile := strings.TrimSpace(strings.TrimPrefix(cldDrvInfo.DriverLibFileName, GRPC_PLUGIN_PREFIX))
+	if pluginFile == "" {
+		return "", fmt.Errorf("%q: driver plugin file can't be empty!!", cldDrvInfo.DriverName)
+	}
+	if filepath.IsAbs(pluginFile) {
+		return pluginFile, nil
+	}
+
+	cbspiderRoot := os.Getenv("CBSPIDER_ROOT")
+	if cbspiderRoot == "" {
+		return "", fmt.Errorf("$CBSPIDER_ROOT is not set!!")
+	}
+	return filepath.Join(cbspiderRoot, "cloud-driver-libs", pluginFile), nil
+}
@@ -0,0 +1,218 @@
+// Cloud Driver Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package clouddriverhandler
+
+import (
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+)
+
+// Connection Cache
+//   - key: connection name + target zone
+//   - An entry is evicted when its TTL is over, IsConnected() fails or times out,
+//     or the credential, region or config of the connection is changed.
+//   - SPIDER_CONNECTION_CACHE_TTL: TTL in seconds, default 600, 0 disables the cache.
+//     The TTL is capped at 3600, because some drivers(Azure, IBM) embed a 6000 seconds
+//     context in their connection.
+//
+// Evicted connections are closed in the background.
+// The Close() of the in-process drivers releases nothing that an API call in progress uses,
+// and a plugin connection connects again if it is used after Close().
+
+const (
+	defaultConnectionCacheTTL    = 600  // seconds
+	maxConnectionCacheTTL        = 3600 // seconds
+	connectionHealthCheckTimeout = 10 * time.Second
+)
+
+type connectionCacheKey struct {
+	ConnectionName string
+	TargetZone     string
+}
+
+type connectionCacheEntry struct {
+	conn        icon.CloudConnection
+	createdTime time.Time
+}
+
+// ConnectionCacheStats represents the statistics of the connection cache.
+type ConnectionCacheStats struct {
+	Enabled     bool   `json:"Enabled" example:"true"`
+	TTL         string `json:"TTL" example:"10m0s"`
+	Size        int    `json:"Size" example:"3"`      // number of cached connections
+	Hits        int64  `json:"Hits" example:"120"`    // number of calls served from the cache
+	Misses      int64  `json:"Misses" example:"5"`    // number of calls which created a new connection
+	Expired     int64  `json:"Expired" example:"1"`   // number of entries evicted by TTL
+	Unhealthy   int64  `json:"Unhealthy" example:"0"` // number of entries evicted by a failed or timed out IsConnected()
+	Invalidated int64  `json:"Invalidated" example:"2"`
+}
+
+var (
+	connectionCacheTTL  time.Duration
+	connectionCacheMap  = map[connectionCacheKey]*connectionCacheEntry{}
+	connectionCacheLock sync.Mutex
+	// increased by every invalidation, a connection made before an invalidation is not cached
+	connectionCacheGeneration uint64
+
+	connectionCacheStats ConnectionCacheStats
+)
+
+func init() {
+	ttl := defaultConnectionCacheTTL
+	if strTTL := os.Getenv("SPIDER_CONNECTION_CACHE_TTL"); strTTL != "" {
+		num, err := strconv.Atoi(strTTL)
+		if err != nil || num < 0 {
+			cblog.Errorf("SPIDER_CONNECTION_CACHE_TTL(%s) is not a number of seconds, use default(%d).", strTTL, defaultConnectionCacheTTL)
+		} else {
+			ttl = num
+		}
+	}
+	SetConnectionCacheTTL(time.Duration(ttl) * time.Second)
+
+	ccim.AddConnectionInvalidator(evictCachedConnection)
+}
+
+// SetConnectionCacheTTL changes the TTL of the connection cache and flushes the cache.
+// A TTL of 0 disables the cache, a TTL over 3600 seconds is capped at 3600 seconds.
+func SetConnectionCacheTTL(ttl time.Duration) {
+	if ttl > maxConnectionCacheTTL*time.Second {
+		cblog.Errorf("the connection cache TTL(%v) is too long, use max(%ds).", ttl, maxConnectionCacheTTL)
+		ttl = maxConnectionCacheTTL * time.Second
+	}
+
+	connectionCacheLock.Lock()
+	connectionCacheTTL = ttl
+	connectionCacheGeneration++
+	evictedMap := connectionCacheMap
+	connectionCacheMap = map[connectionCacheKey]*connectionCacheEntry{}
+	connectionCacheLock.Unlock()
+
+	for _, entry := range evictedMap {
+		closeEvictedConnection(entry.conn)
+	}
+}
+
+// getCachedCloudConnection returns a cached connection or a new connection made by commonGetCloudConnection.
+func getCachedCloudConnection(cloudConnectName string, targetZoneName string) (icon.CloudConnection, error) {
+	key := connectionCacheKey{ConnectionName: cloudConnectName, TargetZone: targetZoneName}
+
+	connectionCacheLock.Lock()
+	ttl := connectionCacheTTL
+	generation := connectionCacheGeneration
+	entry, ok := connectionCacheMap[key]
+	if ttl > 0 && ok && time.Since(entry.createdTime) > ttl {
+		delete(connectionCacheMap, key)
+		connectionCacheStats.Expired++
+		closeEvictedConnection(entry.conn)
+		ok = false
+	}
+	connectionCacheLock.Unlock()
+
+	if ttl > 0 && ok {
+		// check the health outside of the lock, IsConnected() of a plugin is a remote call
+		healthy := isConnectionHealthy(entry.conn)
+
+		connectionCacheLock.Lock()
+		if healthy {
+			connectionCacheStats.Hits++
+			connectionCacheLock.Unlock()
+			return entry.conn, nil
+		}
+		if connectionCacheMap[key] == entry {
+			delete(connectionCacheMap, key)
+			connectionCacheStats.Unhealthy++
+			closeEvictedConnection(entry.conn)
+		}
+		connectionCacheLock.Unlock()
+	}
+
+	if ttl > 0 {
+		connectionCacheLock.Lock()
+		connectionCacheStats.Misses++
+		connectionCacheLock.Unlock()
+	}
+
+	// connect outside of the lock, ConnectCloud can take a long time
+	conn, err := commonGetCloudConnection(cloudConnectName, targetZoneName)
+	if err != nil || ttl <= 0 {
+		return conn, err
+	}
+
+	connectionCacheLock.Lock()
+	// skip if the TTL was changed or the cache was invalidated while connecting
+	if connectionCacheTTL == ttl && connectionCacheGeneration == generation {
+		if oldEntry, ok := connectionCacheMap[key]; ok {
+			// another call connected at the same time
+			closeEvictedConnection(oldEntry.conn)
+		}
+		connectionCacheMap[key] = &connectionCacheEntry{conn: conn, createdTime: time.Now()}
+	}
+	connectionCacheLock.Unlock()
+
+	return conn, nil
+}
+
+// isConnectionHealthy returns false if IsConnected() fails or does not return in time.
+func isConnectionHealthy(conn icon.CloudConnection) bool {
+	result := make(chan bool, 1)
+	go func() {
+		connected, err := conn.IsConnected()
+		result <- err == nil && connected
+	}()
+
+	select {
+	case healthy := <-result:
+		return healthy
+	case <-time.After(connectionHealthCheckTimeout):
+		cblog.Errorf("IsConnected() did not return in %v, the connection is evicted.", connectionHealthCheckTimeout)
+		return false
+	}
+}
+
+// closeEvictedConnection closes an evicted connection in the background,
+// so a hung driver can not block the cache.
+func closeEvictedConnection(conn icon.CloudConnection) {
+	go func() {
+		if err := conn.Close(); err != nil {
+			cblog.Error(err)
+		}
+	}()
+}
+
+// evictCachedConnection evicts all cached connections of a connection name.
+func evictCachedConnection(connectionName string) {
+	connectionCacheLock.Lock()
+	defer connectionCacheLock.Unlock()
+
+	connectionCacheGeneration++
+	for key, entry := range connectionCacheMap {
+		if key.ConnectionName == connectionName {
+			delete(connectionCacheMap, key)
+			connectionCacheStats.Invalidated++
+			closeEvictedConnection(entry.conn)
+		}
+	}
+}
+
+// GetConnectionCacheStats returns the statistics of the connection cache.
+func GetConnectionCacheStats() ConnectionCacheStats {
+	connectionCacheLock.Lock()
+	defer connectionCacheLock.Unlock()
+
+	stats := connectionCacheStats
+	stats.Enabled = connectionCacheTTL > 0
+	stats.TTL = connectionCacheTTL.String()
+	stats.Size = len(connectionCacheMap)
+	return stats
+}
@@ -58,6 +58,12 @@ const (
 	VM            RES_TYPE = "VM"
 	MYIMAGE       RES_TYPE = "MYIMAGE"
 	NLB           RES_TYPE = "NETWORKLOADBALANCER"
+	VNIC          RES_TYPE = "VNIC"
+	PUBLICIP      RES_TYPE = "PUBLICIP"
+	VPCPEERING    RES_TYPE = "VPCPEERING"
+	ROUTETABLE    RES_TYPE = "ROUTETABLE"
+	NATGATEWAY    RES_TYPE = "NATGATEWAY"
+	DISKSNAPSHOT  RES_TYPE = "DISKSNAPSHOT"
 	TAG           RES_TYPE = "TAG"
 
 	//=========== PMKS: Provider-Managed K8S
@@ -15,15 +15,20 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/binary"
 	"encoding/pem"
 	"fmt"
 	"io"
+	"net"
 
 	"errors"
 	"regexp"
+	"strconv"
+	"strings"
 
 	cblogger "github.com/cloud-barista/cb-log"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	enc "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
 	infostore "github.com/cloud-barista/cb-spider/info-store"
 	"github.com/sirupsen/logrus"
@@ -100,13 +105,18 @@ func init() {
 		cblog.Error(err)
 		return
 	}
-	db.AutoMigrate(&LocalKeyInfo{})
+	infostore.AutoMigrate(db, &LocalKeyInfo{})
 	infostore.Close(db)
 }
 
 func AddKey(providerName string, hashString string, keyPairNameId string, privateKey string) error {
 
-	encPrivateKey, err := enc.Encrypt(enc.SPIDER_KEY, []byte(privateKey))
+	spiderKey, err := enc.GetSpiderKey()
+	if err != nil {
+		return err
+	}
+
+	encPrivateKey, err := enc.Encrypt(spiderKey, []byte(privateKey))
 	if err != nil {
 		return err
 	}
@@ -129,10 +139,15 @@ func ListKey(providerName string, hashString string) ([]*irs.KeyValue, error) {
 		return nil, err
 	}
 
+	spiderKey, err := enc.GetSpiderKey()
+	if err != nil {
+		return nil, err
+	}
+
 	var keyValueList []*irs.KeyValue
 	for _, iidInfo := range iidInfoList {
 
-		decPrivateKey, err := enc.Decrypt(enc.SPIDER_KEY, []byte(iidInfo.PrivateKey))
+		decPrivateKey, err := enc.Decrypt(spiderKey, []byte(iidInfo.PrivateKey))
 		if err != nil {
 			return nil, err
 		}
@@ -157,7 +172,12 @@ func GetKey(providerName string, hashString string, keyPairNameId string) (*irs.
 		return nil, err
 	}
 
-	decPrivateKey, err := enc.Decrypt(enc.SPIDER_KEY, []byte(localKeyInfo.PrivateKey))
+	spiderKey, err := enc.GetSpiderKey()
+	if err != nil {
+		return nil, err
+	}
+
+	decPrivateKey, err := enc.Decrypt(spiderKey, []byte(localKeyInfo.PrivateKey))
 	if err != nil {
 		return nil, err
 	}
@@ -249,3 +269,128 @@ func ValidateWindowsPassword(pw string) error {
 
 	}
 }
+
+//-------------------
+
+const userDataBoundary = "==CB-SPIDER-USERDATA-BOUNDARY=="
+
+// the headers of the user-data formats and their cloud-init MIME types
+var userDataContentTypeList = []struct {
+	Header      string
+	ContentType string
+}{
+	{"#cloud-config", "text/cloud-config"},
+	{"#cloud-boothook", "text/cloud-boothook"},
+	{"#!", "text/x-shellscript"},
+}
+
+// GetUserDataContentType returns the cloud-init MIME type of the given user-data.
+// ex) "#!/bin/bash ..." => "text/x-shellscript", "#cloud-config ..." => "text/cloud-config"
+func GetUserDataContentType(data string) string {
+	trimmed := strings.TrimLeft(data, " \t\r\n")
+	for _, contentType := range userDataContentTypeList {
+		if strings.HasPrefix(trimmed, contentType.Header) {
+			return contentType.ContentType
+		}
+	}
+	return "text/plain"
+}
+
+// GetUserDataHeaderList returns the headers of the supported user-data formats.
+// ex) "#cloud-config", "#cloud-boothook", "#!"
+func GetUserDataHeaderList() []string {
+	headerList := []string{}
+	for _, contentType := range userDataContentTypeList {
+		headerList = append(headerList, contentType.Header)
+	}
+	return headerList
+}
+
+// ValidateUserDataSize checks the size of the user-data with 'userdatamaxsize' in cloudos_meta.yaml.
+// A driver which merges its own init data with MergeUserData checks the merged user-data,
+// because the merged MIME document is the user-data passed to the CSP.
+func ValidateUserDataSize(providerName string, userData string) error {
+	cloudOSMetaInfo, err := cim.GetCloudOSMetaInfo(providerName)
+	if err != nil {
+		return err
+	}
+
+	if len(cloudOSMetaInfo.UserDataMaxSize) == 0 || cloudOSMetaInfo.UserDataMaxSize[0] == "" {
+		return fmt.Errorf("%s does not support UserData!", providerName)
+	}
+	maxSize, err := strconv.Atoi(cloudOSMetaInfo.UserDataMaxSize[0])
+	if err != nil {
+		return fmt.Errorf("%s has an invalid UserDataMaxSize(%s) in cloudos_meta.yaml: %v", providerName, cloudOSMetaInfo.UserDataMaxSize[0], err)
+	}
+	if len(userData) > maxSize {
+		return fmt.Errorf("UserData size(%d bytes) exceeds the max size(%d bytes) of %s!", len(userData), maxSize, providerName)
+	}
+	return nil
+}
+
+// MergeUserData combines the driver's own init data(ex. cb-user setup) with the user's user-data
+// into one cloud-init multi-part MIME document, so that both of them run at boot time.
+// The driver's part is placed first, the user's part runs after it.
+// If one of them is empty, the other one is returned as it is.
+func MergeUserData(driverData string, userData string) string {
+	if strings.TrimSpace(userData) == "" {
+		return driverData
+	}
+	if strings.TrimSpace(driverData) == "" {
+		return userData
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Content-Type: multipart/mixed; boundary=\"" + userDataBoundary + "\"\n")
+	sb.WriteString("MIME-Version: 1.0\n")
+	for i, part := range []string{driverData, userData} {
+		sb.WriteString("\n--" + userDataBoundary + "\n")
+		sb.WriteString("Content-Type: " + GetUserDataContentType(part) + "; charset=\"us-ascii\"\n")
+		sb.WriteString("MIME-Version: 1.0\n")
+		sb.WriteString("Content-Transfer-Encoding: 7bit\n")
+		sb.WriteString(fmt.Sprintf("Content-Disposition: attachment; filename=\"part-%03d\"\n\n", i+1))
+		sb.WriteString(part)
+		if !strings.HasSuffix(part, "\n") {
+			sb.WriteString("\n")
+		}
+	}
+	sb.WriteString("\n--" + userDataBoundary + "--\n")
+	return sb.String()
+}
+
+// NextIPv6SubnetCIDR returns the first /64 block of the VPC IPv6 CIDR not in usedCIDRs.
+func NextIPv6SubnetCIDR(vpcIPv6CIDR string, usedCIDRs []string) (string, error) {
+	_, vpcNet, err := net.ParseCIDR(vpcIPv6CIDR)
+	if err != nil || vpcNet.IP.To4() != nil {
+		return "", fmt.Errorf("invalid VPC IPv6 CIDR: %s", vpcIPv6CIDR)
+	}
+	prefixLen, _ := vpcNet.Mask.Size()
+	if prefixLen > 64 {
+		return "", fmt.Errorf("the VPC IPv6 CIDR %s is smaller than a /64 block", vpcIPv6CIDR)
+	}
+
+	used := map[string]bool{}
+	for _, cidr := range usedCIDRs {
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+			used[ipNet.String()] = true
+		}
+	}
+
+	// the subnet index is in the bits between the VPC prefix and /64
+	bits := 64 - prefixLen
+	if bits > 16 { // enough subnets for a VPC
+		bits = 16
+	}
+	maxCount := uint64(1) << uint(bits)
+	for idx := uint64(0); idx < maxCount; idx++ {
+		ip := make(net.IP, net.IPv6len)
+		copy(ip, vpcNet.IP.To16())
+		hi := binary.BigEndian.Uint64(ip[:8]) | idx
+		binary.BigEndian.PutUint64(ip[:8], hi)
+		subnet := (&net.IPNet{IP: ip, Mask: net.CIDRMask(64, 128)}).String()
+		if !used[subnet] {
+			return subnet, nil
+		}
+	}
+	return "", fmt.Errorf("no available /64 block in the VPC IPv6 CIDR %s", vpcIPv6CIDR)
+}
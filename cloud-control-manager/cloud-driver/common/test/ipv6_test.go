@@ -0,0 +1,31 @@
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"testing"
+
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
+)
+
+func TestNextIPv6SubnetCIDR(t *testing.T) {
+	cidr, err := cdcom.NextIPv6SubnetCIDR("2600:1f18:abcd:1200::/56", []string{"2600:1f18:abcd:1200::/64", "2600:1f18:abcd:1201::/64"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if cidr != "2600:1f18:abcd:1202::/64" {
+		t.Errorf("next /64 block is %s, but expected 2600:1f18:abcd:1202::/64", cidr)
+	}
+
+	if _, err := cdcom.NextIPv6SubnetCIDR("10.0.0.0/16", nil); err == nil {
+		t.Error("NextIPv6SubnetCIDR() with an IPv4 CIDR should fail")
+	}
+	if _, err := cdcom.NextIPv6SubnetCIDR("2600:1f18:abcd:1200::/64", []string{"2600:1f18:abcd:1200::/64"}); err == nil {
+		t.Error("NextIPv6SubnetCIDR() without an available block should fail")
+	}
+}
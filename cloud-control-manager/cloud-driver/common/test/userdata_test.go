@@ -0,0 +1,48 @@
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"strings"
+	"testing"
+
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
+)
+
+func TestGetUserDataContentType(t *testing.T) {
+	for _, tt := range []struct {
+		userData    string
+		contentType string
+	}{
+		{"#!/bin/bash\necho hello", "text/x-shellscript"},
+		{"\n  #cloud-config\npackages: [nginx]", "text/cloud-config"},
+		{"#cloud-boothook\n#!/bin/sh\necho boot", "text/cloud-boothook"},
+		{"echo hello", "text/plain"},
+	} {
+		if got := cdcom.GetUserDataContentType(tt.userData); got != tt.contentType {
+			t.Errorf("GetUserDataContentType(%q) = %s, but expected %s", tt.userData, got, tt.contentType)
+		}
+	}
+}
+
+// the MOCK userdatamaxsize in cloudos_meta.yaml: 16384
+func TestValidateUserDataSize(t *testing.T) {
+	driverData := "#cloud-config\nusers:\n  - name: cb-user\n"
+	userData := "#!/bin/bash\n" + strings.Repeat("#", 16384-len("#!/bin/bash\n"))
+
+	if err := cdcom.ValidateUserDataSize("MOCK", userData); err != nil {
+		t.Fatalf("the user-data of the max size should be valid: %v", err)
+	}
+	// the MIME headers and the driver's init data are added to the user-data
+	if err := cdcom.ValidateUserDataSize("MOCK", cdcom.MergeUserData(driverData, userData)); err == nil {
+		t.Error("the merged user-data over the max size should be invalid")
+	}
+	if err := cdcom.ValidateUserDataSize("MOCK", cdcom.MergeUserData(driverData, "#!/bin/bash\necho hello")); err != nil {
+		t.Errorf("the small merged user-data should be valid: %v", err)
+	}
+}
@@ -0,0 +1,578 @@
+// Driver Plugin of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the CB-Spider side of the DriverHost service.
+// A plugin process is shared by all the connections of the plugin,
+// and it is restarted when it exits unexpectedly.
+//
+// by CB-Spider Team, 2026.10.
+
+//go:generate go run gen/gen_proxy.go
+
+package driverplugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	cblogger "github.com/cloud-barista/cb-log"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+)
+
+const (
+	pluginStartTimeout = 30 * time.Second
+	pluginStopTimeout  = 5 * time.Second
+	// timeout of the calls which do not run a driver method, ex) IsConnected, CloseConnection
+	pluginCallTimeout = 30 * time.Second
+	maxRestartDelay   = 30 * time.Second
+	// the restart delay is reset if a plugin has run longer than this
+	stableRunTime = 1 * time.Minute
+)
+
+var cblog *logrus.Logger
+
+func init() {
+	cblog = cblogger.GetLogger("CLOUD-BARISTA")
+}
+
+var (
+	pluginMap     = map[string]*pluginProcess{} // key: executable path
+	pluginMapLock sync.Mutex
+)
+
+// GetPluginDriver returns the CloudDriver of the plugin executable.
+// The plugin process is started by the first call to the driver.
+func GetPluginDriver(execPath string) (*PluginDriver, error) {
+	execPath, err := filepath.Abs(execPath)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(execPath); err != nil {
+		return nil, err
+	}
+
+	pluginMapLock.Lock()
+	defer pluginMapLock.Unlock()
+
+	proc, ok := pluginMap[execPath]
+	if !ok {
+		proc = &pluginProcess{execPath: execPath}
+		pluginMap[execPath] = proc
+	}
+	return &PluginDriver{proc: proc}, nil
+}
+
+// ShutdownPlugins stops all the plugin processes.
+// It is called when CB-Spider stops, the connections in the plugins are closed with them.
+func ShutdownPlugins() {
+	pluginMapLock.Lock()
+	defer pluginMapLock.Unlock()
+
+	for execPath, proc := range pluginMap {
+		proc.shutdown()
+		delete(pluginMap, execPath)
+	}
+}
+
+//====================================================================
+// plugin process
+
+type pluginProcess struct {
+	execPath string
+
+	lock         sync.Mutex
+	cmd          *exec.Cmd
+	exited       chan struct{} // closed when cmd exits
+	stdin        io.WriteCloser
+	clientConn   *grpc.ClientConn
+	socketDir    string
+	running      bool
+	stopped      bool          // stopped by shutdown(), not restarted
+	starting     chan struct{} // not nil while the plugin is starting, closed when the start is done
+	generation   int           // increased by every start, connection ids are valid only in a generation
+	startedTime  time.Time
+	restartDelay time.Duration
+	restartTime  time.Time // the plugin is not started before this, set by an exit or a failed start
+
+	// driver info of the plugin, loaded once in a generation
+	driverInfo           *DriverInfoResponse
+	driverInfoGeneration int
+}
+
+// client returns the gRPC connection to the running plugin, it starts the plugin if not running.
+// The handshake of the plugin is done without the lock, the other callers wait for its result.
+func (p *pluginProcess) client() (*grpc.ClientConn, int, error) {
+	p.lock.Lock()
+	for p.starting != nil && !p.stopped {
+		starting := p.starting
+		p.lock.Unlock()
+		<-starting
+		p.lock.Lock()
+	}
+
+	if p.stopped {
+		p.lock.Unlock()
+		return nil, 0, fmt.Errorf("plugin %s is stopped", p.execPath)
+	}
+	if p.running {
+		clientConn, generation := p.clientConn, p.generation
+		p.lock.Unlock()
+		return clientConn, generation, nil
+	}
+	if wait := time.Until(p.restartTime); wait > 0 {
+		p.lock.Unlock()
+		return nil, 0, fmt.Errorf("plugin %s is not available, it is restarted in %v", p.execPath, wait.Round(time.Millisecond))
+	}
+
+	starting := make(chan struct{})
+	p.starting = starting
+	p.lock.Unlock()
+
+	launched, err := launchPlugin(p.execPath)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.starting = nil
+	close(starting)
+
+	if err != nil {
+		delay := p.backoff()
+		cblog.Errorf("failed to start the plugin %s: %v, retry after %v", p.execPath, err, delay)
+		return nil, 0, err
+	}
+	if p.stopped {
+		launched.stop()
+		return nil, 0, fmt.Errorf("plugin %s is stopped", p.execPath)
+	}
+
+	p.cmd = launched.cmd
+	p.exited = make(chan struct{})
+	p.stdin = launched.stdin
+	p.clientConn = launched.clientConn
+	p.socketDir = launched.socketDir
+	p.running = true
+	p.generation++
+	p.startedTime = time.Now()
+	cblog.Infof("plugin %s is started(pid: %d)", p.execPath, launched.cmd.Process.Pid)
+
+	go p.supervise(launched, p.exited, p.generation)
+	return p.clientConn, p.generation, nil
+}
+
+// backoff increases the restart delay and sets the next restart time, the lock must be held.
+func (p *pluginProcess) backoff() time.Duration {
+	if p.restartDelay == 0 {
+		p.restartDelay = time.Second
+	} else if p.restartDelay *= 2; p.restartDelay > maxRestartDelay {
+		p.restartDelay = maxRestartDelay
+	}
+	p.restartTime = time.Now().Add(p.restartDelay)
+	return p.restartDelay
+}
+
+// launchedPlugin is a plugin process which has finished its handshake.
+type launchedPlugin struct {
+	cmd        *exec.Cmd
+	stdin      io.WriteCloser
+	clientConn *grpc.ClientConn
+	socketDir  string
+	drained    chan struct{} // closed when the stdout of the plugin is read to the end
+}
+
+// launchPlugin runs the plugin and waits for its handshake.
+func launchPlugin(execPath string) (*launchedPlugin, error) {
+	socketDir, err := os.MkdirTemp("", "spider-plugin-")
+	if err != nil {
+		return nil, err
+	}
+	addr := filepath.Join(socketDir, "plugin.sock")
+
+	cmd := exec.Command(execPath)
+	cmd.Env = append(os.Environ(), ENV_PROTOCOL+"="+PROTOCOL_VERSION, ENV_ADDR+"="+addr)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		os.RemoveAll(socketDir)
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		os.RemoveAll(socketDir)
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(socketDir)
+		return nil, err
+	}
+
+	// the outputs of the driver are logged with the plugin name, ex) [mock-driver-v1.0-grpc] ...
+	pluginName := filepath.Base(execPath)
+	handshake := make(chan string, 1)
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		scanner := bufio.NewScanner(stdout)
+		handshaked := false
+		for scanner.Scan() {
+			line := scanner.Text()
+			if !handshaked && strings.HasPrefix(line, HANDSHAKE_PREFIX) {
+				handshaked = true
+				handshake <- line
+				continue
+			}
+			cblog.Infof("[%s] %s", pluginName, line)
+		}
+		if !handshaked {
+			close(handshake)
+		}
+	}()
+
+	fail := func(err error) (*launchedPlugin, error) {
+		stdin.Close()
+		cmd.Process.Kill()
+		<-drained
+		cmd.Wait()
+		os.RemoveAll(socketDir)
+		return nil, err
+	}
+
+	select {
+	case line, ok := <-handshake:
+		if !ok {
+			return fail(fmt.Errorf("plugin exited before the handshake"))
+		}
+		if line != handshakeLine() {
+			return fail(fmt.Errorf("unsupported plugin handshake: %s, expected: %s", line, handshakeLine()))
+		}
+	case <-time.After(pluginStartTimeout):
+		return fail(fmt.Errorf("plugin did not handshake in %v", pluginStartTimeout))
+	}
+
+	clientConn, err := grpc.NewClient("unix://"+addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(codecName)))
+	if err != nil {
+		return fail(err)
+	}
+
+	return &launchedPlugin{cmd: cmd, stdin: stdin, clientConn: clientConn, socketDir: socketDir, drained: drained}, nil
+}
+
+// stop stops a launched plugin which is not used, ex) it is started while shutdown() is running.
+func (l *launchedPlugin) stop() {
+	l.clientConn.Close()
+	l.stdin.Close() // closing stdin stops the plugin
+	go func() {
+		select {
+		case <-l.drained:
+		case <-time.After(pluginStopTimeout):
+			l.cmd.Process.Kill()
+			<-l.drained
+		}
+		l.cmd.Wait()
+		os.RemoveAll(l.socketDir)
+	}()
+}
+
+// supervise waits for the exit of the plugin and restarts it after the restart delay.
+func (p *pluginProcess) supervise(launched *launchedPlugin, exited chan struct{}, generation int) {
+	// Wait() closes the stdout pipe, so it is called after the stdout is read to the end
+	<-launched.drained
+	err := launched.cmd.Wait()
+	close(exited)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.generation != generation || !p.running {
+		return
+	}
+	p.cleanup()
+	if p.stopped {
+		return
+	}
+
+	if time.Since(p.startedTime) > stableRunTime {
+		p.restartDelay = 0
+	}
+	delay := p.backoff()
+	cblog.Errorf("plugin %s exited unexpectedly(%v), restart in %v", p.execPath, err, delay)
+
+	// client() starts the plugin, and logs the error if it fails
+	time.AfterFunc(delay, func() {
+		p.client()
+	})
+}
+
+// cleanup releases the resources of the exited plugin, the lock must be held.
+func (p *pluginProcess) cleanup() {
+	p.running = false
+	p.clientConn.Close()
+	p.stdin.Close()
+	os.RemoveAll(p.socketDir)
+}
+
+func (p *pluginProcess) shutdown() {
+	p.lock.Lock()
+	p.stopped = true
+	if !p.running {
+		p.lock.Unlock()
+		return
+	}
+	cmd, exited := p.cmd, p.exited
+	p.cleanup() // closing stdin stops the plugin
+	p.lock.Unlock()
+
+	select {
+	case <-exited:
+	case <-time.After(pluginStopTimeout):
+		cmd.Process.Kill()
+	}
+}
+
+// call invokes a method of the DriverHost service.
+func (p *pluginProcess) call(ctx context.Context, method string, req interface{}, res interface{}) (int, error) {
+	clientConn, generation, err := p.client()
+	if err != nil {
+		return 0, err
+	}
+	return generation, clientConn.Invoke(ctx, fullMethod(method), req, res)
+}
+
+//====================================================================
+// CloudDriver
+
+// PluginDriver is a CloudDriver which runs in a plugin process.
+type PluginDriver struct {
+	proc *pluginProcess
+}
+
+// GetDriverInfo returns the version and the capability of the plugin driver.
+// It calls the plugin once in a generation of the plugin process, and returns the cached info after that.
+func (driver *PluginDriver) GetDriverInfo() (DriverInfoResponse, error) {
+	p := driver.proc
+
+	p.lock.Lock()
+	if p.driverInfo != nil && p.running && p.driverInfoGeneration == p.generation {
+		res := *p.driverInfo
+		p.lock.Unlock()
+		return res, nil
+	}
+	p.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+
+	var res DriverInfoResponse
+	generation, err := p.call(ctx, "GetDriverInfo", &Empty{}, &res)
+	if err != nil {
+		err = toDriverError(err)
+		cblog.Error(err)
+		return DriverInfoResponse{}, err
+	}
+
+	p.lock.Lock()
+	p.driverInfo = &res
+	p.driverInfoGeneration = generation
+	p.lock.Unlock()
+	return res, nil
+}
+
+// GetDriverVersion returns "" if the plugin can not be called, use GetDriverInfo() to get the error.
+func (driver *PluginDriver) GetDriverVersion() string {
+	res, _ := driver.GetDriverInfo()
+	return res.Version
+}
+
+// GetDriverCapability returns no capability if the plugin can not be called,
+// use LoadDriverCapability() to get the error.
+func (driver *PluginDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
+	res, _ := driver.GetDriverInfo()
+	return res.Capability
+}
+
+// LoadDriverCapability implements idrv.DriverCapabilityLoader.
+func (driver *PluginDriver) LoadDriverCapability() (idrv.DriverCapabilityInfo, error) {
+	res, err := driver.GetDriverInfo()
+	return res.Capability, err
+}
+
+func (driver *PluginDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
+	conn := &pluginConnection{proc: driver.proc, connectionInfo: connectionInfo}
+	if _, err := conn.getConnectionId(context.Background()); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+//====================================================================
+// CloudConnection
+
+var _ icon.CloudConnection = (*pluginConnection)(nil)
+
+// pluginConnection is a CloudConnection in a plugin process.
+// It connects again with the same ConnectionInfo after the plugin is restarted.
+type pluginConnection struct {
+	proc           *pluginProcess
+	connectionInfo idrv.ConnectionInfo
+
+	lock         sync.Mutex
+	connectionId string
+	generation   int
+}
+
+func (c *pluginConnection) getConnectionId(ctx context.Context) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.proc.lock.Lock()
+	currentGeneration, running := c.proc.generation, c.proc.running
+	c.proc.lock.Unlock()
+
+	if c.connectionId != "" && running && c.generation == currentGeneration {
+		return c.connectionId, nil
+	}
+
+	var res ConnectResponse
+	generation, err := c.proc.call(ctx, "ConnectCloud", &ConnectRequest{ConnectionInfo: c.connectionInfo}, &res)
+	if err != nil {
+		return "", toDriverError(err)
+	}
+	c.connectionId = res.ConnectionId
+	c.generation = generation
+	return c.connectionId, nil
+}
+
+func (c *pluginConnection) resetConnectionId() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.connectionId = ""
+}
+
+// invoke calls method of the handler in the plugin.
+// results are pointers to receive the results of the method except the last error.
+func (c *pluginConnection) invoke(ctx context.Context, handler string, method string, args []interface{}, results ...interface{}) error {
+	rawArgs := []json.RawMessage{}
+	for _, arg := range args {
+		rawArg, err := json.Marshal(arg)
+		if err != nil {
+			return err
+		}
+		rawArgs = append(rawArgs, rawArg)
+	}
+
+	for retry := 0; ; retry++ {
+		connectionId, err := c.getConnectionId(ctx)
+		if err != nil {
+			return err
+		}
+
+		var res InvokeResponse
+		_, err = c.proc.call(ctx, "Invoke", &InvokeRequest{ConnectionId: connectionId, Handler: handler, Method: method, Args: rawArgs}, &res)
+		// the connection was closed by the plugin, the method is not called yet
+		if status.Code(err) == codes.NotFound && retry == 0 {
+			c.resetConnectionId()
+			continue
+		}
+		if err != nil {
+			return toDriverError(err)
+		}
+
+		if len(res.Results) != len(results) {
+			if res.Error != "" {
+				return &DriverError{Code: res.ErrorCode, Message: res.Error}
+			}
+			return fmt.Errorf("%s.%s: %d results are expected, but %d are returned", handler, method, len(results), len(res.Results))
+		}
+		for i, result := range results {
+			if err := json.Unmarshal(res.Results[i], result); err != nil {
+				return err
+			}
+		}
+		if res.Error != "" {
+			return &DriverError{Code: res.ErrorCode, Message: res.Error}
+		}
+		return nil
+	}
+}
+
+func (c *pluginConnection) IsConnected() (bool, error) {
+	c.proc.lock.Lock()
+	running := c.proc.running
+	c.proc.lock.Unlock()
+	if !running {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+
+	connectionId, err := c.getConnectionId(ctx)
+	if err != nil {
+		return false, err
+	}
+	var res IsConnectedResponse
+	if _, err := c.proc.call(ctx, "IsConnected", &ConnectionRequest{ConnectionId: connectionId}, &res); err != nil {
+		return false, toDriverError(err)
+	}
+	if res.Error != "" {
+		return res.Connected, &DriverError{Code: res.ErrorCode, Message: res.Error}
+	}
+	return res.Connected, nil
+}
+
+func (c *pluginConnection) Close() error {
+	c.lock.Lock()
+	connectionId := c.connectionId
+	c.connectionId = ""
+	c.lock.Unlock()
+
+	if connectionId == "" {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+	_, err := c.proc.call(ctx, "CloseConnection", &ConnectionRequest{ConnectionId: connectionId}, &Empty{})
+	return toDriverError(err)
+}
+
+// toDriverError turns a gRPC status into a DriverError with its message.
+func toDriverError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.Unavailable:
+		return fmt.Errorf("driver plugin is not available: %s", st.Message())
+	case codes.Unimplemented:
+		return &DriverError{Code: ERROR_NOT_SUPPORTED, Message: st.Message()}
+	case codes.Canceled:
+		return &DriverError{Code: ERROR_CANCELED, Message: st.Message()}
+	case codes.DeadlineExceeded:
+		return &DriverError{Code: ERROR_DEADLINE_EXCEEDED, Message: st.Message()}
+	}
+	return &DriverError{Code: ERROR_UNKNOWN, Message: st.Message()}
+}
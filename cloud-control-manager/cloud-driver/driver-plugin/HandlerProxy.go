@@ -0,0 +1,1486 @@
+// Code generated by gen/gen_proxy.go; DO NOT EDIT.
+
+package driverplugin
+
+import (
+	"context"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+//================ AnyCallHandler
+
+type anyCallHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.AnyCallHandler = (*anyCallHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateAnyCallHandler() (irs.AnyCallHandler, error) {
+	if err := c.invoke(context.Background(), "AnyCallHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &anyCallHandlerProxy{conn: c}, nil
+}
+
+func (h *anyCallHandlerProxy) AnyCall(arg0 irs.AnyCallInfo) (irs.AnyCallInfo, error) {
+	var ret0 irs.AnyCallInfo
+	err := h.conn.invoke(context.Background(), "AnyCallHandler", "AnyCall", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+//================ ClusterHandler
+
+type clusterHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.ClusterHandler = (*clusterHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateClusterHandler() (irs.ClusterHandler, error) {
+	if err := c.invoke(context.Background(), "ClusterHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &clusterHandlerProxy{conn: c}, nil
+}
+
+func (h *clusterHandlerProxy) AddNodeGroup(arg0 irs.IID, arg1 irs.NodeGroupInfo) (irs.NodeGroupInfo, error) {
+	var ret0 irs.NodeGroupInfo
+	err := h.conn.invoke(context.Background(), "ClusterHandler", "AddNodeGroup", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) ChangeNodeGroupScaling(arg0 irs.IID, arg1 irs.IID, arg2 int, arg3 int, arg4 int) (irs.NodeGroupInfo, error) {
+	var ret0 irs.NodeGroupInfo
+	err := h.conn.invoke(context.Background(), "ClusterHandler", "ChangeNodeGroupScaling", []interface{}{arg0, arg1, arg2, arg3, arg4}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) CreateCluster(arg0 irs.ClusterInfo) (irs.ClusterInfo, error) {
+	var ret0 irs.ClusterInfo
+	err := h.conn.invoke(context.Background(), "ClusterHandler", "CreateCluster", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) DeleteCluster(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "ClusterHandler", "DeleteCluster", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) GenerateClusterToken(arg0 irs.IID) (string, error) {
+	var ret0 string
+	err := h.conn.invoke(context.Background(), "ClusterHandler", "GenerateClusterToken", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) GetCluster(arg0 irs.IID) (irs.ClusterInfo, error) {
+	var ret0 irs.ClusterInfo
+	err := h.conn.invoke(context.Background(), "ClusterHandler", "GetCluster", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) ListCluster() ([]*irs.ClusterInfo, error) {
+	var ret0 []*irs.ClusterInfo
+	err := h.conn.invoke(context.Background(), "ClusterHandler", "ListCluster", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "ClusterHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) RemoveNodeGroup(arg0 irs.IID, arg1 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "ClusterHandler", "RemoveNodeGroup", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) SetNodeGroupAutoScaling(arg0 irs.IID, arg1 irs.IID, arg2 bool) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "ClusterHandler", "SetNodeGroupAutoScaling", []interface{}{arg0, arg1, arg2}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) UpgradeCluster(arg0 irs.IID, arg1 string) (irs.ClusterInfo, error) {
+	var ret0 irs.ClusterInfo
+	err := h.conn.invoke(context.Background(), "ClusterHandler", "UpgradeCluster", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+var _ irs.ClusterHandlerWithContext = (*clusterHandlerProxy)(nil)
+
+func (h *clusterHandlerProxy) AddNodeGroupWithContext(ctx context.Context, arg0 irs.IID, arg1 irs.NodeGroupInfo) (irs.NodeGroupInfo, error) {
+	var ret0 irs.NodeGroupInfo
+	err := h.conn.invoke(ctx, "ClusterHandler", "AddNodeGroup", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) ChangeNodeGroupScalingWithContext(ctx context.Context, arg0 irs.IID, arg1 irs.IID, arg2 int, arg3 int, arg4 int) (irs.NodeGroupInfo, error) {
+	var ret0 irs.NodeGroupInfo
+	err := h.conn.invoke(ctx, "ClusterHandler", "ChangeNodeGroupScaling", []interface{}{arg0, arg1, arg2, arg3, arg4}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) CreateClusterWithContext(ctx context.Context, arg0 irs.ClusterInfo) (irs.ClusterInfo, error) {
+	var ret0 irs.ClusterInfo
+	err := h.conn.invoke(ctx, "ClusterHandler", "CreateCluster", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) DeleteClusterWithContext(ctx context.Context, arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(ctx, "ClusterHandler", "DeleteCluster", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) GenerateClusterTokenWithContext(ctx context.Context, arg0 irs.IID) (string, error) {
+	var ret0 string
+	err := h.conn.invoke(ctx, "ClusterHandler", "GenerateClusterToken", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) GetClusterWithContext(ctx context.Context, arg0 irs.IID) (irs.ClusterInfo, error) {
+	var ret0 irs.ClusterInfo
+	err := h.conn.invoke(ctx, "ClusterHandler", "GetCluster", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) ListClusterWithContext(ctx context.Context) ([]*irs.ClusterInfo, error) {
+	var ret0 []*irs.ClusterInfo
+	err := h.conn.invoke(ctx, "ClusterHandler", "ListCluster", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) ListIIDWithContext(ctx context.Context) ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(ctx, "ClusterHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) RemoveNodeGroupWithContext(ctx context.Context, arg0 irs.IID, arg1 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(ctx, "ClusterHandler", "RemoveNodeGroup", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) SetNodeGroupAutoScalingWithContext(ctx context.Context, arg0 irs.IID, arg1 irs.IID, arg2 bool) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(ctx, "ClusterHandler", "SetNodeGroupAutoScaling", []interface{}{arg0, arg1, arg2}, &ret0)
+	return ret0, err
+}
+
+func (h *clusterHandlerProxy) UpgradeClusterWithContext(ctx context.Context, arg0 irs.IID, arg1 string) (irs.ClusterInfo, error) {
+	var ret0 irs.ClusterInfo
+	err := h.conn.invoke(ctx, "ClusterHandler", "UpgradeCluster", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+//================ DiskHandler
+
+type diskHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.DiskHandler = (*diskHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateDiskHandler() (irs.DiskHandler, error) {
+	if err := c.invoke(context.Background(), "DiskHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &diskHandlerProxy{conn: c}, nil
+}
+
+func (h *diskHandlerProxy) AttachDisk(arg0 irs.IID, arg1 irs.IID) (irs.DiskInfo, error) {
+	var ret0 irs.DiskInfo
+	err := h.conn.invoke(context.Background(), "DiskHandler", "AttachDisk", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) ChangeDiskSize(arg0 irs.IID, arg1 string) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "DiskHandler", "ChangeDiskSize", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) ChangeDiskType(arg0 irs.IID, arg1 string, arg2 string, arg3 string) (irs.DiskInfo, error) {
+	var ret0 irs.DiskInfo
+	err := h.conn.invoke(context.Background(), "DiskHandler", "ChangeDiskType", []interface{}{arg0, arg1, arg2, arg3}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) CreateDisk(arg0 irs.DiskInfo) (irs.DiskInfo, error) {
+	var ret0 irs.DiskInfo
+	err := h.conn.invoke(context.Background(), "DiskHandler", "CreateDisk", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) DeleteDisk(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "DiskHandler", "DeleteDisk", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) DetachDisk(arg0 irs.IID, arg1 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "DiskHandler", "DetachDisk", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) GetDisk(arg0 irs.IID) (irs.DiskInfo, error) {
+	var ret0 irs.DiskInfo
+	err := h.conn.invoke(context.Background(), "DiskHandler", "GetDisk", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) ListDisk() ([]*irs.DiskInfo, error) {
+	var ret0 []*irs.DiskInfo
+	err := h.conn.invoke(context.Background(), "DiskHandler", "ListDisk", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "DiskHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+var _ irs.DiskHandlerWithContext = (*diskHandlerProxy)(nil)
+
+func (h *diskHandlerProxy) AttachDiskWithContext(ctx context.Context, arg0 irs.IID, arg1 irs.IID) (irs.DiskInfo, error) {
+	var ret0 irs.DiskInfo
+	err := h.conn.invoke(ctx, "DiskHandler", "AttachDisk", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) ChangeDiskSizeWithContext(ctx context.Context, arg0 irs.IID, arg1 string) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(ctx, "DiskHandler", "ChangeDiskSize", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) CreateDiskWithContext(ctx context.Context, arg0 irs.DiskInfo) (irs.DiskInfo, error) {
+	var ret0 irs.DiskInfo
+	err := h.conn.invoke(ctx, "DiskHandler", "CreateDisk", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) DeleteDiskWithContext(ctx context.Context, arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(ctx, "DiskHandler", "DeleteDisk", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) DetachDiskWithContext(ctx context.Context, arg0 irs.IID, arg1 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(ctx, "DiskHandler", "DetachDisk", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) GetDiskWithContext(ctx context.Context, arg0 irs.IID) (irs.DiskInfo, error) {
+	var ret0 irs.DiskInfo
+	err := h.conn.invoke(ctx, "DiskHandler", "GetDisk", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) ListDiskWithContext(ctx context.Context) ([]*irs.DiskInfo, error) {
+	var ret0 []*irs.DiskInfo
+	err := h.conn.invoke(ctx, "DiskHandler", "ListDisk", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *diskHandlerProxy) ListIIDWithContext(ctx context.Context) ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(ctx, "DiskHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+//================ DiskSnapshotHandler
+
+type diskSnapshotHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.DiskSnapshotHandler = (*diskSnapshotHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	if err := c.invoke(context.Background(), "DiskSnapshotHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &diskSnapshotHandlerProxy{conn: c}, nil
+}
+
+func (h *diskSnapshotHandlerProxy) CreateDiskFromSnapshot(arg0 irs.IID, arg1 irs.DiskInfo) (irs.DiskInfo, error) {
+	var ret0 irs.DiskInfo
+	err := h.conn.invoke(context.Background(), "DiskSnapshotHandler", "CreateDiskFromSnapshot", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *diskSnapshotHandlerProxy) CreateDiskSnapshot(arg0 irs.DiskSnapshotInfo) (irs.DiskSnapshotInfo, error) {
+	var ret0 irs.DiskSnapshotInfo
+	err := h.conn.invoke(context.Background(), "DiskSnapshotHandler", "CreateDiskSnapshot", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *diskSnapshotHandlerProxy) DeleteDiskSnapshot(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "DiskSnapshotHandler", "DeleteDiskSnapshot", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *diskSnapshotHandlerProxy) GetDiskSnapshot(arg0 irs.IID) (irs.DiskSnapshotInfo, error) {
+	var ret0 irs.DiskSnapshotInfo
+	err := h.conn.invoke(context.Background(), "DiskSnapshotHandler", "GetDiskSnapshot", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *diskSnapshotHandlerProxy) ListDiskSnapshot() ([]*irs.DiskSnapshotInfo, error) {
+	var ret0 []*irs.DiskSnapshotInfo
+	err := h.conn.invoke(context.Background(), "DiskSnapshotHandler", "ListDiskSnapshot", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *diskSnapshotHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "DiskSnapshotHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+//================ FileSystemHandler
+
+type fileSystemHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.FileSystemHandler = (*fileSystemHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateFileSystemHandler() (irs.FileSystemHandler, error) {
+	if err := c.invoke(context.Background(), "FileSystemHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &fileSystemHandlerProxy{conn: c}, nil
+}
+
+func (h *fileSystemHandlerProxy) AddAccessSubnet(arg0 irs.IID, arg1 irs.IID) (irs.FileSystemInfo, error) {
+	var ret0 irs.FileSystemInfo
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "AddAccessSubnet", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) CreateFileSystem(arg0 irs.FileSystemInfo) (irs.FileSystemInfo, error) {
+	var ret0 irs.FileSystemInfo
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "CreateFileSystem", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) DeleteBackup(arg0 irs.IID, arg1 string) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "DeleteBackup", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) DeleteFileSystem(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "DeleteFileSystem", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) GetBackup(arg0 irs.IID, arg1 string) (irs.FileSystemBackupInfo, error) {
+	var ret0 irs.FileSystemBackupInfo
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "GetBackup", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) GetFileSystem(arg0 irs.IID) (irs.FileSystemInfo, error) {
+	var ret0 irs.FileSystemInfo
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "GetFileSystem", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) GetMetaInfo() (irs.FileSystemMetaInfo, error) {
+	var ret0 irs.FileSystemMetaInfo
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "GetMetaInfo", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) ListAccessSubnet(arg0 irs.IID) ([]irs.IID, error) {
+	var ret0 []irs.IID
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "ListAccessSubnet", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) ListBackup(arg0 irs.IID) ([]irs.FileSystemBackupInfo, error) {
+	var ret0 []irs.FileSystemBackupInfo
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "ListBackup", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) ListFileSystem() ([]*irs.FileSystemInfo, error) {
+	var ret0 []*irs.FileSystemInfo
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "ListFileSystem", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) OnDemandBackup(arg0 irs.IID) (irs.FileSystemBackupInfo, error) {
+	var ret0 irs.FileSystemBackupInfo
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "OnDemandBackup", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) RemoveAccessSubnet(arg0 irs.IID, arg1 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "RemoveAccessSubnet", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *fileSystemHandlerProxy) ScheduleBackup(arg0 irs.FileSystemBackupInfo) (irs.FileSystemBackupInfo, error) {
+	var ret0 irs.FileSystemBackupInfo
+	err := h.conn.invoke(context.Background(), "FileSystemHandler", "ScheduleBackup", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+//================ ImageHandler
+
+type imageHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.ImageHandler = (*imageHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateImageHandler() (irs.ImageHandler, error) {
+	if err := c.invoke(context.Background(), "ImageHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &imageHandlerProxy{conn: c}, nil
+}
+
+func (h *imageHandlerProxy) CheckWindowsImage(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "ImageHandler", "CheckWindowsImage", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *imageHandlerProxy) CreateImage(arg0 irs.ImageReqInfo) (irs.ImageInfo, error) {
+	var ret0 irs.ImageInfo
+	err := h.conn.invoke(context.Background(), "ImageHandler", "CreateImage", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *imageHandlerProxy) DeleteImage(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "ImageHandler", "DeleteImage", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *imageHandlerProxy) GetImage(arg0 irs.IID) (irs.ImageInfo, error) {
+	var ret0 irs.ImageInfo
+	err := h.conn.invoke(context.Background(), "ImageHandler", "GetImage", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *imageHandlerProxy) ListImage() ([]*irs.ImageInfo, error) {
+	var ret0 []*irs.ImageInfo
+	err := h.conn.invoke(context.Background(), "ImageHandler", "ListImage", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+//================ KeyPairHandler
+
+type keyPairHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.KeyPairHandler = (*keyPairHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
+	if err := c.invoke(context.Background(), "KeyPairHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &keyPairHandlerProxy{conn: c}, nil
+}
+
+func (h *keyPairHandlerProxy) CreateKey(arg0 irs.KeyPairReqInfo) (irs.KeyPairInfo, error) {
+	var ret0 irs.KeyPairInfo
+	err := h.conn.invoke(context.Background(), "KeyPairHandler", "CreateKey", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *keyPairHandlerProxy) DeleteKey(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "KeyPairHandler", "DeleteKey", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *keyPairHandlerProxy) GetKey(arg0 irs.IID) (irs.KeyPairInfo, error) {
+	var ret0 irs.KeyPairInfo
+	err := h.conn.invoke(context.Background(), "KeyPairHandler", "GetKey", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *keyPairHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "KeyPairHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *keyPairHandlerProxy) ListKey() ([]*irs.KeyPairInfo, error) {
+	var ret0 []*irs.KeyPairInfo
+	err := h.conn.invoke(context.Background(), "KeyPairHandler", "ListKey", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+//================ MyImageHandler
+
+type myImageHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.MyImageHandler = (*myImageHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateMyImageHandler() (irs.MyImageHandler, error) {
+	if err := c.invoke(context.Background(), "MyImageHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &myImageHandlerProxy{conn: c}, nil
+}
+
+func (h *myImageHandlerProxy) CheckWindowsImage(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "MyImageHandler", "CheckWindowsImage", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *myImageHandlerProxy) DeleteMyImage(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "MyImageHandler", "DeleteMyImage", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *myImageHandlerProxy) GetMyImage(arg0 irs.IID) (irs.MyImageInfo, error) {
+	var ret0 irs.MyImageInfo
+	err := h.conn.invoke(context.Background(), "MyImageHandler", "GetMyImage", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *myImageHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "MyImageHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *myImageHandlerProxy) ListMyImage() ([]*irs.MyImageInfo, error) {
+	var ret0 []*irs.MyImageInfo
+	err := h.conn.invoke(context.Background(), "MyImageHandler", "ListMyImage", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *myImageHandlerProxy) SnapshotVM(arg0 irs.MyImageInfo) (irs.MyImageInfo, error) {
+	var ret0 irs.MyImageInfo
+	err := h.conn.invoke(context.Background(), "MyImageHandler", "SnapshotVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+var _ irs.MyImageHandlerWithContext = (*myImageHandlerProxy)(nil)
+
+func (h *myImageHandlerProxy) CheckWindowsImageWithContext(ctx context.Context, arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(ctx, "MyImageHandler", "CheckWindowsImage", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *myImageHandlerProxy) DeleteMyImageWithContext(ctx context.Context, arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(ctx, "MyImageHandler", "DeleteMyImage", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *myImageHandlerProxy) GetMyImageWithContext(ctx context.Context, arg0 irs.IID) (irs.MyImageInfo, error) {
+	var ret0 irs.MyImageInfo
+	err := h.conn.invoke(ctx, "MyImageHandler", "GetMyImage", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *myImageHandlerProxy) ListIIDWithContext(ctx context.Context) ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(ctx, "MyImageHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *myImageHandlerProxy) ListMyImageWithContext(ctx context.Context) ([]*irs.MyImageInfo, error) {
+	var ret0 []*irs.MyImageInfo
+	err := h.conn.invoke(ctx, "MyImageHandler", "ListMyImage", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *myImageHandlerProxy) SnapshotVMWithContext(ctx context.Context, arg0 irs.MyImageInfo) (irs.MyImageInfo, error) {
+	var ret0 irs.MyImageInfo
+	err := h.conn.invoke(ctx, "MyImageHandler", "SnapshotVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+//================ NATGatewayHandler
+
+type natGatewayHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.NATGatewayHandler = (*natGatewayHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	if err := c.invoke(context.Background(), "NATGatewayHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &natGatewayHandlerProxy{conn: c}, nil
+}
+
+func (h *natGatewayHandlerProxy) CreateNATGateway(arg0 irs.NATGatewayInfo) (irs.NATGatewayInfo, error) {
+	var ret0 irs.NATGatewayInfo
+	err := h.conn.invoke(context.Background(), "NATGatewayHandler", "CreateNATGateway", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *natGatewayHandlerProxy) DeleteNATGateway(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "NATGatewayHandler", "DeleteNATGateway", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *natGatewayHandlerProxy) GetNATGateway(arg0 irs.IID) (irs.NATGatewayInfo, error) {
+	var ret0 irs.NATGatewayInfo
+	err := h.conn.invoke(context.Background(), "NATGatewayHandler", "GetNATGateway", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *natGatewayHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "NATGatewayHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *natGatewayHandlerProxy) ListNATGateway() ([]*irs.NATGatewayInfo, error) {
+	var ret0 []*irs.NATGatewayInfo
+	err := h.conn.invoke(context.Background(), "NATGatewayHandler", "ListNATGateway", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+//================ NLBHandler
+
+type nlbHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.NLBHandler = (*nlbHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	if err := c.invoke(context.Background(), "NLBHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &nlbHandlerProxy{conn: c}, nil
+}
+
+func (h *nlbHandlerProxy) AddListener(arg0 irs.IID, arg1 irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	var ret0 irs.NLBInfo
+	err := h.conn.invoke(context.Background(), "NLBHandler", "AddListener", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) AddVMs(arg0 irs.IID, arg1 *[]irs.IID) (irs.VMGroupInfo, error) {
+	var ret0 irs.VMGroupInfo
+	err := h.conn.invoke(context.Background(), "NLBHandler", "AddVMs", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) ChangeHealthCheckerInfo(arg0 irs.IID, arg1 irs.HealthCheckerInfo) (irs.HealthCheckerInfo, error) {
+	var ret0 irs.HealthCheckerInfo
+	err := h.conn.invoke(context.Background(), "NLBHandler", "ChangeHealthCheckerInfo", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) ChangeListener(arg0 irs.IID, arg1 irs.ListenerInfo) (irs.ListenerInfo, error) {
+	var ret0 irs.ListenerInfo
+	err := h.conn.invoke(context.Background(), "NLBHandler", "ChangeListener", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) ChangeVMGroupInfo(arg0 irs.IID, arg1 irs.VMGroupInfo) (irs.VMGroupInfo, error) {
+	var ret0 irs.VMGroupInfo
+	err := h.conn.invoke(context.Background(), "NLBHandler", "ChangeVMGroupInfo", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) CreateNLB(arg0 irs.NLBInfo) (irs.NLBInfo, error) {
+	var ret0 irs.NLBInfo
+	err := h.conn.invoke(context.Background(), "NLBHandler", "CreateNLB", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) DeleteNLB(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "NLBHandler", "DeleteNLB", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) GetNLB(arg0 irs.IID) (irs.NLBInfo, error) {
+	var ret0 irs.NLBInfo
+	err := h.conn.invoke(context.Background(), "NLBHandler", "GetNLB", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) GetVMGroupHealthInfo(arg0 irs.IID) (irs.HealthInfo, error) {
+	var ret0 irs.HealthInfo
+	err := h.conn.invoke(context.Background(), "NLBHandler", "GetVMGroupHealthInfo", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "NLBHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) ListNLB() ([]*irs.NLBInfo, error) {
+	var ret0 []*irs.NLBInfo
+	err := h.conn.invoke(context.Background(), "NLBHandler", "ListNLB", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) RemoveListener(arg0 irs.IID, arg1 irs.ListenerInfo) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "NLBHandler", "RemoveListener", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) RemoveVMs(arg0 irs.IID, arg1 *[]irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "NLBHandler", "RemoveVMs", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+var _ irs.NLBHandlerWithContext = (*nlbHandlerProxy)(nil)
+
+func (h *nlbHandlerProxy) AddVMsWithContext(ctx context.Context, arg0 irs.IID, arg1 *[]irs.IID) (irs.VMGroupInfo, error) {
+	var ret0 irs.VMGroupInfo
+	err := h.conn.invoke(ctx, "NLBHandler", "AddVMs", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) ChangeHealthCheckerInfoWithContext(ctx context.Context, arg0 irs.IID, arg1 irs.HealthCheckerInfo) (irs.HealthCheckerInfo, error) {
+	var ret0 irs.HealthCheckerInfo
+	err := h.conn.invoke(ctx, "NLBHandler", "ChangeHealthCheckerInfo", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) ChangeListenerWithContext(ctx context.Context, arg0 irs.IID, arg1 irs.ListenerInfo) (irs.ListenerInfo, error) {
+	var ret0 irs.ListenerInfo
+	err := h.conn.invoke(ctx, "NLBHandler", "ChangeListener", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) ChangeVMGroupInfoWithContext(ctx context.Context, arg0 irs.IID, arg1 irs.VMGroupInfo) (irs.VMGroupInfo, error) {
+	var ret0 irs.VMGroupInfo
+	err := h.conn.invoke(ctx, "NLBHandler", "ChangeVMGroupInfo", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) CreateNLBWithContext(ctx context.Context, arg0 irs.NLBInfo) (irs.NLBInfo, error) {
+	var ret0 irs.NLBInfo
+	err := h.conn.invoke(ctx, "NLBHandler", "CreateNLB", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) DeleteNLBWithContext(ctx context.Context, arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(ctx, "NLBHandler", "DeleteNLB", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) GetNLBWithContext(ctx context.Context, arg0 irs.IID) (irs.NLBInfo, error) {
+	var ret0 irs.NLBInfo
+	err := h.conn.invoke(ctx, "NLBHandler", "GetNLB", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) GetVMGroupHealthInfoWithContext(ctx context.Context, arg0 irs.IID) (irs.HealthInfo, error) {
+	var ret0 irs.HealthInfo
+	err := h.conn.invoke(ctx, "NLBHandler", "GetVMGroupHealthInfo", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) ListIIDWithContext(ctx context.Context) ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(ctx, "NLBHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) ListNLBWithContext(ctx context.Context) ([]*irs.NLBInfo, error) {
+	var ret0 []*irs.NLBInfo
+	err := h.conn.invoke(ctx, "NLBHandler", "ListNLB", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *nlbHandlerProxy) RemoveVMsWithContext(ctx context.Context, arg0 irs.IID, arg1 *[]irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(ctx, "NLBHandler", "RemoveVMs", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+//================ PriceInfoHandler
+
+type priceInfoHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.PriceInfoHandler = (*priceInfoHandlerProxy)(nil)
+
+func (c *pluginConnection) CreatePriceInfoHandler() (irs.PriceInfoHandler, error) {
+	if err := c.invoke(context.Background(), "PriceInfoHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &priceInfoHandlerProxy{conn: c}, nil
+}
+
+func (h *priceInfoHandlerProxy) GetPriceInfo(arg0 string, arg1 string, arg2 []irs.KeyValue, arg3 bool) (string, error) {
+	var ret0 string
+	err := h.conn.invoke(context.Background(), "PriceInfoHandler", "GetPriceInfo", []interface{}{arg0, arg1, arg2, arg3}, &ret0)
+	return ret0, err
+}
+
+func (h *priceInfoHandlerProxy) ListProductFamily(arg0 string) ([]string, error) {
+	var ret0 []string
+	err := h.conn.invoke(context.Background(), "PriceInfoHandler", "ListProductFamily", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+//================ PublicIPHandler
+
+type publicIPHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.PublicIPHandler = (*publicIPHandlerProxy)(nil)
+
+func (c *pluginConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	if err := c.invoke(context.Background(), "PublicIPHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &publicIPHandlerProxy{conn: c}, nil
+}
+
+func (h *publicIPHandlerProxy) AttachPublicIP(arg0 irs.IID, arg1 irs.IID) (irs.PublicIPInfo, error) {
+	var ret0 irs.PublicIPInfo
+	err := h.conn.invoke(context.Background(), "PublicIPHandler", "AttachPublicIP", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *publicIPHandlerProxy) CreatePublicIP(arg0 irs.PublicIPInfo) (irs.PublicIPInfo, error) {
+	var ret0 irs.PublicIPInfo
+	err := h.conn.invoke(context.Background(), "PublicIPHandler", "CreatePublicIP", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *publicIPHandlerProxy) DeletePublicIP(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "PublicIPHandler", "DeletePublicIP", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *publicIPHandlerProxy) DetachPublicIP(arg0 irs.IID, arg1 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "PublicIPHandler", "DetachPublicIP", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *publicIPHandlerProxy) GetPublicIP(arg0 irs.IID) (irs.PublicIPInfo, error) {
+	var ret0 irs.PublicIPInfo
+	err := h.conn.invoke(context.Background(), "PublicIPHandler", "GetPublicIP", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *publicIPHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "PublicIPHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *publicIPHandlerProxy) ListPublicIP() ([]*irs.PublicIPInfo, error) {
+	var ret0 []*irs.PublicIPInfo
+	err := h.conn.invoke(context.Background(), "PublicIPHandler", "ListPublicIP", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+//================ RegionZoneHandler
+
+type regionZoneHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.RegionZoneHandler = (*regionZoneHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateRegionZoneHandler() (irs.RegionZoneHandler, error) {
+	if err := c.invoke(context.Background(), "RegionZoneHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &regionZoneHandlerProxy{conn: c}, nil
+}
+
+func (h *regionZoneHandlerProxy) GetRegionZone(arg0 string) (irs.RegionZoneInfo, error) {
+	var ret0 irs.RegionZoneInfo
+	err := h.conn.invoke(context.Background(), "RegionZoneHandler", "GetRegionZone", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *regionZoneHandlerProxy) ListOrgRegion() (string, error) {
+	var ret0 string
+	err := h.conn.invoke(context.Background(), "RegionZoneHandler", "ListOrgRegion", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *regionZoneHandlerProxy) ListOrgZone() (string, error) {
+	var ret0 string
+	err := h.conn.invoke(context.Background(), "RegionZoneHandler", "ListOrgZone", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *regionZoneHandlerProxy) ListRegionZone() ([]*irs.RegionZoneInfo, error) {
+	var ret0 []*irs.RegionZoneInfo
+	err := h.conn.invoke(context.Background(), "RegionZoneHandler", "ListRegionZone", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+//================ RouteTableHandler
+
+type routeTableHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.RouteTableHandler = (*routeTableHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	if err := c.invoke(context.Background(), "RouteTableHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &routeTableHandlerProxy{conn: c}, nil
+}
+
+func (h *routeTableHandlerProxy) AddRoutes(arg0 irs.IID, arg1 []irs.RouteInfo) (irs.RouteTableInfo, error) {
+	var ret0 irs.RouteTableInfo
+	err := h.conn.invoke(context.Background(), "RouteTableHandler", "AddRoutes", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *routeTableHandlerProxy) AssociateSubnet(arg0 irs.IID, arg1 irs.IID) (irs.RouteTableInfo, error) {
+	var ret0 irs.RouteTableInfo
+	err := h.conn.invoke(context.Background(), "RouteTableHandler", "AssociateSubnet", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *routeTableHandlerProxy) CreateRouteTable(arg0 irs.RouteTableInfo) (irs.RouteTableInfo, error) {
+	var ret0 irs.RouteTableInfo
+	err := h.conn.invoke(context.Background(), "RouteTableHandler", "CreateRouteTable", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *routeTableHandlerProxy) DeleteRouteTable(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "RouteTableHandler", "DeleteRouteTable", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *routeTableHandlerProxy) DisassociateSubnet(arg0 irs.IID, arg1 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "RouteTableHandler", "DisassociateSubnet", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *routeTableHandlerProxy) GetRouteTable(arg0 irs.IID) (irs.RouteTableInfo, error) {
+	var ret0 irs.RouteTableInfo
+	err := h.conn.invoke(context.Background(), "RouteTableHandler", "GetRouteTable", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *routeTableHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "RouteTableHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *routeTableHandlerProxy) ListRouteTable() ([]*irs.RouteTableInfo, error) {
+	var ret0 []*irs.RouteTableInfo
+	err := h.conn.invoke(context.Background(), "RouteTableHandler", "ListRouteTable", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *routeTableHandlerProxy) RemoveRoutes(arg0 irs.IID, arg1 []irs.RouteInfo) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "RouteTableHandler", "RemoveRoutes", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+//================ SecurityHandler
+
+type securityHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.SecurityHandler = (*securityHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
+	if err := c.invoke(context.Background(), "SecurityHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &securityHandlerProxy{conn: c}, nil
+}
+
+func (h *securityHandlerProxy) AddRules(arg0 irs.IID, arg1 *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	var ret0 irs.SecurityInfo
+	err := h.conn.invoke(context.Background(), "SecurityHandler", "AddRules", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *securityHandlerProxy) CreateSecurity(arg0 irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	var ret0 irs.SecurityInfo
+	err := h.conn.invoke(context.Background(), "SecurityHandler", "CreateSecurity", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *securityHandlerProxy) DeleteSecurity(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "SecurityHandler", "DeleteSecurity", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *securityHandlerProxy) GetSecurity(arg0 irs.IID) (irs.SecurityInfo, error) {
+	var ret0 irs.SecurityInfo
+	err := h.conn.invoke(context.Background(), "SecurityHandler", "GetSecurity", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *securityHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "SecurityHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *securityHandlerProxy) ListSecurity() ([]*irs.SecurityInfo, error) {
+	var ret0 []*irs.SecurityInfo
+	err := h.conn.invoke(context.Background(), "SecurityHandler", "ListSecurity", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *securityHandlerProxy) RemoveRules(arg0 irs.IID, arg1 *[]irs.SecurityRuleInfo) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "SecurityHandler", "RemoveRules", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+//================ TagHandler
+
+type tagHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.TagHandler = (*tagHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateTagHandler() (irs.TagHandler, error) {
+	if err := c.invoke(context.Background(), "TagHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &tagHandlerProxy{conn: c}, nil
+}
+
+func (h *tagHandlerProxy) AddTag(arg0 irs.RSType, arg1 irs.IID, arg2 irs.KeyValue) (irs.KeyValue, error) {
+	var ret0 irs.KeyValue
+	err := h.conn.invoke(context.Background(), "TagHandler", "AddTag", []interface{}{arg0, arg1, arg2}, &ret0)
+	return ret0, err
+}
+
+func (h *tagHandlerProxy) FindTag(arg0 irs.RSType, arg1 string) ([]*irs.TagInfo, error) {
+	var ret0 []*irs.TagInfo
+	err := h.conn.invoke(context.Background(), "TagHandler", "FindTag", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *tagHandlerProxy) GetTag(arg0 irs.RSType, arg1 irs.IID, arg2 string) (irs.KeyValue, error) {
+	var ret0 irs.KeyValue
+	err := h.conn.invoke(context.Background(), "TagHandler", "GetTag", []interface{}{arg0, arg1, arg2}, &ret0)
+	return ret0, err
+}
+
+func (h *tagHandlerProxy) ListTag(arg0 irs.RSType, arg1 irs.IID) ([]irs.KeyValue, error) {
+	var ret0 []irs.KeyValue
+	err := h.conn.invoke(context.Background(), "TagHandler", "ListTag", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *tagHandlerProxy) RemoveTag(arg0 irs.RSType, arg1 irs.IID, arg2 string) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "TagHandler", "RemoveTag", []interface{}{arg0, arg1, arg2}, &ret0)
+	return ret0, err
+}
+
+//================ VMHandler
+
+type vmHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.VMHandler = (*vmHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateVMHandler() (irs.VMHandler, error) {
+	if err := c.invoke(context.Background(), "VMHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &vmHandlerProxy{conn: c}, nil
+}
+
+func (h *vmHandlerProxy) ChangeVMSpec(arg0 irs.IID, arg1 string) (irs.VMInfo, error) {
+	var ret0 irs.VMInfo
+	err := h.conn.invoke(context.Background(), "VMHandler", "ChangeVMSpec", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) GetVM(arg0 irs.IID) (irs.VMInfo, error) {
+	var ret0 irs.VMInfo
+	err := h.conn.invoke(context.Background(), "VMHandler", "GetVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) GetVMStatus(arg0 irs.IID) (irs.VMStatus, error) {
+	var ret0 irs.VMStatus
+	err := h.conn.invoke(context.Background(), "VMHandler", "GetVMStatus", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "VMHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) ListVM() ([]*irs.VMInfo, error) {
+	var ret0 []*irs.VMInfo
+	err := h.conn.invoke(context.Background(), "VMHandler", "ListVM", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) ListVMStatus() ([]*irs.VMStatusInfo, error) {
+	var ret0 []*irs.VMStatusInfo
+	err := h.conn.invoke(context.Background(), "VMHandler", "ListVMStatus", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) RebootVM(arg0 irs.IID) (irs.VMStatus, error) {
+	var ret0 irs.VMStatus
+	err := h.conn.invoke(context.Background(), "VMHandler", "RebootVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) ResumeVM(arg0 irs.IID) (irs.VMStatus, error) {
+	var ret0 irs.VMStatus
+	err := h.conn.invoke(context.Background(), "VMHandler", "ResumeVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) StartVM(arg0 irs.VMReqInfo) (irs.VMInfo, error) {
+	var ret0 irs.VMInfo
+	err := h.conn.invoke(context.Background(), "VMHandler", "StartVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) SuspendVM(arg0 irs.IID) (irs.VMStatus, error) {
+	var ret0 irs.VMStatus
+	err := h.conn.invoke(context.Background(), "VMHandler", "SuspendVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) TerminateVM(arg0 irs.IID) (irs.VMStatus, error) {
+	var ret0 irs.VMStatus
+	err := h.conn.invoke(context.Background(), "VMHandler", "TerminateVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+var _ irs.VMHandlerWithContext = (*vmHandlerProxy)(nil)
+
+func (h *vmHandlerProxy) GetVMStatusWithContext(ctx context.Context, arg0 irs.IID) (irs.VMStatus, error) {
+	var ret0 irs.VMStatus
+	err := h.conn.invoke(ctx, "VMHandler", "GetVMStatus", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) GetVMWithContext(ctx context.Context, arg0 irs.IID) (irs.VMInfo, error) {
+	var ret0 irs.VMInfo
+	err := h.conn.invoke(ctx, "VMHandler", "GetVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) ListIIDWithContext(ctx context.Context) ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(ctx, "VMHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) ListVMStatusWithContext(ctx context.Context) ([]*irs.VMStatusInfo, error) {
+	var ret0 []*irs.VMStatusInfo
+	err := h.conn.invoke(ctx, "VMHandler", "ListVMStatus", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) ListVMWithContext(ctx context.Context) ([]*irs.VMInfo, error) {
+	var ret0 []*irs.VMInfo
+	err := h.conn.invoke(ctx, "VMHandler", "ListVM", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) RebootVMWithContext(ctx context.Context, arg0 irs.IID) (irs.VMStatus, error) {
+	var ret0 irs.VMStatus
+	err := h.conn.invoke(ctx, "VMHandler", "RebootVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) ResumeVMWithContext(ctx context.Context, arg0 irs.IID) (irs.VMStatus, error) {
+	var ret0 irs.VMStatus
+	err := h.conn.invoke(ctx, "VMHandler", "ResumeVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) StartVMWithContext(ctx context.Context, arg0 irs.VMReqInfo) (irs.VMInfo, error) {
+	var ret0 irs.VMInfo
+	err := h.conn.invoke(ctx, "VMHandler", "StartVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) SuspendVMWithContext(ctx context.Context, arg0 irs.IID) (irs.VMStatus, error) {
+	var ret0 irs.VMStatus
+	err := h.conn.invoke(ctx, "VMHandler", "SuspendVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmHandlerProxy) TerminateVMWithContext(ctx context.Context, arg0 irs.IID) (irs.VMStatus, error) {
+	var ret0 irs.VMStatus
+	err := h.conn.invoke(ctx, "VMHandler", "TerminateVM", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+//================ VMSpecHandler
+
+type vmSpecHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.VMSpecHandler = (*vmSpecHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error) {
+	if err := c.invoke(context.Background(), "VMSpecHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &vmSpecHandlerProxy{conn: c}, nil
+}
+
+func (h *vmSpecHandlerProxy) GetOrgVMSpec(arg0 string) (string, error) {
+	var ret0 string
+	err := h.conn.invoke(context.Background(), "VMSpecHandler", "GetOrgVMSpec", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmSpecHandlerProxy) GetVMSpec(arg0 string) (irs.VMSpecInfo, error) {
+	var ret0 irs.VMSpecInfo
+	err := h.conn.invoke(context.Background(), "VMSpecHandler", "GetVMSpec", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vmSpecHandlerProxy) ListOrgVMSpec() (string, error) {
+	var ret0 string
+	err := h.conn.invoke(context.Background(), "VMSpecHandler", "ListOrgVMSpec", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vmSpecHandlerProxy) ListVMSpec() ([]*irs.VMSpecInfo, error) {
+	var ret0 []*irs.VMSpecInfo
+	err := h.conn.invoke(context.Background(), "VMSpecHandler", "ListVMSpec", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+//================ VNicHandler
+
+type vNicHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.VNicHandler = (*vNicHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	if err := c.invoke(context.Background(), "VNicHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &vNicHandlerProxy{conn: c}, nil
+}
+
+func (h *vNicHandlerProxy) AttachVNic(arg0 irs.IID, arg1 irs.IID) (irs.VNicInfo, error) {
+	var ret0 irs.VNicInfo
+	err := h.conn.invoke(context.Background(), "VNicHandler", "AttachVNic", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *vNicHandlerProxy) CreateVNic(arg0 irs.VNicInfo) (irs.VNicInfo, error) {
+	var ret0 irs.VNicInfo
+	err := h.conn.invoke(context.Background(), "VNicHandler", "CreateVNic", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vNicHandlerProxy) DeleteVNic(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "VNicHandler", "DeleteVNic", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vNicHandlerProxy) DetachVNic(arg0 irs.IID, arg1 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "VNicHandler", "DetachVNic", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *vNicHandlerProxy) GetVNic(arg0 irs.IID) (irs.VNicInfo, error) {
+	var ret0 irs.VNicInfo
+	err := h.conn.invoke(context.Background(), "VNicHandler", "GetVNic", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vNicHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "VNicHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vNicHandlerProxy) ListVNic() ([]*irs.VNicInfo, error) {
+	var ret0 []*irs.VNicInfo
+	err := h.conn.invoke(context.Background(), "VNicHandler", "ListVNic", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+//================ VPCHandler
+
+type vpcHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.VPCHandler = (*vpcHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateVPCHandler() (irs.VPCHandler, error) {
+	if err := c.invoke(context.Background(), "VPCHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &vpcHandlerProxy{conn: c}, nil
+}
+
+func (h *vpcHandlerProxy) AddSubnet(arg0 irs.IID, arg1 irs.SubnetInfo) (irs.VPCInfo, error) {
+	var ret0 irs.VPCInfo
+	err := h.conn.invoke(context.Background(), "VPCHandler", "AddSubnet", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcHandlerProxy) CreateVPC(arg0 irs.VPCReqInfo) (irs.VPCInfo, error) {
+	var ret0 irs.VPCInfo
+	err := h.conn.invoke(context.Background(), "VPCHandler", "CreateVPC", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcHandlerProxy) DeleteVPC(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "VPCHandler", "DeleteVPC", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcHandlerProxy) GetVPC(arg0 irs.IID) (irs.VPCInfo, error) {
+	var ret0 irs.VPCInfo
+	err := h.conn.invoke(context.Background(), "VPCHandler", "GetVPC", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "VPCHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcHandlerProxy) ListVPC() ([]*irs.VPCInfo, error) {
+	var ret0 []*irs.VPCInfo
+	err := h.conn.invoke(context.Background(), "VPCHandler", "ListVPC", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcHandlerProxy) RemoveSubnet(arg0 irs.IID, arg1 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "VPCHandler", "RemoveSubnet", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+//================ VPCPeeringHandler
+
+type vpcPeeringHandlerProxy struct {
+	conn *pluginConnection
+}
+
+var _ irs.VPCPeeringHandler = (*vpcPeeringHandlerProxy)(nil)
+
+func (c *pluginConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	if err := c.invoke(context.Background(), "VPCPeeringHandler", "", nil); err != nil {
+		return nil, err
+	}
+	return &vpcPeeringHandlerProxy{conn: c}, nil
+}
+
+func (h *vpcPeeringHandlerProxy) AcceptVPCPeering(arg0 irs.IID) (irs.VPCPeeringInfo, error) {
+	var ret0 irs.VPCPeeringInfo
+	err := h.conn.invoke(context.Background(), "VPCPeeringHandler", "AcceptVPCPeering", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcPeeringHandlerProxy) DeleteVPCPeering(arg0 irs.IID) (bool, error) {
+	var ret0 bool
+	err := h.conn.invoke(context.Background(), "VPCPeeringHandler", "DeleteVPCPeering", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcPeeringHandlerProxy) GetOwnerAccountId() (string, error) {
+	var ret0 string
+	err := h.conn.invoke(context.Background(), "VPCPeeringHandler", "GetOwnerAccountId", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcPeeringHandlerProxy) GetVPCPeering(arg0 irs.IID) (irs.VPCPeeringInfo, error) {
+	var ret0 irs.VPCPeeringInfo
+	err := h.conn.invoke(context.Background(), "VPCPeeringHandler", "GetVPCPeering", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcPeeringHandlerProxy) ListIID() ([]*irs.IID, error) {
+	var ret0 []*irs.IID
+	err := h.conn.invoke(context.Background(), "VPCPeeringHandler", "ListIID", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcPeeringHandlerProxy) ListVPCPeering() ([]*irs.VPCPeeringInfo, error) {
+	var ret0 []*irs.VPCPeeringInfo
+	err := h.conn.invoke(context.Background(), "VPCPeeringHandler", "ListVPCPeering", []interface{}{}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcPeeringHandlerProxy) PropagateRoutes(arg0 irs.IID, arg1 irs.IID) (irs.VPCPeeringInfo, error) {
+	var ret0 irs.VPCPeeringInfo
+	err := h.conn.invoke(context.Background(), "VPCPeeringHandler", "PropagateRoutes", []interface{}{arg0, arg1}, &ret0)
+	return ret0, err
+}
+
+func (h *vpcPeeringHandlerProxy) RequestVPCPeering(arg0 irs.VPCPeeringInfo) (irs.VPCPeeringInfo, error) {
+	var ret0 irs.VPCPeeringInfo
+	err := h.conn.invoke(context.Background(), "VPCPeeringHandler", "RequestVPCPeering", []interface{}{arg0}, &ret0)
+	return ret0, err
+}
@@ -0,0 +1,224 @@
+// Driver Plugin of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the protocol between CB-Spider and an out-of-process driver plugin.
+//
+// A driver plugin is an executable which runs a CloudDriver behind the DriverHost gRPC service.
+//   - CB-Spider starts the plugin with SPIDER_PLUGIN_PROTOCOL and SPIDER_PLUGIN_ADDR(unix socket path).
+//   - The plugin listens on SPIDER_PLUGIN_ADDR and prints the handshake line to stdout.
+//   - The plugin exits when its stdin is closed, ex) CB-Spider is terminated.
+//   - Messages are JSON-encoded, so the driver types of interfaces/resources are used as they are.
+//   - An error of the driver is returned with its message and an error code, see DriverError.
+//
+// by CB-Spider Team, 2026.10.
+
+package driverplugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+)
+
+const (
+	PROTOCOL_VERSION = "1"
+
+	ENV_PROTOCOL = "SPIDER_PLUGIN_PROTOCOL"
+	ENV_ADDR     = "SPIDER_PLUGIN_ADDR"
+
+	// handshake line printed by a plugin: "SPIDER_PLUGIN|{protocol version}|READY"
+	HANDSHAKE_PREFIX = "SPIDER_PLUGIN|"
+)
+
+func handshakeLine() string {
+	return HANDSHAKE_PREFIX + PROTOCOL_VERSION + "|READY"
+}
+
+//====================================================================
+// messages
+
+type Empty struct{}
+
+type DriverInfoResponse struct {
+	Version    string
+	Capability idrv.DriverCapabilityInfo
+}
+
+type ConnectRequest struct {
+	ConnectionInfo idrv.ConnectionInfo
+}
+
+type ConnectionRequest struct {
+	ConnectionId string
+}
+
+type ConnectResponse struct {
+	ConnectionId string
+}
+
+type IsConnectedResponse struct {
+	Connected bool
+	Error     string
+	ErrorCode string
+}
+
+// InvokeRequest calls Method of the Handler of a connection, ex) VMHandler.StartVM.
+// An empty Method only creates the handler to check the error of Create{Handler}().
+type InvokeRequest struct {
+	ConnectionId string
+	Handler      string
+	Method       string
+	Args         []json.RawMessage
+}
+
+// InvokeResponse has all the results of the method except the last error.
+type InvokeResponse struct {
+	Results   []json.RawMessage
+	Error     string
+	ErrorCode string
+}
+
+//====================================================================
+// errors
+
+// codes of a driver error, the message of an error is kept as it is
+const (
+	ERROR_UNKNOWN           = ""
+	ERROR_NOT_FOUND         = "NotFound"
+	ERROR_NOT_SUPPORTED     = "NotSupported"
+	ERROR_CANCELED          = "Canceled"
+	ERROR_DEADLINE_EXCEEDED = "DeadlineExceeded"
+)
+
+// DriverError is an error returned by the driver in a plugin.
+type DriverError struct {
+	Code    string
+	Message string
+}
+
+func (e *DriverError) Error() string {
+	return e.Message
+}
+
+// Is makes errors.Is(err, context.Canceled) and errors.Is(err, context.DeadlineExceeded) work across the plugin.
+func (e *DriverError) Is(target error) bool {
+	switch e.Code {
+	case ERROR_CANCELED:
+		return target == context.Canceled
+	case ERROR_DEADLINE_EXCEEDED:
+		return target == context.DeadlineExceeded
+	}
+	return false
+}
+
+// IsNotFoundError reports whether err is a DriverError of a resource which does not exist.
+func IsNotFoundError(err error) bool {
+	var driverErr *DriverError
+	return errors.As(err, &driverErr) && driverErr.Code == ERROR_NOT_FOUND
+}
+
+// errorCode classifies an error of the driver.
+// A driver error can give its code with an ErrorCode() method, or it is classified by the message.
+func errorCode(err error) string {
+	var coded interface{ ErrorCode() string }
+	switch {
+	case errors.As(err, &coded):
+		return coded.ErrorCode()
+	case errors.Is(err, context.Canceled):
+		return ERROR_CANCELED
+	case errors.Is(err, context.DeadlineExceeded):
+		return ERROR_DEADLINE_EXCEEDED
+	}
+
+	msg := strings.ToLower(strings.ReplaceAll(err.Error(), " ", ""))
+	switch {
+	case strings.Contains(msg, "notfound") || strings.Contains(msg, "notexist"):
+		return ERROR_NOT_FOUND
+	case strings.Contains(msg, "notsupported"):
+		return ERROR_NOT_SUPPORTED
+	}
+	return ERROR_UNKNOWN
+}
+
+//====================================================================
+// JSON codec
+
+const codecName = "spider-json"
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return codecName
+}
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+//====================================================================
+// DriverHost service
+
+const serviceName = "spider.driverplugin.DriverHost"
+
+type driverHostServer interface {
+	GetDriverInfo(ctx context.Context, req *Empty) (*DriverInfoResponse, error)
+	ConnectCloud(ctx context.Context, req *ConnectRequest) (*ConnectResponse, error)
+	IsConnected(ctx context.Context, req *ConnectionRequest) (*IsConnectedResponse, error)
+	CloseConnection(ctx context.Context, req *ConnectionRequest) (*Empty, error)
+	Invoke(ctx context.Context, req *InvokeRequest) (*InvokeResponse, error)
+}
+
+func unaryHandler[Req any, Res any](method string, fn func(srv driverHostServer, ctx context.Context, req *Req) (*Res, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: method,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			req := new(Req)
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return fn(srv.(driverHostServer), ctx, req)
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod(method)}
+			return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return fn(srv.(driverHostServer), ctx, req.(*Req))
+			})
+		},
+	}
+}
+
+var driverHostServiceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*driverHostServer)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryHandler("GetDriverInfo", driverHostServer.GetDriverInfo),
+		unaryHandler("ConnectCloud", driverHostServer.ConnectCloud),
+		unaryHandler("IsConnected", driverHostServer.IsConnected),
+		unaryHandler("CloseConnection", driverHostServer.CloseConnection),
+		unaryHandler("Invoke", driverHostServer.Invoke),
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "driver-plugin/Protocol.go",
+}
+
+func fullMethod(method string) string {
+	return fmt.Sprintf("/%s/%s", serviceName, method)
+}
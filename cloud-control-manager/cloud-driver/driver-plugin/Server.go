@@ -0,0 +1,297 @@
+// Driver Plugin of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the plugin side of the DriverHost service.
+//
+// by CB-Spider Team, 2026.10.
+
+package driverplugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/rs/xid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+)
+
+// a connection which is not used for this time is closed by the plugin
+const connectionIdleTimeout = 1 * time.Hour
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Serve runs driver behind the DriverHost service.
+// It is called by the main() of a driver plugin, ex) drivers/mock-grpc-plugin.
+// It returns when CB-Spider closes the stdin of the plugin.
+func Serve(driver idrv.CloudDriver) error {
+	if os.Getenv(ENV_PROTOCOL) != PROTOCOL_VERSION {
+		return fmt.Errorf("this is a CB-Spider driver plugin(protocol %s), it is started by CB-Spider", PROTOCOL_VERSION)
+	}
+	addr := os.Getenv(ENV_ADDR)
+	if addr == "" {
+		return fmt.Errorf("%s is not set", ENV_ADDR)
+	}
+
+	os.Remove(addr) // stale socket of a crashed plugin
+	listener, err := net.Listen("unix", addr)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(addr)
+
+	server := newHostServer(driver)
+	grpcServer := grpc.NewServer()
+	grpcServer.RegisterService(&driverHostServiceDesc, server)
+
+	// CB-Spider is gone when the stdin is closed
+	go func() {
+		io.Copy(io.Discard, os.Stdin)
+		grpcServer.Stop()
+	}()
+	go server.closeIdleConnections()
+
+	fmt.Println(handshakeLine())
+	return grpcServer.Serve(listener)
+}
+
+type hostConnection struct {
+	lock       sync.Mutex
+	conn       icon.CloudConnection
+	handlerMap map[string]reflect.Value // key: handler name, ex) VMHandler
+	lastUsed   time.Time
+}
+
+type hostServer struct {
+	driver idrv.CloudDriver
+
+	lock    sync.Mutex
+	connMap map[string]*hostConnection // key: connection id
+}
+
+func newHostServer(driver idrv.CloudDriver) *hostServer {
+	return &hostServer{driver: driver, connMap: map[string]*hostConnection{}}
+}
+
+func (s *hostServer) GetDriverInfo(ctx context.Context, req *Empty) (*DriverInfoResponse, error) {
+	return &DriverInfoResponse{
+		Version:    s.driver.GetDriverVersion(),
+		Capability: s.driver.GetDriverCapability(),
+	}, nil
+}
+
+func (s *hostServer) ConnectCloud(ctx context.Context, req *ConnectRequest) (res *ConnectResponse, err error) {
+	defer recoverDriverPanic(&err)
+
+	conn, err := s.driver.ConnectCloud(req.ConnectionInfo)
+	if err != nil {
+		return nil, driverStatusError(err)
+	}
+
+	connectionId := xid.New().String()
+	s.lock.Lock()
+	s.connMap[connectionId] = &hostConnection{conn: conn, handlerMap: map[string]reflect.Value{}, lastUsed: time.Now()}
+	s.lock.Unlock()
+
+	return &ConnectResponse{ConnectionId: connectionId}, nil
+}
+
+func (s *hostServer) IsConnected(ctx context.Context, req *ConnectionRequest) (res *IsConnectedResponse, err error) {
+	defer recoverDriverPanic(&err)
+
+	hostConn, err := s.getConnection(req.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	connected, err := hostConn.conn.IsConnected()
+	res = &IsConnectedResponse{Connected: connected}
+	if err != nil {
+		res.Error, res.ErrorCode = err.Error(), errorCode(err)
+	}
+	return res, nil
+}
+
+func (s *hostServer) CloseConnection(ctx context.Context, req *ConnectionRequest) (res *Empty, err error) {
+	defer recoverDriverPanic(&err)
+
+	s.lock.Lock()
+	hostConn, ok := s.connMap[req.ConnectionId]
+	delete(s.connMap, req.ConnectionId)
+	s.lock.Unlock()
+
+	if ok {
+		hostConn.conn.Close()
+	}
+	return &Empty{}, nil
+}
+
+func (s *hostServer) Invoke(ctx context.Context, req *InvokeRequest) (res *InvokeResponse, err error) {
+	defer recoverDriverPanic(&err)
+
+	hostConn, err := s.getConnection(req.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	handler, err := hostConn.getHandler(req.Handler)
+	if err != nil {
+		return &InvokeResponse{Error: err.Error(), ErrorCode: errorCode(err)}, nil
+	}
+	if req.Method == "" {
+		return &InvokeResponse{}, nil
+	}
+
+	// the context-aware method is used if the driver has it, ex) StartVMWithContext
+	withContext := true
+	method := handler.MethodByName(req.Method + "WithContext")
+	if !method.IsValid() || method.Type().NumIn() == 0 || method.Type().In(0) != contextType {
+		withContext = false
+		method = handler.MethodByName(req.Method)
+	}
+	if !method.IsValid() {
+		return nil, status.Errorf(codes.Unimplemented, "%s.%s is not supported by the driver", req.Handler, req.Method)
+	}
+
+	args, err := decodeArgs(method.Type(), withContext, req.Args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s.%s: %v", req.Handler, req.Method, err)
+	}
+	if withContext {
+		args = append([]reflect.Value{reflect.ValueOf(ctx)}, args...)
+	}
+
+	return encodeResults(method.Call(args))
+}
+
+func (s *hostServer) getConnection(connectionId string) (*hostConnection, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	hostConn, ok := s.connMap[connectionId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "connection %s does not exist", connectionId)
+	}
+	hostConn.lastUsed = time.Now()
+	return hostConn, nil
+}
+
+func (s *hostServer) closeIdleConnections() {
+	for range time.Tick(connectionIdleTimeout / 6) {
+		s.lock.Lock()
+		for connectionId, hostConn := range s.connMap {
+			if time.Since(hostConn.lastUsed) > connectionIdleTimeout {
+				delete(s.connMap, connectionId)
+				go hostConn.conn.Close()
+			}
+		}
+		s.lock.Unlock()
+	}
+}
+
+// getHandler returns the handler made by Create{handlerName}() of the connection.
+func (c *hostConnection) getHandler(handlerName string) (reflect.Value, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if handler, ok := c.handlerMap[handlerName]; ok {
+		return handler, nil
+	}
+
+	// only the Create*Handler() of CloudConnection can be called
+	if _, ok := reflect.TypeOf((*icon.CloudConnection)(nil)).Elem().MethodByName("Create" + handlerName); !ok {
+		return reflect.Value{}, fmt.Errorf("%s is not a handler of CloudConnection", handlerName)
+	}
+
+	outs := reflect.ValueOf(c.conn).MethodByName("Create" + handlerName).Call(nil)
+	if err, _ := outs[1].Interface().(error); err != nil {
+		return reflect.Value{}, err
+	}
+	if outs[0].IsNil() {
+		return reflect.Value{}, &DriverError{Code: ERROR_NOT_SUPPORTED, Message: fmt.Sprintf("%s is not supported by the driver", handlerName)}
+	}
+
+	c.handlerMap[handlerName] = outs[0]
+	return outs[0], nil
+}
+
+func decodeArgs(methodType reflect.Type, withContext bool, rawArgs []json.RawMessage) ([]reflect.Value, error) {
+	first := 0
+	if withContext {
+		first = 1
+	}
+	if methodType.NumIn()-first != len(rawArgs) {
+		return nil, fmt.Errorf("%d arguments are required, but %d are given", methodType.NumIn()-first, len(rawArgs))
+	}
+
+	args := []reflect.Value{}
+	for i, rawArg := range rawArgs {
+		arg := reflect.New(methodType.In(first + i))
+		if err := json.Unmarshal(rawArg, arg.Interface()); err != nil {
+			return nil, err
+		}
+		args = append(args, arg.Elem())
+	}
+	return args, nil
+}
+
+func encodeResults(outs []reflect.Value) (*InvokeResponse, error) {
+	res := &InvokeResponse{}
+	if len(outs) > 0 && outs[len(outs)-1].Type() == errorType {
+		if err, _ := outs[len(outs)-1].Interface().(error); err != nil {
+			res.Error, res.ErrorCode = err.Error(), errorCode(err)
+		}
+		outs = outs[:len(outs)-1]
+	}
+
+	for _, out := range outs {
+		result, err := json.Marshal(out.Interface())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode the result: %v", err)
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+// driverStatusError turns an error of the driver into a gRPC status with the code of the error.
+// A connection failure of the driver, ex) a wrong credential, is FailedPrecondition,
+// not Unavailable which means the plugin itself is not available.
+func driverStatusError(err error) error {
+	code := codes.FailedPrecondition
+	switch errorCode(err) {
+	case ERROR_NOT_FOUND:
+		code = codes.NotFound
+	case ERROR_NOT_SUPPORTED:
+		code = codes.Unimplemented
+	case ERROR_CANCELED:
+		code = codes.Canceled
+	case ERROR_DEADLINE_EXCEEDED:
+		code = codes.DeadlineExceeded
+	}
+	return status.Error(code, err.Error())
+}
+
+// recoverDriverPanic turns a panic of the driver into an error,
+// so a faulty call does not stop the plugin.
+func recoverDriverPanic(err *error) {
+	if r := recover(); r != nil {
+		*err = status.Errorf(codes.Internal, "driver panic: %v", r)
+	}
+}
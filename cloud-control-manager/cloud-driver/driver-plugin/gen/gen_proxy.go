@@ -0,0 +1,157 @@
+//go:build ignore
+
+// Driver Plugin of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// gen_proxy generates HandlerProxy.go, the handler proxies of pluginConnection.
+// Run "go generate" in driver-plugin after changing the handler interfaces.
+//
+// by CB-Spider Team, 2026.10.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"reflect"
+	"strings"
+	"unicode"
+
+	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// context-aware interfaces of handlers, see interfaces/resources/ContextHandler.go
+var contextHandlerMap = map[string]reflect.Type{
+	"VMHandler":      reflect.TypeOf((*irs.VMHandlerWithContext)(nil)).Elem(),
+	"NLBHandler":     reflect.TypeOf((*irs.NLBHandlerWithContext)(nil)).Elem(),
+	"ClusterHandler": reflect.TypeOf((*irs.ClusterHandlerWithContext)(nil)).Elem(),
+	"DiskHandler":    reflect.TypeOf((*irs.DiskHandlerWithContext)(nil)).Elem(),
+	"MyImageHandler": reflect.TypeOf((*irs.MyImageHandlerWithContext)(nil)).Elem(),
+}
+
+func typeName(t reflect.Type) string {
+	return strings.ReplaceAll(t.String(), "resources.", "irs.")
+}
+
+func proxyName(handlerName string) string {
+	runes := []rune(handlerName)
+	// ex) VMHandler => vmHandlerProxy, NLBHandler => nlbHandlerProxy
+	i := 0
+	for i < len(runes) && unicode.IsUpper(runes[i]) && (i+1 == len(runes) || unicode.IsUpper(runes[i+1])) {
+		runes[i] = unicode.ToLower(runes[i])
+		i++
+	}
+	if i == 0 {
+		runes[0] = unicode.ToLower(runes[0])
+	}
+	return string(runes) + "Proxy"
+}
+
+// writeMethod writes a proxy method, withContext is true for a *WithContext method.
+func writeMethod(buf *bytes.Buffer, handlerName string, methodName string, methodType reflect.Type, withContext bool) {
+	proxy := proxyName(handlerName)
+
+	first := 0
+	params := []string{}
+	if withContext {
+		first = 1
+		params = append(params, "ctx context.Context")
+	}
+	args := []string{}
+	for i := first; i < methodType.NumIn(); i++ {
+		arg := fmt.Sprintf("arg%d", i-first)
+		params = append(params, arg+" "+typeName(methodType.In(i)))
+		args = append(args, arg)
+	}
+
+	outs := []string{}
+	for i := 0; i < methodType.NumOut(); i++ {
+		outs = append(outs, typeName(methodType.Out(i)))
+	}
+
+	invokeName := strings.TrimSuffix(methodName, "WithContext")
+	ctx := "context.Background()"
+	if withContext {
+		ctx = "ctx"
+	}
+
+	fmt.Fprintf(buf, "func (h *%s) %s(%s) (%s) {\n", proxy, methodName, strings.Join(params, ", "), strings.Join(outs, ", "))
+	rets := []string{}
+	for i := 0; i < methodType.NumOut()-1; i++ {
+		fmt.Fprintf(buf, "\tvar ret%d %s\n", i, typeName(methodType.Out(i)))
+		rets = append(rets, fmt.Sprintf("ret%d", i))
+	}
+	results := ""
+	for _, ret := range rets {
+		results += ", &" + ret
+	}
+	fmt.Fprintf(buf, "\terr := h.conn.invoke(%s, %q, %q, []interface{}{%s}%s)\n", ctx, handlerName, invokeName, strings.Join(args, ", "), results)
+	fmt.Fprintf(buf, "\treturn %s\n}\n\n", strings.Join(append(rets, "err"), ", "))
+}
+
+func main() {
+	var buf bytes.Buffer
+
+	buf.WriteString(`// Code generated by gen/gen_proxy.go; DO NOT EDIT.
+
+package driverplugin
+
+import (
+	"context"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+`)
+
+	connType := reflect.TypeOf((*icon.CloudConnection)(nil)).Elem()
+	for i := 0; i < connType.NumMethod(); i++ {
+		createMethod := connType.Method(i)
+		if !strings.HasPrefix(createMethod.Name, "Create") {
+			continue
+		}
+		handlerType := createMethod.Type.Out(0)
+		handlerName := handlerType.Name()
+		proxy := proxyName(handlerName)
+
+		fmt.Fprintf(&buf, "//================ %s\n\n", handlerName)
+		fmt.Fprintf(&buf, "type %s struct {\n\tconn *pluginConnection\n}\n\n", proxy)
+		fmt.Fprintf(&buf, "var _ irs.%s = (*%s)(nil)\n\n", handlerName, proxy)
+
+		fmt.Fprintf(&buf, "func (c *pluginConnection) %s() (irs.%s, error) {\n", createMethod.Name, handlerName)
+		fmt.Fprintf(&buf, "\tif err := c.invoke(context.Background(), %q, \"\", nil); err != nil {\n\t\treturn nil, err\n\t}\n", handlerName)
+		fmt.Fprintf(&buf, "\treturn &%s{conn: c}, nil\n}\n\n", proxy)
+
+		for j := 0; j < handlerType.NumMethod(); j++ {
+			method := handlerType.Method(j)
+			writeMethod(&buf, handlerName, method.Name, method.Type, false)
+		}
+
+		if ctxType, ok := contextHandlerMap[handlerName]; ok {
+			fmt.Fprintf(&buf, "var _ irs.%s = (*%s)(nil)\n\n", ctxType.Name(), proxy)
+			for j := 0; j < ctxType.NumMethod(); j++ {
+				method := ctxType.Method(j)
+				if !strings.HasSuffix(method.Name, "WithContext") {
+					continue
+				}
+				writeMethod(&buf, handlerName, method.Name, method.Type, true)
+			}
+		}
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile("HandlerProxy.go", src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,112 @@
+// Driver Plugin Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package driverplugintest
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	cblog "github.com/cloud-barista/cb-log"
+
+	driverplugin "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/driver-plugin"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func buildMockPlugin(t *testing.T) string {
+	pluginPath := filepath.Join(t.TempDir(), "mock-driver-v1.0-grpc")
+	out, err := exec.Command("go", "build", "-o", pluginPath, "../../drivers/mock-grpc-plugin").CombinedOutput()
+	if err != nil {
+		t.Fatalf("failed to build the mock plugin: %v\n%s", err, out)
+	}
+	return pluginPath
+}
+
+func TestMockPlugin(t *testing.T) {
+	cblog.SetLevel("error")
+
+	pluginPath := buildMockPlugin(t)
+	t.Cleanup(driverplugin.ShutdownPlugins)
+
+	driver, err := driverplugin.GetPluginDriver(pluginPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if driver.GetDriverVersion() == "" {
+		t.Fatal("driver version is empty")
+	}
+
+	conn, err := driver.ConnectCloud(idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{MockName: "PluginTest"},
+		RegionInfo:     idrv.RegionInfo{Region: "default"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpcHandler, err := conn.CreateVPCHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// (1) calls through the plugin
+	vpcInfo, err := vpcHandler.CreateVPC(irs.VPCReqInfo{
+		IId:            irs.IID{NameId: "plugin-vpc"},
+		IPv4_CIDR:      "10.0.0.0/16",
+		SubnetInfoList: []irs.SubnetInfo{{IId: irs.IID{NameId: "plugin-subnet"}, IPv4_CIDR: "10.0.1.0/24"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vpcInfo.IId.SystemId != "plugin-vpc" || len(vpcInfo.SubnetInfoList) != 1 {
+		t.Fatalf("unexpected VPC: %+v", vpcInfo)
+	}
+	vpcList, err := vpcHandler.ListVPC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vpcList) != 1 {
+		t.Fatalf("1 VPC is expected, but %d", len(vpcList))
+	}
+
+	// (2) an error of the driver is returned with its message and code
+	_, err = vpcHandler.GetVPC(irs.IID{NameId: "not-exist", SystemId: "not-exist"})
+	if err == nil {
+		t.Fatal("GetVPC of a not existing VPC should fail")
+	}
+	if !driverplugin.IsNotFoundError(err) || !strings.Contains(err.Error(), "not-exist VPC does not exist") {
+		t.Fatalf("a NotFound error with the message of the driver is expected: %#v", err)
+	}
+
+	// (3) the plugin is restarted after a crash, and the connection is connected again
+	if err := exec.Command("pkill", "-KILL", "-f", pluginPath).Run(); err != nil {
+		t.Fatalf("failed to kill the plugin: %v", err)
+	}
+	time.Sleep(500 * time.Millisecond)
+
+	// a call in the restart delay fails at once, it does not start the plugin
+	if _, err := vpcHandler.ListVPC(); err == nil || !strings.Contains(err.Error(), "restarted in") {
+		t.Fatalf("a call in the restart delay should fail with the restart delay: %v", err)
+	}
+	time.Sleep(2 * time.Second)
+
+	vpcList, err = vpcHandler.ListVPC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the Mock driver keeps its resources in memory, they are gone with the crashed plugin
+	if len(vpcList) != 0 {
+		t.Fatalf("no VPC is expected after the restart, but %d", len(vpcList))
+	}
+	if connected, err := conn.IsConnected(); err != nil || !connected {
+		t.Fatalf("the connection should be connected again: %v, %v", connected, err)
+	}
+}
@@ -11,6 +11,7 @@
 package connect
 
 import (
+	"errors"
 	cs2015 "github.com/alibabacloud-go/cs-20151215/v4/client"
 	ecs2014 "github.com/alibabacloud-go/ecs-20140526/v4/client"
 	vpc2016 "github.com/alibabacloud-go/vpc-20160428/v6/client"
@@ -188,3 +189,27 @@ func (cloudConn *AlibabaCloudConnection) CreateTagHandler() (irs.TagHandler, err
 	handler := alirs.AlibabaTagHandler{cloudConn.Region, cloudConn.VMClient, cloudConn.Cs2015Client, cloudConn.VpcClient, cloudConn.NLBClient, cloudConn.NasClient}
 	return &handler, nil
 }
+
+func (cloudConn *AlibabaCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return nil, errors.New("Alibaba Cloud Driver does not support VNicHandler yet.")
+}
+
+func (cloudConn *AlibabaCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	return nil, errors.New("Alibaba Cloud Driver does not support PublicIPHandler yet.")
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("Alibaba Cloud Driver does not support VPCPeeringHandler yet.")
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("Alibaba Cloud Driver does not support RouteTableHandler yet.")
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("Alibaba Cloud Driver does not support NATGatewayHandler yet.")
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("Alibaba Cloud Driver does not support DiskSnapshotHandler yet.")
+}
@@ -317,6 +317,10 @@ func (diskHandler *AlibabaDiskHandler) ChangeDiskSize(diskIID irs.IID, size stri
 	return true, nil
 }
 
+func (diskHandler *AlibabaDiskHandler) ChangeDiskType(diskIID irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	return irs.DiskInfo{}, errors.New("Alibaba Cloud Driver does not support ChangeDiskType yet.")
+}
+
 func (diskHandler *AlibabaDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 	hiscallInfo := GetCallLogScheme(diskHandler.Region, call.DISK, diskIID.NameId, "DeleteDisk()")
 	start := call.Start()
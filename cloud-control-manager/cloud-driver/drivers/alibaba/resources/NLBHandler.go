@@ -395,6 +395,14 @@ func (NLBHandler *AlibabaNLBHandler) ChangeListener(nlbIID irs.IID, listener irs
 	return irs.ListenerInfo{}, errors.New("ALIBABA_CANNOT_CHANGE_LISTENER")
 }
 
+func (NLBHandler *AlibabaNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	return irs.NLBInfo{}, errors.New("Alibaba Cloud Driver does not support AddListener yet.")
+}
+
+func (NLBHandler *AlibabaNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	return false, errors.New("Alibaba Cloud Driver does not support RemoveListener yet.")
+}
+
 // ------ Backend Control
 func (NLBHandler *AlibabaNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup irs.VMGroupInfo) (irs.VMGroupInfo, error) {
 	return irs.VMGroupInfo{}, errors.New("ALIBABA_CANNOT_CHANGE_VMGROUP")
@@ -171,6 +171,13 @@ func (vmHandler *AlibabaVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 	userData := string(fileDataCloudInit)
 	//userData = strings.ReplaceAll(userData, "{{username}}", CBDefaultVmUserName)
 	//userData = strings.ReplaceAll(userData, "{{public_key}}", keyPairInfo.PublicKey)
+	// append user's user-data(script or cloud-config) after cb-user setup
+	userData = cdcom.MergeUserData(userData, vmReqInfo.UserData)
+	err = cdcom.ValidateUserDataSize("ALIBABA", userData)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
 	userDataBase64 := base64.StdEncoding.EncodeToString([]byte(userData))
 	cblogger.Debugf("cloud-init data : [%s]", userDataBase64)
 
@@ -829,6 +836,10 @@ func (vmHandler *AlibabaVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, err
 	return irs.VMStatus("Terminating"), nil
 }
 
+func (vmHandler *AlibabaVMHandler) ChangeVMSpec(vmIID irs.IID, specName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, errors.New("Alibaba Cloud Driver does not support ChangeVMSpec yet.")
+}
+
 func (vmHandler *AlibabaVMHandler) GetVM(vmIID irs.IID) (irs.VMInfo, error) {
 	cblogger.Infof("vmID : [%s]", vmIID.SystemId)
 
@@ -11,6 +11,8 @@
 package connect
 
 import (
+	"errors"
+
 	cblog "github.com/cloud-barista/cb-log"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 
@@ -217,3 +219,31 @@ func (cloudConn *AwsCloudConnection) CreatePriceInfoHandler() (irs.PriceInfoHand
 	handler := ars.AwsPriceInfoHandler{Region: cloudConn.Region, Client: cloudConn.PriceInfoClient}
 	return &handler, nil
 }
+
+func (cloudConn *AwsCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsVNicHandler{Region: cloudConn.Region, Client: cloudConn.VNetworkClient}
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsPublicIPHandler{Region: cloudConn.Region, Client: cloudConn.VNetworkClient}
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("AWS Driver does not support VPCPeeringHandler yet.")
+}
+
+func (cloudConn *AwsCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("AWS Driver does not support RouteTableHandler yet.")
+}
+
+func (cloudConn *AwsCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("AWS Driver does not support NATGatewayHandler yet.")
+}
+
+func (cloudConn *AwsCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("AWS Driver does not support DiskSnapshotHandler yet.")
+}
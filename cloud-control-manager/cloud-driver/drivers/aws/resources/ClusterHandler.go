@@ -31,6 +31,8 @@ type AwsClusterHandler struct {
 	StsClient   *sts.STS
 	AutoScaling *autoscaling.AutoScaling
 	TagHandler  *AwsTagHandler // 2024-07-18 TagHandler add
+
+	ctx context.Context // request context set by withContext(), nil: no cancel
 }
 
 const (
@@ -248,7 +250,7 @@ func (ClusterHandler *AwsClusterHandler) WaitUntilNodegroupActive(clusterName st
 		NodegroupName: aws.String(nodegroupName),
 	}
 
-	err := ClusterHandler.Client.WaitUntilNodegroupActive(input)
+	err := ClusterHandler.Client.WaitUntilNodegroupActiveWithContext(requestContext(ClusterHandler.ctx), input)
 	if err != nil {
 		cblogger.Errorf("failed to wait until Nodegroup Active : %v", err)
 		return err
@@ -265,7 +267,7 @@ func (ClusterHandler *AwsClusterHandler) WaitUntilClusterActive(clusterName stri
 	}
 
 	// AWS SDK의 WaitUntilClusterActive 함수는 내부적으로 폴링(polling) 메커니즘을 구현
-	err := ClusterHandler.Client.WaitUntilClusterActive(input)
+	err := ClusterHandler.Client.WaitUntilClusterActiveWithContext(requestContext(ClusterHandler.ctx), input)
 	if err != nil {
 		cblogger.Errorf("failed to wait until cluster Active: %v", err)
 		return err
@@ -1270,7 +1272,10 @@ func (ClusterHandler *AwsClusterHandler) UpgradeCluster(clusterIID irs.IID, newV
 
 		// [추가] Control Plane 업그레이드 후 추가 대기 시간
 		cblogger.Info("Control plane marked as ACTIVE, waiting additional time for version propagation...")
-		time.Sleep(120 * time.Second) // 2분 대기
+		if err := aws.SleepWithContext(requestContext(ClusterHandler.ctx), 120*time.Second); err != nil { // 2분 대기
+			cblogger.Error(err)
+			return irs.ClusterInfo{}, err
+		}
 
 		// [추가] Control Plane 버전 재확인
 		checkInput := &eks.DescribeClusterInput{
@@ -1314,7 +1319,10 @@ func (ClusterHandler *AwsClusterHandler) UpgradeCluster(clusterIID irs.IID, newV
 
 		cblogger.Infof("Cluster is in %s state, waiting %d seconds before retrying (%d/%d)",
 			clusterStatus, currentInterval, i+1, maxRetries)
-		time.Sleep(time.Duration(currentInterval) * time.Second)
+		if err := aws.SleepWithContext(requestContext(ClusterHandler.ctx), time.Duration(currentInterval)*time.Second); err != nil {
+			cblogger.Error(err)
+			return irs.ClusterInfo{}, err
+		}
 
 		// 지수 백오프 적용
 		currentInterval = int(float64(currentInterval) * backoffFactor)
@@ -1375,7 +1383,10 @@ func (ClusterHandler *AwsClusterHandler) UpgradeCluster(clusterIID irs.IID, newV
 					retryDelay := (retry + 1) * 60 // 점진적 증가: 60초, 120초, ...
 					cblogger.Infof("Retrying node group upgrade in %d seconds (%d/%d)",
 						retryDelay, retry+1, maxNodeGroupRetries)
-					time.Sleep(time.Duration(retryDelay) * time.Second)
+					if err := aws.SleepWithContext(requestContext(ClusterHandler.ctx), time.Duration(retryDelay)*time.Second); err != nil {
+						cblogger.Error(err)
+						return irs.ClusterInfo{}, err
+					}
 				}
 			}
 
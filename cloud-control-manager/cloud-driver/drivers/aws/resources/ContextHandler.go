@@ -0,0 +1,341 @@
+// AWS Driver of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the context-aware handlers of the AWS Driver,
+// they send the API calls of a handler with the request context.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// newClientWithContext returns a copy of c that sends every request with ctx.
+// When ctx is done, an API call in progress is aborted and no new API call is sent,
+// so a waiter such as WaitUntilInstanceRunning stops at its next poll.
+// A request with its own context, such as a timeout, is canceled by either of them.
+func newClientWithContext(ctx context.Context, c *client.Client) *client.Client {
+	ctxClient := *c
+	ctxClient.Handlers = c.Handlers.Copy()
+	ctxClient.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "spider.RequestContextHandler",
+		Fn: func(r *request.Request) {
+			if r.Context() == aws.BackgroundContext() {
+				r.SetContext(ctx)
+				return
+			}
+			merged, cancel := context.WithCancel(r.Context())
+			stop := context.AfterFunc(ctx, cancel)
+			r.Handlers.Complete.PushBack(func(*request.Request) {
+				stop()
+				cancel()
+			})
+			r.SetContext(merged)
+		},
+	})
+	return &ctxClient
+}
+
+// requestContext returns the request context of a handler, or the background context if it has none.
+func requestContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	return ctx
+}
+
+func newEC2ClientWithContext(ctx context.Context, svc *ec2.EC2) *ec2.EC2 {
+	if svc == nil {
+		return nil
+	}
+	return &ec2.EC2{Client: newClientWithContext(ctx, svc.Client)}
+}
+
+func newTagHandlerWithContext(ctx context.Context, tagHandler *AwsTagHandler) *AwsTagHandler {
+	if tagHandler == nil {
+		return nil
+	}
+	ctxTagHandler := *tagHandler
+	ctxTagHandler.Client = newEC2ClientWithContext(ctx, tagHandler.Client)
+	return &ctxTagHandler
+}
+
+// -------- VMHandlerWithContext
+
+func (vmHandler *AwsVMHandler) withContext(ctx context.Context) *AwsVMHandler {
+	ctxHandler := *vmHandler
+	ctxHandler.ctx = ctx
+	ctxHandler.orgHandler = vmHandler.withoutContext()
+	ctxHandler.Client = newEC2ClientWithContext(ctx, vmHandler.Client)
+	ctxHandler.TagHandler = newTagHandlerWithContext(ctx, vmHandler.TagHandler)
+	return &ctxHandler
+}
+
+// withoutContext returns the handler without the request context.
+// It is used for the steps which must be completed even if the request is canceled.
+func (vmHandler *AwsVMHandler) withoutContext() *AwsVMHandler {
+	if vmHandler.orgHandler == nil {
+		return vmHandler
+	}
+	return vmHandler.orgHandler
+}
+
+func (vmHandler *AwsVMHandler) ListIIDWithContext(ctx context.Context) ([]*irs.IID, error) {
+	return vmHandler.withContext(ctx).ListIID()
+}
+
+func (vmHandler *AwsVMHandler) StartVMWithContext(ctx context.Context, vmReqInfo irs.VMReqInfo) (irs.VMInfo, error) {
+	return vmHandler.withContext(ctx).StartVM(vmReqInfo)
+}
+
+func (vmHandler *AwsVMHandler) SuspendVMWithContext(ctx context.Context, vmIID irs.IID) (irs.VMStatus, error) {
+	return vmHandler.withContext(ctx).SuspendVM(vmIID)
+}
+
+func (vmHandler *AwsVMHandler) ResumeVMWithContext(ctx context.Context, vmIID irs.IID) (irs.VMStatus, error) {
+	return vmHandler.withContext(ctx).ResumeVM(vmIID)
+}
+
+func (vmHandler *AwsVMHandler) RebootVMWithContext(ctx context.Context, vmIID irs.IID) (irs.VMStatus, error) {
+	return vmHandler.withContext(ctx).RebootVM(vmIID)
+}
+
+func (vmHandler *AwsVMHandler) TerminateVMWithContext(ctx context.Context, vmIID irs.IID) (irs.VMStatus, error) {
+	return vmHandler.withContext(ctx).TerminateVM(vmIID)
+}
+
+func (vmHandler *AwsVMHandler) ListVMStatusWithContext(ctx context.Context) ([]*irs.VMStatusInfo, error) {
+	return vmHandler.withContext(ctx).ListVMStatus()
+}
+
+func (vmHandler *AwsVMHandler) GetVMStatusWithContext(ctx context.Context, vmIID irs.IID) (irs.VMStatus, error) {
+	return vmHandler.withContext(ctx).GetVMStatus(vmIID)
+}
+
+func (vmHandler *AwsVMHandler) ListVMWithContext(ctx context.Context) ([]*irs.VMInfo, error) {
+	return vmHandler.withContext(ctx).ListVM()
+}
+
+func (vmHandler *AwsVMHandler) GetVMWithContext(ctx context.Context, vmIID irs.IID) (irs.VMInfo, error) {
+	return vmHandler.withContext(ctx).GetVM(vmIID)
+}
+
+// -------- DiskHandlerWithContext
+
+func (DiskHandler *AwsDiskHandler) withContext(ctx context.Context) *AwsDiskHandler {
+	ctxHandler := *DiskHandler
+	ctxHandler.Client = newEC2ClientWithContext(ctx, DiskHandler.Client)
+	ctxHandler.TagHandler = newTagHandlerWithContext(ctx, DiskHandler.TagHandler)
+	return &ctxHandler
+}
+
+func (DiskHandler *AwsDiskHandler) ListIIDWithContext(ctx context.Context) ([]*irs.IID, error) {
+	return DiskHandler.withContext(ctx).ListIID()
+}
+
+func (DiskHandler *AwsDiskHandler) CreateDiskWithContext(ctx context.Context, diskReqInfo irs.DiskInfo) (irs.DiskInfo, error) {
+	return DiskHandler.withContext(ctx).CreateDisk(diskReqInfo)
+}
+
+func (DiskHandler *AwsDiskHandler) ListDiskWithContext(ctx context.Context) ([]*irs.DiskInfo, error) {
+	return DiskHandler.withContext(ctx).ListDisk()
+}
+
+func (DiskHandler *AwsDiskHandler) GetDiskWithContext(ctx context.Context, diskIID irs.IID) (irs.DiskInfo, error) {
+	return DiskHandler.withContext(ctx).GetDisk(diskIID)
+}
+
+func (DiskHandler *AwsDiskHandler) ChangeDiskSizeWithContext(ctx context.Context, diskIID irs.IID, size string) (bool, error) {
+	return DiskHandler.withContext(ctx).ChangeDiskSize(diskIID, size)
+}
+
+func (DiskHandler *AwsDiskHandler) DeleteDiskWithContext(ctx context.Context, diskIID irs.IID) (bool, error) {
+	return DiskHandler.withContext(ctx).DeleteDisk(diskIID)
+}
+
+func (DiskHandler *AwsDiskHandler) AttachDiskWithContext(ctx context.Context, diskIID irs.IID, ownerVM irs.IID) (irs.DiskInfo, error) {
+	return DiskHandler.withContext(ctx).AttachDisk(diskIID, ownerVM)
+}
+
+func (DiskHandler *AwsDiskHandler) DetachDiskWithContext(ctx context.Context, diskIID irs.IID, ownerVM irs.IID) (bool, error) {
+	return DiskHandler.withContext(ctx).DetachDisk(diskIID, ownerVM)
+}
+
+// -------- MyImageHandlerWithContext
+
+func (ImageHandler *AwsMyImageHandler) withContext(ctx context.Context) *AwsMyImageHandler {
+	ctxHandler := *ImageHandler
+	ctxHandler.Client = newEC2ClientWithContext(ctx, ImageHandler.Client)
+	ctxHandler.TagHandler = newTagHandlerWithContext(ctx, ImageHandler.TagHandler)
+	return &ctxHandler
+}
+
+func (ImageHandler *AwsMyImageHandler) SnapshotVMWithContext(ctx context.Context, snapshotReqInfo irs.MyImageInfo) (irs.MyImageInfo, error) {
+	return ImageHandler.withContext(ctx).SnapshotVM(snapshotReqInfo)
+}
+
+func (ImageHandler *AwsMyImageHandler) ListIIDWithContext(ctx context.Context) ([]*irs.IID, error) {
+	return ImageHandler.withContext(ctx).ListIID()
+}
+
+func (ImageHandler *AwsMyImageHandler) ListMyImageWithContext(ctx context.Context) ([]*irs.MyImageInfo, error) {
+	return ImageHandler.withContext(ctx).ListMyImage()
+}
+
+func (ImageHandler *AwsMyImageHandler) GetMyImageWithContext(ctx context.Context, myImageIID irs.IID) (irs.MyImageInfo, error) {
+	return ImageHandler.withContext(ctx).GetMyImage(myImageIID)
+}
+
+func (ImageHandler *AwsMyImageHandler) CheckWindowsImageWithContext(ctx context.Context, myImageIID irs.IID) (bool, error) {
+	return ImageHandler.withContext(ctx).CheckWindowsImage(myImageIID)
+}
+
+func (ImageHandler *AwsMyImageHandler) DeleteMyImageWithContext(ctx context.Context, myImageIID irs.IID) (bool, error) {
+	return ImageHandler.withContext(ctx).DeleteMyImage(myImageIID)
+}
+
+// -------- NLBHandlerWithContext
+
+func (NLBHandler *AwsNLBHandler) withContext(ctx context.Context) *AwsNLBHandler {
+	ctxHandler := *NLBHandler
+	if NLBHandler.Client != nil {
+		ctxHandler.Client = &elbv2.ELBV2{Client: newClientWithContext(ctx, NLBHandler.Client.Client)}
+	}
+	ctxHandler.VMClient = newEC2ClientWithContext(ctx, NLBHandler.VMClient)
+	ctxHandler.TagHandler = newTagHandlerWithContext(ctx, NLBHandler.TagHandler)
+	return &ctxHandler
+}
+
+func (NLBHandler *AwsNLBHandler) ListIIDWithContext(ctx context.Context) ([]*irs.IID, error) {
+	return NLBHandler.withContext(ctx).ListIID()
+}
+
+func (NLBHandler *AwsNLBHandler) CreateNLBWithContext(ctx context.Context, nlbReqInfo irs.NLBInfo) (irs.NLBInfo, error) {
+	return NLBHandler.withContext(ctx).CreateNLB(nlbReqInfo)
+}
+
+func (NLBHandler *AwsNLBHandler) ListNLBWithContext(ctx context.Context) ([]*irs.NLBInfo, error) {
+	return NLBHandler.withContext(ctx).ListNLB()
+}
+
+func (NLBHandler *AwsNLBHandler) GetNLBWithContext(ctx context.Context, nlbIID irs.IID) (irs.NLBInfo, error) {
+	return NLBHandler.withContext(ctx).GetNLB(nlbIID)
+}
+
+func (NLBHandler *AwsNLBHandler) DeleteNLBWithContext(ctx context.Context, nlbIID irs.IID) (bool, error) {
+	return NLBHandler.withContext(ctx).DeleteNLB(nlbIID)
+}
+
+func (NLBHandler *AwsNLBHandler) GetVMGroupHealthInfoWithContext(ctx context.Context, nlbIID irs.IID) (irs.HealthInfo, error) {
+	return NLBHandler.withContext(ctx).GetVMGroupHealthInfo(nlbIID)
+}
+
+func (NLBHandler *AwsNLBHandler) AddVMsWithContext(ctx context.Context, nlbIID irs.IID, vmIIDs *[]irs.IID) (irs.VMGroupInfo, error) {
+	return NLBHandler.withContext(ctx).AddVMs(nlbIID, vmIIDs)
+}
+
+func (NLBHandler *AwsNLBHandler) RemoveVMsWithContext(ctx context.Context, nlbIID irs.IID, vmIIDs *[]irs.IID) (bool, error) {
+	return NLBHandler.withContext(ctx).RemoveVMs(nlbIID, vmIIDs)
+}
+
+func (NLBHandler *AwsNLBHandler) ChangeListenerWithContext(ctx context.Context, nlbIID irs.IID, listener irs.ListenerInfo) (irs.ListenerInfo, error) {
+	return NLBHandler.withContext(ctx).ChangeListener(nlbIID, listener)
+}
+
+func (NLBHandler *AwsNLBHandler) ChangeVMGroupInfoWithContext(ctx context.Context, nlbIID irs.IID, vmGroup irs.VMGroupInfo) (irs.VMGroupInfo, error) {
+	return NLBHandler.withContext(ctx).ChangeVMGroupInfo(nlbIID, vmGroup)
+}
+
+func (NLBHandler *AwsNLBHandler) ChangeHealthCheckerInfoWithContext(ctx context.Context, nlbIID irs.IID, healthChecker irs.HealthCheckerInfo) (irs.HealthCheckerInfo, error) {
+	return NLBHandler.withContext(ctx).ChangeHealthCheckerInfo(nlbIID, healthChecker)
+}
+
+// -------- ClusterHandlerWithContext
+
+func (ClusterHandler *AwsClusterHandler) withContext(ctx context.Context) *AwsClusterHandler {
+	ctxHandler := *ClusterHandler
+	ctxHandler.ctx = ctx
+	if ClusterHandler.Client != nil {
+		ctxHandler.Client = &eks.EKS{Client: newClientWithContext(ctx, ClusterHandler.Client.Client)}
+	}
+	ctxHandler.EC2Client = newEC2ClientWithContext(ctx, ClusterHandler.EC2Client)
+	if ClusterHandler.Iam != nil {
+		ctxHandler.Iam = &iam.IAM{Client: newClientWithContext(ctx, ClusterHandler.Iam.Client)}
+	}
+	if ClusterHandler.StsClient != nil {
+		ctxHandler.StsClient = &sts.STS{Client: newClientWithContext(ctx, ClusterHandler.StsClient.Client)}
+	}
+	if ClusterHandler.AutoScaling != nil {
+		ctxHandler.AutoScaling = &autoscaling.AutoScaling{Client: newClientWithContext(ctx, ClusterHandler.AutoScaling.Client)}
+	}
+	ctxHandler.TagHandler = newTagHandlerWithContext(ctx, ClusterHandler.TagHandler)
+	return &ctxHandler
+}
+
+func (ClusterHandler *AwsClusterHandler) ListIIDWithContext(ctx context.Context) ([]*irs.IID, error) {
+	return ClusterHandler.withContext(ctx).ListIID()
+}
+
+func (ClusterHandler *AwsClusterHandler) CreateClusterWithContext(ctx context.Context, clusterReqInfo irs.ClusterInfo) (irs.ClusterInfo, error) {
+	return ClusterHandler.withContext(ctx).CreateCluster(clusterReqInfo)
+}
+
+func (ClusterHandler *AwsClusterHandler) ListClusterWithContext(ctx context.Context) ([]*irs.ClusterInfo, error) {
+	return ClusterHandler.withContext(ctx).ListCluster()
+}
+
+func (ClusterHandler *AwsClusterHandler) GetClusterWithContext(ctx context.Context, clusterIID irs.IID) (irs.ClusterInfo, error) {
+	return ClusterHandler.withContext(ctx).GetCluster(clusterIID)
+}
+
+func (ClusterHandler *AwsClusterHandler) DeleteClusterWithContext(ctx context.Context, clusterIID irs.IID) (bool, error) {
+	return ClusterHandler.withContext(ctx).DeleteCluster(clusterIID)
+}
+
+func (ClusterHandler *AwsClusterHandler) GenerateClusterTokenWithContext(ctx context.Context, clusterIID irs.IID) (string, error) {
+	return ClusterHandler.withContext(ctx).GenerateClusterToken(clusterIID)
+}
+
+func (ClusterHandler *AwsClusterHandler) AddNodeGroupWithContext(ctx context.Context, clusterIID irs.IID, nodeGroupReqInfo irs.NodeGroupInfo) (irs.NodeGroupInfo, error) {
+	return ClusterHandler.withContext(ctx).AddNodeGroup(clusterIID, nodeGroupReqInfo)
+}
+
+func (ClusterHandler *AwsClusterHandler) SetNodeGroupAutoScalingWithContext(ctx context.Context, clusterIID irs.IID, nodeGroupIID irs.IID, on bool) (bool, error) {
+	return ClusterHandler.withContext(ctx).SetNodeGroupAutoScaling(clusterIID, nodeGroupIID, on)
+}
+
+func (ClusterHandler *AwsClusterHandler) ChangeNodeGroupScalingWithContext(ctx context.Context, clusterIID irs.IID, nodeGroupIID irs.IID,
+	desiredNodeSize int, minNodeSize int, maxNodeSize int) (irs.NodeGroupInfo, error) {
+	return ClusterHandler.withContext(ctx).ChangeNodeGroupScaling(clusterIID, nodeGroupIID, desiredNodeSize, minNodeSize, maxNodeSize)
+}
+
+func (ClusterHandler *AwsClusterHandler) RemoveNodeGroupWithContext(ctx context.Context, clusterIID irs.IID, nodeGroupIID irs.IID) (bool, error) {
+	return ClusterHandler.withContext(ctx).RemoveNodeGroup(clusterIID, nodeGroupIID)
+}
+
+func (ClusterHandler *AwsClusterHandler) UpgradeClusterWithContext(ctx context.Context, clusterIID irs.IID, newVersion string) (irs.ClusterInfo, error) {
+	return ClusterHandler.withContext(ctx).UpgradeCluster(clusterIID, newVersion)
+}
+
+var _ irs.VMHandlerWithContext = &AwsVMHandler{}
+var _ irs.NLBHandlerWithContext = &AwsNLBHandler{}
+var _ irs.ClusterHandlerWithContext = &AwsClusterHandler{}
+var _ irs.DiskHandlerWithContext = &AwsDiskHandler{}
+var _ irs.MyImageHandlerWithContext = &AwsMyImageHandler{}
@@ -107,6 +107,12 @@ func (DiskHandler *AwsDiskHandler) CreateDisk(diskReqInfo irs.DiskInfo) (irs.Dis
 	// case1 : 빈 disk 생성
 	input.VolumeType = aws.String(volumeType)
 
+	// provisioned performance: gp3, io1, io2
+	input.Iops, input.Throughput, err = convertDiskPerformance(diskReqInfo.IOPS, diskReqInfo.Throughput)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
 	// case2 : snapshot에서 disk 생성
 	//Iops:             aws.Int64(1000),
 	//SnapshotId:       aws.String("snap-066877671789bd71b"),
@@ -263,6 +269,82 @@ func (DiskHandler *AwsDiskHandler) ChangeDiskSize(diskIID irs.IID, size string)
 	// }
 	return true, nil
 }
+
+/*
+ChangeDiskType : Elastic Volumes, the Disk can be changed in use(attached).
+
+	The magnetic(standard) volume type cannot be changed.
+	IOPS : gp3, io1, io2 only
+	Throughput : gp3 only
+	After a modification, the Disk cannot be modified again for 6 hours.
+*/
+func (DiskHandler *AwsDiskHandler) ChangeDiskType(diskIID irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	hiscallInfo := GetCallLogScheme(DiskHandler.Region, call.DISK, diskIID.NameId, "ChangeDiskType()")
+
+	diskInfo, err := DiskHandler.GetDisk(diskIID)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+	if diskInfo.DiskType == "standard" || diskType == "standard" {
+		return irs.DiskInfo{}, errors.New("AWS does not support the change of the standard(Magnetic) Disk Type")
+	}
+
+	input := &ec2.ModifyVolumeInput{
+		VolumeId:   aws.String(diskIID.SystemId),
+		VolumeType: aws.String(diskType),
+	}
+	input.Iops, input.Throughput, err = convertDiskPerformance(iops, throughput)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+
+	start := call.Start()
+	result, err := DiskHandler.Client.ModifyVolume(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return irs.DiskInfo{}, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	cblogger.Debug("originalVolumeType : " + aws.StringValue(result.VolumeModification.OriginalVolumeType))
+	cblogger.Debug("targetVolumeType : " + aws.StringValue(result.VolumeModification.TargetVolumeType))
+
+	diskInfo, err = DiskHandler.GetDisk(diskIID)
+	if err != nil {
+		return irs.DiskInfo{}, err
+	}
+	// the volume has the target values during the modification(optimizing)
+	diskInfo.DiskType = aws.StringValue(result.VolumeModification.TargetVolumeType)
+	if result.VolumeModification.TargetIops != nil {
+		diskInfo.IOPS = strconv.FormatInt(*result.VolumeModification.TargetIops, 10)
+	}
+	if result.VolumeModification.TargetThroughput != nil {
+		diskInfo.Throughput = strconv.FormatInt(*result.VolumeModification.TargetThroughput, 10)
+	}
+	return diskInfo, nil
+}
+
+// "" => nil: keep the value or use the default of AWS
+func convertDiskPerformance(iops string, throughput string) (*int64, *int64, error) {
+	var awsIops, awsThroughput *int64
+	if iops != "" {
+		value, err := strconv.ParseInt(iops, 10, 64)
+		if err != nil {
+			return nil, nil, errors.New("IOPS : " + iops + " is not valid")
+		}
+		awsIops = aws.Int64(value)
+	}
+	if throughput != "" {
+		value, err := strconv.ParseInt(throughput, 10, 64)
+		if err != nil {
+			return nil, nil, errors.New("Throughput : " + throughput + " is not valid")
+		}
+		awsThroughput = aws.Int64(value)
+	}
+	return awsIops, awsThroughput, nil
+}
 func (DiskHandler *AwsDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 	hiscallInfo := GetCallLogScheme(DiskHandler.Region, call.DISK, diskIID.NameId, "DeleteDisk()")
 	start := call.Start()
@@ -860,6 +942,12 @@ func (DiskHandler *AwsDiskHandler) convertVolumeInfoToDiskInfo(volumeInfo *ec2.V
 	// tag에서 빼야하나?
 	diskInfo.DiskSize = strconv.Itoa(int(*volumeInfo.Size))
 	diskInfo.DiskType = *volumeInfo.VolumeType
+	if volumeInfo.Iops != nil {
+		diskInfo.IOPS = strconv.FormatInt(*volumeInfo.Iops, 10)
+	}
+	if volumeInfo.Throughput != nil {
+		diskInfo.Throughput = strconv.FormatInt(*volumeInfo.Throughput, 10)
+	}
 	//diskInfo.Status = irs.DiskStatus(*volumeInfo.State) //State: "attached",
 
 	attachments := volumeInfo.Attachments
@@ -234,6 +234,14 @@ func (NLBHandler *AwsNLBHandler) CreateTargetGroup(nlbReqInfo irs.NLBInfo) (*elb
 		//}
 	}
 
+	// HTTP 헬스체크 경로 및 성공 응답 코드 설정
+	if nlbReqInfo.HealthChecker.HTTPPath != "" {
+		input.HealthCheckPath = aws.String(nlbReqInfo.HealthChecker.HTTPPath)
+	}
+	if nlbReqInfo.HealthChecker.ExpectedStatusCodes != "" {
+		input.Matcher = &elbv2.Matcher{HttpCode: aws.String(nlbReqInfo.HealthChecker.ExpectedStatusCodes)}
+	}
+
 	result, err := NLBHandler.Client.CreateTargetGroup(input)
 	cblogger.Debug(result)
 	if err != nil {
@@ -388,6 +396,7 @@ func (NLBHandler *AwsNLBHandler) ExtractVmSubnets(VMs *[]irs.IID) ([]*string, er
 }
 
 func (NLBHandler *AwsNLBHandler) CheckHealthCheckerValidation(reqHealthCheckerInfo irs.HealthCheckerInfo) error {
+	// NLB Target Group does not support a Host header for health checks
 	if reqHealthCheckerInfo.Interval > 0 {
 		//TCP, TLS, UDP, or TCP_UDP의 경우 Health check interval은 10이나 30만 가능함.
 		// The approximate amount of time, in seconds, between health checks of an individual target.
@@ -654,6 +663,26 @@ func (NLBHandler *AwsNLBHandler) CreateNLB(nlbReqInfo irs.NLBInfo) (irs.NLBInfo,
 
 	cblogger.Debug(listener)
 
+	//================
+	// 추가 리스너 생성
+	//================
+	for idx := 1; idx < len(nlbReqInfo.ListenerList); idx++ {
+		_, errAddListener := NLBHandler.AddListener(nlbReqInfo.IId, nlbReqInfo.ListenerList[idx])
+		if errAddListener != nil {
+			cblogger.Error(errAddListener.Error())
+
+			//생성된 NLB 포함 리소스들 삭제
+			cblogger.Infof("Starting NLB [%s] and related resources deletion due to listener creation failure!!", nlbReqInfo.IId.NameId)
+			_, errNlbInfo := NLBHandler.DeleteNLB(nlbReqInfo.IId)
+			if errNlbInfo != nil {
+				cblogger.Errorf("Failed to delete NLB [%s] and related resources due to listener creation failure!!", nlbReqInfo.IId.NameId)
+				cblogger.Error(errNlbInfo.Error())
+			}
+
+			return irs.NLBInfo{}, errAddListener
+		}
+	}
+
 	//================================
 	// 가장 최신 정보로 정보를 갱신 함.
 	//================================
@@ -822,7 +851,8 @@ func (NLBHandler *AwsNLBHandler) GetNLB(nlbIID irs.IID) (irs.NLBInfo, error) {
 	}
 }
 
-func (NLBHandler *AwsNLBHandler) ExtractListenerInfo(nlbIID irs.IID) (irs.ListenerInfo, error) {
+// NLB에 연결된 모든 리스너 목록을 조회 함.
+func (NLBHandler *AwsNLBHandler) ExtractListeners(nlbIID irs.IID) ([]*elbv2.Listener, error) {
 	//리스너는 NLB와 연결되어야만 생성 가능하기에 Arn으로 조회 함.
 	inputListener := &elbv2.DescribeListenersInput{
 		LoadBalancerArn: aws.String(nlbIID.SystemId),
@@ -838,7 +868,7 @@ func (NLBHandler *AwsNLBHandler) ExtractListenerInfo(nlbIID irs.IID) (irs.Listen
 				//Test 결과 NLB에 리스너가 할당되지 않아도 지금은 ErrCodeListenerNotFoundException 예외는 발생하지 않고 정상 결과로 처리되지만
 				//만약을 위해서 TargetGroup처럼 NotFound의 경우 정상 처리 함.
 				cblogger.Info("Listener Not Found is not treated as an error for retrieval and deletion logic.")
-				return irs.ListenerInfo{}, nil
+				return nil, nil
 			case elbv2.ErrCodeLoadBalancerNotFoundException:
 				cblogger.Error(elbv2.ErrCodeLoadBalancerNotFoundException, aerr.Error())
 			case elbv2.ErrCodeUnsupportedProtocolException:
@@ -851,31 +881,119 @@ func (NLBHandler *AwsNLBHandler) ExtractListenerInfo(nlbIID irs.IID) (irs.Listen
 			// Message from an error.
 			cblogger.Error(err.Error())
 		}
-		return irs.ListenerInfo{}, err
+		return nil, err
 	}
 
 	cblogger.Debug(resListener)
 
-	if len(resListener.Listeners) > 0 {
-		retListenerInfo := irs.ListenerInfo{
-			CspID:    *resListener.Listeners[0].ListenerArn,
-			Protocol: *resListener.Listeners[0].Protocol, // TCP|UDP
-			//IP       string // Auto Generated and attached
-			//DNSName  string // Optional, Auto Generated and attached
+	return resListener.Listeners, nil
+}
+
+// NLB의 VM그룹(NLB 이름의 타겟그룹)으로 전달하는 리스너 정보를 조회 함.
+// 해당 리스너가 없으면 첫 번째 리스너 정보를 리턴 함.
+func (NLBHandler *AwsNLBHandler) ExtractListenerInfo(nlbIID irs.IID) (irs.ListenerInfo, error) {
+	listeners, err := NLBHandler.ExtractListeners(nlbIID)
+	if err != nil {
+		return irs.ListenerInfo{}, err
+	}
+	if len(listeners) == 0 {
+		return irs.ListenerInfo{}, nil
+	}
+
+	retTargetGroupInfo, errVMGroupInfo := NLBHandler.ExtractVMGroupInfo(nlbIID)
+	if errVMGroupInfo != nil {
+		cblogger.Error(errVMGroupInfo.Error())
+		return irs.ListenerInfo{}, errVMGroupInfo
+	}
+
+	for _, curListener := range listeners {
+		if getListenerTargetGroupArn(curListener) == retTargetGroupInfo.VMGroup.CspID {
+			return convertListenerInfo(curListener), nil
 		}
-		retListenerInfo.Port = strconv.FormatInt(*resListener.Listeners[0].Port, 10)
+	}
+	return convertListenerInfo(listeners[0]), nil
+}
 
-		//Key Value 처리
-		// keyValueList, _ := ConvertKeyValueList(resListener.Listeners[0])
-		// retListenerInfo.KeyValueList = keyValueList
+func convertListenerInfo(listener *elbv2.Listener) irs.ListenerInfo {
+	retListenerInfo := irs.ListenerInfo{
+		CspID:    *listener.ListenerArn,
+		Protocol: *listener.Protocol, // TCP|UDP
+		//IP       string // Auto Generated and attached
+		//DNSName  string // Optional, Auto Generated and attached
+	}
+	retListenerInfo.Port = strconv.FormatInt(*listener.Port, 10)
 
-		// Use irs.StructToKeyValueList to populate KeyValueList
-		retListenerInfo.KeyValueList = irs.StructToKeyValueList(resListener.Listeners[0])
+	// Use irs.StructToKeyValueList to populate KeyValueList
+	retListenerInfo.KeyValueList = irs.StructToKeyValueList(listener)
 
-		return retListenerInfo, nil
-	} else {
-		return irs.ListenerInfo{}, nil
+	return retListenerInfo
+}
+
+// 리스너가 트래픽을 전달하는 타겟그룹의 ARN을 리턴 함.
+func getListenerTargetGroupArn(listener *elbv2.Listener) string {
+	for _, action := range listener.DefaultActions {
+		if action.TargetGroupArn != nil {
+			return *action.TargetGroupArn
+		}
+	}
+	return ""
+}
+
+// listenerTargetGroupName returns the name of the target group of an additional listener.
+// The target group of the first listener has the NLB name, and the name of a target group is limited to 32 characters.
+func listenerTargetGroupName(nlbName string, listener irs.ListenerInfo) string {
+	suffix := "-" + strings.ToLower(strings.ReplaceAll(listener.Protocol, "_", "")) + listener.Port
+	if len(nlbName)+len(suffix) > 32 {
+		nlbName = strings.TrimRight(nlbName[:32-len(suffix)], "-")
 	}
+	return nlbName + suffix
+}
+
+// 타겟그룹 ARN으로 VM그룹 정보(VM 목록 제외)를 조회 함.
+func (NLBHandler *AwsNLBHandler) ExtractTargetGroupVMGroup(targetGroupArn string) (irs.VMGroupInfo, error) {
+	input := &elbv2.DescribeTargetGroupsInput{
+		TargetGroupArns: []*string{aws.String(targetGroupArn)},
+	}
+
+	result, err := NLBHandler.Client.DescribeTargetGroups(input)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMGroupInfo{}, err
+	}
+	if len(result.TargetGroups) == 0 {
+		return irs.VMGroupInfo{}, errors.New("TargetGroupNotFound: The TargetGroup '" + targetGroupArn + "' does not exist")
+	}
+
+	retVMGroupInfo := irs.VMGroupInfo{
+		CspID:    *result.TargetGroups[0].TargetGroupArn,
+		Protocol: *result.TargetGroups[0].Protocol,
+	}
+	retVMGroupInfo.Port = strconv.FormatInt(*result.TargetGroups[0].Port, 10)
+	retVMGroupInfo.KeyValueList = irs.StructToKeyValueList(result.TargetGroups[0])
+
+	return retVMGroupInfo, nil
+}
+
+// NLB의 VM그룹 외에 추가 리스너들이 사용하는 타겟그룹들의 VM그룹 정보를 조회 함.
+func (NLBHandler *AwsNLBHandler) ExtractListenerVMGroups(nlbIID irs.IID, vmGroupCspID string) ([]irs.VMGroupInfo, error) {
+	listeners, err := NLBHandler.ExtractListeners(nlbIID)
+	if err != nil {
+		return nil, err
+	}
+
+	vmGroupList := []irs.VMGroupInfo{}
+	for _, curListener := range listeners {
+		targetGroupArn := getListenerTargetGroupArn(curListener)
+		if targetGroupArn == "" || targetGroupArn == vmGroupCspID {
+			continue
+		}
+		vmGroup, err := NLBHandler.ExtractTargetGroupVMGroup(targetGroupArn)
+		if err != nil {
+			return nil, err
+		}
+		vmGroupList = append(vmGroupList, vmGroup)
+	}
+	return vmGroupList, nil
 }
 
 func (NLBHandler *AwsNLBHandler) ExtractVMGroupInfo(nlbIID irs.IID) (TargetGroupInfo, error) {
@@ -929,6 +1047,12 @@ func (NLBHandler *AwsNLBHandler) ExtractVMGroupInfo(nlbIID irs.IID) (TargetGroup
 			Timeout:   int(*result.TargetGroups[0].HealthCheckTimeoutSeconds),
 			Threshold: int(*result.TargetGroups[0].HealthyThresholdCount),
 		}
+		if result.TargetGroups[0].HealthCheckPath != nil {
+			targetGroupInfo.HealthChecker.HTTPPath = *result.TargetGroups[0].HealthCheckPath
+		}
+		if result.TargetGroups[0].Matcher != nil && result.TargetGroups[0].Matcher.HttpCode != nil {
+			targetGroupInfo.HealthChecker.ExpectedStatusCodes = *result.TargetGroups[0].Matcher.HttpCode
+		}
 
 		//================
 		//Key Value 처리
@@ -1031,22 +1155,6 @@ func (NLBHandler *AwsNLBHandler) ExtractNLBInfo(nlbResInfo *elbv2.LoadBalancer)
 	retNLBInfo.VMGroup = retTargetGroupInfo.VMGroup
 	retNLBInfo.HealthChecker = retTargetGroupInfo.HealthChecker
 
-	//==================
-	// 리스너 처리
-	//==================
-	cblogger.Info("Listener information retrieval started")
-	retListenerInfo, errListener := NLBHandler.ExtractListenerInfo(retNLBInfo.IId) //NLB Arn으로 검색 함.
-	if errListener != nil {
-		cblogger.Error(errListener.Error())
-		return irs.NLBInfo{}, errListener
-	}
-
-	// 리스너 정보가 존재하면 NLB의 DNS 정보를 리스너에 셋팅해줌.
-	if retListenerInfo.Port != "" {
-		retListenerInfo.DNSName = *nlbResInfo.DNSName
-	}
-	retNLBInfo.Listener = retListenerInfo
-
 	//=================
 	// IP 정보 추출
 	//=================
@@ -1062,7 +1170,47 @@ func (NLBHandler *AwsNLBHandler) ExtractNLBInfo(nlbResInfo *elbv2.LoadBalancer)
 			}
 		}
 	}
-	retNLBInfo.Listener.IP = eips
+
+	//==================
+	// 리스너 처리
+	//==================
+	// NLB의 VM그룹으로 전달하는 리스너가 첫 번째 리스너이며, 추가 리스너들은 각자의 타겟그룹을 사용 함.
+	cblogger.Info("Listener information retrieval started")
+	listeners, errListener := NLBHandler.ExtractListeners(retNLBInfo.IId) //NLB Arn으로 검색 함.
+	if errListener != nil {
+		cblogger.Error(errListener.Error())
+		return irs.NLBInfo{}, errListener
+	}
+
+	for _, curListener := range listeners {
+		// 리스너 정보가 존재하면 NLB의 DNS 정보를 리스너에 셋팅해줌.
+		binding := irs.ListenerBindingInfo{Listener: convertListenerInfo(curListener)}
+		binding.Listener.DNSName = *nlbResInfo.DNSName
+		binding.Listener.IP = eips
+
+		targetGroupArn := getListenerTargetGroupArn(curListener)
+		if targetGroupArn == retNLBInfo.VMGroup.CspID {
+			binding.VMGroup = retNLBInfo.VMGroup
+			retNLBInfo.ListenerList = append([]irs.ListenerBindingInfo{binding}, retNLBInfo.ListenerList...)
+			continue
+		}
+		if targetGroupArn != "" {
+			vmGroup, errVMGroup := NLBHandler.ExtractTargetGroupVMGroup(targetGroupArn)
+			if errVMGroup != nil {
+				cblogger.Error(errVMGroup.Error())
+				return irs.NLBInfo{}, errVMGroup
+			}
+			binding.VMGroup = vmGroup
+			binding.VMGroup.VMs = retNLBInfo.VMGroup.VMs
+		}
+		retNLBInfo.ListenerList = append(retNLBInfo.ListenerList, binding)
+	}
+
+	if len(retNLBInfo.ListenerList) > 0 {
+		retNLBInfo.Listener = retNLBInfo.ListenerList[0].Listener
+	} else {
+		retNLBInfo.Listener.IP = eips
+	}
 
 	return retNLBInfo, nil
 }
@@ -1139,6 +1287,29 @@ func (NLBHandler *AwsNLBHandler) DeleteNLB(nlbIID irs.IID) (bool, error) {
 	cblogger.Debug("NLB information to be deleted")
 	cblogger.Debug(nlbInfo)
 
+	//=========================
+	// 추가 Listener 및 TargetGroup 삭제
+	//=========================
+	for _, binding := range nlbInfo.ListenerList {
+		if binding.Listener.CspID == nlbInfo.Listener.CspID {
+			continue
+		}
+		cblogger.Infof("[%s] Listener deletion started", binding.Listener.CspID)
+		_, errDeleteListener := NLBHandler.DeleteListener(&binding.Listener.CspID)
+		if errDeleteListener != nil {
+			cblogger.Error(errDeleteListener.Error())
+			return false, errDeleteListener
+		}
+		if binding.VMGroup.CspID != "" && binding.VMGroup.CspID != nlbInfo.VMGroup.CspID {
+			cblogger.Infof("[%s] TargetGroup deletion started", binding.VMGroup.CspID)
+			_, errDeleteTargetGroup := NLBHandler.DeleteTargetGroup(&binding.VMGroup.CspID)
+			if errDeleteTargetGroup != nil {
+				cblogger.Error(errDeleteTargetGroup.Error())
+				return false, errDeleteTargetGroup
+			}
+		}
+	}
+
 	//=========================
 	// Listener 삭제
 	//=========================
@@ -1365,6 +1536,152 @@ func (NLBHandler *AwsNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.Lis
 
 }
 
+// 추가 리스너는 NLB의 VM그룹과 같은 VM들과 헬스체커 설정을 사용하는 자신의 타겟그룹으로 트래픽을 전달 함.
+func (NLBHandler *AwsNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	if nlbIID.NameId == "" || nlbIID.SystemId == "" {
+		cblogger.Error("IID value is null.")
+		return irs.NLBInfo{}, awserr.New(CUSTOM_ERR_CODE_BAD_REQUEST, "nlbIID value of the input parameter is empty.", nil)
+	}
+
+	nlbInfo, errNLBInfo := NLBHandler.GetNLB(nlbIID)
+	if errNLBInfo != nil {
+		cblogger.Error(errNLBInfo.Error())
+		return irs.NLBInfo{}, errNLBInfo
+	}
+	for _, binding := range nlbInfo.ListenerList {
+		if strings.EqualFold(binding.Listener.Protocol, listenerBinding.Listener.Protocol) && binding.Listener.Port == listenerBinding.Listener.Port {
+			return irs.NLBInfo{}, awserr.New(CUSTOM_ERR_CODE_BAD_REQUEST, "The "+listenerBinding.Listener.Protocol+":"+listenerBinding.Listener.Port+" listener already exists.", nil)
+		}
+	}
+
+	//================
+	// 타겟그룹 생성
+	//================
+	// TCP 헬스체크는 타임아웃 설정을 지원하지 않음.
+	healthChecker := nlbInfo.HealthChecker
+	if strings.EqualFold(healthChecker.Protocol, "TCP") {
+		healthChecker.Timeout = 0
+	}
+	reqInfo := irs.NLBInfo{
+		IId:           irs.IID{NameId: listenerTargetGroupName(nlbInfo.IId.NameId, listenerBinding.Listener), SystemId: nlbInfo.IId.SystemId},
+		VpcIID:        nlbInfo.VpcIID,
+		Listener:      listenerBinding.Listener,
+		VMGroup:       listenerBinding.VMGroup,
+		HealthChecker: healthChecker,
+		TagList:       nlbInfo.TagList,
+	}
+	targetGroup, errTargetGroup := NLBHandler.CreateTargetGroup(reqInfo)
+	if errTargetGroup != nil {
+		cblogger.Error(errTargetGroup.Error())
+		return irs.NLBInfo{}, errTargetGroup
+	}
+	reqInfo.VMGroup.CspID = *targetGroup.TargetGroups[0].TargetGroupArn
+
+	//=============================
+	// 타겟그룹에 VM 추가 및 리스너 생성
+	//=============================
+	var errListener error
+	if nlbInfo.VMGroup.VMs != nil && len(*nlbInfo.VMGroup.VMs) > 0 {
+		errListener = NLBHandler.RegisterTargets(reqInfo.VMGroup.CspID, reqInfo.VMGroup.Port, nlbInfo.VMGroup.VMs)
+	}
+	if errListener == nil {
+		_, errListener = NLBHandler.CreateListener(reqInfo)
+	}
+	if errListener != nil {
+		cblogger.Error(errListener.Error())
+
+		cblogger.Infof("Starting TargetGroup [%s] deletion due to listener creation failure!!", reqInfo.VMGroup.CspID)
+		_, errDeleteTargetGroup := NLBHandler.DeleteTargetGroup(&reqInfo.VMGroup.CspID)
+		if errDeleteTargetGroup != nil {
+			cblogger.Error(errDeleteTargetGroup.Error())
+		}
+		return irs.NLBInfo{}, errListener
+	}
+
+	return NLBHandler.GetNLB(nlbIID)
+}
+
+// NLB의 VM그룹으로 전달하는 리스너는 NLB의 VM그룹 정보와 함께 사용되므로 삭제할 수 없음.
+func (NLBHandler *AwsNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	if nlbIID.SystemId == "" {
+		cblogger.Error("IID value is Null.")
+		return false, awserr.New(CUSTOM_ERR_CODE_BAD_REQUEST, "nlbIID.systemId value of the input parameter is empty.", nil)
+	}
+
+	nlbInfo, errNLBInfo := NLBHandler.GetNLB(nlbIID)
+	if errNLBInfo != nil {
+		cblogger.Error(errNLBInfo.Error())
+		return false, errNLBInfo
+	}
+
+	for _, binding := range nlbInfo.ListenerList {
+		if !strings.EqualFold(binding.Listener.Protocol, listener.Protocol) || binding.Listener.Port != listener.Port {
+			continue
+		}
+		if binding.Listener.CspID == nlbInfo.Listener.CspID {
+			return false, awserr.New(CUSTOM_ERR_CODE_BAD_REQUEST, "The "+listener.Protocol+":"+listener.Port+" listener uses the VM group of the NLB and cannot be removed.", nil)
+		}
+
+		_, errDeleteListener := NLBHandler.DeleteListener(&binding.Listener.CspID)
+		if errDeleteListener != nil {
+			cblogger.Error(errDeleteListener.Error())
+			return false, errDeleteListener
+		}
+		if binding.VMGroup.CspID != "" && binding.VMGroup.CspID != nlbInfo.VMGroup.CspID {
+			_, errDeleteTargetGroup := NLBHandler.DeleteTargetGroup(&binding.VMGroup.CspID)
+			if errDeleteTargetGroup != nil {
+				cblogger.Error(errDeleteTargetGroup.Error())
+				return false, errDeleteTargetGroup
+			}
+		}
+		return true, nil
+	}
+
+	return false, awserr.New(CUSTOM_ERR_CODE_BAD_REQUEST, "The "+listener.Protocol+":"+listener.Port+" listener does not exist.", nil)
+}
+
+// 추가 리스너의 타겟그룹에 VM들을 등록 함.
+func (NLBHandler *AwsNLBHandler) RegisterTargets(targetGroupArn string, port string, vmIIDs *[]irs.IID) error {
+	targetPort, err := strconv.ParseInt(port, 10, 64)
+	if err != nil {
+		cblogger.Error(port, "is not number!!")
+		return err
+	}
+
+	input := &elbv2.RegisterTargetsInput{
+		TargetGroupArn: aws.String(targetGroupArn),
+	}
+	for _, curVM := range *vmIIDs {
+		input.Targets = append(input.Targets, &elbv2.TargetDescription{Id: aws.String(curVM.SystemId), Port: aws.Int64(targetPort)})
+	}
+
+	result, err := NLBHandler.Client.RegisterTargets(input)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	cblogger.Debug(result)
+	return nil
+}
+
+// 추가 리스너의 타겟그룹에서 VM들을 제거 함.
+func (NLBHandler *AwsNLBHandler) DeregisterTargets(targetGroupArn string, vmIIDs *[]irs.IID) error {
+	input := &elbv2.DeregisterTargetsInput{
+		TargetGroupArn: aws.String(targetGroupArn),
+	}
+	for _, curVM := range *vmIIDs {
+		input.Targets = append(input.Targets, &elbv2.TargetDescription{Id: aws.String(curVM.SystemId)})
+	}
+
+	result, err := NLBHandler.Client.DeregisterTargets(input)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	cblogger.Debug(result)
+	return nil
+}
+
 // ------ Backend Control
 func (NLBHandler *AwsNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup irs.VMGroupInfo) (irs.VMGroupInfo, error) {
 	// logger for HisCall
@@ -1477,6 +1794,18 @@ func (NLBHandler *AwsNLBHandler) AddVMs(nlbIID irs.IID, vmIIDs *[]irs.IID) (irs.
 	cblogger.Infof("Instances added to VM group (%s) successfully", retTargetGroupInfo.VMGroup.CspID)
 	cblogger.Debug(result)
 
+	// 추가 리스너들의 타겟그룹에도 VM 추가
+	listenerVMGroups, errListenerVMGroups := NLBHandler.ExtractListenerVMGroups(nlbIID, retTargetGroupInfo.VMGroup.CspID)
+	if errListenerVMGroups != nil {
+		cblogger.Error(errListenerVMGroups.Error())
+		return irs.VMGroupInfo{}, errListenerVMGroups
+	}
+	for _, vmGroup := range listenerVMGroups {
+		if err := NLBHandler.RegisterTargets(vmGroup.CspID, vmGroup.Port, vmIIDs); err != nil {
+			return irs.VMGroupInfo{}, err
+		}
+	}
+
 	//최신 정보 전달을 위해 다시 호출함.
 	retTargetGroupInfo, errVMGroupInfo = NLBHandler.ExtractVMGroupInfo(nlbIID)
 	if errVMGroupInfo != nil {
@@ -1563,6 +1892,18 @@ func (NLBHandler *AwsNLBHandler) RemoveVMs(nlbIID irs.IID, vmIIDs *[]irs.IID) (b
 	cblogger.Infof("Instances successfully removed from VM group (%s)", retTargetGroupInfo.VMGroup.CspID)
 	cblogger.Debug(result)
 
+	// 추가 리스너들의 타겟그룹에서도 VM 제거
+	listenerVMGroups, errListenerVMGroups := NLBHandler.ExtractListenerVMGroups(nlbIID, retTargetGroupInfo.VMGroup.CspID)
+	if errListenerVMGroups != nil {
+		cblogger.Error(errListenerVMGroups.Error())
+		return false, errListenerVMGroups
+	}
+	for _, vmGroup := range listenerVMGroups {
+		if err := NLBHandler.DeregisterTargets(vmGroup.CspID, vmIIDs); err != nil {
+			return false, err
+		}
+	}
+
 	return true, nil
 }
 
@@ -1732,6 +2073,14 @@ func (NLBHandler *AwsNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, healthC
 		}
 	}
 
+	// HTTP 헬스체크 경로 및 성공 응답 코드 설정
+	if healthChecker.HTTPPath != "" {
+		input.HealthCheckPath = aws.String(healthChecker.HTTPPath)
+	}
+	if healthChecker.ExpectedStatusCodes != "" {
+		input.Matcher = &elbv2.Matcher{HttpCode: aws.String(healthChecker.ExpectedStatusCodes)}
+	}
+
 	// logger for HisCall
 	callogger := call.GetLogger("HISCALL")
 	callLogInfo := call.CLOUDLOGSCHEMA{
@@ -1771,6 +2120,20 @@ func (NLBHandler *AwsNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, healthC
 	cblogger.Info("Health information modification completed")
 	cblogger.Debug(result)
 
+	// 추가 리스너들의 타겟그룹에도 같은 헬스체커 설정을 반영 함.
+	listenerVMGroups, errListenerVMGroups := NLBHandler.ExtractListenerVMGroups(nlbIID, retTargetGroupInfo.VMGroup.CspID)
+	if errListenerVMGroups != nil {
+		cblogger.Error(errListenerVMGroups.Error())
+		return irs.HealthCheckerInfo{}, errListenerVMGroups
+	}
+	for _, vmGroup := range listenerVMGroups {
+		input.TargetGroupArn = aws.String(vmGroup.CspID)
+		if _, err := NLBHandler.Client.ModifyTargetGroup(input); err != nil {
+			cblogger.Error(err)
+			return irs.HealthCheckerInfo{}, err
+		}
+	}
+
 	//최신 정보 조회
 	//최종 반영까지 시간이 걸리기 때문에 이전 정보를 수신할 확률이 높음.
 	retTargetGroupInfo, errVMGroupInfo = NLBHandler.ExtractVMGroupInfo(nlbIID)
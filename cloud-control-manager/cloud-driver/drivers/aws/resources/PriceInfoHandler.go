@@ -172,6 +172,16 @@ func (priceInfoHandler *AwsPriceInfoHandler) GetPriceInfo(productFamily string,
 				}
 
 				for termsKey, termsValue := range awsPrice["terms"].(map[string]interface{}) {
+					if termsKey == "Reserved" {
+						for _, policyValue := range termsValue.(map[string]interface{}) {
+							reserved, ok := extractReservedPolicy(policyValue.(map[string]interface{}), filterList)
+							if !ok {
+								continue
+							}
+							AppendReservedToPrice(priceMap, productInfo, reserved, awsPrice)
+						}
+						continue
+					}
 					if termsKey != "OnDemand" {
 						continue
 					}
@@ -205,28 +215,7 @@ func (priceInfoHandler *AwsPriceInfoHandler) GetPriceInfo(productFamily string,
 							onDemand.Description = fmt.Sprintf("%s", priceDimensionsValue.(map[string]interface{})["description"])
 							for key, val := range priceDimensionsValue.(map[string]interface{})["pricePerUnit"].(map[string]interface{}) {
 								onDemand.Currency = key
-
-								priceStr := fmt.Sprintf("%s", val)
-								priceFloat, err := strconv.ParseFloat(priceStr, 64)
-								if err == nil {
-									parts := strings.Split(priceStr, ".")
-									decimalDigits := 0
-									if len(parts) > 1 {
-										decimalDigits = len(strings.TrimRight(parts[1], "0"))
-									}
-
-									if decimalDigits < 2 {
-										onDemand.Price = fmt.Sprintf("%.2f", priceFloat)
-									} else {
-										trimmedPrice := strings.TrimRight(fmt.Sprintf("%f", priceFloat), "0")
-										if trimmedPrice[len(trimmedPrice)-1] == '.' {
-											trimmedPrice = trimmedPrice[:len(trimmedPrice)-1]
-										}
-										onDemand.Price = trimmedPrice
-									}
-								} else {
-									onDemand.Price = priceStr
-								}
+								onDemand.Price = formatPrice(fmt.Sprintf("%s", val))
 
 								if key == "USD" {
 									break
@@ -534,6 +523,139 @@ func AppendOnDemandToPrice(priceMap map[string]irs.Price, productInfo irs.Produc
 	}
 }
 
+// formatPrice formats the price with at least 2 decimal digits, ex) "0.0100000000" => "0.01", "0.0116000000" => "0.0116"
+func formatPrice(priceStr string) string {
+	priceFloat, err := strconv.ParseFloat(priceStr, 64)
+	if err != nil {
+		return priceStr
+	}
+
+	parts := strings.Split(priceStr, ".")
+	decimalDigits := 0
+	if len(parts) > 1 {
+		decimalDigits = len(strings.TrimRight(parts[1], "0"))
+	}
+
+	if decimalDigits < 2 {
+		return fmt.Sprintf("%.2f", priceFloat)
+	}
+	trimmedPrice := strings.TrimRight(fmt.Sprintf("%f", priceFloat), "0")
+	if trimmedPrice[len(trimmedPrice)-1] == '.' {
+		trimmedPrice = trimmedPrice[:len(trimmedPrice)-1]
+	}
+	return trimmedPrice
+}
+
+// extractReservedPolicy makes a Reserved price from an AWS Reserved offer term.
+// An offer term has an hourly price dimension(Hrs) and an upfront fee dimension(Quantity).
+// return false if the term is filtered by the reserved options(leaseContractLength, offeringClass, purchaseOption).
+func extractReservedPolicy(policyValue map[string]interface{}, filterList []irs.KeyValue) (irs.Reserved, bool) {
+	termAttributes, _ := policyValue["termAttributes"].(map[string]interface{})
+	if isFilteredByReservedOptions(termAttributes, filterList) {
+		return irs.Reserved{}, false
+	}
+
+	leaseContractLength := fmt.Sprintf("%v", termAttributes["LeaseContractLength"])
+	offeringClass := fmt.Sprintf("%v", termAttributes["OfferingClass"])
+	purchaseOption := fmt.Sprintf("%v", termAttributes["PurchaseOption"])
+
+	var reserved irs.Reserved
+	reserved.Term = strings.Replace(leaseContractLength, "yr", " Year", 1) // ex) 1yr => 1 Year
+	reserved.PurchaseOption = purchaseOption
+	reserved.Description = fmt.Sprintf("%s %s %s", leaseContractLength, offeringClass, purchaseOption)
+	reserved.UpfrontPrice = "0"
+
+	priceDimensions, _ := policyValue["priceDimensions"].(map[string]interface{})
+	for priceDimensionsKey, priceDimensionsValue := range priceDimensions {
+		priceDimension, ok := priceDimensionsValue.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		pricePerUnit, _ := priceDimension["pricePerUnit"].(map[string]interface{})
+		usd, ok := pricePerUnit["USD"]
+		if !ok {
+			continue
+		}
+		reserved.Currency = "USD"
+
+		switch fmt.Sprintf("%s", priceDimension["unit"]) {
+		case "Hrs":
+			reserved.PricingId = priceDimensionsKey
+			reserved.Unit = "Hour"
+			reserved.Price = formatPrice(fmt.Sprintf("%s", usd))
+		case "Quantity":
+			reserved.UpfrontPrice = formatPrice(fmt.Sprintf("%s", usd))
+		}
+	}
+
+	if reserved.PricingId == "" {
+		return irs.Reserved{}, false
+	}
+	if isFilteredByReservedPrice(priceDimensions[reserved.PricingId], reserved.PricingId, filterList) {
+		return irs.Reserved{}, false
+	}
+	return reserved, true
+}
+
+// the pricingId and unit filters are applied to the hourly price dimension like OnDemandPolicyFilter
+func isFilteredByReservedPrice(priceDimension interface{}, pricingId string, filterList []irs.KeyValue) bool {
+	for _, filter := range filterList {
+		switch filter.Key {
+		case "pricingId":
+			if filter.Value != pricingId {
+				cblogger.Info("filtered by priceDimension ", filter.Value, pricingId)
+				return true
+			}
+		case "unit":
+			dimension, _ := priceDimension.(map[string]interface{})
+			pricePerUnit, _ := dimension["pricePerUnit"].(map[string]interface{})
+			if filter.Value != pricePerUnit["USD"] {
+				cblogger.Info("filtered by price per unit ", filter.Value, pricePerUnit)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isFilteredByReservedOptions(termAttributes map[string]interface{}, filterList []irs.KeyValue) bool {
+	for _, filter := range filterList {
+		switch filter.Key {
+		case "pricingPolicy":
+			if filter.Value != "Reserved" {
+				return true
+			}
+		case "leaseContractLength":
+			if !strings.EqualFold(filter.Value, fmt.Sprintf("%v", termAttributes["LeaseContractLength"])) {
+				return true
+			}
+		case "offeringClass":
+			if !strings.EqualFold(filter.Value, fmt.Sprintf("%v", termAttributes["OfferingClass"])) {
+				return true
+			}
+		case "purchaseOption":
+			if !strings.EqualFold(filter.Value, fmt.Sprintf("%v", termAttributes["PurchaseOption"])) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func AppendReservedToPrice(priceMap map[string]irs.Price, productInfo irs.ProductInfo, reserved irs.Reserved, jsonValue aws.JSONValue) {
+	productId := productInfo.ProductId
+	aPrice, ok := priceMap[productId]
+	if !ok {
+		aPrice = irs.Price{
+			ZoneName:    "NA",
+			ProductInfo: productInfo,
+			PriceInfo:   irs.PriceInfo{CSPPriceInfo: jsonValue},
+		}
+	}
+	aPrice.PriceInfo.Reserved = append(aPrice.PriceInfo.Reserved, reserved)
+	priceMap[productId] = aPrice
+}
+
 func convertMiBtoGiBStringWithUnitForFilter(mibStr string) string {
 	mibVal, err := strconv.ParseFloat(mibStr, 64)
 	if err != nil {
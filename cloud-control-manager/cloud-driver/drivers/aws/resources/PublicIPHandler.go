@@ -0,0 +1,223 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// Spider 의 PublicIP = AWS 의 Elastic IP
+type AwsPublicIPHandler struct {
+	Region idrv.RegionInfo
+	Client *ec2.EC2
+}
+
+const (
+	AWS_ADDRESS_DOMAIN_VPC = "vpc"
+
+	RESOURCE_TYPE_ELASTIC_IP = "elastic-ip"
+)
+
+func (publicIPHandler *AwsPublicIPHandler) CreatePublicIP(publicIPReqInfo irs.PublicIPInfo) (irs.PublicIPInfo, error) {
+	hiscallInfo := GetCallLogScheme(publicIPHandler.Region, call.PUBLICIP, publicIPReqInfo.IId.NameId, "AllocateAddress()")
+	start := call.Start()
+
+	tagSpecifications, err := ConvertTagListToTagSpecifications(RESOURCE_TYPE_ELASTIC_IP, publicIPReqInfo.TagList, publicIPReqInfo.IId.NameId)
+	if err != nil {
+		return irs.PublicIPInfo{}, fmt.Errorf("failed to convert tag list: %w", err)
+	}
+
+	input := &ec2.AllocateAddressInput{
+		Domain:            aws.String(AWS_ADDRESS_DOMAIN_VPC),
+		TagSpecifications: tagSpecifications,
+	}
+	result, err := publicIPHandler.Client.AllocateAddress(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return irs.PublicIPInfo{}, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return publicIPHandler.GetPublicIP(irs.IID{NameId: publicIPReqInfo.IId.NameId, SystemId: *result.AllocationId})
+}
+
+func (publicIPHandler *AwsPublicIPHandler) ListPublicIP() ([]*irs.PublicIPInfo, error) {
+	hiscallInfo := GetCallLogScheme(publicIPHandler.Region, call.PUBLICIP, "PublicIP", "ListPublicIP()")
+	start := call.Start()
+
+	addresses, err := publicIPHandler.describeAddresses(nil)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return nil, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	publicIPInfoList := []*irs.PublicIPInfo{}
+	for _, address := range addresses {
+		publicIPInfo := convertAddressToPublicIPInfo(address)
+		publicIPInfoList = append(publicIPInfoList, &publicIPInfo)
+	}
+	return publicIPInfoList, nil
+}
+
+func (publicIPHandler *AwsPublicIPHandler) GetPublicIP(publicIPIID irs.IID) (irs.PublicIPInfo, error) {
+	hiscallInfo := GetCallLogScheme(publicIPHandler.Region, call.PUBLICIP, publicIPIID.NameId, "GetPublicIP()")
+	start := call.Start()
+
+	address, err := publicIPHandler.getAddress(publicIPIID)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return irs.PublicIPInfo{}, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return convertAddressToPublicIPInfo(address), nil
+}
+
+func (publicIPHandler *AwsPublicIPHandler) DeletePublicIP(publicIPIID irs.IID) (bool, error) {
+	hiscallInfo := GetCallLogScheme(publicIPHandler.Region, call.PUBLICIP, publicIPIID.NameId, "ReleaseAddress()")
+	start := call.Start()
+
+	input := &ec2.ReleaseAddressInput{
+		AllocationId: aws.String(publicIPIID.SystemId),
+	}
+	_, err := publicIPHandler.Client.ReleaseAddress(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return false, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return true, nil
+}
+
+func (publicIPHandler *AwsPublicIPHandler) AttachPublicIP(publicIPIID irs.IID, ownerVM irs.IID) (irs.PublicIPInfo, error) {
+	hiscallInfo := GetCallLogScheme(publicIPHandler.Region, call.PUBLICIP, publicIPIID.NameId, "AssociateAddress()")
+	start := call.Start()
+
+	input := &ec2.AssociateAddressInput{
+		AllocationId:       aws.String(publicIPIID.SystemId),
+		InstanceId:         aws.String(ownerVM.SystemId),
+		AllowReassociation: aws.Bool(false),
+	}
+	_, err := publicIPHandler.Client.AssociateAddress(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return irs.PublicIPInfo{}, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return publicIPHandler.GetPublicIP(publicIPIID)
+}
+
+func (publicIPHandler *AwsPublicIPHandler) DetachPublicIP(publicIPIID irs.IID, ownerVM irs.IID) (bool, error) {
+	hiscallInfo := GetCallLogScheme(publicIPHandler.Region, call.PUBLICIP, publicIPIID.NameId, "DisassociateAddress()")
+
+	address, err := publicIPHandler.getAddress(publicIPIID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	if address.AssociationId == nil || aws.StringValue(address.InstanceId) != ownerVM.SystemId {
+		return false, fmt.Errorf("PublicIP %s is not attached to VM %s", publicIPIID.SystemId, ownerVM.SystemId)
+	}
+
+	start := call.Start()
+	input := &ec2.DisassociateAddressInput{
+		AssociationId: address.AssociationId,
+	}
+	_, err = publicIPHandler.Client.DisassociateAddress(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return false, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return true, nil
+}
+
+func (publicIPHandler *AwsPublicIPHandler) ListIID() ([]*irs.IID, error) {
+	hiscallInfo := GetCallLogScheme(publicIPHandler.Region, call.PUBLICIP, "ListIID", "DescribeAddresses()")
+	start := call.Start()
+
+	addresses, err := publicIPHandler.describeAddresses(nil)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return nil, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	iidList := []*irs.IID{}
+	for _, address := range addresses {
+		iid := irs.IID{SystemId: aws.StringValue(address.AllocationId)}
+		iidList = append(iidList, &iid)
+	}
+	return iidList, nil
+}
+
+func (publicIPHandler *AwsPublicIPHandler) describeAddresses(allocationIds []*string) ([]*ec2.Address, error) {
+	input := &ec2.DescribeAddressesInput{
+		AllocationIds: allocationIds,
+		Filters: []*ec2.Filter{
+			{Name: aws.String("domain"), Values: []*string{aws.String(AWS_ADDRESS_DOMAIN_VPC)}},
+		},
+	}
+	result, err := publicIPHandler.Client.DescribeAddresses(input)
+	if err != nil {
+		return nil, err
+	}
+	return result.Addresses, nil
+}
+
+func (publicIPHandler *AwsPublicIPHandler) getAddress(publicIPIID irs.IID) (*ec2.Address, error) {
+	addresses, err := publicIPHandler.describeAddresses([]*string{aws.String(publicIPIID.SystemId)})
+	if err != nil {
+		return nil, err
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("PublicIP %s does not exist", publicIPIID.SystemId)
+	}
+	return addresses[0], nil
+}
+
+func convertAddressToPublicIPInfo(address *ec2.Address) irs.PublicIPInfo {
+	publicIPInfo := irs.PublicIPInfo{
+		IId:      irs.IID{SystemId: aws.StringValue(address.AllocationId)},
+		PublicIP: aws.StringValue(address.PublicIp),
+		Status:   irs.PublicIPAvailable,
+	}
+
+	for _, tag := range address.Tags {
+		if aws.StringValue(tag.Key) == "Name" {
+			publicIPInfo.IId.NameId = aws.StringValue(tag.Value)
+		}
+		publicIPInfo.TagList = append(publicIPInfo.TagList, irs.KeyValue{Key: aws.StringValue(tag.Key), Value: aws.StringValue(tag.Value)})
+	}
+	if address.AssociationId != nil {
+		publicIPInfo.Status = irs.PublicIPAttached
+		if address.InstanceId != nil {
+			publicIPInfo.OwnerVM = irs.IID{SystemId: *address.InstanceId}
+		}
+	}
+
+	publicIPInfo.KeyValueList = irs.StructToKeyValueList(address)
+	return publicIPInfo
+}
@@ -155,11 +155,7 @@ func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.Se
 				//ipPermission.SetToPort(0)
 			}
 
-			ipPermission.SetIpRanges([]*ec2.IpRange{
-				(&ec2.IpRange{}).
-					SetCidrIp(ip.CIDR),
-				//SetCidrIp("0.0.0.0/0"),
-			})
+			setIpPermissionTarget(ipPermission, ip)
 			// cblogger.Debug("===>변환완료")
 			// cblogger.Debug(ipPermission)
 
@@ -222,11 +218,7 @@ func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.Se
 				//ipPermission.SetToPort(0)
 			}
 
-			ipPermission.SetIpRanges([]*ec2.IpRange{
-				(&ec2.IpRange{}).
-					SetCidrIp(ip.CIDR),
-				//SetCidrIp("0.0.0.0/0"),
-			})
+			setIpPermissionTarget(ipPermission, ip)
 			//ipPermissions = append(ipPermissions, ipPermission)
 			ipPermissionsEgress = append(ipPermissionsEgress, ipPermission)
 		}
@@ -462,6 +454,32 @@ func ExtractSecurityInfo(securityGroupResult *ec2.SecurityGroup) irs.SecurityInf
 	return securityInfo
 }
 
+// set the target of an IpPermission: the remote SecurityGroup if specified, otherwise the IPv4 or IPv6 CIDR
+// AWS allows only inbound/outbound allow rules without priorities, so Priority and Action are not used.
+func setIpPermissionTarget(ipPermission *ec2.IpPermission, ip irs.SecurityRuleInfo) {
+	if ip.RemoteSecurityGroupIID != nil {
+		userIdGroupPair := (&ec2.UserIdGroupPair{}).SetGroupId(ip.RemoteSecurityGroupIID.SystemId)
+		if ip.Description != "" {
+			userIdGroupPair.SetDescription(ip.Description)
+		}
+		ipPermission.SetUserIdGroupPairs([]*ec2.UserIdGroupPair{userIdGroupPair})
+		return
+	}
+	if strings.Contains(ip.CIDR, ":") {
+		ipv6Range := (&ec2.Ipv6Range{}).SetCidrIpv6(ip.CIDR)
+		if ip.Description != "" {
+			ipv6Range.SetDescription(ip.Description)
+		}
+		ipPermission.SetIpv6Ranges([]*ec2.Ipv6Range{ipv6Range})
+		return
+	}
+	ipRange := (&ec2.IpRange{}).SetCidrIp(ip.CIDR)
+	if ip.Description != "" {
+		ipRange.SetDescription(ip.Description)
+	}
+	ipPermission.SetIpRanges([]*ec2.IpRange{ipRange})
+}
+
 // IpPermission에서 공통정보 추출
 func ExtractIpPermissionCommon(ip *ec2.IpPermission, securityRuleInfo *irs.SecurityRuleInfo) {
 	//공통 정보
@@ -497,6 +515,9 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 				Direction: direction, // "inbound | outbound"
 				CIDR:      *ipv4.CidrIp,
 			}
+			if ipv4.Description != nil {
+				securityRuleInfo.Description = *ipv4.Description
+			}
 			cblogger.Debug(*ipv4.CidrIp)
 
 			ExtractIpPermissionCommon(ip, &securityRuleInfo) //IP & Port & Protocol 추출
@@ -509,6 +530,9 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 				Direction: direction, // "inbound | outbound"
 				CIDR:      *ipv6.CidrIpv6,
 			}
+			if ipv6.Description != nil {
+				securityRuleInfo.Description = *ipv6.Description
+			}
 			cblogger.Debug(*ipv6.CidrIpv6)
 
 			ExtractIpPermissionCommon(ip, &securityRuleInfo) //IP & Port & Protocol 추출
@@ -516,10 +540,15 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 		}
 
 		//ELB나 보안그룹 참조 방식 처리
+		//CIDR에는 이전 버전과의 호환을 위해 참조하는 보안그룹의 GroupId를 유지함
 		for _, userIdGroup := range ip.UserIdGroupPairs {
 			securityRuleInfo := irs.SecurityRuleInfo{
-				Direction: direction, // "inbound | outbound"
-				CIDR:      *userIdGroup.GroupId,
+				Direction:              direction, // "inbound | outbound"
+				CIDR:                   *userIdGroup.GroupId,
+				RemoteSecurityGroupIID: &irs.IID{SystemId: *userIdGroup.GroupId},
+			}
+			if userIdGroup.Description != nil {
+				securityRuleInfo.Description = *userIdGroup.Description
 			}
 			cblogger.Debug(*userIdGroup.UserId)
 
@@ -689,11 +718,7 @@ func (securityHandler *AwsSecurityHandler) ProcessAddRules(newGroupId *string, s
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionTarget(ipPermission, ip)
 		// cblogger.Debug("===>변환완료")
 		// cblogger.Debug(ipPermission)
 
@@ -756,11 +781,7 @@ func (securityHandler *AwsSecurityHandler) ProcessAddRules(newGroupId *string, s
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionTarget(ipPermission, ip)
 		//ipPermissions = append(ipPermissions, ipPermission)
 		ipPermissionsEgress = append(ipPermissionsEgress, ipPermission)
 	}
@@ -848,11 +869,7 @@ func (securityHandler *AwsSecurityHandler) ProcessRemoveRules(newGroupId *string
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionTarget(ipPermission, ip)
 		// cblogger.Debug("===>변환완료")
 		// cblogger.Debug(ipPermission)
 
@@ -915,11 +932,7 @@ func (securityHandler *AwsSecurityHandler) ProcessRemoveRules(newGroupId *string
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionTarget(ipPermission, ip)
 		//ipPermissions = append(ipPermissions, ipPermission)
 		ipPermissionsEgress = append(ipPermissionsEgress, ipPermission)
 	}
@@ -7,6 +7,7 @@
 package resources
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -30,6 +31,9 @@ type AwsVMHandler struct {
 	Region     idrv.RegionInfo
 	Client     *ec2.EC2
 	TagHandler *AwsTagHandler // 2024-07-18 TagHandler add
+
+	ctx        context.Context // request context set by withContext(), nil: no cancel
+	orgHandler *AwsVMHandler   // handler without the request context, set by withContext()
 }
 
 func Connect(region string) *ec2.EC2 {
@@ -317,6 +321,13 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 		cblogger.Debugf("Windows Cloud-Init : [%s]", userData)
 	} else {
 		userData = string(fileDataCloudInit)
+		// append user's user-data(script or cloud-config) after cb-user setup
+		userData = cdcom.MergeUserData(userData, vmReqInfo.UserData)
+		err := cdcom.ValidateUserDataSize("AWS", userData)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.VMInfo{}, err
+		}
 	}
 
 	//userData = strings.ReplaceAll(userData, "{{username}}", CBDefaultVmUserName)
@@ -378,6 +389,17 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 		TagSpecifications: tagSpecifications,
 	}
 
+	//=============================
+	// Spot 처리
+	//=============================
+	if vmReqInfo.PurchaseOption.PurchaseType == irs.PurchaseSpot {
+		input.InstanceMarketOptions = convertSpotMarketOptions(vmReqInfo.PurchaseOption)
+		// a Spot instance can hibernate only if it is launched with the hibernation configured
+		if vmReqInfo.PurchaseOption.InterruptionBehavior == irs.InterruptionHibernate {
+			input.HibernationOptions = &ec2.HibernationOptionsRequest{Configured: aws.Bool(true)}
+		}
+	}
+
 	//=============================
 	// SystemDisk 처리 - 이슈 #348에 의해 RootDisk 기능 지원
 	//=============================
@@ -478,6 +500,10 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 	newVmId := *runResult.Instances[0].InstanceId
 	cblogger.Infof("[%s] VM has been created.", newVmId)
 
+	// The instance exists, so the remaining steps are not canceled with the request.
+	// If they were canceled, StartVM would fail without the instance ID and the VM would leak.
+	vmHandler = vmHandler.withoutContext()
+
 	/*
 		if baseName != "" {
 			// Tag에 VM Name 설정
@@ -506,7 +532,10 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 	//2021-05-11 EIP 할당 로직이 제거되었으며 빠른 생성을 위해 Running 상태가 될때까지 대기하지 않음.
 	//2021-05-11 WaitForRun을 호출하지 않아도 GetVM() 호출 시 에러가 발생하지 않는 것은 확인했음. (우선은 정책이 최종 확정이 아니라서 WaitForRun을 사용하도록 원복함.)
 	cblogger.Debug("Waiting for EC2 to be in the Running state to obtain the latest information about the VM")
-	WaitForRun(vmHandler.Client, newVmId)
+	err = WaitForRunWithContext(requestContext(vmHandler.ctx), vmHandler.Client, newVmId)
+	if err != nil {
+		cblogger.Error(err)
+	}
 	cblogger.Debug("EC2 Running state completed: ", runResult.Instances[0].State.Name)
 
 	/* 2020-04-08 EIP 로직 제거
@@ -591,6 +620,11 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 
 // VM이 Running 상태일때까지 대기 함.
 func WaitForRun(svc *ec2.EC2, instanceID string) {
+	WaitForRunWithContext(context.Background(), svc, instanceID)
+}
+
+// WaitForRunWithContext is WaitForRun, which stops polling as soon as ctx is done.
+func WaitForRunWithContext(ctx context.Context, svc *ec2.EC2, instanceID string) error {
 	cblogger.Infof("EC2 ID : [%s]", instanceID)
 
 	input := &ec2.DescribeInstancesInput{
@@ -598,11 +632,12 @@ func WaitForRun(svc *ec2.EC2, instanceID string) {
 			aws.String(instanceID),
 		},
 	}
-	err := svc.WaitUntilInstanceRunning(input)
+	err := svc.WaitUntilInstanceRunningWithContext(ctx, input)
 	if err != nil {
 		cblogger.Errorf("failed to wait until instances exist: %v", err)
 	}
 	cblogger.Info("=========WaitForRun() ended")
+	return err
 }
 
 // func (vmHandler *AwsVMHandler) ResumeVM(vmNameId string) (irs.VMStatus, error) {
@@ -814,6 +849,11 @@ func (vmHandler *AwsVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, error)
 	vmID := vmIID.SystemId
 	cblogger.Infof("vmID : [%s]", vmID)
 
+	// a persistent Spot request launches a new instance after the termination, so it is cancelled first
+	if err := vmHandler.cancelSpotInstanceRequest(vmIID); err != nil {
+		return irs.VMStatus("Failed"), err
+	}
+
 	input := &ec2.TerminateInstancesInput{
 		//InstanceIds: instanceIds,
 		InstanceIds: []*string{
@@ -851,6 +891,49 @@ func (vmHandler *AwsVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, error)
 	return irs.VMStatus("Terminating"), nil
 }
 
+// ChangeVMSpec changes the instance type of a stopped instance.
+// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-instance-resize.html
+func (vmHandler *AwsVMHandler) ChangeVMSpec(vmIID irs.IID, specName string) (irs.VMInfo, error) {
+	cblogger.Infof("vmNameId : [%s], specName : [%s]", vmIID.NameId, specName)
+
+	vmID := vmIID.SystemId
+
+	input := &ec2.ModifyInstanceAttributeInput{
+		InstanceId: aws.String(vmID),
+		InstanceType: &ec2.AttributeValue{
+			Value: aws.String(specName),
+		},
+	}
+
+	// logger for HisCall
+	callogger := call.GetLogger("HISCALL")
+	callLogInfo := call.CLOUDLOGSCHEMA{
+		CloudOS:      call.AWS,
+		RegionZone:   vmHandler.Region.Zone,
+		ResourceType: call.VM,
+		ResourceName: vmIID.SystemId,
+		CloudOSAPI:   "ModifyInstanceAttribute()",
+		ElapsedTime:  "",
+		ErrorMSG:     "",
+	}
+	callLogStart := call.Start()
+
+	result, err := vmHandler.Client.ModifyInstanceAttribute(input)
+	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	if cblogger.Level.String() == "debug" {
+		cblogger.Debug(result)
+	}
+	if err != nil {
+		callLogInfo.ErrorMSG = err.Error()
+		callogger.Info(call.String(callLogInfo))
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
+	callogger.Info(call.String(callLogInfo))
+
+	return vmHandler.GetVM(vmIID)
+}
+
 // https://docs.aws.amazon.com/ko_kr/AWSEC2/latest/APIReference/API_GetPasswordData.html
 // https://awscli.amazonaws.com/v2/documentation/api/latest/reference/ec2/get-password-data.html
 // @TODO : ssh key를 이용해서 암호가 해독된 Password를 조회해야 함.
@@ -1140,6 +1223,12 @@ func (vmHandler *AwsVMHandler) ExtractDescribeInstanceToVmInfo(instance *ec2.Ins
 		vmInfo.PrivateIP = *instance.PrivateIpAddress
 	}
 
+	// IPv6 address in a dual-stack subnet
+	vmInfo.IPv6Address = extractIPv6Address(instance)
+
+	// OnDemand or Spot
+	vmInfo.PurchaseOption = extractPurchaseOption(instance)
+
 	//vmInfo.PrivateDNS = *reservation.Instances[0].NetworkInterfaces[0].PrivateDnsName		//없는 경우 존재해서 Instances[0].PrivateDnsName로 대체 - i-0b75cac73c4575386
 	if !reflect.ValueOf(instance.PrivateDnsName).IsNil() {
 		vmInfo.PrivateDNS = *instance.PrivateDnsName
@@ -1307,6 +1396,12 @@ func (vmHandler *AwsVMHandler) ExtractDescribeInstances(reservation *ec2.Reserva
 		vmInfo.PrivateIP = *reservation.Instances[0].PrivateIpAddress
 	}
 
+	// IPv6 address in a dual-stack subnet
+	vmInfo.IPv6Address = extractIPv6Address(reservation.Instances[0])
+
+	// OnDemand or Spot
+	vmInfo.PurchaseOption = extractPurchaseOption(reservation.Instances[0])
+
 	//vmInfo.PrivateDNS = *reservation.Instances[0].NetworkInterfaces[0].PrivateDnsName		//없는 경우 존재해서 Instances[0].PrivateDnsName로 대체 - i-0b75cac73c4575386
 	if !reflect.ValueOf(reservation.Instances[0].PrivateDnsName).IsNil() {
 		vmInfo.PrivateDNS = *reservation.Instances[0].PrivateDnsName
@@ -1749,3 +1844,89 @@ func (vmHandler *AwsVMHandler) ListIID() ([]*irs.IID, error) {
 
 	return iidList, nil
 }
+
+// extractIPv6Address returns the first IPv6 address of the network interfaces of an instance.
+func extractIPv6Address(instance *ec2.Instance) string {
+	for _, networkInterface := range instance.NetworkInterfaces {
+		for _, ipv6 := range networkInterface.Ipv6Addresses {
+			if ipv6.Ipv6Address != nil {
+				return *ipv6.Ipv6Address
+			}
+		}
+	}
+	return ""
+}
+
+// convertSpotMarketOptions converts a Spot purchase option to the market options of RunInstances.
+// Stop and Hibernate need a persistent Spot request, it is cancelled by TerminateVM().
+func convertSpotMarketOptions(option irs.VMPurchaseOption) *ec2.InstanceMarketOptionsRequest {
+	spotOptions := &ec2.SpotMarketOptions{
+		InstanceInterruptionBehavior: aws.String(ec2.InstanceInterruptionBehaviorTerminate),
+		SpotInstanceType:             aws.String(ec2.SpotInstanceTypeOneTime),
+	}
+	if option.MaxPrice != "" {
+		spotOptions.MaxPrice = aws.String(option.MaxPrice)
+	}
+
+	switch option.InterruptionBehavior {
+	case irs.InterruptionStop:
+		spotOptions.InstanceInterruptionBehavior = aws.String(ec2.InstanceInterruptionBehaviorStop)
+		spotOptions.SpotInstanceType = aws.String(ec2.SpotInstanceTypePersistent)
+	case irs.InterruptionHibernate:
+		spotOptions.InstanceInterruptionBehavior = aws.String(ec2.InstanceInterruptionBehaviorHibernate)
+		spotOptions.SpotInstanceType = aws.String(ec2.SpotInstanceTypePersistent)
+	}
+
+	return &ec2.InstanceMarketOptionsRequest{
+		MarketType:  aws.String(ec2.MarketTypeSpot),
+		SpotOptions: spotOptions,
+	}
+}
+
+// cancelSpotInstanceRequest cancels the Spot request of a Spot instance.
+// The SpotInstanceRequestId of the instance is also in the KeyValueList of its VMInfo.
+func (vmHandler *AwsVMHandler) cancelSpotInstanceRequest(vmIID irs.IID) error {
+	instance, err := DescribeInstanceById(vmHandler.Client, vmIID)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	if instance.SpotInstanceRequestId == nil || *instance.SpotInstanceRequestId == "" {
+		return nil
+	}
+
+	// logger for HisCall
+	callogger := call.GetLogger("HISCALL")
+	callLogInfo := call.CLOUDLOGSCHEMA{
+		CloudOS:      call.AWS,
+		RegionZone:   vmHandler.Region.Zone,
+		ResourceType: call.VM,
+		ResourceName: vmIID.SystemId,
+		CloudOSAPI:   "CancelSpotInstanceRequests()",
+		ElapsedTime:  "",
+		ErrorMSG:     "",
+	}
+	callLogStart := call.Start()
+
+	_, err = vmHandler.Client.CancelSpotInstanceRequests(&ec2.CancelSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: []*string{instance.SpotInstanceRequestId},
+	})
+	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	if err != nil {
+		callLogInfo.ErrorMSG = err.Error()
+		callogger.Info(call.String(callLogInfo))
+		cblogger.Errorf("Could not cancel the Spot request %s: %v", *instance.SpotInstanceRequestId, err)
+		return err
+	}
+	callogger.Info(call.String(callLogInfo))
+	return nil
+}
+
+// extractPurchaseOption returns the purchase type of an instance by its lifecycle.
+// MaxPrice and InterruptionBehavior are kept in the Spot request, so they are not returned.
+func extractPurchaseOption(instance *ec2.Instance) irs.VMPurchaseOption {
+	if instance.InstanceLifecycle != nil && *instance.InstanceLifecycle == ec2.InstanceLifecycleTypeSpot {
+		return irs.VMPurchaseOption{PurchaseType: irs.PurchaseSpot}
+	}
+	return irs.VMPurchaseOption{PurchaseType: irs.PurchaseOnDemand}
+}
@@ -0,0 +1,302 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is AWS VNic(ENI) Handler of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// Spider 의 VNic = AWS 의 ENI(Elastic Network Interface)
+type AwsVNicHandler struct {
+	Region idrv.RegionInfo
+	Client *ec2.EC2
+}
+
+const (
+	AWS_ENI_STATUS_AVAILABLE = "available"
+	AWS_ENI_STATUS_ATTACHING = "attaching"
+	AWS_ENI_STATUS_INUSE     = "in-use"
+	AWS_ENI_STATUS_DETACHING = "detaching"
+
+	RESOURCE_TYPE_NETWORK_INTERFACE = "network-interface"
+)
+
+func (vNicHandler *AwsVNicHandler) CreateVNic(vNicReqInfo irs.VNicInfo) (irs.VNicInfo, error) {
+	hiscallInfo := GetCallLogScheme(vNicHandler.Region, call.VNIC, vNicReqInfo.IId.NameId, "CreateNetworkInterface()")
+	start := call.Start()
+
+	if vNicReqInfo.SubnetIID.SystemId == "" {
+		return irs.VNicInfo{}, errors.New("SubnetIID is required to create a VNic")
+	}
+
+	tagSpecifications, err := ConvertTagListToTagSpecifications(RESOURCE_TYPE_NETWORK_INTERFACE, vNicReqInfo.TagList, vNicReqInfo.IId.NameId)
+	if err != nil {
+		return irs.VNicInfo{}, fmt.Errorf("failed to convert tag list: %w", err)
+	}
+
+	input := &ec2.CreateNetworkInterfaceInput{
+		SubnetId:          aws.String(vNicReqInfo.SubnetIID.SystemId),
+		TagSpecifications: tagSpecifications,
+	}
+	for _, sgIID := range vNicReqInfo.SecurityGroupIIDs {
+		input.Groups = append(input.Groups, aws.String(sgIID.SystemId))
+	}
+	if vNicReqInfo.PrivateIP != "" {
+		input.PrivateIpAddress = aws.String(vNicReqInfo.PrivateIP)
+	}
+
+	result, err := vNicHandler.Client.CreateNetworkInterface(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return irs.VNicInfo{}, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return convertNetworkInterfaceToVNicInfo(result.NetworkInterface), nil
+}
+
+func (vNicHandler *AwsVNicHandler) ListVNic() ([]*irs.VNicInfo, error) {
+	hiscallInfo := GetCallLogScheme(vNicHandler.Region, call.VNIC, "VNic", "ListVNic()")
+	start := call.Start()
+
+	result, err := vNicHandler.describeNetworkInterfaces(nil)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return nil, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	vNicInfoList := []*irs.VNicInfo{}
+	for _, eni := range result {
+		if !isVNic(eni) {
+			continue
+		}
+		vNicInfo := convertNetworkInterfaceToVNicInfo(eni)
+		vNicInfoList = append(vNicInfoList, &vNicInfo)
+	}
+	return vNicInfoList, nil
+}
+
+func (vNicHandler *AwsVNicHandler) GetVNic(vNicIID irs.IID) (irs.VNicInfo, error) {
+	hiscallInfo := GetCallLogScheme(vNicHandler.Region, call.VNIC, vNicIID.NameId, "GetVNic()")
+	start := call.Start()
+
+	eni, err := vNicHandler.getNetworkInterface(vNicIID)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return irs.VNicInfo{}, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return convertNetworkInterfaceToVNicInfo(eni), nil
+}
+
+func (vNicHandler *AwsVNicHandler) DeleteVNic(vNicIID irs.IID) (bool, error) {
+	hiscallInfo := GetCallLogScheme(vNicHandler.Region, call.VNIC, vNicIID.NameId, "DeleteNetworkInterface()")
+	start := call.Start()
+
+	input := &ec2.DeleteNetworkInterfaceInput{
+		NetworkInterfaceId: aws.String(vNicIID.SystemId),
+	}
+	_, err := vNicHandler.Client.DeleteNetworkInterface(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return false, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return true, nil
+}
+
+/*
+ENI 는 VM 의 다음 DeviceIndex 로 Attach.
+DeviceIndex 0 은 VM 의 Primary ENI.
+*/
+func (vNicHandler *AwsVNicHandler) AttachVNic(vNicIID irs.IID, ownerVM irs.IID) (irs.VNicInfo, error) {
+	hiscallInfo := GetCallLogScheme(vNicHandler.Region, call.VNIC, vNicIID.NameId, "AttachNetworkInterface()")
+
+	// (1) find the next device index of the VM
+	instances, err := vNicHandler.Client.DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: []*string{aws.String(ownerVM.SystemId)},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VNicInfo{}, err
+	}
+	if len(instances.Reservations) == 0 || len(instances.Reservations[0].Instances) == 0 {
+		return irs.VNicInfo{}, fmt.Errorf("VM %s does not exist", ownerVM.SystemId)
+	}
+	deviceIndex := int64(0)
+	for _, eni := range instances.Reservations[0].Instances[0].NetworkInterfaces {
+		if eni.Attachment != nil && eni.Attachment.DeviceIndex != nil && *eni.Attachment.DeviceIndex >= deviceIndex {
+			deviceIndex = *eni.Attachment.DeviceIndex + 1
+		}
+	}
+
+	// (2) attach
+	start := call.Start()
+	input := &ec2.AttachNetworkInterfaceInput{
+		DeviceIndex:        aws.Int64(deviceIndex),
+		InstanceId:         aws.String(ownerVM.SystemId),
+		NetworkInterfaceId: aws.String(vNicIID.SystemId),
+	}
+	_, err = vNicHandler.Client.AttachNetworkInterface(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return irs.VNicInfo{}, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return vNicHandler.GetVNic(vNicIID)
+}
+
+func (vNicHandler *AwsVNicHandler) DetachVNic(vNicIID irs.IID, ownerVM irs.IID) (bool, error) {
+	hiscallInfo := GetCallLogScheme(vNicHandler.Region, call.VNIC, vNicIID.NameId, "DetachNetworkInterface()")
+
+	eni, err := vNicHandler.getNetworkInterface(vNicIID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	if eni.Attachment == nil || eni.Attachment.InstanceId == nil || *eni.Attachment.InstanceId != ownerVM.SystemId {
+		return false, fmt.Errorf("VNic %s is not attached to VM %s", vNicIID.SystemId, ownerVM.SystemId)
+	}
+
+	start := call.Start()
+	input := &ec2.DetachNetworkInterfaceInput{
+		AttachmentId: eni.Attachment.AttachmentId,
+	}
+	_, err = vNicHandler.Client.DetachNetworkInterface(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return false, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return true, nil
+}
+
+func (vNicHandler *AwsVNicHandler) ListIID() ([]*irs.IID, error) {
+	hiscallInfo := GetCallLogScheme(vNicHandler.Region, call.VNIC, "ListIID", "DescribeNetworkInterfaces()")
+	start := call.Start()
+
+	result, err := vNicHandler.describeNetworkInterfaces(nil)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return nil, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	iidList := []*irs.IID{}
+	for _, eni := range result {
+		if !isVNic(eni) {
+			continue
+		}
+		iid := irs.IID{SystemId: *eni.NetworkInterfaceId}
+		iidList = append(iidList, &iid)
+	}
+	return iidList, nil
+}
+
+// Primary ENI(DeviceIndex 0) 는 VM 의 일부이고, AWS 가 관리하는 ENI(NLB, NAT Gateway 등)는 VNic 이 아님.
+func isVNic(eni *ec2.NetworkInterface) bool {
+	if aws.BoolValue(eni.RequesterManaged) {
+		return false
+	}
+	if eni.Attachment != nil && aws.Int64Value(eni.Attachment.DeviceIndex) == 0 && eni.Attachment.InstanceId != nil {
+		return false
+	}
+	return true
+}
+
+func (vNicHandler *AwsVNicHandler) describeNetworkInterfaces(eniIds []*string) ([]*ec2.NetworkInterface, error) {
+	input := &ec2.DescribeNetworkInterfacesInput{NetworkInterfaceIds: eniIds}
+
+	var eniList []*ec2.NetworkInterface
+	err := vNicHandler.Client.DescribeNetworkInterfacesPages(input, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		eniList = append(eniList, page.NetworkInterfaces...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return eniList, nil
+}
+
+func (vNicHandler *AwsVNicHandler) getNetworkInterface(vNicIID irs.IID) (*ec2.NetworkInterface, error) {
+	eniList, err := vNicHandler.describeNetworkInterfaces([]*string{aws.String(vNicIID.SystemId)})
+	if err != nil {
+		return nil, err
+	}
+	if len(eniList) == 0 {
+		return nil, fmt.Errorf("VNic %s does not exist", vNicIID.SystemId)
+	}
+	return eniList[0], nil
+}
+
+func convertNetworkInterfaceToVNicInfo(eni *ec2.NetworkInterface) irs.VNicInfo {
+	vNicInfo := irs.VNicInfo{
+		IId:        irs.IID{SystemId: aws.StringValue(eni.NetworkInterfaceId)},
+		VpcIID:     irs.IID{SystemId: aws.StringValue(eni.VpcId)},
+		SubnetIID:  irs.IID{SystemId: aws.StringValue(eni.SubnetId)},
+		PrivateIP:  aws.StringValue(eni.PrivateIpAddress),
+		MacAddress: aws.StringValue(eni.MacAddress),
+	}
+
+	for _, tag := range eni.TagSet {
+		if aws.StringValue(tag.Key) == "Name" {
+			vNicInfo.IId.NameId = aws.StringValue(tag.Value)
+		}
+		vNicInfo.TagList = append(vNicInfo.TagList, irs.KeyValue{Key: aws.StringValue(tag.Key), Value: aws.StringValue(tag.Value)})
+	}
+	for _, group := range eni.Groups {
+		vNicInfo.SecurityGroupIIDs = append(vNicInfo.SecurityGroupIIDs, irs.IID{NameId: aws.StringValue(group.GroupName), SystemId: aws.StringValue(group.GroupId)})
+	}
+	if eni.Association != nil {
+		vNicInfo.PublicIP = aws.StringValue(eni.Association.PublicIp)
+	}
+
+	switch aws.StringValue(eni.Status) {
+	case AWS_ENI_STATUS_AVAILABLE:
+		vNicInfo.Status = irs.VNicAvailable
+	case AWS_ENI_STATUS_INUSE, AWS_ENI_STATUS_ATTACHING, AWS_ENI_STATUS_DETACHING:
+		vNicInfo.Status = irs.VNicAttached
+	default:
+		vNicInfo.Status = irs.VNicError
+	}
+	if eni.Attachment != nil && eni.Attachment.InstanceId != nil {
+		vNicInfo.OwnerVM = irs.IID{SystemId: *eni.Attachment.InstanceId}
+	}
+
+	vNicInfo.KeyValueList = irs.StructToKeyValueList(eni)
+	return vNicInfo
+}
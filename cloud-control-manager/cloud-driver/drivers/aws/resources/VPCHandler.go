@@ -14,11 +14,13 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
@@ -39,6 +41,14 @@ func (VPCHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCIn
 		return irs.VPCInfo{}, errors.New("Connection information does not contain Zone information.")
 	}
 
+	// the IPv6 CIDR of the VPC is a random /56 assigned by Amazon, so the Subnet's IPv6 CIDR can not be requested
+	for _, subnetInfo := range vpcReqInfo.SubnetInfoList {
+		if subnetInfo.IPv6_CIDR != "" {
+			cblogger.Errorf("[%s] the Subnet's IPv6 CIDR can not be specified", subnetInfo.IId.NameId)
+			return irs.VPCInfo{}, errors.New("The IPv6 CIDR of the Subnet '" + subnetInfo.IId.NameId + "' can not be specified: AWS assigns a random /56 IPv6 CIDR to the VPC, and CB-Spider assigns a /64 of it to each Subnet.")
+		}
+	}
+
 	// Convert TagList to TagSpecifications
 	tagSpecifications, err := ConvertTagListToTagSpecifications("vpc", vpcReqInfo.TagList, vpcReqInfo.IId.NameId)
 	if err != nil {
@@ -50,6 +60,10 @@ func (VPCHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCIn
 		CidrBlock:         aws.String(vpcReqInfo.IPv4_CIDR),
 		TagSpecifications: tagSpecifications,
 	}
+	// dual-stack: Amazon provides a /56 IPv6 CIDR block
+	if vpcReqInfo.EnableIPv6 {
+		input.AmazonProvidedIpv6CidrBlock = aws.Bool(true)
+	}
 
 	//cblogger.Debug(input)
 	// logger for HisCall
@@ -90,6 +104,15 @@ func (VPCHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCIn
 	retVpcInfo := ExtractVpcDescribeInfo(result.Vpc)
 	retVpcInfo.IId.NameId = vpcReqInfo.IId.NameId // NameId는 요청 받은 값으로 리턴해야 함.
 
+	// the IPv6 CIDR block is associated asynchronously
+	if vpcReqInfo.EnableIPv6 {
+		retVpcInfo.IPv6_CIDR, err = VPCHandler.waitVpcIPv6CIDR(retVpcInfo.IId.SystemId)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.VPCInfo{}, VPCHandler.rollbackVPC(retVpcInfo.IId.SystemId, err)
+		}
+	}
+
 	/*
 		// 2024.07.16 Delete with Tag support
 		if len(tagSpecifications) == 0 {
@@ -158,6 +181,12 @@ func (VPCHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCIn
 	if errRoute != nil {
 		return retVpcInfo, errRoute
 	}
+	if retVpcInfo.IPv6_CIDR != "" {
+		errRoute = VPCHandler.CreateIPv6RouteIGW(retVpcInfo.IId.SystemId, *resultIGW.InternetGateway.InternetGatewayId)
+		if errRoute != nil {
+			return irs.VPCInfo{}, VPCHandler.rollbackVPC(retVpcInfo.IId.SystemId, errRoute)
+		}
+	}
 
 	//==========================
 	// Subnet 생성
@@ -167,9 +196,24 @@ func (VPCHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCIn
 	for _, curSubnet := range vpcReqInfo.SubnetInfoList {
 		cblogger.Infof("[%s] Subnet Create", curSubnet.IId.NameId)
 		cblogger.Infof("Req Subnet Info [%v]", curSubnet)
+		if retVpcInfo.IPv6_CIDR != "" {
+			usedCIDRs := []string{}
+			for _, one := range resSubnetList {
+				usedCIDRs = append(usedCIDRs, one.IPv6_CIDR)
+			}
+			curSubnet.IPv6_CIDR, err = cdcom.NextIPv6SubnetCIDR(retVpcInfo.IPv6_CIDR, usedCIDRs)
+			if err != nil {
+				cblogger.Error(err)
+				return irs.VPCInfo{}, VPCHandler.rollbackVPC(retVpcInfo.IId.SystemId, err)
+			}
+		}
 		resSubnet, errSubnet := VPCHandler.CreateSubnet(retVpcInfo.IId.SystemId, curSubnet)
 
 		if errSubnet != nil {
+			// a dual-stack subnet can fail in the IPv6 setup after it is created
+			if retVpcInfo.IPv6_CIDR != "" {
+				return irs.VPCInfo{}, VPCHandler.rollbackVPC(retVpcInfo.IId.SystemId, errSubnet)
+			}
 			return retVpcInfo, errSubnet
 		}
 		resSubnetList = append(resSubnetList, resSubnet)
@@ -180,6 +224,17 @@ func (VPCHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCIn
 	return retVpcInfo, nil
 }
 
+// IPv6 설정에 실패한 VPC를 생성된 Subnet, IGW와 함께 삭제함
+func (VPCHandler *AwsVPCHandler) rollbackVPC(vpcId string, cause error) error {
+	cblogger.Infof("Rollback the VPC [%s]", vpcId)
+	_, err := VPCHandler.DeleteVPC(irs.IID{SystemId: vpcId})
+	if err != nil {
+		cblogger.Error(err)
+		return fmt.Errorf("%v, and failed to rollback the VPC %s: %v", cause, vpcId, err)
+	}
+	return cause
+}
+
 // 생성된 VPC의 라우팅 테이블에 IGW(Internet Gateway) 라우팅 정보를 생성함 (AWS 콘솔의 라우팅 테이블의 [라우팅] Tab 처리)
 func (VPCHandler *AwsVPCHandler) CreateRouteIGW(vpcId string, igwId string) error {
 	cblogger.Infof("VPC ID : [%s] / IGW ID : [%s]", vpcId, igwId)
@@ -237,6 +292,73 @@ func (VPCHandler *AwsVPCHandler) CreateRouteIGW(vpcId string, igwId string) erro
 	return nil
 }
 
+// 생성된 VPC의 기본 라우팅 테이블에 IPv6(::/0) IGW 라우팅 정보를 생성함 (dual-stack VPC)
+func (VPCHandler *AwsVPCHandler) CreateIPv6RouteIGW(vpcId string, igwId string) error {
+	routeTableId, errRoute := VPCHandler.GetDefaultRouteTable(vpcId)
+	if errRoute != nil {
+		return errRoute
+	}
+
+	input := &ec2.CreateRouteInput{
+		DestinationIpv6CidrBlock: aws.String("::/0"),
+		GatewayId:                aws.String(igwId),
+		RouteTableId:             aws.String(routeTableId),
+	}
+
+	// logger for HisCall
+	callogger := call.GetLogger("HISCALL")
+	callLogInfo := call.CLOUDLOGSCHEMA{
+		CloudOS:      call.AWS,
+		RegionZone:   VPCHandler.Region.Zone,
+		ResourceType: call.VPCSUBNET,
+		ResourceName: igwId,
+		CloudOSAPI:   "CreateRoute()",
+		ElapsedTime:  "",
+		ErrorMSG:     "",
+	}
+	callLogStart := call.Start()
+
+	_, err := VPCHandler.Client.CreateRoute(input)
+	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	if err != nil {
+		cblogger.Errorf("Failed to add routing information for IGW [%s] to RouteTable [%s] for destination (::/0).", igwId, routeTableId)
+		cblogger.Error(err)
+		callLogInfo.ErrorMSG = err.Error()
+		callogger.Info(call.String(callLogInfo))
+		return err
+	}
+	callogger.Info(call.String(callLogInfo))
+	return nil
+}
+
+// VPC의 IPv6 CIDR 블록이 할당될 때까지 대기함
+func (VPCHandler *AwsVPCHandler) waitVpcIPv6CIDR(vpcId string) (string, error) {
+	for i := 0; i < 30; i++ {
+		result, err := VPCHandler.Client.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: []*string{aws.String(vpcId)}})
+		if err != nil {
+			return "", err
+		}
+		if len(result.Vpcs) > 0 {
+			if cidr := extractVpcIPv6CIDR(result.Vpcs[0]); cidr != "" {
+				return cidr, nil
+			}
+		}
+		time.Sleep(time.Second)
+	}
+	return "", fmt.Errorf("the IPv6 CIDR block of VPC %s is not associated in time", vpcId)
+}
+
+// associated 상태인 VPC의 IPv6 CIDR 블록을 추출함
+func extractVpcIPv6CIDR(vpcInfo *ec2.Vpc) string {
+	for _, assoc := range vpcInfo.Ipv6CidrBlockAssociationSet {
+		if assoc.Ipv6CidrBlock != nil && assoc.Ipv6CidrBlockState != nil && assoc.Ipv6CidrBlockState.State != nil &&
+			*assoc.Ipv6CidrBlockState.State == ec2.VpcCidrBlockStateCodeAssociated {
+			return *assoc.Ipv6CidrBlock
+		}
+	}
+	return ""
+}
+
 // https://docs.aws.amazon.com/ko_kr/vpc/latest/userguide/VPC_Route_Tables.html
 // https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeRouteTables.html
 // 자동 생성된 VPC의 기본 라우팅 테이블 정보를 찾음
@@ -317,6 +439,9 @@ func (VPCHandler *AwsVPCHandler) CreateSubnet(vpcId string, reqSubnetInfo irs.Su
 		AvailabilityZone:  aws.String(zoneId),
 		TagSpecifications: tagSpecifications,
 	}
+	if reqSubnetInfo.IPv6_CIDR != "" {
+		input.Ipv6CidrBlock = aws.String(reqSubnetInfo.IPv6_CIDR)
+	}
 
 	// logger for HisCall
 	callogger := call.GetLogger("HISCALL")
@@ -359,6 +484,25 @@ func (VPCHandler *AwsVPCHandler) CreateSubnet(vpcId string, reqSubnetInfo irs.Su
 	vNetworkInfo := ExtractSubnetDescribeInfo(result.Subnet)
 	vNetworkInfo.TagList, _ = VPCHandler.TagHandler.ListTag(irs.SUBNET, vNetworkInfo.IId)
 
+	// VMs in a dual-stack subnet get an IPv6 address automatically
+	if reqSubnetInfo.IPv6_CIDR != "" {
+		_, err = VPCHandler.Client.ModifySubnetAttribute(&ec2.ModifySubnetAttributeInput{
+			SubnetId:                    result.Subnet.SubnetId,
+			AssignIpv6AddressOnCreation: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+		})
+		if err != nil {
+			cblogger.Error(err)
+			// delete the created subnet, not to leave a subnet without the IPv6 setup
+			_, errDelete := VPCHandler.DeleteSubnet(vNetworkInfo.IId)
+			if errDelete != nil {
+				cblogger.Error(errDelete)
+				return irs.SubnetInfo{}, fmt.Errorf("%v, and failed to delete the subnet %s: %v", err, vNetworkInfo.IId.SystemId, errDelete)
+			}
+			return irs.SubnetInfo{}, err
+		}
+		vNetworkInfo.IPv6_CIDR = reqSubnetInfo.IPv6_CIDR
+	}
+
 	/*
 		//Subnet Name 태깅
 		if SetNameTag(VPCHandler.Client, *result.Subnet.SubnetId, reqSubnetInfo.IId.NameId) {
@@ -553,6 +697,7 @@ func ExtractVpcDescribeInfo(vpcInfo *ec2.Vpc) irs.VPCInfo {
 	awsVpcInfo := irs.VPCInfo{
 		IId:       irs.IID{SystemId: *vpcInfo.VpcId},
 		IPv4_CIDR: *vpcInfo.CidrBlock,
+		IPv6_CIDR: extractVpcIPv6CIDR(vpcInfo),
 		//IsDefault: *vpcInfo.IsDefault,
 		//State:     *vpcInfo.State,
 	}
@@ -793,6 +938,9 @@ func (VPCHandler *AwsVPCHandler) DeleteRouteIGW(vpcId string) error {
 	//ec2.Route
 	findIgw := false
 	for _, curRoute := range result.RouteTables[0].Routes {
+		if curRoute.DestinationCidrBlock == nil { // IPv6 route of a dual-stack VPC
+			continue
+		}
 		cblogger.Infof("DestinationCidrBlock[%s] Check", *curRoute.DestinationCidrBlock)
 
 		if "0.0.0.0/0" == *curRoute.DestinationCidrBlock {
@@ -1081,6 +1229,13 @@ func ExtractSubnetDescribeInfo(subnetInfo *ec2.Subnet) irs.SubnetInfo {
 		//Status:    *subnetInfo.State,
 		Zone: *subnetInfo.AvailabilityZone,
 	}
+	for _, assoc := range subnetInfo.Ipv6CidrBlockAssociationSet {
+		if assoc.Ipv6CidrBlock != nil && assoc.Ipv6CidrBlockState != nil && assoc.Ipv6CidrBlockState.State != nil &&
+			*assoc.Ipv6CidrBlockState.State == ec2.SubnetCidrBlockStateCodeAssociated {
+			vNetworkInfo.IPv6_CIDR = *assoc.Ipv6CidrBlock
+			break
+		}
+	}
 
 	/*
 		cblogger.Debug("Name Tag 찾기")
@@ -1108,6 +1263,27 @@ func ExtractSubnetDescribeInfo(subnetInfo *ec2.Subnet) irs.SubnetInfo {
 
 func (VPCHandler *AwsVPCHandler) AddSubnet(vpcIID irs.IID, subnetInfo irs.SubnetInfo) (irs.VPCInfo, error) {
 	cblogger.Infof("add [%s] Subnet - CIDR : %s", subnetInfo.IId.NameId, subnetInfo.IPv4_CIDR)
+
+	// in a dual-stack VPC, assign the next /64 block if not specified
+	if subnetInfo.IPv6_CIDR == "" {
+		curVpcInfo, err := VPCHandler.GetVPC(vpcIID)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.VPCInfo{}, err
+		}
+		if curVpcInfo.IPv6_CIDR != "" {
+			usedCIDRs := []string{}
+			for _, one := range curVpcInfo.SubnetInfoList {
+				usedCIDRs = append(usedCIDRs, one.IPv6_CIDR)
+			}
+			subnetInfo.IPv6_CIDR, err = cdcom.NextIPv6SubnetCIDR(curVpcInfo.IPv6_CIDR, usedCIDRs)
+			if err != nil {
+				cblogger.Error(err)
+				return irs.VPCInfo{}, err
+			}
+		}
+	}
+
 	resSubnet, errSubnet := VPCHandler.CreateSubnet(vpcIID.SystemId, subnetInfo)
 	if errSubnet != nil {
 		cblogger.Error(errSubnet)
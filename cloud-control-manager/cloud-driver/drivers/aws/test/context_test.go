@@ -0,0 +1,109 @@
+// AWS Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package awstest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	awsrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/aws/resources"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const describePendingInstanceResponse = `<DescribeInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>fake</requestId>
+  <reservationSet><item>
+    <reservationId>r-fake</reservationId>
+    <instancesSet><item>
+      <instanceId>i-fake</instanceId>
+      <instanceState><code>0</code><name>pending</name></instanceState>
+    </item></instancesSet>
+  </item></reservationSet>
+</DescribeInstancesResponse>`
+
+func countActions(actions []string, action string) int {
+	count := 0
+	for _, a := range actions {
+		if a == action {
+			count++
+		}
+	}
+	return count
+}
+
+func TestWaitForRunStopsPollingOnCancel(t *testing.T) {
+	fake := newFakeEC2(t, map[string]string{
+		"DescribeInstances": describePendingInstanceResponse,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(500*time.Millisecond, cancel)
+
+	// the waiter polls every 15 seconds while the VM is pending
+	start := time.Now()
+	err := awsrs.WaitForRunWithContext(ctx, fake.client(), "i-fake")
+	if err == nil {
+		t.Fatal("WaitForRunWithContext should fail when ctx is canceled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("the poll should stop as soon as ctx is canceled, but it took %v", elapsed)
+	}
+
+	polled := countActions(fake.actions(), "DescribeInstances")
+	if polled != 1 {
+		t.Fatalf("only the first poll should be sent before the cancel, but %d", polled)
+	}
+	time.Sleep(time.Second)
+	if countActions(fake.actions(), "DescribeInstances") != polled {
+		t.Fatal("the poll should not continue after WaitForRunWithContext returned")
+	}
+}
+
+func TestVMHandlerWithContextDoesNotCallCSPAfterCancel(t *testing.T) {
+	fake := newFakeEC2(t, map[string]string{
+		"DescribeInstances": describeInstancesResponse(""),
+	})
+	var vmHandler irs.VMHandler = &awsrs.AwsVMHandler{Region: idrv.RegionInfo{Region: "us-east-1"}, Client: fake.client()}
+	ctxHandler, ok := vmHandler.(irs.VMHandlerWithContext)
+	if !ok {
+		t.Fatal("AwsVMHandler should implement VMHandlerWithContext")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err := ctxHandler.GetVMStatusWithContext(ctx, irs.IID{NameId: "vm", SystemId: "i-fake"}); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	_, err := ctxHandler.GetVMStatusWithContext(ctx, irs.IID{NameId: "vm", SystemId: "i-fake"})
+	if err == nil || !strings.Contains(err.Error(), "canceled") {
+		t.Fatalf("GetVMStatusWithContext with a canceled ctx should fail with the cancel, but %v", err)
+	}
+	if polled := countActions(fake.actions(), "DescribeInstances"); polled != 1 {
+		t.Fatalf("no API call should be sent after the cancel, but %d calls", polled)
+	}
+
+	// the handler itself is not bound to the canceled ctx
+	if _, err := vmHandler.GetVMStatus(irs.IID{NameId: "vm", SystemId: "i-fake"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNLBAndClusterHandlersSupportContext(t *testing.T) {
+	var nlbHandler irs.NLBHandler = &awsrs.AwsNLBHandler{}
+	if _, ok := nlbHandler.(irs.NLBHandlerWithContext); !ok {
+		t.Error("AwsNLBHandler should implement NLBHandlerWithContext")
+	}
+	var clusterHandler irs.ClusterHandler = &awsrs.AwsClusterHandler{}
+	if _, ok := clusterHandler.(irs.ClusterHandlerWithContext); !ok {
+		t.Error("AwsClusterHandler should implement ClusterHandlerWithContext")
+	}
+}
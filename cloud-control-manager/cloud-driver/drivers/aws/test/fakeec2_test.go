@@ -0,0 +1,131 @@
+// AWS Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// A fake EC2 endpoint to test the AWS driver without an AWS account.
+//
+// by CB-Spider Team, 2026.10.
+
+package awstest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+
+	cblog "github.com/cloud-barista/cb-log"
+
+	awsrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/aws/resources"
+)
+
+// fakeEC2 answers the EC2 and ELBv2 Query APIs with the XML response of each action.
+type fakeEC2 struct {
+	server *httptest.Server
+
+	lock      sync.Mutex
+	responses map[string]string // key: Action or Action?Param=Value, value: XML response body
+	requests  []url.Values      // received requests in order
+}
+
+func newFakeEC2(t *testing.T, responses map[string]string) *fakeEC2 {
+	cblog.SetLevel("error")
+	awsrs.InitLog()
+
+	fake := &fakeEC2{responses: responses}
+	fake.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		fake.lock.Lock()
+		fake.requests = append(fake.requests, r.Form)
+		body, ok := fake.responses[r.Form.Get("Action")]
+		for key, value := range fake.responses {
+			// Action?Param=Value: the response only for the requests with the parameter
+			action, param, found := strings.Cut(key, "?")
+			if !found || action != r.Form.Get("Action") {
+				continue
+			}
+			if name, paramValue, _ := strings.Cut(param, "="); r.Form.Get(name) == paramValue {
+				body, ok = value, true
+			}
+		}
+		fake.lock.Unlock()
+
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`<Response><Errors><Error><Code>InvalidAction</Code><Message>not faked</Message></Error></Errors><RequestID>fake</RequestID></Response>`))
+			return
+		}
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(fake.server.Close)
+	return fake
+}
+
+// client returns an EC2 client which calls the fake endpoint.
+func (fake *fakeEC2) client() *ec2.EC2 {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-east-1"),
+		Endpoint:    aws.String(fake.server.URL),
+		Credentials: credentials.NewStaticCredentials("fake", "fake", ""),
+		MaxRetries:  aws.Int(0),
+	}))
+	return ec2.New(sess)
+}
+
+// elbClient returns an ELBv2 client which calls the fake endpoint.
+func (fake *fakeEC2) elbClient() *elbv2.ELBV2 {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-east-1"),
+		Endpoint:    aws.String(fake.server.URL),
+		Credentials: credentials.NewStaticCredentials("fake", "fake", ""),
+		MaxRetries:  aws.Int(0),
+	}))
+	return elbv2.New(sess)
+}
+
+// requestsOf returns all received requests of an action in order.
+func (fake *fakeEC2) requestsOf(action string) []url.Values {
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+	requests := []url.Values{}
+	for _, req := range fake.requests {
+		if req.Get("Action") == action {
+			requests = append(requests, req)
+		}
+	}
+	return requests
+}
+
+// actions returns the Action of the received requests in order.
+func (fake *fakeEC2) actions() []string {
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+	actions := []string{}
+	for _, req := range fake.requests {
+		actions = append(actions, req.Get("Action"))
+	}
+	return actions
+}
+
+// request returns the first received request of an action.
+func (fake *fakeEC2) request(action string) url.Values {
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+	for _, req := range fake.requests {
+		if req.Get("Action") == action {
+			return req
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// AWS Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package awstest
+
+import (
+	"testing"
+
+	awsrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/aws/resources"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+const elbMetadata = `<ResponseMetadata><RequestId>fake</RequestId></ResponseMetadata>`
+
+// the target group of the NLB has the NLB name, and the UDP:53 listener has its own target group
+func targetGroupXML(arn, name, protocol, port string) string {
+	return `<member><TargetGroupArn>` + arn + `</TargetGroupArn><TargetGroupName>` + name + `</TargetGroupName>
+      <Protocol>` + protocol + `</Protocol><Port>` + port + `</Port><VpcId>vpc-fake</VpcId>
+      <HealthCheckProtocol>TCP</HealthCheckProtocol><HealthCheckPort>traffic-port</HealthCheckPort>
+      <HealthCheckIntervalSeconds>30</HealthCheckIntervalSeconds><HealthCheckTimeoutSeconds>10</HealthCheckTimeoutSeconds>
+      <HealthyThresholdCount>3</HealthyThresholdCount></member>`
+}
+
+func listenerXML(arn, protocol, port, targetGroupArn string) string {
+	return `<member><ListenerArn>` + arn + `</ListenerArn><LoadBalancerArn>arn-nlb</LoadBalancerArn>
+      <Protocol>` + protocol + `</Protocol><Port>` + port + `</Port>
+      <DefaultActions><member><Type>forward</Type><TargetGroupArn>` + targetGroupArn + `</TargetGroupArn></member></DefaultActions></member>`
+}
+
+func newFakeNLB(t *testing.T, listeners string) (*fakeEC2, *awsrs.AwsNLBHandler) {
+	fake := newFakeEC2(t, map[string]string{
+		"DescribeLoadBalancers": `<DescribeLoadBalancersResponse><DescribeLoadBalancersResult><LoadBalancers><member>
+      <LoadBalancerArn>arn-nlb</LoadBalancerArn><LoadBalancerName>nlb-01</LoadBalancerName><Type>network</Type>
+      <VpcId>vpc-fake</VpcId><DNSName>nlb-01.fake</DNSName><CreatedTime>2026-10-01T00:00:00Z</CreatedTime>
+      </member></LoadBalancers></DescribeLoadBalancersResult>` + elbMetadata + `</DescribeLoadBalancersResponse>`,
+		"DescribeTargetGroups?Names.member.1=nlb-01": `<DescribeTargetGroupsResponse><DescribeTargetGroupsResult><TargetGroups>` +
+			targetGroupXML("arn-tg", "nlb-01", "TCP", "8080") + `</TargetGroups></DescribeTargetGroupsResult>` + elbMetadata + `</DescribeTargetGroupsResponse>`,
+		"DescribeTargetGroups?TargetGroupArns.member.1=arn-tg-udp53": `<DescribeTargetGroupsResponse><DescribeTargetGroupsResult><TargetGroups>` +
+			targetGroupXML("arn-tg-udp53", "nlb-01-udp53", "UDP", "5353") + `</TargetGroups></DescribeTargetGroupsResult>` + elbMetadata + `</DescribeTargetGroupsResponse>`,
+		"DescribeTargetHealth": `<DescribeTargetHealthResponse><DescribeTargetHealthResult><TargetHealthDescriptions><member>
+      <Target><Id>i-vm01</Id><Port>8080</Port></Target><TargetHealth><State>healthy</State></TargetHealth>
+      </member></TargetHealthDescriptions></DescribeTargetHealthResult>` + elbMetadata + `</DescribeTargetHealthResponse>`,
+		"DescribeListeners": `<DescribeListenersResponse><DescribeListenersResult><Listeners>` + listeners +
+			`</Listeners></DescribeListenersResult>` + elbMetadata + `</DescribeListenersResponse>`,
+		"DescribeTags": `<DescribeTagsResponse><DescribeTagsResult><TagDescriptions/></DescribeTagsResult>` + elbMetadata + `</DescribeTagsResponse>`,
+		"CreateTargetGroup": `<CreateTargetGroupResponse><CreateTargetGroupResult><TargetGroups>` +
+			targetGroupXML("arn-tg-udp53", "nlb-01-udp53", "UDP", "5353") + `</TargetGroups></CreateTargetGroupResult>` + elbMetadata + `</CreateTargetGroupResponse>`,
+		"RegisterTargets":   `<RegisterTargetsResponse><RegisterTargetsResult/>` + elbMetadata + `</RegisterTargetsResponse>`,
+		"DeregisterTargets": `<DeregisterTargetsResponse><DeregisterTargetsResult/>` + elbMetadata + `</DeregisterTargetsResponse>`,
+		"CreateListener": `<CreateListenerResponse><CreateListenerResult><Listeners>` +
+			listenerXML("arn-listener-udp53", "UDP", "53", "arn-tg-udp53") + `</Listeners></CreateListenerResult>` + elbMetadata + `</CreateListenerResponse>`,
+		"DeleteListener":    `<DeleteListenerResponse><DeleteListenerResult/>` + elbMetadata + `</DeleteListenerResponse>`,
+		"DeleteTargetGroup": `<DeleteTargetGroupResponse><DeleteTargetGroupResult/>` + elbMetadata + `</DeleteTargetGroupResponse>`,
+	})
+	region := idrv.RegionInfo{Region: "us-east-1", Zone: "us-east-1a"}
+	nlbHandler := &awsrs.AwsNLBHandler{Region: region, Client: fake.elbClient(), VMClient: fake.client(),
+		TagHandler: &awsrs.AwsTagHandler{Region: region, Client: fake.client(), NLBClient: fake.elbClient()}}
+	return fake, nlbHandler
+}
+
+func TestNLBAddListener(t *testing.T) {
+	fake, nlbHandler := newFakeNLB(t, listenerXML("arn-listener-tcp80", "TCP", "80", "arn-tg"))
+
+	_, err := nlbHandler.AddListener(irs.IID{NameId: "nlb-01", SystemId: "arn-nlb"}, irs.ListenerBindingInfo{
+		Listener: irs.ListenerInfo{Protocol: "UDP", Port: "53"},
+		VMGroup:  irs.VMGroupInfo{Protocol: "UDP", Port: "5353"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the listener has its own target group with the VMs of the NLB
+	createTargetGroup := fake.request("CreateTargetGroup")
+	if createTargetGroup.Get("Name") != "nlb-01-udp53" || createTargetGroup.Get("Port") != "5353" || createTargetGroup.Get("Protocol") != "UDP" {
+		t.Errorf("the target group of the listener is wrong: %v", createTargetGroup)
+	}
+	if createTargetGroup.Get("HealthCheckTimeoutSeconds") != "" {
+		t.Errorf("the TCP health check timeout should not be copied: %v", createTargetGroup)
+	}
+	registerTargets := fake.request("RegisterTargets")
+	if registerTargets.Get("TargetGroupArn") != "arn-tg-udp53" || registerTargets.Get("Targets.member.1.Id") != "i-vm01" ||
+		registerTargets.Get("Targets.member.1.Port") != "5353" {
+		t.Errorf("the VMs of the NLB should be registered to the target group of the listener: %v", registerTargets)
+	}
+	createListener := fake.request("CreateListener")
+	if createListener.Get("Port") != "53" || createListener.Get("DefaultActions.member.1.TargetGroupArn") != "arn-tg-udp53" {
+		t.Errorf("the listener should forward to its target group: %v", createListener)
+	}
+
+	// the listener already exists
+	if _, err := nlbHandler.AddListener(irs.IID{NameId: "nlb-01", SystemId: "arn-nlb"}, irs.ListenerBindingInfo{
+		Listener: irs.ListenerInfo{Protocol: "TCP", Port: "80"},
+		VMGroup:  irs.VMGroupInfo{Protocol: "TCP", Port: "8080"},
+	}); err == nil {
+		t.Error("AddListener() with an existing listener should fail")
+	}
+}
+
+func TestNLBRemoveListener(t *testing.T) {
+	fake, nlbHandler := newFakeNLB(t, listenerXML("arn-listener-udp53", "UDP", "53", "arn-tg-udp53")+
+		listenerXML("arn-listener-tcp80", "TCP", "80", "arn-tg"))
+	nlbIID := irs.IID{NameId: "nlb-01", SystemId: "arn-nlb"}
+
+	nlbInfo, err := nlbHandler.GetNLB(nlbIID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nlbInfo.ListenerList) != 2 || nlbInfo.ListenerList[0].Listener.Port != "80" || nlbInfo.ListenerList[1].VMGroup.Port != "5353" {
+		t.Fatalf("the listener of the NLB's VM group should be the first: %+v", nlbInfo.ListenerList)
+	}
+	if nlbInfo.Listener.CspID != "arn-listener-tcp80" {
+		t.Errorf("the Listener should be the listener of the NLB's VM group: %s", nlbInfo.Listener.CspID)
+	}
+
+	// the listener of the NLB's VM group
+	if _, err := nlbHandler.RemoveListener(nlbIID, irs.ListenerInfo{Protocol: "TCP", Port: "80"}); err == nil {
+		t.Error("RemoveListener() of the listener of the NLB's VM group should fail")
+	}
+	if fake.request("DeleteListener") != nil {
+		t.Fatalf("no listener should be deleted: %v", fake.actions())
+	}
+
+	if _, err := nlbHandler.RemoveListener(nlbIID, irs.ListenerInfo{Protocol: "UDP", Port: "53"}); err != nil {
+		t.Fatal(err)
+	}
+	if deleteListener := fake.request("DeleteListener"); deleteListener.Get("ListenerArn") != "arn-listener-udp53" {
+		t.Errorf("the UDP:53 listener should be deleted: %v", deleteListener)
+	}
+	if deleteTargetGroup := fake.request("DeleteTargetGroup"); deleteTargetGroup == nil || deleteTargetGroup.Get("TargetGroupArn") != "arn-tg-udp53" {
+		t.Errorf("the target group of the UDP:53 listener should be deleted: %v", deleteTargetGroup)
+	}
+}
+
+func TestNLBRemoveVMsFromAllListeners(t *testing.T) {
+	fake, nlbHandler := newFakeNLB(t, listenerXML("arn-listener-tcp80", "TCP", "80", "arn-tg")+
+		listenerXML("arn-listener-udp53", "UDP", "53", "arn-tg-udp53"))
+
+	if _, err := nlbHandler.RemoveVMs(irs.IID{NameId: "nlb-01", SystemId: "arn-nlb"}, &[]irs.IID{{SystemId: "i-vm01"}}); err != nil {
+		t.Fatal(err)
+	}
+	targetGroups := []string{}
+	for _, req := range fake.requestsOf("DeregisterTargets") {
+		targetGroups = append(targetGroups, req.Get("TargetGroupArn"))
+	}
+	if len(targetGroups) != 2 || targetGroups[0] != "arn-tg" || targetGroups[1] != "arn-tg-udp53" {
+		t.Errorf("the VM should be removed from the target groups of all listeners: %v", targetGroups)
+	}
+}
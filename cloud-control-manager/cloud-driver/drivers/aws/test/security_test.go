@@ -0,0 +1,38 @@
+// AWS Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package awstest
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awsrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/aws/resources"
+)
+
+func TestExtractIpPermissionsOfRemoteSecurityGroup(t *testing.T) {
+	ipPermissions := []*ec2.IpPermission{{
+		IpProtocol:       aws.String("tcp"),
+		FromPort:         aws.Int64(5432),
+		ToPort:           aws.Int64(5432),
+		UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-remote"), UserId: aws.String("123456789012")}},
+	}}
+
+	rules := awsrs.ExtractIpPermissions(ipPermissions, "inbound")
+	if len(rules) != 1 {
+		t.Fatalf("one rule is expected: %+v", rules)
+	}
+	if rules[0].RemoteSecurityGroupIID == nil || rules[0].RemoteSecurityGroupIID.SystemId != "sg-remote" {
+		t.Errorf("the rule should have the remote SecurityGroup sg-remote: %+v", rules[0])
+	}
+	// the CIDR keeps the GroupId for the compatibility
+	if rules[0].CIDR != "sg-remote" {
+		t.Errorf("the CIDR should be the GroupId sg-remote: %+v", rules[0])
+	}
+}
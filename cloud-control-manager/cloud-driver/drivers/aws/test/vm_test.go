@@ -0,0 +1,104 @@
+// AWS Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package awstest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	awsrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/aws/resources"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func describeInstancesResponse(spotInstanceRequestId string) string {
+	spotRequest := ""
+	if spotInstanceRequestId != "" {
+		spotRequest = fmt.Sprintf("<spotInstanceRequestId>%s</spotInstanceRequestId><instanceLifecycle>spot</instanceLifecycle>", spotInstanceRequestId)
+	}
+	return `<DescribeInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>fake</requestId>
+  <reservationSet><item>
+    <reservationId>r-fake</reservationId>
+    <instancesSet><item>
+      <instanceId>i-fake</instanceId>` + spotRequest + `
+      <instanceState><code>16</code><name>running</name></instanceState>
+    </item></instancesSet>
+  </item></reservationSet>
+</DescribeInstancesResponse>`
+}
+
+const cancelSpotInstanceRequestsResponse = `<CancelSpotInstanceRequestsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>fake</requestId>
+  <spotInstanceRequestSet><item><spotInstanceRequestId>sir-fake</spotInstanceRequestId><state>cancelled</state></item></spotInstanceRequestSet>
+</CancelSpotInstanceRequestsResponse>`
+
+const terminateInstancesResponse = `<TerminateInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>fake</requestId>
+  <instancesSet><item>
+    <instanceId>i-fake</instanceId>
+    <currentState><code>32</code><name>shutting-down</name></currentState>
+    <previousState><code>16</code><name>running</name></previousState>
+  </item></instancesSet>
+</TerminateInstancesResponse>`
+
+func TestTerminateSpotVMCancelsSpotRequest(t *testing.T) {
+	fake := newFakeEC2(t, map[string]string{
+		"DescribeInstances":          describeInstancesResponse("sir-fake"),
+		"CancelSpotInstanceRequests": cancelSpotInstanceRequestsResponse,
+		"TerminateInstances":         terminateInstancesResponse,
+	})
+	vmHandler := &awsrs.AwsVMHandler{Region: idrv.RegionInfo{Region: "us-east-1"}, Client: fake.client()}
+
+	if _, err := vmHandler.TerminateVM(irs.IID{NameId: "spot-vm", SystemId: "i-fake"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the persistent Spot request is cancelled before the instance is terminated
+	expected := []string{"DescribeInstances", "CancelSpotInstanceRequests", "TerminateInstances"}
+	if actions := fake.actions(); !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("expected calls: %v, but %v", expected, actions)
+	}
+	if id := fake.request("CancelSpotInstanceRequests").Get("SpotInstanceRequestId.1"); id != "sir-fake" {
+		t.Fatalf("the Spot request sir-fake should be cancelled, but %q", id)
+	}
+}
+
+func TestTerminateOnDemandVM(t *testing.T) {
+	fake := newFakeEC2(t, map[string]string{
+		"DescribeInstances":  describeInstancesResponse(""),
+		"TerminateInstances": terminateInstancesResponse,
+	})
+	vmHandler := &awsrs.AwsVMHandler{Region: idrv.RegionInfo{Region: "us-east-1"}, Client: fake.client()}
+
+	if _, err := vmHandler.TerminateVM(irs.IID{NameId: "ondemand-vm", SystemId: "i-fake"}); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"DescribeInstances", "TerminateInstances"}
+	if actions := fake.actions(); !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("expected calls: %v, but %v", expected, actions)
+	}
+}
+
+func TestTerminateSpotVMFailsIfSpotRequestIsNotCancelled(t *testing.T) {
+	fake := newFakeEC2(t, map[string]string{
+		"DescribeInstances": describeInstancesResponse("sir-fake"),
+		// CancelSpotInstanceRequests fails
+		"TerminateInstances": terminateInstancesResponse,
+	})
+	vmHandler := &awsrs.AwsVMHandler{Region: idrv.RegionInfo{Region: "us-east-1"}, Client: fake.client()}
+
+	if _, err := vmHandler.TerminateVM(irs.IID{NameId: "spot-vm", SystemId: "i-fake"}); err == nil {
+		t.Fatal("TerminateVM should fail if the Spot request is not cancelled")
+	}
+	if fake.request("TerminateInstances") != nil {
+		t.Fatal("the instance should not be terminated while its Spot request is open")
+	}
+}
@@ -0,0 +1,110 @@
+// AWS Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package awstest
+
+import (
+	"testing"
+
+	awsrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/aws/resources"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// a dual-stack VPC with a /72 IPv6 CIDR, which is too small for a /64 Subnet
+const dualStackVpc = `<vpcId>vpc-fake</vpcId>
+      <cidrBlock>10.0.0.0/16</cidrBlock>
+      <ipv6CidrBlockAssociationSet><item>
+        <ipv6CidrBlock>2600:1f18:abcd:1200::/72</ipv6CidrBlock>
+        <ipv6CidrBlockState><state>associated</state></ipv6CidrBlockState>
+      </item></ipv6CidrBlockAssociationSet>`
+
+func TestCreateDualStackVPCRollsBackOnIPv6Failure(t *testing.T) {
+	fake := newFakeEC2(t, map[string]string{
+		"CreateVpc":                `<CreateVpcResponse><requestId>fake</requestId><vpc>` + dualStackVpc + `</vpc></CreateVpcResponse>`,
+		"DescribeVpcs":             `<DescribeVpcsResponse><requestId>fake</requestId><vpcSet><item>` + dualStackVpc + `</item></vpcSet></DescribeVpcsResponse>`,
+		"DescribeSubnets":          `<DescribeSubnetsResponse><requestId>fake</requestId><subnetSet/></DescribeSubnetsResponse>`,
+		"CreateInternetGateway":    `<CreateInternetGatewayResponse><requestId>fake</requestId><internetGateway><internetGatewayId>igw-fake</internetGatewayId></internetGateway></CreateInternetGatewayResponse>`,
+		"CreateTags":               `<CreateTagsResponse><requestId>fake</requestId><return>true</return></CreateTagsResponse>`,
+		"AttachInternetGateway":    `<AttachInternetGatewayResponse><requestId>fake</requestId><return>true</return></AttachInternetGatewayResponse>`,
+		"DescribeRouteTables":      `<DescribeRouteTablesResponse><requestId>fake</requestId><routeTableSet><item><routeTableId>rtb-fake</routeTableId><routeSet><item><destinationCidrBlock>0.0.0.0/0</destinationCidrBlock><gatewayId>igw-fake</gatewayId></item><item><destinationIpv6CidrBlock>::/0</destinationIpv6CidrBlock><gatewayId>igw-fake</gatewayId></item></routeSet></item></routeTableSet></DescribeRouteTablesResponse>`,
+		"CreateRoute":              `<CreateRouteResponse><requestId>fake</requestId><return>true</return></CreateRouteResponse>`,
+		"DeleteRoute":              `<DeleteRouteResponse><requestId>fake</requestId><return>true</return></DeleteRouteResponse>`,
+		"DescribeInternetGateways": `<DescribeInternetGatewaysResponse><requestId>fake</requestId><internetGatewaySet><item><internetGatewayId>igw-fake</internetGatewayId></item></internetGatewaySet></DescribeInternetGatewaysResponse>`,
+		"DetachInternetGateway":    `<DetachInternetGatewayResponse><requestId>fake</requestId><return>true</return></DetachInternetGatewayResponse>`,
+		"DeleteInternetGateway":    `<DeleteInternetGatewayResponse><requestId>fake</requestId><return>true</return></DeleteInternetGatewayResponse>`,
+		"DeleteVpc":                `<DeleteVpcResponse><requestId>fake</requestId><return>true</return></DeleteVpcResponse>`,
+	})
+	region := idrv.RegionInfo{Region: "us-east-1", Zone: "us-east-1a"}
+	vpcHandler := &awsrs.AwsVPCHandler{Region: region, Client: fake.client(),
+		TagHandler: &awsrs.AwsTagHandler{Region: region, Client: fake.client()}}
+
+	_, err := vpcHandler.CreateVPC(irs.VPCReqInfo{
+		IId:            irs.IID{NameId: "dual-stack-vpc"},
+		IPv4_CIDR:      "10.0.0.0/16",
+		EnableIPv6:     true,
+		SubnetInfoList: []irs.SubnetInfo{{IId: irs.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.1.0/24"}},
+	})
+	if err == nil {
+		t.Fatal("CreateVPC should fail when no IPv6 block can be assigned to the Subnet")
+	}
+
+	for _, action := range []string{"DeleteInternetGateway", "DeleteVpc"} {
+		if fake.request(action) == nil {
+			t.Errorf("the failed VPC should be rolled back, but %s is not called: %v", action, fake.actions())
+		}
+	}
+	if fake.request("CreateSubnet") != nil {
+		t.Error("no Subnet should be created without an IPv6 block")
+	}
+}
+
+func TestCreateVPCRejectsSubnetIPv6CIDR(t *testing.T) {
+	fake := newFakeEC2(t, map[string]string{})
+	region := idrv.RegionInfo{Region: "us-east-1", Zone: "us-east-1a"}
+	vpcHandler := &awsrs.AwsVPCHandler{Region: region, Client: fake.client(),
+		TagHandler: &awsrs.AwsTagHandler{Region: region, Client: fake.client()}}
+
+	_, err := vpcHandler.CreateVPC(irs.VPCReqInfo{
+		IId:        irs.IID{NameId: "dual-stack-vpc"},
+		IPv4_CIDR:  "10.0.0.0/16",
+		EnableIPv6: true,
+		SubnetInfoList: []irs.SubnetInfo{{IId: irs.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.1.0/24",
+			IPv6_CIDR: "2600:1f18:abcd:1200::/64"}},
+	})
+	if err == nil {
+		t.Fatal("CreateVPC should fail with the IPv6 CIDR of a Subnet")
+	}
+	if len(fake.actions()) != 0 {
+		t.Errorf("no AWS API should be called: %v", fake.actions())
+	}
+}
+
+func TestCreateSubnetDeletesSubnetOnIPv6Failure(t *testing.T) {
+	fake := newFakeEC2(t, map[string]string{
+		"CreateSubnet": `<CreateSubnetResponse><requestId>fake</requestId><subnet><subnetId>subnet-fake</subnetId><vpcId>vpc-fake</vpcId>
+      <cidrBlock>10.0.1.0/24</cidrBlock><availabilityZone>us-east-1a</availabilityZone></subnet></CreateSubnetResponse>`,
+		"DeleteSubnet": `<DeleteSubnetResponse><requestId>fake</requestId><return>true</return></DeleteSubnetResponse>`,
+	})
+	region := idrv.RegionInfo{Region: "us-east-1", Zone: "us-east-1a"}
+	vpcHandler := &awsrs.AwsVPCHandler{Region: region, Client: fake.client(),
+		TagHandler: &awsrs.AwsTagHandler{Region: region, Client: fake.client()}}
+
+	_, err := vpcHandler.CreateSubnet("vpc-fake", irs.SubnetInfo{IId: irs.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.1.0/24",
+		IPv6_CIDR: "2600:1f18:abcd:1200::/64"})
+	if err == nil {
+		t.Fatal("CreateSubnet should fail when ModifySubnetAttribute fails")
+	}
+	if fake.request("ModifySubnetAttribute") == nil {
+		t.Fatalf("ModifySubnetAttribute should be called: %v", fake.actions())
+	}
+	req := fake.request("DeleteSubnet")
+	if req == nil || req.Get("SubnetId") != "subnet-fake" {
+		t.Errorf("the created Subnet should be deleted: %v", fake.actions())
+	}
+}
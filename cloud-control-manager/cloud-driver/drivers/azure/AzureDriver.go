@@ -78,6 +78,7 @@ func (AzureDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.TagSupportResourceType = []ires.RSType{ires.VPC, ires.SG, ires.KEY, ires.VM, ires.NLB, ires.DISK, ires.MYIMAGE, ires.CLUSTER}
 
 	drvCapabilityInfo.VPC_CIDR = true
+	drvCapabilityInfo.SG_DENY_RULE = true
 
 	return drvCapabilityInfo
 }
@@ -275,3 +275,27 @@ func (cloudConn *AzureCloudConnection) CreateTagHandler() (irs.TagHandler, error
 	return &tagHandler, nil
 	// return nil, errors.New("Azure Driver: not implemented")
 }
+
+func (cloudConn *AzureCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return nil, errors.New("Azure Driver does not support VNicHandler yet.")
+}
+
+func (cloudConn *AzureCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	return nil, errors.New("Azure Driver does not support PublicIPHandler yet.")
+}
+
+func (cloudConn *AzureCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("Azure Driver does not support VPCPeeringHandler yet.")
+}
+
+func (cloudConn *AzureCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("Azure Driver does not support RouteTableHandler yet.")
+}
+
+func (cloudConn *AzureCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("Azure Driver does not support NATGatewayHandler yet.")
+}
+
+func (cloudConn *AzureCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("Azure Driver does not support DiskSnapshotHandler yet.")
+}
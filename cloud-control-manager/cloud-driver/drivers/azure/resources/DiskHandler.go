@@ -243,6 +243,10 @@ func (diskHandler *AzureDiskHandler) ChangeDiskSize(diskIID irs.IID, size string
 	LoggingInfo(hiscallInfo, start)
 	return true, nil
 }
+
+func (diskHandler *AzureDiskHandler) ChangeDiskType(diskIID irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	return irs.DiskInfo{}, errors.New("Azure Driver does not support ChangeDiskType yet.")
+}
 func (diskHandler *AzureDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 	hiscallInfo := GetCallLogScheme(diskHandler.Region, call.DISK, diskIID.NameId, "DeleteDisk()")
 	start := call.Start()
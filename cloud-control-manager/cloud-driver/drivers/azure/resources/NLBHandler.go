@@ -491,6 +491,14 @@ func (nlbHandler *AzureNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.L
 	return info.Listener, nil
 }
 
+func (nlbHandler *AzureNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	return irs.NLBInfo{}, errors.New("Azure Driver does not support AddListener yet.")
+}
+
+func (nlbHandler *AzureNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	return false, errors.New("Azure Driver does not support RemoveListener yet.")
+}
+
 func (nlbHandler *AzureNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup irs.VMGroupInfo) (irs.VMGroupInfo, error) {
 	hiscallInfo := GetCallLogScheme(nlbHandler.Region, "NETWORKLOADBALANCE", nlbIID.NameId, "ChangeVMGroupInfo()")
 	start := call.Start()
@@ -863,7 +871,7 @@ func (nlbHandler *AzureNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, healt
 		nlb.Properties.Probes[0].Properties.IntervalInSeconds = &intervalInSecondsInt32
 		nlb.Properties.Probes[0].Properties.NumberOfProbes = &thresholdInt32
 		if protocol == armnetwork.ProbeProtocolHTTP || protocol == armnetwork.ProbeProtocolHTTPS {
-			path := "/"
+			path := getAzureProbeRequestPath(healthChecker)
 			nlb.Properties.Probes[0].Properties.RequestPath = &path
 		} else {
 			nlb.Properties.Probes[0].Properties.RequestPath = nil
@@ -1361,6 +1369,9 @@ func (nlbHandler *AzureNLBHandler) getLoadBalancingRuleInfoByNLB(nlb *armnetwork
 			healthCheckerInfo.Interval = int(*probe.Properties.IntervalInSeconds)
 			healthCheckerInfo.Port = strconv.Itoa(int(*probe.Properties.Port))
 			healthCheckerInfo.Protocol = strings.ToUpper(string(*probe.Properties.Protocol))
+			if probe.Properties.RequestPath != nil {
+				healthCheckerInfo.HTTPPath = *probe.Properties.RequestPath
+			}
 			break
 		}
 	}
@@ -1502,13 +1513,20 @@ func getAzureProbeByCBHealthChecker(healthChecker irs.HealthCheckerInfo) (*armne
 	}
 
 	if protocol == armnetwork.ProbeProtocolHTTP || protocol == armnetwork.ProbeProtocolHTTPS {
-		path := "/"
+		path := getAzureProbeRequestPath(healthChecker)
 		probe.Properties.RequestPath = &path
 	}
 
 	return probe, nil
 }
 
+func getAzureProbeRequestPath(healthChecker irs.HealthCheckerInfo) string {
+	if healthChecker.HTTPPath == "" {
+		return "/"
+	}
+	return healthChecker.HTTPPath
+}
+
 func getAzureFrontendIPConfiguration(publicIp *armnetwork.PublicIPAddress) *armnetwork.FrontendIPConfiguration {
 	return &armnetwork.FrontendIPConfiguration{
 		Name: toStrPtr(generateRandName(FrontEndIPConfigPrefix)),
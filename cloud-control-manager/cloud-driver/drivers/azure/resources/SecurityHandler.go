@@ -39,7 +39,7 @@ func (securityHandler *AzureSecurityHandler) setterSec(securityGroup *armnetwork
 
 	var securityRuleArr []irs.SecurityRuleInfo
 	for _, sgRule := range securityGroup.Properties.SecurityRules {
-		if *sgRule.Properties.Access == armnetwork.SecurityRuleAccessAllow {
+		if !isCBDefaultDenyRule(sgRule) {
 			ruleInfo, _ := convertRuleInfoAZToCB(sgRule)
 			securityRuleArr = append(securityRuleArr, ruleInfo)
 		} // else {
@@ -456,6 +456,8 @@ func convertRulePortRangeAZToCB(portRange string, protocol string) (from string,
 	return portRangeArr[0], portRangeArr[1]
 }
 
+// equalsRule compares the rules without the Description.
+// The Priority is compared only when both rules have it, and an empty Action is "allow".
 func equalsRule(pre irs.SecurityRuleInfo, post irs.SecurityRuleInfo) bool {
 	if pre.ToPort == "-1" || pre.FromPort == "-1" {
 		pre.FromPort = "1"
@@ -465,9 +467,35 @@ func equalsRule(pre irs.SecurityRuleInfo, post irs.SecurityRuleInfo) bool {
 		post.FromPort = "1"
 		post.ToPort = "65535"
 	}
+	if pre.Priority == "" || post.Priority == "" {
+		pre.Priority, post.Priority = "", ""
+	}
+	pre.Action, post.Action = string(convertRuleActionCBToAZ(pre.Action)), string(convertRuleActionCBToAZ(post.Action))
+	pre.Description, post.Description = "", ""
 	return strings.ToLower(fmt.Sprintf("%#v", pre)) == strings.ToLower(fmt.Sprintf("%#v", post))
 }
 
+func convertRuleActionCBToAZ(action string) armnetwork.SecurityRuleAccess {
+	if strings.EqualFold(action, "deny") {
+		return armnetwork.SecurityRuleAccessDeny
+	}
+	return armnetwork.SecurityRuleAccessAllow
+}
+
+func convertRuleActionAZToCB(access armnetwork.SecurityRuleAccess) string {
+	if access == armnetwork.SecurityRuleAccessDeny {
+		return "deny"
+	}
+	return "allow"
+}
+
+// isCBDefaultDenyRule checks whether the rule is the outbound deny rule added by addCBDefaultRule.
+// It is not a user rule, so it is hidden from the rule list.
+func isCBDefaultDenyRule(sgRule *armnetwork.SecurityRule) bool {
+	return sgRule.Name != nil && *sgRule.Name == "deny-outbound" &&
+		*sgRule.Properties.Access == armnetwork.SecurityRuleAccessDeny && int(*sgRule.Properties.Priority) == maxPriority
+}
+
 func convertRuleDirectionCBToAZ(direction string) (armnetwork.SecurityRuleDirection, error) {
 	if strings.ToLower(direction) == "inbound" {
 		return armnetwork.SecurityRuleDirectionInbound, nil
@@ -527,10 +555,8 @@ func addCBDefaultRule(azureSGRuleList []*armnetwork.SecurityRule) ([]*armnetwork
 	outboundPriority := initPriority
 	var addCBDefaultRuleList []*armnetwork.SecurityRule
 	for _, sgRule := range azureSGRuleList {
-		if *sgRule.Properties.Access == armnetwork.SecurityRuleAccessAllow {
-			if *sgRule.Properties.Direction == armnetwork.SecurityRuleDirectionOutbound && outboundPriority < int(*sgRule.Properties.Priority) {
-				outboundPriority = int(*sgRule.Properties.Priority)
-			}
+		if *sgRule.Properties.Direction == armnetwork.SecurityRuleDirectionOutbound && outboundPriority < int(*sgRule.Properties.Priority) {
+			outboundPriority = int(*sgRule.Properties.Priority)
 		}
 	}
 
@@ -617,22 +643,32 @@ func convertRuleInfoAZToCB(rawRule *armnetwork.SecurityRule) (irs.SecurityRuleIn
 		return irs.SecurityRuleInfo{}, err
 	}
 	cidr := convertRuleCIDRAZToCB(*rawRule.Properties.SourceAddressPrefix)
+	description := ""
+	if rawRule.Properties.Description != nil {
+		description = *rawRule.Properties.Description
+	}
 	if *rawRule.Properties.Direction == armnetwork.SecurityRuleDirectionInbound {
 		RuleInfo := irs.SecurityRuleInfo{
-			IPProtocol: protocols,
-			Direction:  direction,
-			CIDR:       cidr,
-			FromPort:   fromPort,
-			ToPort:     toPort,
+			IPProtocol:  protocols,
+			Direction:   direction,
+			CIDR:        cidr,
+			FromPort:    fromPort,
+			ToPort:      toPort,
+			Priority:    strconv.Itoa(int(*rawRule.Properties.Priority)),
+			Action:      convertRuleActionAZToCB(*rawRule.Properties.Access),
+			Description: description,
 		}
 		return RuleInfo, nil
 	} else {
 		RuleInfo := irs.SecurityRuleInfo{
-			IPProtocol: protocols,
-			Direction:  direction,
-			CIDR:       cidr,
-			FromPort:   fromPort,
-			ToPort:     toPort,
+			IPProtocol:  protocols,
+			Direction:   direction,
+			CIDR:        cidr,
+			FromPort:    fromPort,
+			ToPort:      toPort,
+			Priority:    strconv.Itoa(int(*rawRule.Properties.Priority)),
+			Action:      convertRuleActionAZToCB(*rawRule.Properties.Access),
+			Description: description,
 		}
 		return RuleInfo, nil
 	}
@@ -652,6 +688,11 @@ func convertRuleInfoCBToAZ(rule irs.SecurityRuleInfo, priority int) (armnetwork.
 	if err != nil {
 		return armnetwork.SecurityRule{}, err
 	}
+	access := convertRuleActionCBToAZ(rule.Action)
+	var description *string
+	if rule.Description != "" {
+		description = toStrPtr(rule.Description)
+	}
 
 	if direction == armnetwork.SecurityRuleDirectionInbound {
 		sgRuleInfo := armnetwork.SecurityRule{
@@ -662,9 +703,10 @@ func convertRuleInfoCBToAZ(rule irs.SecurityRuleInfo, priority int) (armnetwork.
 				DestinationAddressPrefix: toStrPtr("*"),
 				DestinationPortRange:     toStrPtr(portRange),
 				Protocol:                 &protocol,
-				Access:                   (*armnetwork.SecurityRuleAccess)(toStrPtr(string(armnetwork.SecurityRuleAccessAllow))),
+				Access:                   &access,
 				Priority:                 toInt32Ptr(priority),
 				Direction:                &direction,
+				Description:              description,
 			},
 		}
 		return sgRuleInfo, nil
@@ -677,9 +719,10 @@ func convertRuleInfoCBToAZ(rule irs.SecurityRuleInfo, priority int) (armnetwork.
 				DestinationAddressPrefix: toStrPtr(rule.CIDR),
 				DestinationPortRange:     toStrPtr(portRange),
 				Protocol:                 &protocol,
-				Access:                   (*armnetwork.SecurityRuleAccess)(toStrPtr(string(armnetwork.SecurityRuleAccessAllow))),
+				Access:                   &access,
 				Priority:                 toInt32Ptr(priority),
 				Direction:                &direction,
+				Description:              description,
 			},
 		}
 		return sgRuleInfo, nil
@@ -688,10 +731,13 @@ func convertRuleInfoCBToAZ(rule irs.SecurityRuleInfo, priority int) (armnetwork.
 }
 
 func convertRuleInfoListCBToAZ(rules []irs.SecurityRuleInfo) ([]*armnetwork.SecurityRule, error) {
+	priorityList, err := getRulePriorities(nil, rules)
+	if err != nil {
+		return nil, err
+	}
 	var azureSGRuleList []*armnetwork.SecurityRule
 	for idx, rule := range rules {
-		priorityNum := initPriority + idx
-		sgRuleInfo, err := convertRuleInfoCBToAZ(rule, priorityNum)
+		sgRuleInfo, err := convertRuleInfoCBToAZ(rule, priorityList[idx])
 		if err != nil {
 			return nil, err
 		}
@@ -700,6 +746,62 @@ func convertRuleInfoListCBToAZ(rules []irs.SecurityRuleInfo) ([]*armnetwork.Secu
 	return azureSGRuleList, nil
 }
 
+// getRulePriorities returns the Azure priority of each rule.
+// A rule with a Priority uses it, and the other rules are numbered after the last priority of the direction.
+// The priorities of a direction are shared by the allow and deny rules.
+func getRulePriorities(baseRawRules []*armnetwork.SecurityRule, rules []irs.SecurityRuleInfo) ([]int, error) {
+	lastPriority := map[string]int{"inbound": initPriority - 1, "outbound": initPriority - 1}
+	usedPriority := map[string]bool{}
+	for _, sgRule := range baseRawRules {
+		if isCBDefaultDenyRule(sgRule) {
+			continue
+		}
+		direction, err := convertRuleDirectionAZToCB(*sgRule.Properties.Direction)
+		if err != nil {
+			return nil, err
+		}
+		priority := int(*sgRule.Properties.Priority)
+		usedPriority[fmt.Sprintf("%s/%d", direction, priority)] = true
+		if lastPriority[direction] < priority {
+			lastPriority[direction] = priority
+		}
+	}
+
+	priorityList := make([]int, len(rules))
+	for idx, rule := range rules {
+		if rule.Priority == "" {
+			continue
+		}
+		direction := strings.ToLower(rule.Direction)
+		priority, err := strconv.Atoi(rule.Priority)
+		if err != nil || priority < initPriority || priority >= maxPriority {
+			return nil, errors.New(fmt.Sprintf("invalid rule Priority %s: Azure supports %d ~ %d", rule.Priority, initPriority, maxPriority-1))
+		}
+		key := fmt.Sprintf("%s/%d", direction, priority)
+		if usedPriority[key] {
+			return nil, errors.New(fmt.Sprintf("the %s rule Priority %s is already used", direction, rule.Priority))
+		}
+		usedPriority[key] = true
+		priorityList[idx] = priority
+		if lastPriority[direction] < priority {
+			lastPriority[direction] = priority
+		}
+	}
+
+	for idx, rule := range rules {
+		if rule.Priority != "" {
+			continue
+		}
+		direction := strings.ToLower(rule.Direction)
+		lastPriority[direction]++
+		if lastPriority[direction] >= maxPriority {
+			return nil, errors.New(fmt.Sprintf("no more rule Priority for the %s rules", direction))
+		}
+		priorityList[idx] = lastPriority[direction]
+	}
+	return priorityList, nil
+}
+
 type securityRuleInfoWithName struct {
 	Name     string
 	RuleInfo irs.SecurityRuleInfo
@@ -708,7 +810,7 @@ type securityRuleInfoWithName struct {
 func getRuleInfoWithNames(rawRules []*armnetwork.SecurityRule) (*[]securityRuleInfoWithName, error) {
 	var ruleInfoWithNames []securityRuleInfoWithName
 	for _, sgRule := range rawRules {
-		if *sgRule.Properties.Access == armnetwork.SecurityRuleAccessAllow {
+		if !isCBDefaultDenyRule(sgRule) {
 			ruleInfo, err := convertRuleInfoAZToCB(sgRule)
 			if err != nil {
 				return nil, err
@@ -733,31 +835,14 @@ type unControlledRule struct {
 }
 
 func getAddAzureRules(baseRawRules []*armnetwork.SecurityRule, addRuleInfo *[]irs.SecurityRuleInfo) (*[]armnetwork.SecurityRule, error) {
-	inboundPriority := initPriority
-	outboundPriority := initPriority
-	for _, sgRule := range baseRawRules {
-		if *sgRule.Properties.Access == armnetwork.SecurityRuleAccessAllow {
-			if *sgRule.Properties.Direction == armnetwork.SecurityRuleDirectionInbound && inboundPriority < int(*sgRule.Properties.Priority) {
-				inboundPriority = int(*sgRule.Properties.Priority)
-			}
-			if *sgRule.Properties.Direction == armnetwork.SecurityRuleDirectionOutbound && outboundPriority < int(*sgRule.Properties.Priority) {
-				outboundPriority = int(*sgRule.Properties.Priority)
-			}
-		}
+	priorityList, err := getRulePriorities(baseRawRules, *addRuleInfo)
+	if err != nil {
+		return nil, err
 	}
 	var azureSGRuleList []armnetwork.SecurityRule
 
 	for idx, rule := range *addRuleInfo {
-		priorityNum := initPriority + 1 + idx
-		if strings.ToLower(rule.Direction) == "inbound" {
-			priorityNum = inboundPriority + 1 + idx
-			inboundPriority = inboundPriority + 1 + idx
-		}
-		if strings.ToLower(rule.Direction) == "outbound" {
-			priorityNum = outboundPriority + 1 + idx
-			outboundPriority = outboundPriority + 1 + idx
-		}
-		sgRuleInfo, err := convertRuleInfoCBToAZ(rule, priorityNum)
+		sgRuleInfo, err := convertRuleInfoCBToAZ(rule, priorityList[idx])
 		if err != nil {
 			return nil, err
 		}
@@ -12,6 +12,7 @@ package resources
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -360,6 +361,11 @@ func (vmHandler *AzureVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, e
 				"createdBy": &vmReqInfo.IId.NameId,
 			}
 		}
+		// 3-3. Set VmReqInfo - user's user-data(script or cloud-config) as CustomData for cloud-init
+		if vmReqInfo.UserData != "" {
+			customData := base64.StdEncoding.EncodeToString([]byte(vmReqInfo.UserData))
+			vmOpts.Properties.OSProfile.CustomData = &customData
+		}
 	} else {
 		if len(vmReqInfo.IId.NameId) > 15 {
 			computerName := vmReqInfo.IId.NameId[:15]
@@ -763,6 +769,10 @@ func (vmHandler *AzureVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, error
 	return irs.NotExist, nil
 }
 
+func (vmHandler *AzureVMHandler) ChangeVMSpec(vmIID irs.IID, specName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, errors.New("Azure Driver does not support ChangeVMSpec yet.")
+}
+
 func (vmHandler *AzureVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	// log HisCall
 	hiscallInfo := GetCallLogScheme(vmHandler.Region, call.VM, VM, "ListVMStatus()")
@@ -12,6 +12,7 @@ package connect
 
 import (
 	"context"
+	"errors"
 
 	filestore "cloud.google.com/go/filestore/apiv1"
 	cblog "github.com/cloud-barista/cb-log"
@@ -191,3 +192,27 @@ func (cloudConn *GCPCloudConnection) CreateTagHandler() (irs.TagHandler, error)
 
 	return &tagHandler, nil
 }
+
+func (cloudConn *GCPCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return nil, errors.New("GCP Cloud Driver does not support VNicHandler yet.")
+}
+
+func (cloudConn *GCPCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	return nil, errors.New("GCP Cloud Driver does not support PublicIPHandler yet.")
+}
+
+func (cloudConn *GCPCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("GCP Cloud Driver does not support VPCPeeringHandler yet.")
+}
+
+func (cloudConn *GCPCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("GCP Cloud Driver does not support RouteTableHandler yet.")
+}
+
+func (cloudConn *GCPCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("GCP Cloud Driver does not support NATGatewayHandler yet.")
+}
+
+func (cloudConn *GCPCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("GCP Cloud Driver does not support DiskSnapshotHandler yet.")
+}
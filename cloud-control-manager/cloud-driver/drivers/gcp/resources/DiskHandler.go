@@ -220,6 +220,10 @@ func (DiskHandler *GCPDiskHandler) ChangeDiskSize(diskIID irs.IID, size string)
 	return true, nil
 }
 
+func (DiskHandler *GCPDiskHandler) ChangeDiskType(diskIID irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	return irs.DiskInfo{}, errors.New("GCP Cloud Driver does not support ChangeDiskType yet.")
+}
+
 func (DiskHandler *GCPDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 	hiscallInfo := GetCallLogScheme(DiskHandler.Region, call.DISK, diskIID.NameId, "DeleteDisk()")
 	start := call.Start()
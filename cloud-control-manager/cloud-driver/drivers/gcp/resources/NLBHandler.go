@@ -854,6 +854,14 @@ func (nlbHandler *GCPNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.Lis
 	//return listenerInfo, nil
 }
 
+func (nlbHandler *GCPNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	return irs.NLBInfo{}, errors.New("GCP Cloud Driver does not support AddListener yet.")
+}
+
+func (nlbHandler *GCPNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	return false, errors.New("GCP Cloud Driver does not support RemoveListener yet.")
+}
+
 /*
 VM Group 변경에서는 VMs 는 제외임.
 GCP의 경우 frontend와 backend를 protocol, ip로 연결하지 않으므로 해당 기능은 제외한다.
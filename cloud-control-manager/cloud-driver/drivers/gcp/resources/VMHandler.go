@@ -307,6 +307,16 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 		},
 	}
 
+	// user's user-data: a script runs as 'startup-script', a cloud-config is passed as 'user-data' to cloud-init
+	if vmReqInfo.UserData != "" {
+		userDataKey := "startup-script"
+		if cdcom.GetUserDataContentType(vmReqInfo.UserData) == "text/cloud-config" {
+			userDataKey = "user-data"
+		}
+		userData := vmReqInfo.UserData
+		instance.Metadata.Items = append(instance.Metadata.Items, &compute.MetadataItems{Key: userDataKey, Value: &userData})
+	}
+
 	//Windows OS인 경우 administrator 계정 비번 설정 및 계정 활성화
 	if isWindows {
 		err := cdcom.ValidateWindowsPassword(vmReqInfo.VMUserPasswd)
@@ -860,6 +870,10 @@ func (vmHandler *GCPVMHandler) TerminateVM(vmID irs.IID) (irs.VMStatus, error) {
 	return irs.VMStatus("Terminating"), nil
 }
 
+func (vmHandler *GCPVMHandler) ChangeVMSpec(vmIID irs.IID, specName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, errors.New("GCP Cloud Driver does not support ChangeVMSpec yet.")
+}
+
 func (vmHandler *GCPVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	projectID := vmHandler.Credential.ProjectID
 	regionID := vmHandler.Region.Region
@@ -217,3 +217,27 @@ func (cloudConn *IbmCloudConnection) CreateTagHandler() (irs.TagHandler, error)
 	}
 	return &TagHandler, nil
 }
+
+func (cloudConn *IbmCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return nil, errors.New("Ibm Driver does not support VNicHandler yet.")
+}
+
+func (cloudConn *IbmCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	return nil, errors.New("Ibm Driver does not support PublicIPHandler yet.")
+}
+
+func (cloudConn *IbmCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("Ibm Driver does not support VPCPeeringHandler yet.")
+}
+
+func (cloudConn *IbmCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("Ibm Driver does not support RouteTableHandler yet.")
+}
+
+func (cloudConn *IbmCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("Ibm Driver does not support NATGatewayHandler yet.")
+}
+
+func (cloudConn *IbmCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("Ibm Driver does not support DiskSnapshotHandler yet.")
+}
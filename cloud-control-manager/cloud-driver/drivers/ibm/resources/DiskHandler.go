@@ -166,6 +166,10 @@ func (diskHandler *IbmDiskHandler) ChangeDiskSize(diskIID irs.IID, size string)
 	return true, nil
 }
 
+func (diskHandler *IbmDiskHandler) ChangeDiskType(diskIID irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	return irs.DiskInfo{}, errors.New("Ibm Driver does not support ChangeDiskType yet.")
+}
+
 func (diskHandler *IbmDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 	hiscallInfo := GetCallLogScheme(diskHandler.Region, call.DISK, diskIID.SystemId, "DeleteDisk()")
 	start := call.Start()
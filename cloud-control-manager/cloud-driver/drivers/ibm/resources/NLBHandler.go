@@ -323,6 +323,14 @@ func (nlbHandler *IbmNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.Lis
 	return info.Listener, err
 }
 
+func (nlbHandler *IbmNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	return irs.NLBInfo{}, errors.New("Ibm Driver does not support AddListener yet.")
+}
+
+func (nlbHandler *IbmNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	return false, errors.New("Ibm Driver does not support RemoveListener yet.")
+}
+
 // ------ Backend Control
 func (nlbHandler *IbmNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup irs.VMGroupInfo) (irs.VMGroupInfo, error) {
 	hiscallInfo := GetCallLogScheme(nlbHandler.Region, "NETWORKLOADBALANCE", nlbIID.NameId, "ChangeVMGroupInfo()")
@@ -181,6 +181,15 @@ func (vmHandler *IbmVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 		}
 		userData = string(fileDataCloudInit)
 		userData = strings.ReplaceAll(userData, "{{username}}", CBDefaultVmUserName)
+		// append user's user-data(script or cloud-config) after cb-user setup
+		userData = cdcom.MergeUserData(userData, vmReqInfo.UserData)
+		err = cdcom.ValidateUserDataSize("IBM", userData)
+		if err != nil {
+			createErr := errors.New(fmt.Sprintf("Failed to Create VM. err = %s", err.Error()))
+			cblogger.Error(createErr.Error())
+			LoggingError(hiscallInfo, createErr)
+			return irs.VMInfo{}, createErr
+		}
 	}
 
 	// 2.Create VM
@@ -664,6 +673,10 @@ func (vmHandler *IbmVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, error)
 	return irs.Terminating, nil
 }
 
+func (vmHandler *IbmVMHandler) ChangeVMSpec(vmIID irs.IID, specName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, errors.New("Ibm Driver does not support ChangeVMSpec yet.")
+}
+
 func (vmHandler *IbmVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	hiscallInfo := GetCallLogScheme(vmHandler.Region, call.VM, "VMStatus", "ListVMStatus()")
 	start := call.Start()
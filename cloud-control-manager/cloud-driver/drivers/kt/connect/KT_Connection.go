@@ -136,3 +136,27 @@ func (cloudConn *KTCloudVpcConnection) Close() error {
 func (cloudConn *KTCloudVpcConnection) CreateTagHandler() (irs.TagHandler, error) {
 	return nil, fmt.Errorf("KT Cloud VPC Driver: not implemented")
 }
+
+func (cloudConn *KTCloudVpcConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return nil, fmt.Errorf("KT Cloud VPC Driver does not support VNicHandler yet.")
+}
+
+func (cloudConn *KTCloudVpcConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	return nil, fmt.Errorf("KT Cloud VPC Driver does not support PublicIPHandler yet.")
+}
+
+func (cloudConn *KTCloudVpcConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, fmt.Errorf("KT Cloud VPC Driver does not support VPCPeeringHandler yet.")
+}
+
+func (cloudConn *KTCloudVpcConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, fmt.Errorf("KT Cloud VPC Driver does not support RouteTableHandler yet.")
+}
+
+func (cloudConn *KTCloudVpcConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, fmt.Errorf("KT Cloud VPC Driver does not support NATGatewayHandler yet.")
+}
+
+func (cloudConn *KTCloudVpcConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, fmt.Errorf("KT Cloud VPC Driver does not support DiskSnapshotHandler yet.")
+}
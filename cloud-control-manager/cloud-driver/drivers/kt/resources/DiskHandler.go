@@ -217,6 +217,10 @@ func (diskHandler *KTVpcDiskHandler) ChangeDiskSize(diskIID irs.IID, newDiskSize
 	return false, fmt.Errorf("Does not support ChangeDiskSize() yet!!")
 }
 
+func (diskHandler *KTVpcDiskHandler) ChangeDiskType(diskIID irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	return irs.DiskInfo{}, fmt.Errorf("KT Cloud VPC Driver does not support ChangeDiskType yet.")
+}
+
 func (diskHandler *KTVpcDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 	cblogger.Info("KT Cloud VPC Driver: called DeleteDisk()")
 	callLogInfo := getCallLogScheme(diskHandler.RegionInfo.Region, call.DISK, "DeleteDisk()", "DeleteDisk()")
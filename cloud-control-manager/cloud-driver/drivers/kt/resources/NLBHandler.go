@@ -408,6 +408,14 @@ func (nlbHandler *KTVpcNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.L
 	return irs.ListenerInfo{}, fmt.Errorf("Does not support yet!!")
 }
 
+func (nlbHandler *KTVpcNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	return irs.NLBInfo{}, fmt.Errorf("KT Cloud VPC Driver does not support AddListener yet.")
+}
+
+func (nlbHandler *KTVpcNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	return false, fmt.Errorf("KT Cloud VPC Driver does not support RemoveListener yet.")
+}
+
 // ------ Backend Control
 func (nlbHandler *KTVpcNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup irs.VMGroupInfo) (irs.VMGroupInfo, error) {
 
@@ -258,6 +258,16 @@ func (vmHandler *KTVpcVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, e
 		}
 	}
 
+	// Append user's user-data(script or cloud-config) after cb-user setup
+	mergedUserData := keycommon.MergeUserData(*initUserData, vmReqInfo.UserData)
+	sizeErr := keycommon.ValidateUserDataSize("KT", mergedUserData)
+	if sizeErr != nil {
+		cblogger.Error(sizeErr.Error())
+		loggingError(callLogInfo, sizeErr)
+		return irs.VMInfo{}, sizeErr
+	}
+	initUserData = &mergedUserData
+
 	vmCreateOpts.UserData = []byte(*initUserData) // Apply cloud-init script
 	createOpts.CreateOptsBuilder = vmCreateOpts
 
@@ -755,6 +765,10 @@ func (vmHandler *KTVpcVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, error
 	return irs.Terminating, nil
 }
 
+func (vmHandler *KTVpcVMHandler) ChangeVMSpec(vmIID irs.IID, specName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, errors.New("KT Cloud VPC Driver does not support ChangeVMSpec yet.")
+}
+
 func (vmHandler *KTVpcVMHandler) GetVMStatus(vmIID irs.IID) (irs.VMStatus, error) {
 	cblogger.Info("KT Cloud VPC Driver: called GetVMStatus()")
 	callLogInfo := getCallLogScheme(vmHandler.RegionInfo.Zone, call.VM, vmIID.SystemId, "GetVMStatus()")
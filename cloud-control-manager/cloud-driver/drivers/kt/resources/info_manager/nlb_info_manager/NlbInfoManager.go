@@ -36,7 +36,7 @@ func init() {
 	if err != nil {
 		panic("failed to connect database")
 	}
-	db.AutoMigrate(&NlbInfo{})
+	infostore.AutoMigrate(db, &NlbInfo{})
 	infostore.Close(db)
 }
 
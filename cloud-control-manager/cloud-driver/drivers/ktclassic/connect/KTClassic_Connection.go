@@ -146,3 +146,27 @@ func (cloudConn *KtCloudConnection) Close() error {
 	cblogger.Info("KT Cloud Driver: called Close()!")
 	return nil
 }
+
+func (cloudConn *KtCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return nil, fmt.Errorf("KT Cloud Driver does not support VNicHandler yet.")
+}
+
+func (cloudConn *KtCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	return nil, fmt.Errorf("KT Cloud Driver does not support PublicIPHandler yet.")
+}
+
+func (cloudConn *KtCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, fmt.Errorf("KT Cloud Driver does not support VPCPeeringHandler yet.")
+}
+
+func (cloudConn *KtCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, fmt.Errorf("KT Cloud Driver does not support RouteTableHandler yet.")
+}
+
+func (cloudConn *KtCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, fmt.Errorf("KT Cloud Driver does not support NATGatewayHandler yet.")
+}
+
+func (cloudConn *KtCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, fmt.Errorf("KT Cloud Driver does not support DiskSnapshotHandler yet.")
+}
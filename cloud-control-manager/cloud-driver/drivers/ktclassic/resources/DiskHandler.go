@@ -326,6 +326,10 @@ func (diskHandler *KtCloudDiskHandler) ChangeDiskSize(diskIID irs.IID, newDiskSi
 	return true, nil
 }
 
+func (diskHandler *KtCloudDiskHandler) ChangeDiskType(diskIID irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	return irs.DiskInfo{}, fmt.Errorf("KT Cloud Driver does not support ChangeDiskType yet.")
+}
+
 func (diskHandler *KtCloudDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 	cblogger.Info("KT Cloud Driver: called DeleteDisk()")
 	InitLog()
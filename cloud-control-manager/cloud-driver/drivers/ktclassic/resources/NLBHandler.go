@@ -269,6 +269,14 @@ func (nlbHandler *KtCloudNLBHandler) ChangeListener(nlbIID irs.IID, listener irs
 	return irs.ListenerInfo{}, fmt.Errorf("KT Cloud does not support ChangeListener() yet!!")
 }
 
+func (nlbHandler *KtCloudNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	return irs.NLBInfo{}, fmt.Errorf("KT Cloud Driver does not support AddListener yet.")
+}
+
+func (nlbHandler *KtCloudNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	return false, fmt.Errorf("KT Cloud Driver does not support RemoveListener yet.")
+}
+
 func (nlbHandler *KtCloudNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup irs.VMGroupInfo) (irs.VMGroupInfo, error) {
 	cblogger.Info("KT Cloud Driver: called ChangeVMGroupInfo()")
 
@@ -234,6 +234,16 @@ func (vmHandler *KtCloudVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 	}
 	// cblogger.Infof("init UserData : [%s]", *initUserData)
 
+	// Append user's user-data(script or cloud-config) after cb-user setup
+	mergedUserData := keycommon.MergeUserData(*initUserData, vmReqInfo.UserData)
+	sizeErr := keycommon.ValidateUserDataSize("KTCLASSIC", mergedUserData)
+	if sizeErr != nil {
+		cblogger.Error(sizeErr.Error())
+		LoggingError(callLogInfo, sizeErr)
+		return irs.VMInfo{}, sizeErr
+	}
+	initUserData = &mergedUserData
+
 	// # To Check if the Requested S/G exits
 	var sgSystemIDs []string
 	for _, sgIID := range vmReqInfo.SecurityGroupIIDs {
@@ -1122,6 +1132,10 @@ func (vmHandler *KtCloudVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, err
 	}
 }
 
+func (vmHandler *KtCloudVMHandler) ChangeVMSpec(vmIID irs.IID, specName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, errors.New("KT Cloud Driver does not support ChangeVMSpec yet.")
+}
+
 /*
 # KT Cloud serverInstanceStatusName ??
 Stopped
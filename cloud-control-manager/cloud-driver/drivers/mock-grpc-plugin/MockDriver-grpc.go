@@ -0,0 +1,26 @@
+// Mock Driver of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver as an out-of-process driver plugin.
+//
+// by CB-Spider Team, 2026.10.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	driverplugin "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/driver-plugin"
+	mock "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+)
+
+func main() {
+	if err := driverplugin.Serve(new(mock.MockDriver)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
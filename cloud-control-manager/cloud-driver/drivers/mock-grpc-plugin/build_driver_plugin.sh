@@ -0,0 +1,14 @@
+#!/bin/bash
+source $CBSPIDER_ROOT/setup.env
+
+DRIVERLIB_PATH=$CBSPIDER_ROOT/cloud-driver-libs
+DRIVERFILENAME=mock-driver-v1.0-grpc
+
+function build() {
+    rm -rf $DRIVERLIB_PATH/${DRIVERFILENAME}
+    go build -o ${DRIVERFILENAME} MockDriver-grpc.go || return 1
+    chmod +x ${DRIVERFILENAME} || return 1
+    mv ./${DRIVERFILENAME} $DRIVERLIB_PATH || return 1
+}
+
+build
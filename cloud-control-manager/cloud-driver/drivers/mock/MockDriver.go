@@ -52,11 +52,25 @@ func (MockDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.MyImageHandler = true
 	drvCapabilityInfo.NLBHandler = true
 	drvCapabilityInfo.ClusterHandler = true
+	drvCapabilityInfo.VNicHandler = true
+	drvCapabilityInfo.PublicIPHandler = true
+	drvCapabilityInfo.VPCPeeringHandler = true
+	drvCapabilityInfo.RouteTableHandler = true
+	drvCapabilityInfo.NATGatewayHandler = true
+
+	drvCapabilityInfo.DiskSnapshotHandler = true
 
 	drvCapabilityInfo.TagHandler = true
 	drvCapabilityInfo.TagSupportResourceType = []ires.RSType{ires.VPC, ires.SUBNET, ires.SG, ires.KEY, ires.VM, ires.NLB, ires.DISK, ires.MYIMAGE, ires.CLUSTER}
 
 	drvCapabilityInfo.VPC_CIDR = true
+	drvCapabilityInfo.SG_REFERENCE_RULE = true
+	drvCapabilityInfo.SG_DENY_RULE = true
+	drvCapabilityInfo.IPV6_DUAL_STACK = true
+	drvCapabilityInfo.SPOT_VM = true
+	drvCapabilityInfo.SPOT_PRICE = true
+	drvCapabilityInfo.VM_SPEC_CHANGE = true
+	drvCapabilityInfo.NLB_MULTI_LISTENER = true
 
 	return drvCapabilityInfo
 }
@@ -123,3 +123,39 @@ func (cloudConn *MockConnection) CreateTagHandler() (irs.TagHandler, error) {
 	handler := mkrs.MockTagHandler{MockName: cloudConn.MockName}
 	return &handler, nil
 }
+
+func (cloudConn *MockConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	cblogger.Info("Mock Driver: called CreateVNicHandler()!")
+	handler := mkrs.MockVNicHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
+func (cloudConn *MockConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	cblogger.Info("Mock Driver: called CreatePublicIPHandler()!")
+	handler := mkrs.MockPublicIPHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
+func (cloudConn *MockConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	cblogger.Info("Mock Driver: called CreateVPCPeeringHandler()!")
+	handler := mkrs.MockVPCPeeringHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
+func (cloudConn *MockConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	cblogger.Info("Mock Driver: called CreateRouteTableHandler()!")
+	handler := mkrs.MockRouteTableHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
+func (cloudConn *MockConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	cblogger.Info("Mock Driver: called CreateNATGatewayHandler()!")
+	handler := mkrs.MockNATGatewayHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
+func (cloudConn *MockConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	cblogger.Info("Mock Driver: called CreateDiskSnapshotHandler()!")
+	handler := mkrs.MockDiskSnapshotHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
@@ -43,6 +43,8 @@ func (anyCallHandler *MockAnyCallHandler) AnyCall(callInfo irs.AnyCallInfo) (irs
 	switch callInfo.FID {
 	case "countAll" : 
 		return countAll(anyCallHandler, callInfo)
+	case "interruptSpotVM" :
+		return interruptSpotVMCall(anyCallHandler, callInfo)
 
 	// add more ...
 
@@ -97,3 +99,39 @@ func countAll(anyCallHandler *MockAnyCallHandler, callInfo irs.AnyCallInfo) (irs
         return callInfo, nil
 }
 
+/********************************************************
+        // call example: simulate the interruption of a Spot VM
+        curl -sX POST http://localhost:1024/spider/anycall -H 'Content-Type: application/json' -d \
+        '{
+                "ConnectionName" : "mock-config01",
+                "ReqInfo" : {
+                        "FID" : "interruptSpotVM",
+                        "IKeyValueList" : [{"Key":"vmId", "Value":"spot-vm-01"}]
+                }
+        }' | json_pp
+********************************************************/
+func interruptSpotVMCall(anyCallHandler *MockAnyCallHandler, callInfo irs.AnyCallInfo) (irs.AnyCallInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AnyCall()/interruptSpotVM()!")
+
+	// Input Arg Validation
+	if callInfo.IKeyValueList == nil {
+		return irs.AnyCallInfo{}, errors.New("Mock Driver: " + callInfo.FID + "'s Argument is empty!")
+	}
+	if callInfo.IKeyValueList[0].Key != "vmId" {
+		return irs.AnyCallInfo{}, errors.New("Mock Driver: " + callInfo.FID + "'s Argument is not 'vmId'!")
+	}
+
+	vmStatus, err := interruptSpotVM(anyCallHandler.MockName, irs.IID{SystemId: callInfo.IKeyValueList[0].Value})
+	if err != nil {
+		return irs.AnyCallInfo{}, err
+	}
+
+	// make results
+	if callInfo.OKeyValueList == nil {
+		callInfo.OKeyValueList = []irs.KeyValue{}
+	}
+	callInfo.OKeyValueList = append(callInfo.OKeyValueList, irs.KeyValue{Key: "VMStatus", Value: string(vmStatus)})
+
+	return callInfo, nil
+}
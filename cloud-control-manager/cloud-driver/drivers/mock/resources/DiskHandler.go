@@ -89,8 +89,11 @@ func CloneDiskInfo(srcInfo irs.DiskInfo) irs.DiskInfo {
 	// clone DiskInfo
 	clonedInfo := irs.DiskInfo{
 		IId:          irs.IID{srcInfo.IId.NameId, srcInfo.IId.SystemId},
+		Zone:         srcInfo.Zone,
 		DiskType:     srcInfo.DiskType,
 		DiskSize:     srcInfo.DiskSize,
+		IOPS:         srcInfo.IOPS,
+		Throughput:   srcInfo.Throughput,
 		Status:       srcInfo.Status,
 		OwnerVM:      irs.IID{srcInfo.OwnerVM.NameId, srcInfo.OwnerVM.SystemId},
 		CreatedTime:  srcInfo.CreatedTime,
@@ -160,6 +163,44 @@ func (diskHandler *MockDiskHandler) ChangeDiskSize(iid irs.IID, size string) (bo
 	return false, fmt.Errorf("%s Disk does not exist!!", iid.NameId)
 }
 
+// The MEM Disk Type cannot be changed online.
+func (diskHandler *MockDiskHandler) ChangeDiskType(iid irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ChangeDiskType()!")
+
+	mockName := diskHandler.MockName
+
+	diskMapLock.Lock()
+	defer diskMapLock.Unlock()
+	infoList, ok := diskInfoMap[mockName]
+	if !ok {
+		return irs.DiskInfo{}, fmt.Errorf("%s Disk does not exist!!", iid.NameId)
+	}
+
+	for _, info := range infoList {
+		if (*info).IId.NameId == iid.NameId {
+			if info.DiskType == "MEM" || diskType == "MEM" {
+				return irs.DiskInfo{}, fmt.Errorf("the MEM Disk Type cannot be changed online!!")
+			}
+			if info.DiskType != diskType {
+				// the provisioned performance of the old Disk Type is not kept
+				info.IOPS = ""
+				info.Throughput = ""
+			}
+			info.DiskType = diskType
+			if iops != "" {
+				info.IOPS = iops
+			}
+			if throughput != "" {
+				info.Throughput = throughput
+			}
+			return CloneDiskInfo(*info), nil
+		}
+	}
+
+	return irs.DiskInfo{}, fmt.Errorf("%s Disk does not exist!!", iid.NameId)
+}
+
 func (diskHandler *MockDiskHandler) DeleteDisk(iid irs.IID) (bool, error) {
 	cblogger := cblog.GetLogger("CB-SPIDER")
 	cblogger.Info("Mock Driver: called DeleteDisk()!")
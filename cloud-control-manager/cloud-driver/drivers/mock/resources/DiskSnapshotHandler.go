@@ -0,0 +1,207 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var diskSnapshotInfoMap map[string][]*irs.DiskSnapshotInfo
+
+type MockDiskSnapshotHandler struct {
+	MockName string
+}
+
+func init() {
+	// cblog is a global variable.
+	diskSnapshotInfoMap = make(map[string][]*irs.DiskSnapshotInfo)
+}
+
+var diskSnapshotMapLock = new(sync.RWMutex)
+
+// (1) get the source Disk
+// (2) create diskSnapshotInfo object
+// (3) insert diskSnapshotInfo into global Map
+func (snapshotHandler *MockDiskSnapshotHandler) CreateDiskSnapshot(snapshotReqInfo irs.DiskSnapshotInfo) (irs.DiskSnapshotInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateDiskSnapshot()!")
+
+	mockName := snapshotHandler.MockName
+
+	// (1) get the source Disk
+	diskHandler := MockDiskHandler{MockName: mockName}
+	diskInfo, err := diskHandler.GetDisk(snapshotReqInfo.SourceDisk)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskSnapshotInfo{}, err
+	}
+
+	diskSnapshotMapLock.Lock()
+	defer diskSnapshotMapLock.Unlock()
+
+	if findDiskSnapshot(mockName, snapshotReqInfo.IId.NameId) != nil {
+		err := fmt.Errorf("%s DiskSnapshot already exists!!", snapshotReqInfo.IId.NameId)
+		cblogger.Error(err)
+		return irs.DiskSnapshotInfo{}, err
+	}
+
+	// (2) create diskSnapshotInfo object
+	info := irs.DiskSnapshotInfo{
+		IId:          irs.IID{NameId: snapshotReqInfo.IId.NameId, SystemId: snapshotReqInfo.IId.NameId},
+		SourceDisk:   diskInfo.IId,
+		Zone:         diskInfo.Zone,
+		DiskSize:     diskInfo.DiskSize,
+		Status:       irs.DiskSnapshotAvailable,
+		CreatedTime:  time.Now(),
+		TagList:      snapshotReqInfo.TagList,
+		KeyValueList: []irs.KeyValue{{Key: "SourceDiskType", Value: diskInfo.DiskType}},
+	}
+
+	// (3) insert diskSnapshotInfo into global Map
+	diskSnapshotInfoMap[mockName] = append(diskSnapshotInfoMap[mockName], &info)
+
+	return CloneDiskSnapshotInfo(info), nil
+}
+
+func (snapshotHandler *MockDiskSnapshotHandler) ListDiskSnapshot() ([]*irs.DiskSnapshotInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListDiskSnapshot()!")
+
+	diskSnapshotMapLock.RLock()
+	defer diskSnapshotMapLock.RUnlock()
+
+	infoList := []*irs.DiskSnapshotInfo{}
+	for _, info := range diskSnapshotInfoMap[snapshotHandler.MockName] {
+		clonedInfo := CloneDiskSnapshotInfo(*info)
+		infoList = append(infoList, &clonedInfo)
+	}
+	return infoList, nil
+}
+
+func (snapshotHandler *MockDiskSnapshotHandler) GetDiskSnapshot(iid irs.IID) (irs.DiskSnapshotInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetDiskSnapshot()!")
+
+	diskSnapshotMapLock.RLock()
+	defer diskSnapshotMapLock.RUnlock()
+
+	info := findDiskSnapshot(snapshotHandler.MockName, iid.SystemId)
+	if info == nil {
+		return irs.DiskSnapshotInfo{}, fmt.Errorf("%s DiskSnapshot does not exist!!", iid.NameId)
+	}
+	return CloneDiskSnapshotInfo(*info), nil
+}
+
+func (snapshotHandler *MockDiskSnapshotHandler) DeleteDiskSnapshot(iid irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteDiskSnapshot()!")
+
+	mockName := snapshotHandler.MockName
+
+	diskSnapshotMapLock.Lock()
+	defer diskSnapshotMapLock.Unlock()
+
+	infoList := diskSnapshotInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.SystemId == iid.SystemId {
+			diskSnapshotInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s DiskSnapshot does not exist!!", iid.NameId)
+}
+
+// (1) get the DiskSnapshot
+// (2) check the size of the new Disk
+// (3) create the Disk with the Disk Handler
+func (snapshotHandler *MockDiskSnapshotHandler) CreateDiskFromSnapshot(snapshotIID irs.IID, diskReqInfo irs.DiskInfo) (irs.DiskInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateDiskFromSnapshot()!")
+
+	// (1) get the DiskSnapshot
+	snapshotInfo, err := snapshotHandler.GetDiskSnapshot(snapshotIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+
+	// (2) check the size of the new Disk
+	if diskReqInfo.DiskSize == "" || diskReqInfo.DiskSize == "default" {
+		diskReqInfo.DiskSize = snapshotInfo.DiskSize
+	}
+	reqSize, err := strconv.Atoi(diskReqInfo.DiskSize)
+	if err != nil {
+		err := fmt.Errorf("invalid DiskSize: %s", diskReqInfo.DiskSize)
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+	snapshotSize, _ := strconv.Atoi(snapshotInfo.DiskSize)
+	if reqSize < snapshotSize {
+		err := fmt.Errorf("the DiskSize(%dGB) cannot be smaller than the DiskSnapshot %s(%dGB)", reqSize, snapshotIID.NameId, snapshotSize)
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+	if diskReqInfo.DiskType == "" || diskReqInfo.DiskType == "default" {
+		for _, kv := range snapshotInfo.KeyValueList {
+			if kv.Key == "SourceDiskType" {
+				diskReqInfo.DiskType = kv.Value
+			}
+		}
+	}
+
+	// (3) create the Disk with the Disk Handler
+	diskHandler := MockDiskHandler{MockName: snapshotHandler.MockName}
+	diskInfo, err := diskHandler.CreateDisk(diskReqInfo)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DiskInfo{}, err
+	}
+	diskInfo.KeyValueList = append(diskInfo.KeyValueList, irs.KeyValue{Key: "SourceSnapshot", Value: snapshotInfo.IId.SystemId})
+	return diskInfo, nil
+}
+
+func (snapshotHandler *MockDiskSnapshotHandler) ListIID() ([]*irs.IID, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListIID()!")
+
+	diskSnapshotMapLock.RLock()
+	defer diskSnapshotMapLock.RUnlock()
+
+	iidList := []*irs.IID{}
+	for _, info := range diskSnapshotInfoMap[snapshotHandler.MockName] {
+		iid := info.IId
+		iidList = append(iidList, &iid)
+	}
+	return iidList, nil
+}
+
+func CloneDiskSnapshotInfo(srcInfo irs.DiskSnapshotInfo) irs.DiskSnapshotInfo {
+	clonedInfo := srcInfo
+	clonedInfo.TagList = append([]irs.KeyValue{}, srcInfo.TagList...)
+	clonedInfo.KeyValueList = append([]irs.KeyValue{}, srcInfo.KeyValueList...)
+	return clonedInfo
+}
+
+// findDiskSnapshot returns the DiskSnapshot in the global Map, diskSnapshotMapLock must be held.
+func findDiskSnapshot(mockName string, systemId string) *irs.DiskSnapshotInfo {
+	for _, info := range diskSnapshotInfoMap[mockName] {
+		if info.IId.SystemId == systemId {
+			return info
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"fmt"
+	"sync"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var natGatewayInfoMap map[string][]*irs.NATGatewayInfo
+
+type MockNATGatewayHandler struct {
+	MockName string
+}
+
+func init() {
+	// cblog is a global variable.
+	natGatewayInfoMap = make(map[string][]*irs.NATGatewayInfo)
+}
+
+var natGatewayMapLock = new(sync.RWMutex)
+
+// serial number to make IP addresses of NAT gateways
+var natGatewaySerial = 0
+
+// (1) check the VPC and the Subnet
+// (2) create natGatewayInfo object
+// (3) insert natGatewayInfo into global Map
+func (natGatewayHandler *MockNATGatewayHandler) CreateNATGateway(natGatewayReqInfo irs.NATGatewayInfo) (irs.NATGatewayInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateNATGateway()!")
+
+	mockName := natGatewayHandler.MockName
+
+	// (1) check the VPC and the Subnet
+	vpcInfo, err := getVPCInfo(mockName, natGatewayReqInfo.VpcIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NATGatewayInfo{}, err
+	}
+	if !hasSubnet(vpcInfo, natGatewayReqInfo.SubnetIID) {
+		err := fmt.Errorf("%s Subnet does not exist in %s VPC!!", natGatewayReqInfo.SubnetIID.NameId, vpcInfo.IId.NameId)
+		cblogger.Error(err)
+		return irs.NATGatewayInfo{}, err
+	}
+
+	natGatewayMapLock.Lock()
+	defer natGatewayMapLock.Unlock()
+
+	if findNATGateway(mockName, natGatewayReqInfo.IId.NameId) != nil {
+		err := fmt.Errorf("%s NATGateway already exists!!", natGatewayReqInfo.IId.NameId)
+		cblogger.Error(err)
+		return irs.NATGatewayInfo{}, err
+	}
+
+	// (2) create natGatewayInfo object
+	natGatewaySerial++
+	info := irs.NATGatewayInfo{
+		IId:       irs.IID{NameId: natGatewayReqInfo.IId.NameId, SystemId: natGatewayReqInfo.IId.NameId},
+		VpcIID:    vpcInfo.IId,
+		SubnetIID: natGatewayReqInfo.SubnetIID,
+		PublicIP:  fmt.Sprintf("3.35.%d.%d", natGatewaySerial/250, natGatewaySerial%250+1),
+		PrivateIP: fmt.Sprintf("10.0.%d.%d", natGatewaySerial/250, natGatewaySerial%250+1),
+		Status:    irs.NATGatewayAvailable,
+		TagList:   natGatewayReqInfo.TagList,
+	}
+
+	// (3) insert NATGatewayInfo into global Map
+	natGatewayInfoMap[mockName] = append(natGatewayInfoMap[mockName], &info)
+
+	return CloneNATGatewayInfo(info), nil
+}
+
+func (natGatewayHandler *MockNATGatewayHandler) ListNATGateway() ([]*irs.NATGatewayInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListNATGateway()!")
+
+	natGatewayMapLock.RLock()
+	defer natGatewayMapLock.RUnlock()
+
+	infoList := []*irs.NATGatewayInfo{}
+	for _, info := range natGatewayInfoMap[natGatewayHandler.MockName] {
+		clonedInfo := CloneNATGatewayInfo(*info)
+		infoList = append(infoList, &clonedInfo)
+	}
+	return infoList, nil
+}
+
+func (natGatewayHandler *MockNATGatewayHandler) GetNATGateway(iid irs.IID) (irs.NATGatewayInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetNATGateway()!")
+
+	natGatewayMapLock.RLock()
+	defer natGatewayMapLock.RUnlock()
+
+	info := findNATGateway(natGatewayHandler.MockName, iid.SystemId)
+	if info == nil {
+		return irs.NATGatewayInfo{}, fmt.Errorf("%s NATGateway does not exist!!", iid.NameId)
+	}
+	return CloneNATGatewayInfo(*info), nil
+}
+
+func (natGatewayHandler *MockNATGatewayHandler) DeleteNATGateway(iid irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteNATGateway()!")
+
+	mockName := natGatewayHandler.MockName
+
+	natGatewayMapLock.Lock()
+	defer natGatewayMapLock.Unlock()
+
+	infoList := natGatewayInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.SystemId == iid.SystemId {
+			natGatewayInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s NATGateway does not exist!!", iid.NameId)
+}
+
+func (natGatewayHandler *MockNATGatewayHandler) ListIID() ([]*irs.IID, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListIID()!")
+
+	natGatewayMapLock.RLock()
+	defer natGatewayMapLock.RUnlock()
+
+	iidList := []*irs.IID{}
+	for _, info := range natGatewayInfoMap[natGatewayHandler.MockName] {
+		iid := info.IId
+		iidList = append(iidList, &iid)
+	}
+	return iidList, nil
+}
+
+func CloneNATGatewayInfo(srcInfo irs.NATGatewayInfo) irs.NATGatewayInfo {
+	clonedInfo := srcInfo
+	clonedInfo.TagList = append([]irs.KeyValue{}, srcInfo.TagList...)
+	clonedInfo.KeyValueList = append([]irs.KeyValue{}, srcInfo.KeyValueList...)
+	return clonedInfo
+}
+
+// findNATGateway returns the NATGateway in the global Map, natGatewayMapLock must be held.
+func findNATGateway(mockName string, systemId string) *irs.NATGatewayInfo {
+	for _, info := range natGatewayInfoMap[mockName] {
+		if info.IId.SystemId == systemId {
+			return info
+		}
+	}
+	return nil
+}
+
+func hasSubnet(vpcInfo irs.VPCInfo, subnetIID irs.IID) bool {
+	for _, subnetInfo := range vpcInfo.SubnetInfoList {
+		if subnetInfo.IId.SystemId == subnetIID.SystemId {
+			return true
+		}
+	}
+	return false
+}
@@ -12,6 +12,7 @@ package resources
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -49,9 +50,19 @@ func (nlbHandler *MockNLBHandler) CreateNLB(nlbInfo irs.NLBInfo) (irs.NLBInfo, e
 	nlbInfo.CreatedTime = time.Now()
 	nlbInfo.Listener.IP = "1.2.3.4"
 	nlbInfo.Listener.DNSName = ""
-	nlbInfo.Listener.CspID = nlbInfo.IId.NameId + "-Listener-" + xid.New().String()
-	nlbInfo.VMGroup.CspID = nlbInfo.IId.NameId + "-VMGroup-" + xid.New().String()
 	nlbInfo.HealthChecker.CspID = nlbInfo.IId.NameId + "-HealthChecker-" + xid.New().String()
+
+	// Listener and VMGroup are the shorthand of a single listener binding
+	if len(nlbInfo.ListenerList) == 0 {
+		nlbInfo.ListenerList = []irs.ListenerBindingInfo{{Listener: nlbInfo.Listener, VMGroup: nlbInfo.VMGroup}}
+	}
+	for idx := range nlbInfo.ListenerList {
+		setListenerBinding(&nlbInfo, &nlbInfo.ListenerList[idx])
+	}
+	nlbInfo.Listener = nlbInfo.ListenerList[0].Listener
+	nlbInfo.VMGroup.Protocol = nlbInfo.ListenerList[0].VMGroup.Protocol
+	nlbInfo.VMGroup.Port = nlbInfo.ListenerList[0].VMGroup.Port
+	nlbInfo.VMGroup.CspID = nlbInfo.ListenerList[0].VMGroup.CspID
 	clonedInfo := CloneNLBInfo(nlbInfo)
 	infoList = append(infoList, &clonedInfo)
 	nlbInfoMap[mockName] = infoList
@@ -59,6 +70,16 @@ func (nlbHandler *MockNLBHandler) CreateNLB(nlbInfo irs.NLBInfo) (irs.NLBInfo, e
 	return CloneNLBInfo(nlbInfo), nil
 }
 
+// setListenerBinding sets the frontend IP and CspIDs of a listener binding,
+// the VMs of the binding are the VMs of the NLB's VMGroup.
+func setListenerBinding(nlbInfo *irs.NLBInfo, binding *irs.ListenerBindingInfo) {
+	binding.Listener.IP = "1.2.3.4"
+	binding.Listener.DNSName = ""
+	binding.Listener.CspID = nlbInfo.IId.NameId + "-Listener-" + xid.New().String()
+	binding.VMGroup.CspID = nlbInfo.IId.NameId + "-VMGroup-" + xid.New().String()
+	binding.VMGroup.VMs = nlbInfo.VMGroup.VMs
+}
+
 func CloneNLBInfoList(srcInfoList []*irs.NLBInfo) []*irs.NLBInfo {
 	clonedInfoList := []*irs.NLBInfo{}
 	for _, srcInfo := range srcInfoList {
@@ -98,6 +119,7 @@ func CloneNLBInfo(srcInfo irs.NLBInfo) irs.NLBInfo {
 		Listener:      srcInfo.Listener,
 		VMGroup:       srcInfo.VMGroup,
 		HealthChecker: srcInfo.HealthChecker,
+		ListenerList:  append([]irs.ListenerBindingInfo{}, srcInfo.ListenerList...),
 		CreatedTime:   srcInfo.CreatedTime,
 		TagList:       srcInfo.TagList, // clone TagList
 		KeyValueList:  srcInfo.KeyValueList,
@@ -276,6 +298,9 @@ func (nlbHandler *MockNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.Li
 		if info.IId.NameId == nlbIID.NameId {
 			info.Listener.Protocol = listener.Protocol
 			info.Listener.Port = listener.Port
+			if len(info.ListenerList) > 0 {
+				info.ListenerList[0].Listener = info.Listener
+			}
 			return CloneListenerInfo(info.Listener), nil
 		}
 	}
@@ -283,6 +308,75 @@ func (nlbHandler *MockNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.Li
 	return irs.ListenerInfo{}, fmt.Errorf("%s NLB does not exist!!", nlbIID.NameId)
 }
 
+func (nlbHandler *MockNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AddListener()!")
+
+	nlbMapLock.Lock()
+	defer nlbMapLock.Unlock()
+
+	mockName := nlbHandler.MockName
+	infoList, ok := nlbInfoMap[mockName]
+	if !ok {
+		return irs.NLBInfo{}, fmt.Errorf("%s NLB does not exist!!", nlbIID.NameId)
+	}
+
+	for _, info := range infoList {
+		if info.IId.NameId == nlbIID.NameId {
+			for _, binding := range info.ListenerList {
+				if strings.EqualFold(binding.Listener.Protocol, listenerBinding.Listener.Protocol) &&
+					binding.Listener.Port == listenerBinding.Listener.Port {
+					return irs.NLBInfo{}, fmt.Errorf("%s NLB already has the %s:%s Listener!!", nlbIID.NameId,
+						listenerBinding.Listener.Protocol, listenerBinding.Listener.Port)
+				}
+			}
+			setListenerBinding(info, &listenerBinding)
+			info.ListenerList = append(info.ListenerList, listenerBinding)
+			return CloneNLBInfo(*info), nil
+		}
+	}
+
+	return irs.NLBInfo{}, fmt.Errorf("%s NLB does not exist!!", nlbIID.NameId)
+}
+
+func (nlbHandler *MockNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RemoveListener()!")
+
+	nlbMapLock.Lock()
+	defer nlbMapLock.Unlock()
+
+	mockName := nlbHandler.MockName
+	infoList, ok := nlbInfoMap[mockName]
+	if !ok {
+		return false, fmt.Errorf("%s NLB does not exist!!", nlbIID.NameId)
+	}
+
+	for _, info := range infoList {
+		if info.IId.NameId == nlbIID.NameId {
+			for idx, binding := range info.ListenerList {
+				if !strings.EqualFold(binding.Listener.Protocol, listener.Protocol) || binding.Listener.Port != listener.Port {
+					continue
+				}
+				if len(info.ListenerList) == 1 {
+					return false, fmt.Errorf("%s NLB must have at least one Listener!!", nlbIID.NameId)
+				}
+				info.ListenerList = append(info.ListenerList[:idx], info.ListenerList[idx+1:]...)
+
+				// the next Listener becomes the first Listener
+				info.Listener = info.ListenerList[0].Listener
+				info.VMGroup.Protocol = info.ListenerList[0].VMGroup.Protocol
+				info.VMGroup.Port = info.ListenerList[0].VMGroup.Port
+				info.VMGroup.CspID = info.ListenerList[0].VMGroup.CspID
+				return true, nil
+			}
+			return false, fmt.Errorf("%s NLB does not have the %s:%s Listener!!", nlbIID.NameId, listener.Protocol, listener.Port)
+		}
+	}
+
+	return false, fmt.Errorf("%s NLB does not exist!!", nlbIID.NameId)
+}
+
 func CloneListenerInfo(srcInfo irs.ListenerInfo) irs.ListenerInfo {
 	/*
 		type ListenerInfo struct {
@@ -326,6 +420,10 @@ func (nlbHandler *MockNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup irs.
 		if info.IId.NameId == nlbIID.NameId {
 			info.VMGroup.Protocol = vmGroup.Protocol
 			info.VMGroup.Port = vmGroup.Port
+			if len(info.ListenerList) > 0 {
+				info.ListenerList[0].VMGroup.Protocol = vmGroup.Protocol
+				info.ListenerList[0].VMGroup.Port = vmGroup.Port
+			}
 			return CloneVMGroupInfo(info.VMGroup), nil
 		}
 	}
@@ -388,6 +486,9 @@ func (nlbHandler *MockNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, health
 			info.HealthChecker.Interval = healthChecker.Interval
 			info.HealthChecker.Timeout = healthChecker.Timeout
 			info.HealthChecker.Threshold = healthChecker.Threshold
+			info.HealthChecker.HTTPPath = healthChecker.HTTPPath
+			info.HealthChecker.ExpectedStatusCodes = healthChecker.ExpectedStatusCodes
+			info.HealthChecker.HostHeader = healthChecker.HostHeader
 			return CloneHealthCheckerInfo(info.HealthChecker), nil
 		}
 	}
@@ -404,17 +505,26 @@ func CloneHealthCheckerInfo(srcInfo irs.HealthCheckerInfo) irs.HealthCheckerInfo
 			Timeout         int     // secs, Waiting time to decide an unhealthy VM when no response.
 			Threshold       int     // num, The number of continuous health checks to change the VM status.
 
+			HTTPPath            string // HTTP only
+			ExpectedStatusCodes string // HTTP only
+			HostHeader          string // HTTP only
+
 			CspID           string  // Optional, May be Used by Driver.
 			KeyValueList []KeyValue
 		}
 	*/
 
 	clonedInfo := irs.HealthCheckerInfo{
-		Protocol:     srcInfo.Protocol,
-		Port:         srcInfo.Port,
-		Interval:     srcInfo.Interval,
-		Timeout:      srcInfo.Timeout,
-		Threshold:    srcInfo.Threshold,
+		Protocol:  srcInfo.Protocol,
+		Port:      srcInfo.Port,
+		Interval:  srcInfo.Interval,
+		Timeout:   srcInfo.Timeout,
+		Threshold: srcInfo.Threshold,
+
+		HTTPPath:            srcInfo.HTTPPath,
+		ExpectedStatusCodes: srcInfo.ExpectedStatusCodes,
+		HostHeader:          srcInfo.HostHeader,
+
 		CspID:        srcInfo.CspID,
 		KeyValueList: srcInfo.KeyValueList,
 	}
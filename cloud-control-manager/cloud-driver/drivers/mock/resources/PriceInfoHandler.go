@@ -88,6 +88,7 @@ type NLBInfo struct {
 type PricingList struct {
 	PayAsYouGo PayAsYouGo   `json:"payAsYouGo"`
 	SavingPlan []SavingPlan `json:"savingPlan"`
+	Spot       []SpotPrice  `json:"spot"`
 }
 type PayAsYouGo struct {
 	PricingId string `json:"priceId"`
@@ -102,6 +103,12 @@ type SavingPlan struct {
 	Currency  string `json:"currency"`
 	Price     string `json:"price"`
 }
+type SpotPrice struct {
+	PricingId string `json:"priceId"`
+	Unit      string `json:"unit"`
+	Currency  string `json:"currency"`
+	Price     string `json:"price"`
+}
 
 //------- common struct for price info
 //====================================================
@@ -268,6 +275,11 @@ func transformToProductInfo(productFamily string, jsonData *interface{}, filterL
 		// check filter
 		i := interface{}(productInfo)
 		hasKey, checked = checkFilters(&i, filterList)
+		if !hasKey {
+			// check the CSP attributes(ex: vcpu, volumeType) with no key in productInfo
+			i = getCSPProductInfo(productFamily, jsonData)
+			hasKey, checked = checkFilters(&i, convertCSPFilterKeys(filterList))
+		}
 		if hasKey {
 			if !checked { // Has any key but not matched
 				return hasKey, nil, nil
@@ -276,27 +288,40 @@ func transformToProductInfo(productFamily string, jsonData *interface{}, filterL
 	}
 
 	// filterList == nil or no policy Filter or checked == true
-	// set CSPProductInfo after checking filter, because CSPProductInfo is not used for filtering
+	productInfo.CSPProductInfo = getCSPProductInfo(productFamily, jsonData)
+
+	return hasKey, &productInfo, nil
+}
+
+// Mock's product info of each family, productFamily is already checked by the caller
+func getCSPProductInfo(productFamily string, jsonData *interface{}) interface{} {
 	switch productFamily {
 	case COMPUTE_INSTANCE:
-		json := (*jsonData).(InstanceData)
-		productInfo.CSPProductInfo = json.InstanceInfo
-
+		return (*jsonData).(InstanceData).InstanceInfo
 	case STORAGE:
-		json := (*jsonData).(StorageData)
-		productInfo.CSPProductInfo = json.StorageInfo
+		return (*jsonData).(StorageData).StorageInfo
+	default: // NETWORK_LOAD_BALANCER
+		return (*jsonData).(NLBData).NLBInfo
+	}
+}
 
-	case NETWORK_LOAD_BALANCER:
-		json := (*jsonData).(NLBData)
-		productInfo.CSPProductInfo = json.NLBInfo
+// filter keys of Spider which have different names in Mock's product info
+var cspFilterKeyMap = map[string]string{
+	"volumeType": "storageType",
+}
 
-	default:
-		err := errors.New(productFamily + " is not supported product family!")
-		cblogger.Error(err)
-		return hasKey, nil, err
+func convertCSPFilterKeys(filterList []irs.KeyValue) []irs.KeyValue {
+	cspFilterList := make([]irs.KeyValue, 0, len(filterList))
+	for _, filter := range filterList {
+		for key, cspKey := range cspFilterKeyMap {
+			if strings.EqualFold(filter.Key, key) {
+				filter.Key = cspKey
+				break
+			}
+		}
+		cspFilterList = append(cspFilterList, filter)
 	}
-
-	return hasKey, &productInfo, nil
+	return cspFilterList
 }
 
 func checkFilters(jsonData *interface{}, filterList []irs.KeyValue) (hasKey bool, result bool) {
@@ -383,6 +408,30 @@ func transformToPriceInfo(productFamily string, jsonData *interface{}, filterLis
 	}
 
 	priceInfo.OnDemand = onDemand
+
+	// Transform SavingPlan to Reserved
+	for _, savingPlan := range priceList.SavingPlan {
+		priceInfo.Reserved = append(priceInfo.Reserved, irs.Reserved{
+			PricingId:   savingPlan.PricingId,
+			Term:        savingPlan.Term,
+			Unit:        savingPlan.Unit,
+			Currency:    savingPlan.Currency,
+			Price:       savingPlan.Price,
+			Description: "Saving plan pricing policy",
+		})
+	}
+
+	// Transform spot prices, Mock has a single Spot price for the region
+	for _, spot := range priceList.Spot {
+		priceInfo.Spot = append(priceInfo.Spot, irs.Spot{
+			PricingId:   spot.PricingId,
+			Unit:        spot.Unit,
+			Currency:    spot.Currency,
+			Price:       spot.Price,
+			Description: "Spot pricing policy",
+		})
+	}
+
 	priceInfo.CSPPriceInfo = priceList
 
 	if filterList == nil {
@@ -392,9 +441,39 @@ func transformToPriceInfo(productFamily string, jsonData *interface{}, filterLis
 	gHasKey := false
 	checked := false
 
+	// check the pricing policy filters(pricingPolicy, LeaseContractLength)
+	var priceFilterList []irs.KeyValue
+	for _, filter := range filterList {
+		switch {
+		case strings.EqualFold(filter.Key, "pricingPolicy"):
+			gHasKey = true
+			if !hasPricingPolicy(&priceInfo, filter.Value) {
+				return gHasKey, nil, nil
+			}
+		case strings.EqualFold(filter.Key, "LeaseContractLength"):
+			gHasKey = true
+			// leave only the Reserved prices of the lease term
+			var reservedList []irs.Reserved
+			for _, reserved := range priceInfo.Reserved {
+				if strings.EqualFold(reserved.Term, filter.Value) {
+					reservedList = append(reservedList, reserved)
+				}
+			}
+			if len(reservedList) == 0 {
+				return gHasKey, nil, nil
+			}
+			priceInfo.Reserved = reservedList
+		default:
+			priceFilterList = append(priceFilterList, filter)
+		}
+	}
+	if len(priceFilterList) == 0 {
+		return gHasKey, &priceInfo, nil
+	}
+
 	// check filter on OnDemand
 	i := interface{}(onDemand)
-	hasKey, checked := checkFilters(&i, filterList)
+	hasKey, checked := checkFilters(&i, priceFilterList)
 	if hasKey {
 		gHasKey = true
 		if !checked { // Has any key but not matched
@@ -405,6 +484,19 @@ func transformToPriceInfo(productFamily string, jsonData *interface{}, filterLis
 	return gHasKey, &priceInfo, nil
 }
 
+// pricingPolicy: OnDemand, Reserved(SavingPlan) or Spot
+func hasPricingPolicy(priceInfo *irs.PriceInfo, pricingPolicy string) bool {
+	switch {
+	case strings.EqualFold(pricingPolicy, "OnDemand"):
+		return priceInfo.OnDemand.PricingId != ""
+	case strings.EqualFold(pricingPolicy, "Reserved"), strings.EqualFold(pricingPolicy, "SavingPlan"):
+		return len(priceInfo.Reserved) > 0
+	case strings.EqualFold(pricingPolicy, "Spot"):
+		return len(priceInfo.Spot) > 0
+	}
+	return false
+}
+
 func getMockPriceInfo(productFamily string, regionName string) ([]*interface{}, error) {
 	cblogger := cblog.GetLogger("CB-SPIDER")
 	cblogger.Info("Mock Driver: called getMockPriceInfo()!")
@@ -0,0 +1,185 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"fmt"
+	"sync"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var publicIPInfoMap map[string][]*irs.PublicIPInfo
+
+type MockPublicIPHandler struct {
+	MockName string
+}
+
+func init() {
+	// cblog is a global variable.
+	publicIPInfoMap = make(map[string][]*irs.PublicIPInfo)
+}
+
+var publicIPMapLock = new(sync.RWMutex)
+
+// serial number to make public IP addresses
+var publicIPSerial = 0
+
+// (1) create publicIPInfo object
+// (2) insert publicIPInfo into global Map
+func (publicIPHandler *MockPublicIPHandler) CreatePublicIP(publicIPReqInfo irs.PublicIPInfo) (irs.PublicIPInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreatePublicIP()!")
+
+	mockName := publicIPHandler.MockName
+	publicIPReqInfo.IId.SystemId = publicIPReqInfo.IId.NameId
+	publicIPReqInfo.Status = irs.PublicIPAvailable
+	publicIPReqInfo.OwnerVM = irs.IID{}
+
+	publicIPMapLock.Lock()
+	defer publicIPMapLock.Unlock()
+
+	// (1) create publicIPInfo object
+	publicIPSerial++
+	publicIPReqInfo.PublicIP = fmt.Sprintf("3.34.%d.%d", publicIPSerial/250, publicIPSerial%250+1)
+
+	// (2) insert PublicIPInfo into global Map
+	publicIPInfoMap[mockName] = append(publicIPInfoMap[mockName], &publicIPReqInfo)
+
+	return publicIPReqInfo, nil
+}
+
+func (publicIPHandler *MockPublicIPHandler) ListPublicIP() ([]*irs.PublicIPInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListPublicIP()!")
+
+	publicIPMapLock.RLock()
+	defer publicIPMapLock.RUnlock()
+
+	// cloning list of PublicIP
+	infoList := []*irs.PublicIPInfo{}
+	for _, info := range publicIPInfoMap[publicIPHandler.MockName] {
+		clonedInfo := *info
+		infoList = append(infoList, &clonedInfo)
+	}
+	return infoList, nil
+}
+
+func (publicIPHandler *MockPublicIPHandler) GetPublicIP(iid irs.IID) (irs.PublicIPInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetPublicIP()!")
+
+	publicIPMapLock.RLock()
+	defer publicIPMapLock.RUnlock()
+
+	info := findPublicIP(publicIPHandler.MockName, iid)
+	if info == nil {
+		return irs.PublicIPInfo{}, fmt.Errorf("%s PublicIP does not exist!!", iid.NameId)
+	}
+	return *info, nil
+}
+
+func (publicIPHandler *MockPublicIPHandler) DeletePublicIP(iid irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeletePublicIP()!")
+
+	mockName := publicIPHandler.MockName
+
+	publicIPMapLock.Lock()
+	defer publicIPMapLock.Unlock()
+
+	infoList := publicIPInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.SystemId == iid.SystemId {
+			if info.Status == irs.PublicIPAttached {
+				return false, fmt.Errorf("%s PublicIP is attached to %s VM!!", iid.NameId, info.OwnerVM.NameId)
+			}
+			publicIPInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s PublicIP does not exist!!", iid.NameId)
+}
+
+func (publicIPHandler *MockPublicIPHandler) AttachPublicIP(publicIPIID irs.IID, ownerVM irs.IID) (irs.PublicIPInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AttachPublicIP()!")
+
+	mockName := publicIPHandler.MockName
+
+	publicIPMapLock.Lock()
+	defer publicIPMapLock.Unlock()
+
+	info := findPublicIP(mockName, publicIPIID)
+	if info == nil {
+		return irs.PublicIPInfo{}, fmt.Errorf("%s PublicIP does not exist!!", publicIPIID.NameId)
+	}
+	if info.Status == irs.PublicIPAttached {
+		return irs.PublicIPInfo{}, fmt.Errorf("%s PublicIP is already Attached status!!", publicIPIID.NameId)
+	}
+	if err := setVMPublicIP(mockName, ownerVM, info.PublicIP); err != nil {
+		return irs.PublicIPInfo{}, err
+	}
+	info.OwnerVM = ownerVM
+	info.Status = irs.PublicIPAttached
+	return *info, nil
+}
+
+func (publicIPHandler *MockPublicIPHandler) DetachPublicIP(publicIPIID irs.IID, ownerVM irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DetachPublicIP()!")
+
+	mockName := publicIPHandler.MockName
+
+	publicIPMapLock.Lock()
+	defer publicIPMapLock.Unlock()
+
+	info := findPublicIP(mockName, publicIPIID)
+	if info == nil {
+		return false, fmt.Errorf("%s PublicIP does not exist!!", publicIPIID.NameId)
+	}
+	if info.Status != irs.PublicIPAttached || info.OwnerVM.SystemId != ownerVM.SystemId {
+		return false, fmt.Errorf("%s PublicIP is not attached to %s VM!!", publicIPIID.NameId, ownerVM.NameId)
+	}
+	// the VM gets an ephemeral public IP again
+	if err := setVMPublicIP(mockName, ownerVM, ""); err != nil {
+		return false, err
+	}
+	info.OwnerVM = irs.IID{}
+	info.Status = irs.PublicIPAvailable
+	return true, nil
+}
+
+func (publicIPHandler *MockPublicIPHandler) ListIID() ([]*irs.IID, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListIID()!")
+
+	publicIPMapLock.RLock()
+	defer publicIPMapLock.RUnlock()
+
+	iidList := []*irs.IID{}
+	for _, info := range publicIPInfoMap[publicIPHandler.MockName] {
+		iid := info.IId
+		iidList = append(iidList, &iid)
+	}
+	return iidList, nil
+}
+
+// findPublicIP returns the PublicIP in the global Map, publicIPMapLock must be held.
+func findPublicIP(mockName string, iid irs.IID) *irs.PublicIPInfo {
+	for _, info := range publicIPInfoMap[mockName] {
+		if info.IId.SystemId == iid.SystemId {
+			return info
+		}
+	}
+	return nil
+}
@@ -0,0 +1,327 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var routeTableInfoMap map[string][]*irs.RouteTableInfo
+
+type MockRouteTableHandler struct {
+	MockName string
+}
+
+func init() {
+	// cblog is a global variable.
+	routeTableInfoMap = make(map[string][]*irs.RouteTableInfo)
+}
+
+var routeTableMapLock = new(sync.RWMutex)
+
+// (1) check the VPC
+// (2) create routeTableInfo object with the Local route of the VPC
+// (3) add the requested routes and insert routeTableInfo into global Map
+func (routeTableHandler *MockRouteTableHandler) CreateRouteTable(routeTableReqInfo irs.RouteTableInfo) (irs.RouteTableInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateRouteTable()!")
+
+	mockName := routeTableHandler.MockName
+
+	// (1) check the VPC
+	vpcInfo, err := getVPCInfo(mockName, routeTableReqInfo.VpcIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.RouteTableInfo{}, err
+	}
+
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+
+	if findRouteTable(mockName, routeTableReqInfo.IId.NameId) != nil {
+		err := fmt.Errorf("%s RouteTable already exists!!", routeTableReqInfo.IId.NameId)
+		cblogger.Error(err)
+		return irs.RouteTableInfo{}, err
+	}
+
+	// (2) create routeTableInfo object with the Local route of the VPC
+	info := irs.RouteTableInfo{
+		IId:        irs.IID{NameId: routeTableReqInfo.IId.NameId, SystemId: routeTableReqInfo.IId.NameId},
+		VpcIID:     vpcInfo.IId,
+		RouteList:  []irs.RouteInfo{{DestinationCIDR: vpcInfo.IPv4_CIDR, TargetType: irs.RouteTargetLocal}},
+		SubnetIIDs: []irs.IID{},
+		TagList:    routeTableReqInfo.TagList,
+	}
+
+	// (3) add the requested routes and insert routeTableInfo into global Map
+	if err := addRoutes(mockName, &info, routeTableReqInfo.RouteList); err != nil {
+		cblogger.Error(err)
+		return irs.RouteTableInfo{}, err
+	}
+	routeTableInfoMap[mockName] = append(routeTableInfoMap[mockName], &info)
+
+	return CloneRouteTableInfo(info), nil
+}
+
+func (routeTableHandler *MockRouteTableHandler) ListRouteTable() ([]*irs.RouteTableInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListRouteTable()!")
+
+	routeTableMapLock.RLock()
+	defer routeTableMapLock.RUnlock()
+
+	infoList := []*irs.RouteTableInfo{}
+	for _, info := range routeTableInfoMap[routeTableHandler.MockName] {
+		clonedInfo := CloneRouteTableInfo(*info)
+		infoList = append(infoList, &clonedInfo)
+	}
+	return infoList, nil
+}
+
+func (routeTableHandler *MockRouteTableHandler) GetRouteTable(iid irs.IID) (irs.RouteTableInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetRouteTable()!")
+
+	routeTableMapLock.RLock()
+	defer routeTableMapLock.RUnlock()
+
+	info := findRouteTable(routeTableHandler.MockName, iid.SystemId)
+	if info == nil {
+		return irs.RouteTableInfo{}, fmt.Errorf("%s RouteTable does not exist!!", iid.NameId)
+	}
+	return CloneRouteTableInfo(*info), nil
+}
+
+// A RouteTable with associated subnets can not be deleted.
+func (routeTableHandler *MockRouteTableHandler) DeleteRouteTable(iid irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteRouteTable()!")
+
+	mockName := routeTableHandler.MockName
+
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+
+	infoList := routeTableInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.SystemId == iid.SystemId {
+			if len(info.SubnetIIDs) > 0 {
+				return false, fmt.Errorf("%s RouteTable has %d associated Subnets!!", iid.NameId, len(info.SubnetIIDs))
+			}
+			routeTableInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s RouteTable does not exist!!", iid.NameId)
+}
+
+func (routeTableHandler *MockRouteTableHandler) AddRoutes(routeTableIID irs.IID, routeList []irs.RouteInfo) (irs.RouteTableInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AddRoutes()!")
+
+	mockName := routeTableHandler.MockName
+
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+
+	info := findRouteTable(mockName, routeTableIID.SystemId)
+	if info == nil {
+		return irs.RouteTableInfo{}, fmt.Errorf("%s RouteTable does not exist!!", routeTableIID.NameId)
+	}
+	if err := addRoutes(mockName, info, routeList); err != nil {
+		cblogger.Error(err)
+		return irs.RouteTableInfo{}, err
+	}
+	return CloneRouteTableInfo(*info), nil
+}
+
+// The routes are matched by the DestinationCIDR, and the Local route can not be removed.
+func (routeTableHandler *MockRouteTableHandler) RemoveRoutes(routeTableIID irs.IID, routeList []irs.RouteInfo) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RemoveRoutes()!")
+
+	mockName := routeTableHandler.MockName
+
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+
+	info := findRouteTable(mockName, routeTableIID.SystemId)
+	if info == nil {
+		return false, fmt.Errorf("%s RouteTable does not exist!!", routeTableIID.NameId)
+	}
+
+	remainedList := append([]irs.RouteInfo{}, info.RouteList...)
+	for _, route := range routeList {
+		idx := findRoute(remainedList, route.DestinationCIDR)
+		if idx < 0 {
+			return false, fmt.Errorf("the route to %s does not exist in %s RouteTable!!", route.DestinationCIDR, routeTableIID.NameId)
+		}
+		if remainedList[idx].TargetType == irs.RouteTargetLocal {
+			return false, fmt.Errorf("the Local route to %s can not be removed!!", route.DestinationCIDR)
+		}
+		remainedList = append(remainedList[:idx], remainedList[idx+1:]...)
+	}
+	info.RouteList = remainedList
+	return true, nil
+}
+
+// A Subnet is associated with only one RouteTable, so it is moved from the current one.
+func (routeTableHandler *MockRouteTableHandler) AssociateSubnet(routeTableIID irs.IID, subnetIID irs.IID) (irs.RouteTableInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AssociateSubnet()!")
+
+	mockName := routeTableHandler.MockName
+
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+
+	info := findRouteTable(mockName, routeTableIID.SystemId)
+	if info == nil {
+		return irs.RouteTableInfo{}, fmt.Errorf("%s RouteTable does not exist!!", routeTableIID.NameId)
+	}
+	vpcInfo, err := getVPCInfo(mockName, info.VpcIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.RouteTableInfo{}, err
+	}
+	if !hasSubnet(vpcInfo, subnetIID) {
+		return irs.RouteTableInfo{}, fmt.Errorf("%s Subnet does not exist in %s VPC!!", subnetIID.NameId, vpcInfo.IId.NameId)
+	}
+
+	for _, otherInfo := range routeTableInfoMap[mockName] {
+		otherInfo.SubnetIIDs = removeIID(otherInfo.SubnetIIDs, subnetIID)
+	}
+	info.SubnetIIDs = append(info.SubnetIIDs, subnetIID)
+	return CloneRouteTableInfo(*info), nil
+}
+
+func (routeTableHandler *MockRouteTableHandler) DisassociateSubnet(routeTableIID irs.IID, subnetIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DisassociateSubnet()!")
+
+	mockName := routeTableHandler.MockName
+
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+
+	info := findRouteTable(mockName, routeTableIID.SystemId)
+	if info == nil {
+		return false, fmt.Errorf("%s RouteTable does not exist!!", routeTableIID.NameId)
+	}
+	subnetIIDs := removeIID(info.SubnetIIDs, subnetIID)
+	if len(subnetIIDs) == len(info.SubnetIIDs) {
+		return false, fmt.Errorf("%s Subnet is not associated with %s RouteTable!!", subnetIID.NameId, routeTableIID.NameId)
+	}
+	info.SubnetIIDs = subnetIIDs
+	return true, nil
+}
+
+func (routeTableHandler *MockRouteTableHandler) ListIID() ([]*irs.IID, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListIID()!")
+
+	routeTableMapLock.RLock()
+	defer routeTableMapLock.RUnlock()
+
+	iidList := []*irs.IID{}
+	for _, info := range routeTableInfoMap[routeTableHandler.MockName] {
+		iid := info.IId
+		iidList = append(iidList, &iid)
+	}
+	return iidList, nil
+}
+
+func CloneRouteTableInfo(srcInfo irs.RouteTableInfo) irs.RouteTableInfo {
+	clonedInfo := srcInfo
+	clonedInfo.RouteList = append([]irs.RouteInfo{}, srcInfo.RouteList...)
+	clonedInfo.SubnetIIDs = append([]irs.IID{}, srcInfo.SubnetIIDs...)
+	clonedInfo.TagList = append([]irs.KeyValue{}, srcInfo.TagList...)
+	clonedInfo.KeyValueList = append([]irs.KeyValue{}, srcInfo.KeyValueList...)
+	return clonedInfo
+}
+
+// findRouteTable returns the RouteTable in the global Map, routeTableMapLock must be held.
+func findRouteTable(mockName string, systemId string) *irs.RouteTableInfo {
+	for _, info := range routeTableInfoMap[mockName] {
+		if info.IId.SystemId == systemId {
+			return info
+		}
+	}
+	return nil
+}
+
+// addRoutes checks the routes and their targets, and then appends them to the RouteTable.
+// routeTableMapLock must be held.
+func addRoutes(mockName string, info *irs.RouteTableInfo, routeList []irs.RouteInfo) error {
+	newList := append([]irs.RouteInfo{}, info.RouteList...)
+	for _, route := range routeList {
+		if _, _, err := net.ParseCIDR(route.DestinationCIDR); err != nil {
+			return err
+		}
+		if findRoute(newList, route.DestinationCIDR) >= 0 {
+			return fmt.Errorf("the route to %s already exists in %s RouteTable!!", route.DestinationCIDR, info.IId.NameId)
+		}
+		if err := checkRouteTarget(mockName, info.VpcIID, route); err != nil {
+			return err
+		}
+		newList = append(newList, route)
+	}
+	info.RouteList = newList
+	return nil
+}
+
+func checkRouteTarget(mockName string, vpcIID irs.IID, route irs.RouteInfo) error {
+	switch route.TargetType {
+	case irs.RouteTargetInternetGateway, irs.RouteTargetVNic:
+		return nil
+	case irs.RouteTargetNATGateway:
+		natGatewayMapLock.RLock()
+		defer natGatewayMapLock.RUnlock()
+		natGateway := findNATGateway(mockName, route.TargetIID.SystemId)
+		if natGateway == nil || natGateway.VpcIID.SystemId != vpcIID.SystemId {
+			return fmt.Errorf("%s NATGateway does not exist in %s VPC!!", route.TargetIID.NameId, vpcIID.NameId)
+		}
+		return nil
+	case irs.RouteTargetVPCPeering:
+		vpcPeeringListLock.RLock()
+		defer vpcPeeringListLock.RUnlock()
+		if findVPCPeering(mockName, route.TargetIID.SystemId) == nil {
+			return fmt.Errorf("%s VPCPeering does not exist!!", route.TargetIID.NameId)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%s is not a valid route target type!!", route.TargetType)
+	}
+}
+
+func findRoute(routeList []irs.RouteInfo, destinationCIDR string) int {
+	for idx, route := range routeList {
+		if route.DestinationCIDR == destinationCIDR {
+			return idx
+		}
+	}
+	return -1
+}
+
+func removeIID(iidList []irs.IID, iid irs.IID) []irs.IID {
+	newList := []irs.IID{}
+	for _, elem := range iidList {
+		if elem.SystemId != iid.SystemId {
+			newList = append(newList, elem)
+		}
+	}
+	return newList
+}
@@ -12,6 +12,7 @@ package resources
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	cblog "github.com/cloud-barista/cb-log"
@@ -53,6 +54,12 @@ func (securityHandler *MockSecurityHandler) CreateSecurity(securityReqInfo irs.S
 	sgMapLock.Lock()
 	defer sgMapLock.Unlock()
 	infoList, _ := securityInfoMap[mockName]
+	if securityReqInfo.SecurityRules != nil {
+		if err := checkRemoteSecurityGroups(infoList, securityReqInfo.SecurityRules); err != nil {
+			cblogger.Error(err)
+			return irs.SecurityInfo{}, err
+		}
+	}
 	infoList = append(infoList, &securityInfo)
 	securityInfoMap[mockName] = infoList
 
@@ -165,6 +172,11 @@ func (securityHandler *MockSecurityHandler) AddRules(sgIID irs.IID, securityRule
 		return irs.SecurityInfo{}, fmt.Errorf("%s SecurityGroup does not exist!!", sgIID.NameId)
 	}
 
+	if err := checkRemoteSecurityGroups(infoList, securityRules); err != nil {
+		cblogger.Error(err)
+		return irs.SecurityInfo{}, err
+	}
+
 	// check if all input rules exist
 	for _, info := range infoList {
 		if info.IId.NameId == sgIID.NameId {
@@ -247,6 +259,10 @@ func isEqualRule(a *irs.SecurityRuleInfo, b *irs.SecurityRuleInfo) bool {
 		FromPort   string
 		ToPort     string
 		CIDR       string
+		RemoteSecurityGroupIID *IID
+		Description string
+		Priority    string
+		Action      string
 	}
 	-------------------------------*/
 
@@ -265,10 +281,51 @@ func isEqualRule(a *irs.SecurityRuleInfo, b *irs.SecurityRuleInfo) bool {
 	if a.CIDR != b.CIDR {
 		return false
 	}
+	if (a.RemoteSecurityGroupIID == nil) != (b.RemoteSecurityGroupIID == nil) {
+		return false
+	}
+	if a.RemoteSecurityGroupIID != nil && a.RemoteSecurityGroupIID.SystemId != b.RemoteSecurityGroupIID.SystemId {
+		return false
+	}
+	if ruleAction(a) != ruleAction(b) {
+		return false
+	}
+	// Priority is compared only when both have it, Description is not compared
+	if a.Priority != "" && b.Priority != "" && a.Priority != b.Priority {
+		return false
+	}
 
 	return true
 }
 
+// ruleAction returns the Action of a rule, "allow" if not specified.
+func ruleAction(rule *irs.SecurityRuleInfo) string {
+	if rule.Action == "" {
+		return "allow"
+	}
+	return strings.ToLower(rule.Action)
+}
+
+// checkRemoteSecurityGroups checks that the SecurityGroups referenced by rules exist.
+func checkRemoteSecurityGroups(infoList []*irs.SecurityInfo, securityRules *[]irs.SecurityRuleInfo) error {
+	for _, rule := range *securityRules {
+		if rule.RemoteSecurityGroupIID == nil {
+			continue
+		}
+		exist := false
+		for _, info := range infoList {
+			if info.IId.SystemId == rule.RemoteSecurityGroupIID.SystemId {
+				exist = true
+				break
+			}
+		}
+		if !exist {
+			return fmt.Errorf("%s remote SecurityGroup does not exist!!", rule.RemoteSecurityGroupIID.SystemId)
+		}
+	}
+	return nil
+}
+
 func removeRule(list *[]irs.SecurityRuleInfo, idx int) []irs.SecurityRuleInfo {
 	return append((*list)[:idx], (*list)[idx+1:]...)
 }
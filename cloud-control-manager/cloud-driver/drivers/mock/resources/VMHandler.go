@@ -11,6 +11,7 @@
 package resources
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -35,6 +36,12 @@ func init() {
 
 var vmMapLock = new(sync.RWMutex)
 
+// serial number to make private IPs
+var vmSerial = 0
+
+// public IP of a VM without an attached PublicIP
+const ephemeralPublicIP = "4.3.2.1"
+
 func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, error) {
 	cblogger := cblog.GetLogger("CB-SPIDER")
 	cblogger.Info("Mock Driver: called StartVM()!")
@@ -150,6 +157,15 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 		return irs.VMInfo{}, err
 	}
 
+	// purchase option: OnDemand or Spot
+	purchaseOption := vmReqInfo.PurchaseOption
+	if purchaseOption.PurchaseType == "" {
+		purchaseOption.PurchaseType = irs.PurchaseOnDemand
+	}
+	if purchaseOption.PurchaseType == irs.PurchaseSpot && purchaseOption.InterruptionBehavior == "" {
+		purchaseOption.InterruptionBehavior = irs.InterruptionTerminate
+	}
+
 	// vm creation
 	vmInfo := irs.VMInfo{
 		IId:       vmReqInfo.IId,
@@ -168,10 +184,10 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 		VMUserPasswd: vmReqInfo.VMUserPasswd,
 
 		NetworkInterface: "mockni0",
-		PublicIP:         "4.3.2.1",
+		PublicIP:         ephemeralPublicIP,
 		PublicDNS:        vmReqInfo.IId.NameId + ".spider.barista.com",
-		PrivateIP:        "1.2.3.4",
 		PrivateDNS:       vmReqInfo.IId.NameId + ".spider.barista.com",
+		IPv6Address:      mockIPv6Address(validatedSubnetInfo.IPv6_CIDR, vmReqInfo.IId.NameId),
 
 		VMBootDisk:  "/dev/sda1",
 		VMBlockDisk: "/dev/sda1",
@@ -182,10 +198,17 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 
 		DataDiskIIDs: validatedDiskIIDs,
 
+		PurchaseOption: purchaseOption,
+
 		TagList:      vmReqInfo.TagList,
 		KeyValueList: nil,
 	}
 
+	// user-data: just keep it, because Mock does not boot anything.
+	if vmReqInfo.UserData != "" {
+		vmInfo.KeyValueList = append(vmInfo.KeyValueList, irs.KeyValue{Key: "UserData", Value: vmReqInfo.UserData})
+	}
+
 	// attach disks
 	for _, diskIID := range validatedDiskIIDs {
 		_, err := justAttachDisk(mockName, diskIID, vmReqInfo.IId)
@@ -198,6 +221,9 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 	vmMapLock.Lock()
 	defer vmMapLock.Unlock()
 
+	vmSerial++
+	vmInfo.PrivateIP = fmt.Sprintf("1.2.%d.%d", vmSerial/250, vmSerial%250+4)
+
 	infoList, _ := vmInfoMap[mockName]
 	infoList = append(infoList, &vmInfo)
 	vmInfoMap[mockName] = infoList
@@ -356,6 +382,103 @@ func (vmHandler *MockVMHandler) TerminateVM(iid irs.IID) (irs.VMStatus, error) {
 	return irs.Terminating, nil
 }
 
+// simulate the interruption of a Spot VM by the CSP.
+// The VM is terminated or suspended by its InterruptionBehavior.
+func interruptSpotVM(mockName string, iid irs.IID) (irs.VMStatus, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called interruptSpotVM()!")
+
+	vmMapLock.Lock()
+	defer vmMapLock.Unlock()
+
+	var validatedInfo *irs.VMInfo = nil
+	for _, info := range vmInfoMap[mockName] {
+		if (*info).IId.SystemId == iid.SystemId {
+			validatedInfo = info
+		}
+	}
+	var validatedStatusInfo *irs.VMStatusInfo = nil
+	for _, info := range vmStatusInfoMap[mockName] {
+		if (*info).IId.SystemId == iid.SystemId {
+			validatedStatusInfo = info
+		}
+	}
+	if validatedInfo == nil || validatedStatusInfo == nil {
+		errMSG := iid.SystemId + " vm iid does not exist!!"
+		cblogger.Error(errMSG)
+		return "", fmt.Errorf("%s", errMSG)
+	}
+
+	if validatedInfo.PurchaseOption.PurchaseType != irs.PurchaseSpot {
+		errMSG := iid.SystemId + " is not a Spot VM!!"
+		cblogger.Error(errMSG)
+		return "", fmt.Errorf("%s", errMSG)
+	}
+	if validatedStatusInfo.VmStatus != irs.Running {
+		errMSG := iid.SystemId + " is not running!!"
+		cblogger.Error(errMSG)
+		return "", fmt.Errorf("%s", errMSG)
+	}
+
+	switch validatedInfo.PurchaseOption.InterruptionBehavior {
+	case irs.InterruptionStop, irs.InterruptionHibernate:
+		validatedStatusInfo.VmStatus = irs.Suspended
+	default:
+		validatedStatusInfo.VmStatus = irs.Terminated
+	}
+	validatedInfo.KeyValueList = append(validatedInfo.KeyValueList,
+		irs.KeyValue{Key: "SpotInterruptionTime", Value: time.Now().Format(time.RFC3339)})
+
+	return validatedStatusInfo.VmStatus, nil
+}
+
+func (vmHandler *MockVMHandler) ChangeVMSpec(iid irs.IID, specName string) (irs.VMInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ChangeVMSpec()!")
+
+	mockName := vmHandler.MockName
+
+	// spec validation
+	vmSpecHandler := MockVMSpecHandler{mockName}
+	validatedSpecInfo, err := vmSpecHandler.GetVMSpec(specName)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
+
+	vmMapLock.Lock()
+	defer vmMapLock.Unlock()
+
+	var validatedStatusInfo *irs.VMStatusInfo = nil
+	for _, info := range vmStatusInfoMap[mockName] {
+		if (*info).IId.NameId == iid.NameId {
+			validatedStatusInfo = info
+		}
+	}
+	if validatedStatusInfo == nil {
+		errMSG := iid.NameId + " vm status iid does not exist!!"
+		cblogger.Error(errMSG)
+		return irs.VMInfo{}, fmt.Errorf("%s", errMSG)
+	}
+
+	if validatedStatusInfo.VmStatus != irs.Suspended {
+		errMSG := "change spec is only supported in SUSPENDED status"
+		cblogger.Error(errMSG)
+		return irs.VMInfo{}, fmt.Errorf("%s", errMSG)
+	}
+
+	for _, info := range vmInfoMap[mockName] {
+		if (*info).IId.NameId == iid.NameId {
+			info.VMSpecName = validatedSpecInfo.Name
+			return CloneVMInfo(*info), nil
+		}
+	}
+
+	errMSG := iid.NameId + " vm iid does not exist!!"
+	cblogger.Error(errMSG)
+	return irs.VMInfo{}, fmt.Errorf("%s", errMSG)
+}
+
 func (vmHandler *MockVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	cblogger := cblog.GetLogger("CB-SPIDER")
 	cblogger.Info("Mock Driver: called ListVMStatus()!")
@@ -524,6 +647,9 @@ func CloneVMInfo(srcInfo irs.VMInfo) irs.VMInfo {
 		PublicDNS:        srcInfo.PublicDNS,
 		PrivateIP:        srcInfo.PrivateIP,
 		PrivateDNS:       srcInfo.PrivateDNS,
+		IPv6Address:      srcInfo.IPv6Address,
+
+		PurchaseOption: srcInfo.PurchaseOption,
 
 		SSHAccessPoint: srcInfo.SSHAccessPoint,
 
@@ -627,6 +753,36 @@ func diskDetach(mockName string, iid irs.IID, diskIID irs.IID) (bool, error) {
 	return false, fmt.Errorf(errMSG)
 }
 
+func existVM(mockName string, iid irs.IID) bool {
+	vmMapLock.RLock()
+	defer vmMapLock.RUnlock()
+
+	for _, info := range vmInfoMap[mockName] {
+		if info.IId.SystemId == iid.SystemId {
+			return true
+		}
+	}
+	return false
+}
+
+// setVMPublicIP sets the public IP of a VM, an empty publicIP restores the ephemeral one.
+func setVMPublicIP(mockName string, iid irs.IID, publicIP string) error {
+	if publicIP == "" {
+		publicIP = ephemeralPublicIP
+	}
+
+	vmMapLock.Lock()
+	defer vmMapLock.Unlock()
+
+	for _, info := range vmInfoMap[mockName] {
+		if info.IId.SystemId == iid.SystemId {
+			info.PublicIP = publicIP
+			return nil
+		}
+	}
+	return fmt.Errorf("%s VM does not exist!!", iid.NameId)
+}
+
 func (vmHandler *MockVMHandler) ListIID() ([]*irs.IID, error) {
 	cblogger := cblog.GetLogger("CB-SPIDER")
 	cblogger.Info("AWS Driver: called ListIID()!")
@@ -645,3 +801,76 @@ func (vmHandler *MockVMHandler) ListIID() ([]*irs.IID, error) {
 
 	return iidList, nil
 }
+
+//------ context-aware VMHandler (irs.VMHandlerWithContext)
+// Mock works in memory, so it only needs to check ctx before doing the work.
+
+func (vmHandler *MockVMHandler) ListIIDWithContext(ctx context.Context) ([]*irs.IID, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return vmHandler.ListIID()
+}
+
+func (vmHandler *MockVMHandler) StartVMWithContext(ctx context.Context, vmReqInfo irs.VMReqInfo) (irs.VMInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return irs.VMInfo{}, err
+	}
+	return vmHandler.StartVM(vmReqInfo)
+}
+
+func (vmHandler *MockVMHandler) SuspendVMWithContext(ctx context.Context, iid irs.IID) (irs.VMStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return vmHandler.SuspendVM(iid)
+}
+
+func (vmHandler *MockVMHandler) ResumeVMWithContext(ctx context.Context, iid irs.IID) (irs.VMStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return vmHandler.ResumeVM(iid)
+}
+
+func (vmHandler *MockVMHandler) RebootVMWithContext(ctx context.Context, iid irs.IID) (irs.VMStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return vmHandler.RebootVM(iid)
+}
+
+func (vmHandler *MockVMHandler) TerminateVMWithContext(ctx context.Context, iid irs.IID) (irs.VMStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return vmHandler.TerminateVM(iid)
+}
+
+func (vmHandler *MockVMHandler) ListVMStatusWithContext(ctx context.Context) ([]*irs.VMStatusInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return vmHandler.ListVMStatus()
+}
+
+func (vmHandler *MockVMHandler) GetVMStatusWithContext(ctx context.Context, iid irs.IID) (irs.VMStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return vmHandler.GetVMStatus(iid)
+}
+
+func (vmHandler *MockVMHandler) ListVMWithContext(ctx context.Context) ([]*irs.VMInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return vmHandler.ListVM()
+}
+
+func (vmHandler *MockVMHandler) GetVMWithContext(ctx context.Context, iid irs.IID) (irs.VMInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return irs.VMInfo{}, err
+	}
+	return vmHandler.GetVM(iid)
+}
@@ -0,0 +1,213 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"fmt"
+	"sync"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var vNicInfoMap map[string][]*irs.VNicInfo
+
+type MockVNicHandler struct {
+	MockName string
+}
+
+func init() {
+	// cblog is a global variable.
+	vNicInfoMap = make(map[string][]*irs.VNicInfo)
+}
+
+var vNicMapLock = new(sync.RWMutex)
+
+// serial number to make private IPs and MAC addresses
+var vNicSerial = 0
+
+// (1) create vNicInfo object
+// (2) insert vNicInfo into global Map
+func (vNicHandler *MockVNicHandler) CreateVNic(vNicReqInfo irs.VNicInfo) (irs.VNicInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateVNic()!")
+
+	mockName := vNicHandler.MockName
+	if !existSubnet(mockName, vNicReqInfo.VpcIID, vNicReqInfo.SubnetIID) {
+		return irs.VNicInfo{}, fmt.Errorf("%s Subnet of %s VPC does not exist!!", vNicReqInfo.SubnetIID.NameId, vNicReqInfo.VpcIID.NameId)
+	}
+	vNicReqInfo.IId.SystemId = vNicReqInfo.IId.NameId
+	vNicReqInfo.Status = irs.VNicAvailable
+	vNicReqInfo.PublicIP = ""
+	vNicReqInfo.OwnerVM = irs.IID{}
+
+	vNicMapLock.Lock()
+	defer vNicMapLock.Unlock()
+
+	// (1) create vNicInfo object
+	vNicSerial++
+	if vNicReqInfo.PrivateIP == "" {
+		vNicReqInfo.PrivateIP = fmt.Sprintf("10.0.%d.%d", vNicSerial/250, vNicSerial%250+4)
+	}
+	vNicReqInfo.MacAddress = fmt.Sprintf("02:42:ac:11:%02x:%02x", (vNicSerial>>8)&0xff, vNicSerial&0xff)
+
+	// (2) insert VNicInfo into global Map
+	vNicInfoMap[mockName] = append(vNicInfoMap[mockName], &vNicReqInfo)
+
+	return CloneVNicInfo(vNicReqInfo), nil
+}
+
+func CloneVNicInfoList(srcInfoList []*irs.VNicInfo) []*irs.VNicInfo {
+	clonedInfoList := []*irs.VNicInfo{}
+	for _, srcInfo := range srcInfoList {
+		clonedInfo := CloneVNicInfo(*srcInfo)
+		clonedInfoList = append(clonedInfoList, &clonedInfo)
+	}
+	return clonedInfoList
+}
+
+func CloneVNicInfo(srcInfo irs.VNicInfo) irs.VNicInfo {
+	clonedInfo := srcInfo
+	clonedInfo.SecurityGroupIIDs = append([]irs.IID{}, srcInfo.SecurityGroupIIDs...)
+	return clonedInfo
+}
+
+func (vNicHandler *MockVNicHandler) ListVNic() ([]*irs.VNicInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListVNic()!")
+
+	vNicMapLock.RLock()
+	defer vNicMapLock.RUnlock()
+
+	// cloning list of VNic
+	return CloneVNicInfoList(vNicInfoMap[vNicHandler.MockName]), nil
+}
+
+func (vNicHandler *MockVNicHandler) GetVNic(iid irs.IID) (irs.VNicInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetVNic()!")
+
+	vNicMapLock.RLock()
+	defer vNicMapLock.RUnlock()
+
+	info := findVNic(vNicHandler.MockName, iid)
+	if info == nil {
+		return irs.VNicInfo{}, fmt.Errorf("%s VNic does not exist!!", iid.NameId)
+	}
+	return CloneVNicInfo(*info), nil
+}
+
+func (vNicHandler *MockVNicHandler) DeleteVNic(iid irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteVNic()!")
+
+	mockName := vNicHandler.MockName
+
+	vNicMapLock.Lock()
+	defer vNicMapLock.Unlock()
+
+	infoList := vNicInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.SystemId == iid.SystemId {
+			if info.Status == irs.VNicAttached {
+				return false, fmt.Errorf("%s VNic is attached to %s VM!!", iid.NameId, info.OwnerVM.NameId)
+			}
+			vNicInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s VNic does not exist!!", iid.NameId)
+}
+
+func (vNicHandler *MockVNicHandler) AttachVNic(vNicIID irs.IID, ownerVM irs.IID) (irs.VNicInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AttachVNic()!")
+
+	mockName := vNicHandler.MockName
+	if !existVM(mockName, ownerVM) {
+		return irs.VNicInfo{}, fmt.Errorf("%s VM does not exist!!", ownerVM.NameId)
+	}
+
+	vNicMapLock.Lock()
+	defer vNicMapLock.Unlock()
+
+	info := findVNic(mockName, vNicIID)
+	if info == nil {
+		return irs.VNicInfo{}, fmt.Errorf("%s VNic does not exist!!", vNicIID.NameId)
+	}
+	if info.Status == irs.VNicAttached {
+		return irs.VNicInfo{}, fmt.Errorf("%s VNic is already Attached status!!", vNicIID.NameId)
+	}
+	info.OwnerVM = ownerVM
+	info.Status = irs.VNicAttached
+	return CloneVNicInfo(*info), nil
+}
+
+func (vNicHandler *MockVNicHandler) DetachVNic(vNicIID irs.IID, ownerVM irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DetachVNic()!")
+
+	vNicMapLock.Lock()
+	defer vNicMapLock.Unlock()
+
+	info := findVNic(vNicHandler.MockName, vNicIID)
+	if info == nil {
+		return false, fmt.Errorf("%s VNic does not exist!!", vNicIID.NameId)
+	}
+	if info.Status != irs.VNicAttached || info.OwnerVM.SystemId != ownerVM.SystemId {
+		return false, fmt.Errorf("%s VNic is not attached to %s VM!!", vNicIID.NameId, ownerVM.NameId)
+	}
+	info.OwnerVM = irs.IID{}
+	info.Status = irs.VNicAvailable
+	return true, nil
+}
+
+func (vNicHandler *MockVNicHandler) ListIID() ([]*irs.IID, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListIID()!")
+
+	vNicMapLock.RLock()
+	defer vNicMapLock.RUnlock()
+
+	iidList := []*irs.IID{}
+	for _, info := range vNicInfoMap[vNicHandler.MockName] {
+		iid := info.IId
+		iidList = append(iidList, &iid)
+	}
+	return iidList, nil
+}
+
+func existSubnet(mockName string, vpcIID irs.IID, subnetIID irs.IID) bool {
+	vpcMapLock.RLock()
+	defer vpcMapLock.RUnlock()
+
+	for _, vpcInfo := range vpcInfoMap[mockName] {
+		if vpcInfo.IId.SystemId != vpcIID.SystemId {
+			continue
+		}
+		for _, subnetInfo := range vpcInfo.SubnetInfoList {
+			if subnetInfo.IId.SystemId == subnetIID.SystemId {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findVNic returns the VNic in the global Map, vNicMapLock must be held.
+func findVNic(mockName string, iid irs.IID) *irs.VNicInfo {
+	for _, info := range vNicInfoMap[mockName] {
+		if info.IId.SystemId == iid.SystemId {
+			return info
+		}
+	}
+	return nil
+}
@@ -1,10 +1,14 @@
 package resources
 
 import (
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
+	"net"
 	"sync"
 
 	cblog "github.com/cloud-barista/cb-log"
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
 
@@ -38,11 +42,24 @@ func (vpcHandler *MockVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCI
 	vpcInfo := irs.VPCInfo{
 		IId:            vpcReqInfo.IId,
 		IPv4_CIDR:      vpcReqInfo.IPv4_CIDR,
-		SubnetInfoList: vpcReqInfo.SubnetInfoList,
+		SubnetInfoList: []irs.SubnetInfo{},
 		TagList:        vpcReqInfo.TagList,
 		KeyValueList:   nil,
 	}
 
+	// dual-stack: a ULA /56 block made from the VPC name
+	if vpcReqInfo.EnableIPv6 {
+		vpcInfo.IPv6_CIDR = mockIPv6VPCCIDR(vpcReqInfo.IId.NameId)
+	}
+	for _, subnetInfo := range vpcReqInfo.SubnetInfoList {
+		err := setSubnetIPv6CIDR(&vpcInfo, &subnetInfo)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.VPCInfo{}, err
+		}
+		vpcInfo.SubnetInfoList = append(vpcInfo.SubnetInfoList, subnetInfo)
+	}
+
 	// (2) insert VPCInfo into global Map
 	vpcMapLock.Lock()
 	defer vpcMapLock.Unlock()
@@ -79,6 +96,7 @@ func CloneVPCInfo(srcInfo irs.VPCInfo) irs.VPCInfo {
 	clonedInfo := irs.VPCInfo{
 		IId:            irs.IID{srcInfo.IId.NameId, srcInfo.IId.SystemId},
 		IPv4_CIDR:      srcInfo.IPv4_CIDR,
+		IPv6_CIDR:      srcInfo.IPv6_CIDR,
 		SubnetInfoList: CloneSubnetInfoList(srcInfo.SubnetInfoList),
 		TagList:        srcInfo.TagList, // 필요시 깊은 복사 추가 가능
 		KeyValueList:   srcInfo.KeyValueList,
@@ -100,6 +118,7 @@ func CloneSubnetInfo(srcInfo irs.SubnetInfo) irs.SubnetInfo {
 		IId:          irs.IID{srcInfo.IId.NameId, srcInfo.IId.SystemId},
 		Zone:         srcInfo.Zone,
 		IPv4_CIDR:    srcInfo.IPv4_CIDR,
+		IPv6_CIDR:    srcInfo.IPv6_CIDR,
 		TagList:      srcInfo.TagList, // 필요시 깊은 복사 추가 가능
 		KeyValueList: srcInfo.KeyValueList,
 	}
@@ -166,6 +185,11 @@ func (vpcHandler *MockVPCHandler) AddSubnet(iid irs.IID, subnetInfo irs.SubnetIn
 	subnetInfo.IId.SystemId = subnetInfo.IId.NameId
 	for i, info := range infoList {
 		if info.IId.NameId == iid.NameId {
+			err := setSubnetIPv6CIDR(info, &subnetInfo)
+			if err != nil {
+				cblogger.Error(err)
+				return irs.VPCInfo{}, err
+			}
 			vpcInfoMap[mockName][i].SubnetInfoList = append(info.SubnetInfoList, subnetInfo)
 			return CloneVPCInfo(*info), nil
 		}
@@ -219,3 +243,66 @@ func (vpcHandler *MockVPCHandler) ListIID() ([]*irs.IID, error) {
 	}
 	return iidList, nil
 }
+
+// mockIPv6VPCCIDR makes a ULA(fd00::/8) /56 block from the VPC name.
+func mockIPv6VPCCIDR(vpcName string) string {
+	h := fnv.New64a()
+	h.Write([]byte(vpcName))
+	sum := h.Sum64()
+	ip := make(net.IP, net.IPv6len)
+	ip[0] = 0xfd
+	for i := 1; i < 7; i++ {
+		ip[i] = byte(sum >> (8 * uint(i)))
+	}
+	return (&net.IPNet{IP: ip, Mask: net.CIDRMask(56, 128)}).String()
+}
+
+// setSubnetIPv6CIDR checks the IPv6 CIDR of a Subnet in the VPC, or assigns the next /64 block in a dual-stack VPC.
+func setSubnetIPv6CIDR(vpcInfo *irs.VPCInfo, subnetInfo *irs.SubnetInfo) error {
+	if vpcInfo.IPv6_CIDR == "" {
+		if subnetInfo.IPv6_CIDR != "" {
+			return fmt.Errorf("%s VPC is not dual-stack!!", vpcInfo.IId.NameId)
+		}
+		return nil
+	}
+
+	usedCIDRs := []string{}
+	for _, one := range vpcInfo.SubnetInfoList {
+		usedCIDRs = append(usedCIDRs, one.IPv6_CIDR)
+	}
+
+	if subnetInfo.IPv6_CIDR == "" {
+		cidr, err := cdcom.NextIPv6SubnetCIDR(vpcInfo.IPv6_CIDR, usedCIDRs)
+		if err != nil {
+			return err
+		}
+		subnetInfo.IPv6_CIDR = cidr
+		return nil
+	}
+
+	_, vpcNet, _ := net.ParseCIDR(vpcInfo.IPv6_CIDR)
+	ip, _, err := net.ParseCIDR(subnetInfo.IPv6_CIDR)
+	if err != nil || !vpcNet.Contains(ip) {
+		return fmt.Errorf("%s is not in the VPC IPv6 CIDR %s!!", subnetInfo.IPv6_CIDR, vpcInfo.IPv6_CIDR)
+	}
+	for _, cidr := range usedCIDRs {
+		if cidr == subnetInfo.IPv6_CIDR {
+			return fmt.Errorf("%s is already used by another Subnet!!", subnetInfo.IPv6_CIDR)
+		}
+	}
+	return nil
+}
+
+// mockIPv6Address makes an address in the Subnet IPv6 CIDR from the VM name, empty for an IPv4 only Subnet.
+func mockIPv6Address(subnetIPv6CIDR string, vmName string) string {
+	_, subnetNet, err := net.ParseCIDR(subnetIPv6CIDR)
+	if err != nil {
+		return ""
+	}
+	h := fnv.New32a()
+	h.Write([]byte(vmName))
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, subnetNet.IP.To16())
+	binary.BigEndian.PutUint32(ip[12:], h.Sum32())
+	return ip.String()
+}
@@ -0,0 +1,283 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// A peering is shared by the requester and the accepter MockNames,
+// so the peerings are not grouped by MockName.
+type mockVPCPeering struct {
+	info              irs.VPCPeeringInfo
+	requesterMockName string
+	accepterMockName  string
+}
+
+var vpcPeeringList []*mockVPCPeering
+
+var vpcPeeringListLock = new(sync.RWMutex)
+
+type MockVPCPeeringHandler struct {
+	MockName string
+}
+
+// (1) check the requester and the accepter VPCs
+// (2) check the overlap of the VPC CIDRs
+// (3) insert the peering into the global list
+func (peeringHandler *MockVPCPeeringHandler) RequestVPCPeering(peeringReqInfo irs.VPCPeeringInfo) (irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RequestVPCPeering()!")
+
+	mockName := peeringHandler.MockName
+	accepterMockName := peeringReqInfo.AccepterAccountId
+	if accepterMockName == "" {
+		accepterMockName = mockName
+	}
+
+	// (1) check the requester and the accepter VPCs
+	requesterVPC, err := getVPCInfo(mockName, peeringReqInfo.RequesterVpcIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+	accepterVPC, err := getVPCInfo(accepterMockName, peeringReqInfo.AccepterVpcIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+
+	// (2) check the overlap of the VPC CIDRs
+	if cidrOverlapped(requesterVPC.IPv4_CIDR, accepterVPC.IPv4_CIDR) {
+		err := fmt.Errorf("the CIDR(%s) of %s VPC overlaps the CIDR(%s) of %s VPC", requesterVPC.IPv4_CIDR,
+			requesterVPC.IId.NameId, accepterVPC.IPv4_CIDR, accepterVPC.IId.NameId)
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+
+	vpcPeeringListLock.Lock()
+	defer vpcPeeringListLock.Unlock()
+
+	if findVPCPeering(mockName, peeringReqInfo.IId.NameId) != nil {
+		err := fmt.Errorf("%s VPCPeering already exists!!", peeringReqInfo.IId.NameId)
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+
+	// (3) insert the peering into the global list
+	info := irs.VPCPeeringInfo{
+		IId:               irs.IID{NameId: peeringReqInfo.IId.NameId, SystemId: peeringReqInfo.IId.NameId},
+		RequesterVpcIID:   requesterVPC.IId,
+		RequesterVpcCIDR:  requesterVPC.IPv4_CIDR,
+		AccepterVpcIID:    accepterVPC.IId,
+		AccepterVpcCIDR:   accepterVPC.IPv4_CIDR,
+		AccepterAccountId: peeringReqInfo.AccepterAccountId,
+		AccepterRegion:    peeringReqInfo.AccepterRegion,
+		Status:            irs.VPCPeeringPendingAcceptance,
+		TagList:           peeringReqInfo.TagList,
+	}
+	vpcPeeringList = append(vpcPeeringList, &mockVPCPeering{info: info, requesterMockName: mockName, accepterMockName: accepterMockName})
+
+	return CloneVPCPeeringInfo(info), nil
+}
+
+func (peeringHandler *MockVPCPeeringHandler) AcceptVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AcceptVPCPeering()!")
+
+	mockName := peeringHandler.MockName
+
+	vpcPeeringListLock.Lock()
+	defer vpcPeeringListLock.Unlock()
+
+	peering := findVPCPeering(mockName, peeringIID.SystemId)
+	if peering == nil {
+		err := fmt.Errorf("%s VPCPeering does not exist!!", peeringIID.NameId)
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+	if peering.accepterMockName != mockName {
+		err := fmt.Errorf("%s VPCPeering can be accepted only by the accepter", peeringIID.NameId)
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+	if peering.info.Status != irs.VPCPeeringPendingAcceptance && peering.info.Status != irs.VPCPeeringActive {
+		err := fmt.Errorf("%s VPCPeering can not be accepted in %s status", peeringIID.NameId, peering.info.Status)
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+	peering.info.Status = irs.VPCPeeringActive
+
+	return CloneVPCPeeringInfo(peering.info), nil
+}
+
+func (peeringHandler *MockVPCPeeringHandler) ListVPCPeering() ([]*irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListVPCPeering()!")
+
+	mockName := peeringHandler.MockName
+
+	vpcPeeringListLock.RLock()
+	defer vpcPeeringListLock.RUnlock()
+
+	infoList := []*irs.VPCPeeringInfo{}
+	for _, peering := range vpcPeeringList {
+		if peering.requesterMockName == mockName || peering.accepterMockName == mockName {
+			info := CloneVPCPeeringInfo(peering.info)
+			infoList = append(infoList, &info)
+		}
+	}
+	return infoList, nil
+}
+
+func (peeringHandler *MockVPCPeeringHandler) GetVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetVPCPeering()!")
+
+	vpcPeeringListLock.RLock()
+	defer vpcPeeringListLock.RUnlock()
+
+	peering := findVPCPeering(peeringHandler.MockName, peeringIID.SystemId)
+	if peering == nil {
+		err := fmt.Errorf("%s VPCPeering does not exist!!", peeringIID.NameId)
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+	return CloneVPCPeeringInfo(peering.info), nil
+}
+
+// A peering can be deleted by both of the requester and the accepter.
+func (peeringHandler *MockVPCPeeringHandler) DeleteVPCPeering(peeringIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteVPCPeering()!")
+
+	mockName := peeringHandler.MockName
+
+	vpcPeeringListLock.Lock()
+	defer vpcPeeringListLock.Unlock()
+
+	for idx, peering := range vpcPeeringList {
+		if peering.info.IId.SystemId != peeringIID.SystemId {
+			continue
+		}
+		if peering.requesterMockName != mockName && peering.accepterMockName != mockName {
+			continue
+		}
+		vpcPeeringList = append(vpcPeeringList[:idx], vpcPeeringList[idx+1:]...)
+		return true, nil
+	}
+	err := fmt.Errorf("%s VPCPeering does not exist!!", peeringIID.NameId)
+	cblogger.Error(err)
+	return false, err
+}
+
+func (peeringHandler *MockVPCPeeringHandler) PropagateRoutes(peeringIID irs.IID, vpcIID irs.IID) (irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called PropagateRoutes()!")
+
+	mockName := peeringHandler.MockName
+
+	vpcPeeringListLock.Lock()
+	defer vpcPeeringListLock.Unlock()
+
+	peering := findVPCPeering(mockName, peeringIID.SystemId)
+	if peering == nil {
+		err := fmt.Errorf("%s VPCPeering does not exist!!", peeringIID.NameId)
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+	if peering.info.Status != irs.VPCPeeringActive {
+		err := fmt.Errorf("%s VPCPeering is not active yet", peeringIID.NameId)
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+
+	switch {
+	case peering.requesterMockName == mockName && peering.info.RequesterVpcIID.SystemId == vpcIID.SystemId:
+		peering.info.RequesterRoutePropagated = true
+	case peering.accepterMockName == mockName && peering.info.AccepterVpcIID.SystemId == vpcIID.SystemId:
+		peering.info.AccepterRoutePropagated = true
+	default:
+		err := fmt.Errorf("%s VPC is not a VPC of %s VPCPeering in this connection", vpcIID.NameId, peeringIID.NameId)
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+
+	return CloneVPCPeeringInfo(peering.info), nil
+}
+
+// The MockName is the account ID of the Mock Driver.
+func (peeringHandler *MockVPCPeeringHandler) GetOwnerAccountId() (string, error) {
+	return peeringHandler.MockName, nil
+}
+
+func (peeringHandler *MockVPCPeeringHandler) ListIID() ([]*irs.IID, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListIID()!")
+
+	infoList, err := peeringHandler.ListVPCPeering()
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	iidList := make([]*irs.IID, len(infoList))
+	for i, info := range infoList {
+		iidList[i] = &info.IId
+	}
+	return iidList, nil
+}
+
+func CloneVPCPeeringInfo(srcInfo irs.VPCPeeringInfo) irs.VPCPeeringInfo {
+	clonedInfo := srcInfo
+	clonedInfo.TagList = append([]irs.KeyValue{}, srcInfo.TagList...)
+	clonedInfo.KeyValueList = append([]irs.KeyValue{}, srcInfo.KeyValueList...)
+	return clonedInfo
+}
+
+// findVPCPeering returns the peering of the mockName, vpcPeeringListLock must be held.
+func findVPCPeering(mockName string, systemId string) *mockVPCPeering {
+	for _, peering := range vpcPeeringList {
+		if peering.info.IId.SystemId != systemId {
+			continue
+		}
+		if peering.requesterMockName == mockName || peering.accepterMockName == mockName {
+			return peering
+		}
+	}
+	return nil
+}
+
+func getVPCInfo(mockName string, vpcIID irs.IID) (irs.VPCInfo, error) {
+	vpcMapLock.RLock()
+	defer vpcMapLock.RUnlock()
+
+	for _, vpcInfo := range vpcInfoMap[mockName] {
+		if vpcInfo.IId.SystemId == vpcIID.SystemId {
+			return *vpcInfo, nil
+		}
+	}
+	return irs.VPCInfo{}, fmt.Errorf("%s VPC does not exist!!", vpcIID.NameId)
+}
+
+func cidrOverlapped(cidr1 string, cidr2 string) bool {
+	_, net1, err1 := net.ParseCIDR(cidr1)
+	_, net2, err2 := net.ParseCIDR(cidr2)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return net1.Contains(net2.IP) || net2.Contains(net1.IP)
+}
@@ -0,0 +1,86 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
+
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+var diskTestHandler irs.DiskHandler
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{MockName: "MockDriver-disk"},
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	diskTestHandler, _ = cloudConn.CreateDiskHandler()
+}
+
+func TestChangeDiskType(t *testing.T) {
+	diskInfo, err := diskTestHandler.CreateDisk(irs.DiskInfo{
+		IId:      irs.IID{NameId: "mock-disk-type-01"},
+		DiskType: "HDD",
+		DiskSize: "100",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer diskTestHandler.DeleteDisk(diskInfo.IId)
+
+	changedInfo, err := diskTestHandler.ChangeDiskType(diskInfo.IId, "SSD", "6000", "250")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if changedInfo.DiskType != "SSD" || changedInfo.IOPS != "6000" || changedInfo.Throughput != "250" {
+		t.Errorf("unexpected changed DiskInfo: %#v", changedInfo)
+	}
+
+	// the provisioned performance is not kept with a new Disk Type
+	changedInfo, err = diskTestHandler.ChangeDiskType(diskInfo.IId, "HDD", "", "")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if changedInfo.DiskType != "HDD" || changedInfo.IOPS != "" || changedInfo.Throughput != "" {
+		t.Errorf("unexpected changed DiskInfo: %#v", changedInfo)
+	}
+
+	if _, err := diskTestHandler.ChangeDiskType(diskInfo.IId, "MEM", "", ""); err == nil {
+		t.Error("ChangeDiskType() to the MEM Disk Type should fail")
+	}
+	if _, err := diskTestHandler.ChangeDiskType(irs.IID{NameId: "mock-not-exist-disk"}, "SSD", "", ""); err == nil {
+		t.Error("ChangeDiskType() of a not existing Disk should fail")
+	}
+}
+
+func TestDiskChangeMetaInfo(t *testing.T) {
+	cloudOSMetaInfo, err := cim.GetCloudOSMetaInfo("MOCK")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(cloudOSMetaInfo.DiskChangeType) != 2 || cloudOSMetaInfo.DiskChangeType[0] != "SSD" || cloudOSMetaInfo.DiskChangeType[1] != "HDD" {
+		t.Errorf("unexpected DiskChangeType: %#v", cloudOSMetaInfo.DiskChangeType)
+	}
+	if len(cloudOSMetaInfo.RootDiskChangeType) != 2 || cloudOSMetaInfo.RootDiskChangeType[0] != "SSD" || cloudOSMetaInfo.RootDiskChangeType[1] != "HDD" {
+		t.Errorf("unexpected RootDiskChangeType: %#v", cloudOSMetaInfo.RootDiskChangeType)
+	}
+	if len(cloudOSMetaInfo.DiskPerformance) != 1 || cloudOSMetaInfo.DiskPerformance[0] != "SSD|3000|16000|125|1000" {
+		t.Errorf("unexpected DiskPerformance: %#v", cloudOSMetaInfo.DiskPerformance)
+	}
+}
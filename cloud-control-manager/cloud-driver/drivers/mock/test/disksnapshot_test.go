@@ -0,0 +1,113 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+var snapshotTestDiskHandler irs.DiskHandler
+var snapshotTestHandler irs.DiskSnapshotHandler
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{MockName: "MockDriver-disksnapshot"},
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	snapshotTestDiskHandler, _ = cloudConn.CreateDiskHandler()
+	snapshotTestHandler, _ = cloudConn.CreateDiskSnapshotHandler()
+}
+
+func TestDiskSnapshot(t *testing.T) {
+	diskInfo, err := snapshotTestDiskHandler.CreateDisk(irs.DiskInfo{
+		IId:      irs.IID{NameId: "mock-snapshot-disk-01"},
+		Zone:     "mock-zone-a",
+		DiskType: "HDD",
+		DiskSize: "100",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	snapshotInfo, err := snapshotTestHandler.CreateDiskSnapshot(irs.DiskSnapshotInfo{
+		IId:        irs.IID{NameId: "mock-snapshot-01"},
+		SourceDisk: diskInfo.IId,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if snapshotInfo.SourceDisk.SystemId != diskInfo.IId.SystemId || snapshotInfo.Zone != "mock-zone-a" ||
+		snapshotInfo.DiskSize != "100" || snapshotInfo.Status != irs.DiskSnapshotAvailable {
+		t.Errorf("unexpected DiskSnapshotInfo: %#v", snapshotInfo)
+	}
+	if _, err := snapshotTestHandler.CreateDiskSnapshot(irs.DiskSnapshotInfo{
+		IId:        irs.IID{NameId: "mock-snapshot-02"},
+		SourceDisk: irs.IID{NameId: "mock-not-exist-disk", SystemId: "mock-not-exist-disk"},
+	}); err == nil {
+		t.Error("CreateDiskSnapshot() of a not existing Disk should fail")
+	}
+
+	infoList, err := snapshotTestHandler.ListDiskSnapshot()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(infoList) != 1 {
+		t.Errorf("DiskSnapshot list has %d items, but expected 1", len(infoList))
+	}
+	if _, err := snapshotTestHandler.GetDiskSnapshot(snapshotInfo.IId); err != nil {
+		t.Error(err.Error())
+	}
+
+	// restore to a new Disk in another Zone with a larger size
+	restoredInfo, err := snapshotTestHandler.CreateDiskFromSnapshot(snapshotInfo.IId, irs.DiskInfo{
+		IId:      irs.IID{NameId: "mock-snapshot-disk-02"},
+		Zone:     "mock-zone-b",
+		DiskSize: "200",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if restoredInfo.Zone != "mock-zone-b" || restoredInfo.DiskSize != "200" || restoredInfo.DiskType != "HDD" {
+		t.Errorf("unexpected restored DiskInfo: %#v", restoredInfo)
+	}
+	if _, err := snapshotTestDiskHandler.GetDisk(restoredInfo.IId); err != nil {
+		t.Errorf("the restored Disk does not exist: %v", err)
+	}
+
+	if _, err := snapshotTestHandler.CreateDiskFromSnapshot(snapshotInfo.IId, irs.DiskInfo{
+		IId:      irs.IID{NameId: "mock-snapshot-disk-03"},
+		DiskSize: "50",
+	}); err == nil {
+		t.Error("CreateDiskFromSnapshot() with a smaller size than the DiskSnapshot should fail")
+	}
+
+	// delete
+	if _, err := snapshotTestHandler.DeleteDiskSnapshot(snapshotInfo.IId); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := snapshotTestHandler.GetDiskSnapshot(snapshotInfo.IId); err == nil {
+		t.Error("the DiskSnapshot remains after DeleteDiskSnapshot()")
+	}
+	if _, err := snapshotTestDiskHandler.GetDisk(restoredInfo.IId); err != nil {
+		t.Errorf("the restored Disk was deleted with the DiskSnapshot: %v", err)
+	}
+
+	snapshotTestDiskHandler.DeleteDisk(diskInfo.IId)
+	snapshotTestDiskHandler.DeleteDisk(restoredInfo.IId)
+}
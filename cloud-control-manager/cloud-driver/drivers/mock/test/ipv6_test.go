@@ -0,0 +1,110 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"net"
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+var ipv6VPCTestHandler irs.VPCHandler
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{MockName: "MockDriver-ipv6"},
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	ipv6VPCTestHandler, _ = cloudConn.CreateVPCHandler()
+}
+
+func TestDualStackVPC(t *testing.T) {
+	vpcInfo, err := ipv6VPCTestHandler.CreateVPC(irs.VPCReqInfo{
+		IId:        irs.IID{"mock-ipv6-vpc-01", ""},
+		IPv4_CIDR:  "10.0.0.0/16",
+		EnableIPv6: true,
+		SubnetInfoList: []irs.SubnetInfo{
+			{IId: irs.IID{"mock-ipv6-subnet-01", ""}, IPv4_CIDR: "10.0.1.0/24"},
+			{IId: irs.IID{"mock-ipv6-subnet-02", ""}, IPv4_CIDR: "10.0.2.0/24"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, vpcNet, err := net.ParseCIDR(vpcInfo.IPv6_CIDR)
+	if err != nil {
+		t.Fatalf("invalid VPC IPv6 CIDR: %s", vpcInfo.IPv6_CIDR)
+	}
+	if vpcInfo.SubnetInfoList[0].IPv6_CIDR == vpcInfo.SubnetInfoList[1].IPv6_CIDR {
+		t.Errorf("Subnets have the same IPv6 CIDR %s", vpcInfo.SubnetInfoList[0].IPv6_CIDR)
+	}
+	for _, subnetInfo := range vpcInfo.SubnetInfoList {
+		ip, _, err := net.ParseCIDR(subnetInfo.IPv6_CIDR)
+		if err != nil || !vpcNet.Contains(ip) {
+			t.Errorf("Subnet IPv6 CIDR %s is not in the VPC IPv6 CIDR %s", subnetInfo.IPv6_CIDR, vpcInfo.IPv6_CIDR)
+		}
+	}
+
+	// an IPv6 CIDR out of the VPC
+	_, err = ipv6VPCTestHandler.AddSubnet(vpcInfo.IId, irs.SubnetInfo{IId: irs.IID{"mock-ipv6-subnet-03", ""},
+		IPv4_CIDR: "10.0.3.0/24", IPv6_CIDR: "2001:db8::/64"})
+	if err == nil {
+		t.Error("AddSubnet() with an IPv6 CIDR out of the VPC should fail")
+	}
+
+	vpcInfo, err = ipv6VPCTestHandler.AddSubnet(vpcInfo.IId, irs.SubnetInfo{IId: irs.IID{"mock-ipv6-subnet-03", ""}, IPv4_CIDR: "10.0.3.0/24"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(vpcInfo.SubnetInfoList) != 3 || vpcInfo.SubnetInfoList[2].IPv6_CIDR == "" {
+		t.Errorf("unexpected Subnets: %#v", vpcInfo.SubnetInfoList)
+	}
+
+	if _, err := ipv6VPCTestHandler.DeleteVPC(vpcInfo.IId); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestIPv4OnlyVPC(t *testing.T) {
+	_, err := ipv6VPCTestHandler.CreateVPC(irs.VPCReqInfo{
+		IId:       irs.IID{"mock-ipv4-vpc-01", ""},
+		IPv4_CIDR: "10.0.0.0/16",
+		SubnetInfoList: []irs.SubnetInfo{
+			{IId: irs.IID{"mock-ipv4-subnet-01", ""}, IPv4_CIDR: "10.0.1.0/24", IPv6_CIDR: "fd00::/64"},
+		},
+	})
+	if err == nil {
+		t.Error("CreateVPC() with an IPv6 Subnet in an IPv4 only VPC should fail")
+	}
+
+	vpcInfo, err := ipv6VPCTestHandler.CreateVPC(irs.VPCReqInfo{
+		IId:            irs.IID{"mock-ipv4-vpc-01", ""},
+		IPv4_CIDR:      "10.0.0.0/16",
+		SubnetInfoList: []irs.SubnetInfo{{IId: irs.IID{"mock-ipv4-subnet-01", ""}, IPv4_CIDR: "10.0.1.0/24"}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if vpcInfo.IPv6_CIDR != "" || vpcInfo.SubnetInfoList[0].IPv6_CIDR != "" {
+		t.Errorf("IPv4 only VPC has an IPv6 CIDR: %#v", vpcInfo)
+	}
+	if _, err := ipv6VPCTestHandler.DeleteVPC(vpcInfo.IId); err != nil {
+		t.Error(err.Error())
+	}
+}
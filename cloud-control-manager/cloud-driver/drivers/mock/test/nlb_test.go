@@ -0,0 +1,143 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+var nlbTestHandler irs.NLBHandler
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{MockName: "MockDriver-nlb"},
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	nlbTestHandler, _ = cloudConn.CreateNLBHandler()
+}
+
+func TestNLBSingleListener(t *testing.T) {
+	nlbInfo, err := nlbTestHandler.CreateNLB(irs.NLBInfo{
+		IId:      irs.IID{NameId: "mock-nlb-single"},
+		VpcIID:   irs.IID{NameId: "mock-vpc-01"},
+		Listener: irs.ListenerInfo{Protocol: "TCP", Port: "80"},
+		VMGroup:  irs.VMGroupInfo{Protocol: "TCP", Port: "8080", VMs: &[]irs.IID{{NameId: "mock-vm-01"}}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(nlbInfo.ListenerList) != 1 || nlbInfo.ListenerList[0].Listener.Port != "80" || nlbInfo.ListenerList[0].VMGroup.Port != "8080" {
+		t.Errorf("unexpected ListenerList: %#v", nlbInfo.ListenerList)
+	}
+
+	if _, err := nlbTestHandler.RemoveListener(nlbInfo.IId, irs.ListenerInfo{Protocol: "TCP", Port: "80"}); err == nil {
+		t.Error("RemoveListener() of the last Listener should fail")
+	}
+
+	if _, err := nlbTestHandler.DeleteNLB(nlbInfo.IId); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestNLBMultiListener(t *testing.T) {
+	nlbInfo, err := nlbTestHandler.CreateNLB(irs.NLBInfo{
+		IId:     irs.IID{NameId: "mock-nlb-multi"},
+		VpcIID:  irs.IID{NameId: "mock-vpc-01"},
+		VMGroup: irs.VMGroupInfo{VMs: &[]irs.IID{{NameId: "mock-vm-01"}, {NameId: "mock-vm-02"}}},
+		ListenerList: []irs.ListenerBindingInfo{
+			{Listener: irs.ListenerInfo{Protocol: "TCP", Port: "80"}, VMGroup: irs.VMGroupInfo{Protocol: "TCP", Port: "8080"}},
+			{Listener: irs.ListenerInfo{Protocol: "TCP", Port: "443"}, VMGroup: irs.VMGroupInfo{Protocol: "TCP", Port: "8443"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if nlbInfo.Listener.Port != "80" || nlbInfo.VMGroup.Port != "8080" || len(nlbInfo.ListenerList) != 2 {
+		t.Fatalf("unexpected NLBInfo: %#v", nlbInfo)
+	}
+	if len(*nlbInfo.ListenerList[1].VMGroup.VMs) != 2 {
+		t.Errorf("Listener 443 has %d VMs, but expected 2", len(*nlbInfo.ListenerList[1].VMGroup.VMs))
+	}
+
+	// add and remove a Listener
+	binding := irs.ListenerBindingInfo{Listener: irs.ListenerInfo{Protocol: "UDP", Port: "53"}, VMGroup: irs.VMGroupInfo{Protocol: "UDP", Port: "5353"}}
+	nlbInfo, err = nlbTestHandler.AddListener(nlbInfo.IId, binding)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(nlbInfo.ListenerList) != 3 {
+		t.Errorf("NLB has %d Listeners, but expected 3", len(nlbInfo.ListenerList))
+	}
+	if _, err := nlbTestHandler.AddListener(nlbInfo.IId, binding); err == nil {
+		t.Error("AddListener() with a duplicated Listener should fail")
+	}
+
+	if _, err := nlbTestHandler.RemoveListener(nlbInfo.IId, irs.ListenerInfo{Protocol: "TCP", Port: "8080"}); err == nil {
+		t.Error("RemoveListener() of a not existing Listener should fail")
+	}
+
+	// remove the first Listener, then the next Listener becomes the first Listener
+	if _, err := nlbTestHandler.RemoveListener(nlbInfo.IId, irs.ListenerInfo{Protocol: "TCP", Port: "80"}); err != nil {
+		t.Fatal(err.Error())
+	}
+	nlbInfo, err = nlbTestHandler.GetNLB(nlbInfo.IId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if nlbInfo.Listener.Port != "443" || nlbInfo.VMGroup.Port != "8443" || len(nlbInfo.ListenerList) != 2 {
+		t.Errorf("unexpected NLBInfo after removing the first Listener: %#v", nlbInfo)
+	}
+
+	if _, err := nlbTestHandler.DeleteNLB(nlbInfo.IId); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestNLBHTTPHealthChecker(t *testing.T) {
+	healthChecker := irs.HealthCheckerInfo{Protocol: "HTTP", Port: "8080", Interval: 10, Timeout: 6, Threshold: 3,
+		HTTPPath: "/health", ExpectedStatusCodes: "200-299", HostHeader: "www.example.com"}
+	nlbInfo, err := nlbTestHandler.CreateNLB(irs.NLBInfo{
+		IId:           irs.IID{NameId: "mock-nlb-http"},
+		VpcIID:        irs.IID{NameId: "mock-vpc-01"},
+		Listener:      irs.ListenerInfo{Protocol: "TCP", Port: "80"},
+		VMGroup:       irs.VMGroupInfo{Protocol: "TCP", Port: "8080", VMs: &[]irs.IID{{NameId: "mock-vm-01"}}},
+		HealthChecker: healthChecker,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if nlbInfo.HealthChecker.HTTPPath != "/health" || nlbInfo.HealthChecker.ExpectedStatusCodes != "200-299" || nlbInfo.HealthChecker.HostHeader != "www.example.com" {
+		t.Errorf("unexpected HealthChecker: %#v", nlbInfo.HealthChecker)
+	}
+
+	healthChecker.HTTPPath = "/ready"
+	healthChecker.ExpectedStatusCodes = "200,302"
+	healthChecker.HostHeader = ""
+	changedInfo, err := nlbTestHandler.ChangeHealthCheckerInfo(nlbInfo.IId, healthChecker)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if changedInfo.HTTPPath != "/ready" || changedInfo.ExpectedStatusCodes != "200,302" || changedInfo.HostHeader != "" {
+		t.Errorf("unexpected changed HealthChecker: %#v", changedInfo)
+	}
+
+	if _, err := nlbTestHandler.DeleteNLB(nlbInfo.IId); err != nil {
+		t.Error(err.Error())
+	}
+}
@@ -64,7 +64,7 @@ func TestGetComputeInstancePriceInfo(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		jsonPriceInfo, err := handler.GetPriceInfo(productFamily, tc.regionName, tc.filterList)
+		jsonPriceInfo, err := handler.GetPriceInfo(productFamily, tc.regionName, tc.filterList, true)
 		if err != nil {
 			t.Errorf("GetPriceInfo returned an error: %v", err)
 		}
@@ -105,7 +105,7 @@ func TestGetStoragePriceInfo(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		jsonPriceInfo, err := handler.GetPriceInfo(productFamily, tc.regionName, tc.filterList)
+		jsonPriceInfo, err := handler.GetPriceInfo(productFamily, tc.regionName, tc.filterList, true)
 		if err != nil {
 			t.Errorf("GetPriceInfo returned an error: %v", err)
 		}
@@ -145,7 +145,7 @@ func TestGetNLBPriceInfo(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		jsonPriceInfo, err := handler.GetPriceInfo(productFamily, tc.regionName, tc.filterList)
+		jsonPriceInfo, err := handler.GetPriceInfo(productFamily, tc.regionName, tc.filterList, true)
 		if err != nil {
 			t.Errorf("GetPriceInfo returned an error: %v", err)
 		}
@@ -173,7 +173,7 @@ func countProductInJson(jsonPriceInfo string, t *testing.T) int {
 	}
 
 	// gojq query
-	query, err := gojq.Parse(".cloudPriceList[].priceList[].productInfo.productId")
+	query, err := gojq.Parse(".PriceList[].ProductInfo.ProductId")
 	if err != nil {
 		t.Fatalf("Error parsing query: %v", err)
 	}
@@ -0,0 +1,51 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	"encoding/json"
+	"testing"
+
+	mockres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock/resources"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func TestReservedAndSpotPriceInfo(t *testing.T) {
+	handler := &mockres.MockPriceInfoHandler{}
+
+	jsonPriceInfo, err := handler.GetPriceInfo(mockres.COMPUTE_INSTANCE, "mercury", nil, true)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var cloudPrice irs.CloudPrice
+	if err := json.Unmarshal([]byte(jsonPriceInfo), &cloudPrice); err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(cloudPrice.PriceList) == 0 {
+		t.Fatal("GetPriceInfo() returned no prices")
+	}
+
+	for _, price := range cloudPrice.PriceList {
+		if price.PriceInfo.OnDemand.Price == "" {
+			t.Errorf("%s has no OnDemand price", price.ProductInfo.ProductId)
+		}
+		if len(price.PriceInfo.Reserved) != 3 {
+			t.Errorf("%s has %d Reserved prices, but expected 3", price.ProductInfo.ProductId, len(price.PriceInfo.Reserved))
+		}
+		for _, reserved := range price.PriceInfo.Reserved {
+			if reserved.Term == "" || reserved.Price == "" {
+				t.Errorf("%s has an invalid Reserved price: %#v", price.ProductInfo.ProductId, reserved)
+			}
+		}
+		if len(price.PriceInfo.Spot) != 1 || price.PriceInfo.Spot[0].Price == "" {
+			t.Errorf("%s has invalid Spot prices: %#v", price.ProductInfo.ProductId, price.PriceInfo.Spot)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+var routeTableTestHandler irs.RouteTableHandler
+var natGatewayTestHandler irs.NATGatewayHandler
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{MockName: "MockDriver-routetable"},
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+
+	vpcHandler, _ := cloudConn.CreateVPCHandler()
+	vpcHandler.CreateVPC(irs.VPCReqInfo{
+		IId:       irs.IID{"mock-rt-vpc-01", ""},
+		IPv4_CIDR: "10.0.0.0/16",
+		SubnetInfoList: []irs.SubnetInfo{
+			{IId: irs.IID{"mock-rt-public-subnet", ""}, IPv4_CIDR: "10.0.1.0/24"},
+			{IId: irs.IID{"mock-rt-private-subnet", ""}, IPv4_CIDR: "10.0.2.0/24"},
+		},
+	})
+
+	routeTableTestHandler, _ = cloudConn.CreateRouteTableHandler()
+	natGatewayTestHandler, _ = cloudConn.CreateNATGatewayHandler()
+}
+
+func TestRouteTableWithNATGateway(t *testing.T) {
+	vpcIID := irs.IID{"mock-rt-vpc-01", "mock-rt-vpc-01"}
+	publicSubnetIID := irs.IID{"mock-rt-public-subnet", "mock-rt-public-subnet"}
+	privateSubnetIID := irs.IID{"mock-rt-private-subnet", "mock-rt-private-subnet"}
+
+	natInfo, err := natGatewayTestHandler.CreateNATGateway(irs.NATGatewayInfo{
+		IId: irs.IID{"mock-nat-01", ""}, VpcIID: vpcIID, SubnetIID: publicSubnetIID})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if natInfo.Status != irs.NATGatewayAvailable || natInfo.PublicIP == "" {
+		t.Errorf("unexpected NATGateway: %#v", natInfo)
+	}
+
+	rtInfo, err := routeTableTestHandler.CreateRouteTable(irs.RouteTableInfo{
+		IId: irs.IID{"mock-rt-01", ""}, VpcIID: vpcIID,
+		RouteList: []irs.RouteInfo{{DestinationCIDR: "0.0.0.0/0", TargetType: irs.RouteTargetNATGateway, TargetIID: natInfo.IId}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// the Local route and the NAT route
+	if len(rtInfo.RouteList) != 2 || rtInfo.RouteList[0].TargetType != irs.RouteTargetLocal {
+		t.Errorf("unexpected routes: %#v", rtInfo.RouteList)
+	}
+
+	// duplicated destination
+	_, err = routeTableTestHandler.AddRoutes(rtInfo.IId, []irs.RouteInfo{{DestinationCIDR: "0.0.0.0/0", TargetType: irs.RouteTargetInternetGateway}})
+	if err == nil {
+		t.Error("AddRoutes() with a duplicated destination should fail")
+	}
+	// unknown target
+	_, err = routeTableTestHandler.AddRoutes(rtInfo.IId, []irs.RouteInfo{{DestinationCIDR: "192.168.0.0/16", TargetType: irs.RouteTargetNATGateway,
+		TargetIID: irs.IID{"mock-nat-99", "mock-nat-99"}}})
+	if err == nil {
+		t.Error("AddRoutes() with an unknown NATGateway should fail")
+	}
+	rtInfo, err = routeTableTestHandler.AddRoutes(rtInfo.IId, []irs.RouteInfo{{DestinationCIDR: "192.168.0.0/16", TargetType: irs.RouteTargetInternetGateway}})
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(rtInfo.RouteList) != 3 {
+		t.Errorf("Route list size is %d, but expected 3", len(rtInfo.RouteList))
+	}
+
+	// the Local route can not be removed
+	if _, err := routeTableTestHandler.RemoveRoutes(rtInfo.IId, []irs.RouteInfo{{DestinationCIDR: "10.0.0.0/16"}}); err == nil {
+		t.Error("RemoveRoutes() of the Local route should fail")
+	}
+	if _, err := routeTableTestHandler.RemoveRoutes(rtInfo.IId, []irs.RouteInfo{{DestinationCIDR: "192.168.0.0/16"}}); err != nil {
+		t.Error(err.Error())
+	}
+
+	rtInfo, err = routeTableTestHandler.AssociateSubnet(rtInfo.IId, privateSubnetIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rtInfo.SubnetIIDs) != 1 {
+		t.Errorf("Subnet list size is %d, but expected 1", len(rtInfo.SubnetIIDs))
+	}
+
+	// a RouteTable with associated subnets can not be deleted
+	if _, err := routeTableTestHandler.DeleteRouteTable(rtInfo.IId); err == nil {
+		t.Error("DeleteRouteTable() with associated subnets should fail")
+	}
+	if _, err := routeTableTestHandler.DisassociateSubnet(rtInfo.IId, privateSubnetIID); err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := routeTableTestHandler.DeleteRouteTable(rtInfo.IId); err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := natGatewayTestHandler.DeleteNATGateway(natInfo.IId); err != nil {
+		t.Error(err.Error())
+	}
+
+	iidList, _ := natGatewayTestHandler.ListIID()
+	if len(iidList) != 0 {
+		t.Errorf("NATGateway list size is %d, but expected 0", len(iidList))
+	}
+}
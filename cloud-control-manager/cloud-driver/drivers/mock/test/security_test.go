@@ -198,3 +198,110 @@ func TestSecurityAddRules(t *testing.T) {
 	// pritn 0 Rule
 	// fmt.Printf("\n\t%#v\n", *info4.SecurityRules)
 }
+
+func TestSecurityReferenceRules(t *testing.T) {
+	// the SGs of TestSecurityAddRules
+	infoList, err := securityHandler.ListSecurity()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(infoList) < 2 {
+		t.Fatalf("The number of Infos is %d, but expected 2 or more.", len(infoList))
+	}
+	remoteIID := infoList[1].IId
+
+	//---- unknown remote SG
+	_, err = securityHandler.AddRules(infoList[0].IId, &[]irs.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "80", ToPort: "80", RemoteSecurityGroupIID: &irs.IID{"mock-sg-name99", "mock-sg-name99"}},
+	})
+	if err == nil {
+		t.Error("AddRules() with an unknown remote SecurityGroup should fail")
+	}
+
+	//---- same port with a CIDR and with a remote SG are different rules
+	SecurityRules := &[]irs.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "80", ToPort: "80", CIDR: "0.0.0.0/0"},
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "80", ToPort: "80", RemoteSecurityGroupIID: &remoteIID},
+	}
+	info, err := securityHandler.AddRules(infoList[0].IId, SecurityRules)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(*info.SecurityRules) != 2 {
+		t.Errorf("The number of Rules is not %d. It is %d.", 2, len(*info.SecurityRules))
+	}
+
+	result, err := securityHandler.RemoveRules(infoList[0].IId, &[]irs.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "80", ToPort: "80", RemoteSecurityGroupIID: &remoteIID},
+	})
+	if !result {
+		t.Error(err.Error())
+	}
+	info, err = securityHandler.GetSecurity(infoList[0].IId)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(*info.SecurityRules) != 1 || (*info.SecurityRules)[0].RemoteSecurityGroupIID != nil {
+		t.Errorf("unexpected rules: %#v", *info.SecurityRules)
+	}
+}
+
+func TestSecurityDenyAndPriorityRules(t *testing.T) {
+	// the SGs of TestSecurityAddRules
+	infoList, err := securityHandler.ListSecurity()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(infoList) < 3 {
+		t.Fatalf("The number of Infos is %d, but expected 3 or more.", len(infoList))
+	}
+	sgIID := infoList[2].IId
+
+	//---- an allow rule and a deny rule with the same ports are different rules
+	SecurityRules := &[]irs.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "3389", ToPort: "3389", CIDR: "0.0.0.0/0",
+			Priority: "200", Action: "deny", Description: "deny rdp"},
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "3389", ToPort: "3389", CIDR: "0.0.0.0/0",
+			Priority: "100", Description: "allow rdp"},
+	}
+	info, err := securityHandler.AddRules(sgIID, SecurityRules)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(*info.SecurityRules) != 3 {
+		t.Errorf("The number of Rules is not %d. It is %d.", 3, len(*info.SecurityRules))
+	}
+
+	//---- the same deny rule can not be added again, even with another Description
+	_, err = securityHandler.AddRules(sgIID, &[]irs.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "3389", ToPort: "3389", CIDR: "0.0.0.0/0", Action: "DENY", Description: "other"},
+	})
+	if err == nil {
+		t.Error("AddRules() with an existing deny rule should fail")
+	}
+
+	//---- a different Priority does not match
+	_, err = securityHandler.RemoveRules(sgIID, &[]irs.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "3389", ToPort: "3389", CIDR: "0.0.0.0/0", Priority: "300", Action: "deny"},
+	})
+	if err == nil {
+		t.Error("RemoveRules() with a different Priority should fail")
+	}
+
+	//---- without a Priority, the deny rule matches
+	result, err := securityHandler.RemoveRules(sgIID, &[]irs.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "3389", ToPort: "3389", CIDR: "0.0.0.0/0", Action: "deny"},
+	})
+	if !result {
+		t.Error(err.Error())
+	}
+	info, err = securityHandler.GetSecurity(sgIID)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	for _, rule := range *info.SecurityRules {
+		if rule.Action == "deny" {
+			t.Errorf("the deny rule is not removed: %#v", rule)
+		}
+	}
+}
@@ -13,12 +13,14 @@ import (
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
+	"context"
 	"testing"
 
 	cblog "github.com/cloud-barista/cb-log"
 )
 
 var vmHandler irs.VMHandler
+var vmAnyCallHandler irs.AnyCallHandler
 
 func init() {
 	// make the log level lower to print clearly
@@ -33,6 +35,7 @@ func init() {
 	}
 	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
 	vmHandler, _ = cloudConn.CreateVMHandler()
+	vmAnyCallHandler, _ = cloudConn.CreateAnyCallHandler()
 
 	imageHandler, _ := cloudConn.CreateImageHandler()
 	vmSpecHandler, _ := cloudConn.CreateVMSpecHandler()
@@ -354,3 +357,190 @@ func TestVMTerminateGet(t *testing.T) {
 	}
 
 }
+
+func TestStartVMWithUserData(t *testing.T) {
+
+	info := vmTestInfoList[0]
+	userData := "#!/bin/bash\necho 'hello, spider' > /tmp/hello.txt\n"
+
+	vmReqInfo := irs.VMReqInfo{
+		IId: irs.IID{info.IId, ""},
+
+		ImageIID:          irs.IID{info.ImageIID, ""},
+		VpcIID:            irs.IID{info.VpcIID, ""},
+		SubnetIID:         irs.IID{info.SubnetIID, ""},
+		SecurityGroupIIDs: []irs.IID{{info.SecurityGroupIIDs[0], ""}},
+
+		VMSpecName: info.VMSpecName,
+		KeyPairIID: irs.IID{info.KeyPairIID, ""},
+
+		UserData: userData,
+	}
+	_, err := vmHandler.StartVM(vmReqInfo)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	// check the UserData kept by Mock
+	vmInfo, err := vmHandler.GetVM(irs.IID{info.IId, ""})
+	if err != nil {
+		t.Error(err.Error())
+	}
+	found := false
+	for _, kv := range vmInfo.KeyValueList {
+		if kv.Key == "UserData" && kv.Value == userData {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("UserData of %s is not kept!!", info.IId)
+	}
+
+	_, err = vmHandler.TerminateVM(vmInfo.IId)
+	if err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestStartVMWithCanceledContext(t *testing.T) {
+	// Mock supports context natively, so no adapter is used.
+	ctxHandler, ok := vmHandler.(irs.VMHandlerWithContext)
+	if !ok {
+		t.Fatal("Mock VMHandler does not implement VMHandlerWithContext!")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	info := vmTestInfoList[0]
+	vmReqInfo := irs.VMReqInfo{
+		IId:               irs.IID{"mock-vm-canceled", ""},
+		ImageIID:          irs.IID{info.ImageIID, ""},
+		VMSpecName:        info.VMSpecName,
+		VpcIID:            irs.IID{info.VpcIID, ""},
+		SubnetIID:         irs.IID{info.SubnetIID, ""},
+		SecurityGroupIIDs: []irs.IID{{info.SecurityGroupIIDs[0], ""}},
+		KeyPairIID:        irs.IID{info.KeyPairIID, ""},
+	}
+	if _, err := ctxHandler.StartVMWithContext(ctx, vmReqInfo); err != context.Canceled {
+		t.Fatalf("StartVMWithContext() with canceled context: got %v, want %v", err, context.Canceled)
+	}
+
+	// the canceled request must not create a VM
+	if _, err := vmHandler.GetVM(irs.IID{"mock-vm-canceled", ""}); err == nil {
+		t.Error("mock-vm-canceled should not exist!")
+	}
+}
+
+func TestChangeVMSpec(t *testing.T) {
+
+	info := vmTestInfoList[0]
+	vmReqInfo := irs.VMReqInfo{
+		IId:               irs.IID{"mock-vm-resize", ""},
+		ImageIID:          irs.IID{info.ImageIID, ""},
+		VMSpecName:        info.VMSpecName,
+		VpcIID:            irs.IID{info.VpcIID, ""},
+		SubnetIID:         irs.IID{info.SubnetIID, ""},
+		SecurityGroupIIDs: []irs.IID{{info.SecurityGroupIIDs[0], ""}},
+		KeyPairIID:        irs.IID{info.KeyPairIID, ""},
+	}
+	vmInfo, err := vmHandler.StartVM(vmReqInfo)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// a running VM can not be resized
+	if _, err := vmHandler.ChangeVMSpec(vmInfo.IId, "mock-vmspec-02"); err == nil {
+		t.Error("ChangeVMSpec() of a running VM should fail")
+	}
+
+	if _, err := vmHandler.SuspendVM(vmInfo.IId); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := vmHandler.ChangeVMSpec(vmInfo.IId, "mock-vmspec-99"); err == nil {
+		t.Error("ChangeVMSpec() with an unknown VMSpec should fail")
+	}
+	vmInfo, err = vmHandler.ChangeVMSpec(vmInfo.IId, "mock-vmspec-02")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if vmInfo.VMSpecName != "mock-vmspec-02" {
+		t.Errorf("VMSpecName is %s, but expected mock-vmspec-02", vmInfo.VMSpecName)
+	}
+
+	if _, err := vmHandler.TerminateVM(vmInfo.IId); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestSpotVMInterruption(t *testing.T) {
+
+	info := vmTestInfoList[0]
+	for _, behavior := range []irs.InterruptionBehavior{irs.InterruptionTerminate, irs.InterruptionStop} {
+		vmReqInfo := irs.VMReqInfo{
+			IId:               irs.IID{"mock-vm-spot", ""},
+			ImageIID:          irs.IID{info.ImageIID, ""},
+			VMSpecName:        info.VMSpecName,
+			VpcIID:            irs.IID{info.VpcIID, ""},
+			SubnetIID:         irs.IID{info.SubnetIID, ""},
+			SecurityGroupIIDs: []irs.IID{{info.SecurityGroupIIDs[0], ""}},
+			KeyPairIID:        irs.IID{info.KeyPairIID, ""},
+			PurchaseOption:    irs.VMPurchaseOption{PurchaseType: irs.PurchaseSpot, MaxPrice: "0.05", InterruptionBehavior: behavior},
+		}
+		vmInfo, err := vmHandler.StartVM(vmReqInfo)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if vmInfo.PurchaseOption != vmReqInfo.PurchaseOption {
+			t.Errorf("PurchaseOption is %#v, but expected %#v", vmInfo.PurchaseOption, vmReqInfo.PurchaseOption)
+		}
+
+		callInfo, err := vmAnyCallHandler.AnyCall(irs.AnyCallInfo{FID: "interruptSpotVM",
+			IKeyValueList: []irs.KeyValue{{Key: "vmId", Value: vmInfo.IId.SystemId}}})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		expected := irs.Terminated
+		if behavior == irs.InterruptionStop {
+			expected = irs.Suspended
+		}
+		status, err := vmHandler.GetVMStatus(vmInfo.IId)
+		if err != nil {
+			t.Error(err.Error())
+		}
+		if status != expected || callInfo.OKeyValueList[0].Value != string(expected) {
+			t.Errorf("VM status after the interruption is %s, but expected %s", status, expected)
+		}
+
+		if _, err := vmHandler.TerminateVM(vmInfo.IId); err != nil {
+			t.Error(err.Error())
+		}
+	}
+
+	// an OnDemand VM can not be interrupted
+	onDemandInfo := vmTestInfoList[1]
+	vmInfo, err := vmHandler.StartVM(irs.VMReqInfo{
+		IId:               irs.IID{"mock-vm-ondemand", ""},
+		ImageIID:          irs.IID{onDemandInfo.ImageIID, ""},
+		VMSpecName:        onDemandInfo.VMSpecName,
+		VpcIID:            irs.IID{onDemandInfo.VpcIID, ""},
+		SubnetIID:         irs.IID{onDemandInfo.SubnetIID, ""},
+		SecurityGroupIIDs: []irs.IID{{onDemandInfo.SecurityGroupIIDs[0], ""}},
+		KeyPairIID:        irs.IID{onDemandInfo.KeyPairIID, ""},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if vmInfo.PurchaseOption.PurchaseType != irs.PurchaseOnDemand {
+		t.Errorf("PurchaseType is %s, but expected %s", vmInfo.PurchaseOption.PurchaseType, irs.PurchaseOnDemand)
+	}
+	_, err = vmAnyCallHandler.AnyCall(irs.AnyCallInfo{FID: "interruptSpotVM",
+		IKeyValueList: []irs.KeyValue{{Key: "vmId", Value: vmInfo.IId.SystemId}}})
+	if err == nil {
+		t.Error("interruptSpotVM of an OnDemand VM should fail")
+	}
+	if _, err := vmHandler.TerminateVM(vmInfo.IId); err != nil {
+		t.Error(err.Error())
+	}
+}
@@ -0,0 +1,171 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+var vNicTestHandler irs.VNicHandler
+var publicIPTestHandler irs.PublicIPHandler
+var vNicTestVMHandler irs.VMHandler
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-vnic", // separate name to avoid collision with the data of other tests
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{"default", "", ""},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	vNicTestHandler, _ = cloudConn.CreateVNicHandler()
+	publicIPTestHandler, _ = cloudConn.CreatePublicIPHandler()
+	vNicTestVMHandler, _ = cloudConn.CreateVMHandler()
+
+	imageHandler, _ := cloudConn.CreateImageHandler()
+	vmSpecHandler, _ := cloudConn.CreateVMSpecHandler()
+	vpcHandler, _ := cloudConn.CreateVPCHandler()
+	securityHandler, _ := cloudConn.CreateSecurityHandler()
+	keyPairHandler, _ := cloudConn.CreateKeyPairHandler()
+
+	imageHandler.CreateImage(irs.ImageReqInfo{IId: irs.IID{"mock-img-01", ""}})
+	vmSpecHandler.ListVMSpec()
+	vpcHandler.CreateVPC(irs.VPCReqInfo{
+		IId:            irs.IID{"mock-vnic-vpc", ""},
+		IPv4_CIDR:      "10.0.0.0/16",
+		SubnetInfoList: []irs.SubnetInfo{{IId: irs.IID{"mock-vnic-subnet", ""}, IPv4_CIDR: "10.0.1.0/24"}},
+	})
+	securityHandler.CreateSecurity(irs.SecurityReqInfo{
+		IId:           irs.IID{"mock-vnic-sg", ""},
+		VpcIID:        irs.IID{"mock-vnic-vpc", "mock-vnic-vpc"},
+		SecurityRules: &[]irs.SecurityRuleInfo{{FromPort: "22", ToPort: "22", IPProtocol: "tcp", Direction: "inbound"}},
+	})
+	keyPairHandler.CreateKey(irs.KeyPairReqInfo{IId: irs.IID{"mock-vnic-keypair", ""}})
+	vNicTestVMHandler.StartVM(irs.VMReqInfo{
+		IId:               irs.IID{"mock-vnic-vm", ""},
+		ImageType:         irs.PublicImage,
+		ImageIID:          irs.IID{"mock-img-01", ""},
+		VpcIID:            irs.IID{"mock-vnic-vpc", "mock-vnic-vpc"},
+		SubnetIID:         irs.IID{"mock-vnic-subnet", "mock-vnic-subnet"},
+		SecurityGroupIIDs: []irs.IID{{"mock-vnic-sg", "mock-vnic-sg"}},
+		VMSpecName:        "mock-vmspec-01",
+		KeyPairIID:        irs.IID{"mock-vnic-keypair", ""},
+	})
+}
+
+func TestVNicCreateAttachDelete(t *testing.T) {
+	vm := irs.IID{"mock-vnic-vm", "mock-vnic-vm"}
+
+	// create
+	info, err := vNicTestHandler.CreateVNic(irs.VNicInfo{
+		IId:               irs.IID{"mock-vnic-01", ""},
+		VpcIID:            irs.IID{"mock-vnic-vpc", "mock-vnic-vpc"},
+		SubnetIID:         irs.IID{"mock-vnic-subnet", "mock-vnic-subnet"},
+		SecurityGroupIIDs: []irs.IID{{"mock-vnic-sg", "mock-vnic-sg"}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Status != irs.VNicAvailable || info.PrivateIP == "" || info.MacAddress == "" {
+		t.Errorf("unexpected VNic: %#v", info)
+	}
+
+	// a VNic in an unknown subnet
+	_, err = vNicTestHandler.CreateVNic(irs.VNicInfo{
+		IId:       irs.IID{"mock-vnic-02", ""},
+		VpcIID:    irs.IID{"mock-vnic-vpc", "mock-vnic-vpc"},
+		SubnetIID: irs.IID{"no-such-subnet", "no-such-subnet"},
+	})
+	if err == nil {
+		t.Error("CreateVNic() with an unknown subnet should fail")
+	}
+
+	// attach
+	info, err = vNicTestHandler.AttachVNic(info.IId, vm)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Status != irs.VNicAttached || info.OwnerVM.NameId != vm.NameId {
+		t.Errorf("VNic is not attached: %#v", info)
+	}
+
+	// an attached VNic can not be deleted
+	if _, err := vNicTestHandler.DeleteVNic(info.IId); err == nil {
+		t.Error("DeleteVNic() of an attached VNic should fail")
+	}
+
+	// detach and delete
+	if _, err := vNicTestHandler.DetachVNic(info.IId, vm); err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := vNicTestHandler.DeleteVNic(info.IId); err != nil {
+		t.Error(err.Error())
+	}
+	infoList, err := vNicTestHandler.ListVNic()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(infoList) != 0 {
+		t.Errorf("VNic list size is %d, but expected 0", len(infoList))
+	}
+}
+
+func TestPublicIPCreateAttachDelete(t *testing.T) {
+	vm := irs.IID{"mock-vnic-vm", "mock-vnic-vm"}
+
+	// create
+	info, err := publicIPTestHandler.CreatePublicIP(irs.PublicIPInfo{IId: irs.IID{"mock-publicip-01", ""}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Status != irs.PublicIPAvailable || info.PublicIP == "" {
+		t.Errorf("unexpected PublicIP: %#v", info)
+	}
+
+	// attach: the VM uses the PublicIP
+	info, err = publicIPTestHandler.AttachPublicIP(info.IId, vm)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	vmInfo, err := vNicTestVMHandler.GetVM(vm)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if vmInfo.PublicIP != info.PublicIP {
+		t.Errorf("VM PublicIP is %s, but expected %s", vmInfo.PublicIP, info.PublicIP)
+	}
+
+	// attach to an unknown VM
+	if _, err := publicIPTestHandler.AttachPublicIP(info.IId, irs.IID{"no-such-vm", "no-such-vm"}); err == nil {
+		t.Error("AttachPublicIP() to an unknown VM should fail")
+	}
+
+	// detach: the VM uses its ephemeral PublicIP again
+	if _, err := publicIPTestHandler.DetachPublicIP(info.IId, vm); err != nil {
+		t.Error(err.Error())
+	}
+	vmInfo, _ = vNicTestVMHandler.GetVM(vm)
+	if vmInfo.PublicIP == info.PublicIP {
+		t.Errorf("VM PublicIP is still %s after detaching", vmInfo.PublicIP)
+	}
+
+	if _, err := publicIPTestHandler.DeletePublicIP(info.IId); err != nil {
+		t.Error(err.Error())
+	}
+}
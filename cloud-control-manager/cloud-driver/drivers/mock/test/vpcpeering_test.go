@@ -0,0 +1,153 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+// two mock accounts to test the peering across connections
+var peeringTestHandler irs.VPCPeeringHandler
+var peerPeeringTestHandler irs.VPCPeeringHandler
+
+func newPeeringTestHandler(mockName string, vpcList []irs.VPCReqInfo) irs.VPCPeeringHandler {
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{MockName: mockName},
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	vpcHandler, _ := cloudConn.CreateVPCHandler()
+	for _, vpcReqInfo := range vpcList {
+		vpcHandler.CreateVPC(vpcReqInfo)
+	}
+	handler, _ := cloudConn.CreateVPCPeeringHandler()
+	return handler
+}
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	peeringTestHandler = newPeeringTestHandler("MockDriver-peering-01", []irs.VPCReqInfo{
+		{IId: irs.IID{"mock-peering-vpc-01", ""}, IPv4_CIDR: "10.0.0.0/16"},
+		{IId: irs.IID{"mock-peering-vpc-02", ""}, IPv4_CIDR: "10.1.0.0/16"},
+		{IId: irs.IID{"mock-peering-vpc-03", ""}, IPv4_CIDR: "10.0.1.0/24"},
+	})
+	peerPeeringTestHandler = newPeeringTestHandler("MockDriver-peering-02", []irs.VPCReqInfo{
+		{IId: irs.IID{"mock-peering-vpc-11", ""}, IPv4_CIDR: "10.2.0.0/16"},
+	})
+}
+
+func TestVPCPeeringInConnection(t *testing.T) {
+	vpc01 := irs.IID{"mock-peering-vpc-01", "mock-peering-vpc-01"}
+	vpc02 := irs.IID{"mock-peering-vpc-02", "mock-peering-vpc-02"}
+	vpc03 := irs.IID{"mock-peering-vpc-03", "mock-peering-vpc-03"}
+
+	// overlapped CIDRs
+	_, err := peeringTestHandler.RequestVPCPeering(irs.VPCPeeringInfo{
+		IId: irs.IID{"mock-peering-00", ""}, RequesterVpcIID: vpc01, AccepterVpcIID: vpc03})
+	if err == nil {
+		t.Error("RequestVPCPeering() with overlapped CIDRs should fail")
+	}
+
+	info, err := peeringTestHandler.RequestVPCPeering(irs.VPCPeeringInfo{
+		IId: irs.IID{"mock-peering-01", ""}, RequesterVpcIID: vpc01, AccepterVpcIID: vpc02})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Status != irs.VPCPeeringPendingAcceptance {
+		t.Errorf("Status is %s, but expected %s", info.Status, irs.VPCPeeringPendingAcceptance)
+	}
+
+	// routes can not be propagated before the acceptance
+	if _, err := peeringTestHandler.PropagateRoutes(info.IId, vpc01); err == nil {
+		t.Error("PropagateRoutes() of a pending VPCPeering should fail")
+	}
+
+	info, err = peeringTestHandler.AcceptVPCPeering(info.IId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Status != irs.VPCPeeringActive {
+		t.Errorf("Status is %s, but expected %s", info.Status, irs.VPCPeeringActive)
+	}
+
+	peeringTestHandler.PropagateRoutes(info.IId, vpc01)
+	info, err = peeringTestHandler.PropagateRoutes(info.IId, vpc02)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if !info.RequesterRoutePropagated || !info.AccepterRoutePropagated {
+		t.Errorf("routes are not propagated: %#v", info)
+	}
+
+	if _, err := peeringTestHandler.DeleteVPCPeering(info.IId); err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := peeringTestHandler.GetVPCPeering(info.IId); err == nil {
+		t.Error("GetVPCPeering() of a deleted VPCPeering should fail")
+	}
+}
+
+func TestVPCPeeringAcrossConnections(t *testing.T) {
+	vpc01 := irs.IID{"mock-peering-vpc-01", "mock-peering-vpc-01"}
+	vpc11 := irs.IID{"mock-peering-vpc-11", "mock-peering-vpc-11"}
+
+	peerAccountId, err := peerPeeringTestHandler.GetOwnerAccountId()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	info, err := peeringTestHandler.RequestVPCPeering(irs.VPCPeeringInfo{
+		IId: irs.IID{"mock-peering-11", ""}, RequesterVpcIID: vpc01, AccepterVpcIID: vpc11, AccepterAccountId: peerAccountId})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// only the accepter can accept
+	if _, err := peeringTestHandler.AcceptVPCPeering(info.IId); err == nil {
+		t.Error("AcceptVPCPeering() by the requester should fail")
+	}
+	if _, err := peerPeeringTestHandler.AcceptVPCPeering(info.IId); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// both sides see the peering
+	for _, handler := range []irs.VPCPeeringHandler{peeringTestHandler, peerPeeringTestHandler} {
+		infoList, err := handler.ListVPCPeering()
+		if err != nil {
+			t.Error(err.Error())
+		}
+		if len(infoList) != 1 || infoList[0].Status != irs.VPCPeeringActive {
+			t.Errorf("unexpected VPCPeering list: %#v", infoList)
+		}
+	}
+
+	// each side propagates the routes of its VPC
+	if _, err := peerPeeringTestHandler.PropagateRoutes(info.IId, vpc01); err == nil {
+		t.Error("PropagateRoutes() with the VPC of the other connection should fail")
+	}
+	if _, err := peerPeeringTestHandler.PropagateRoutes(info.IId, vpc11); err != nil {
+		t.Error(err.Error())
+	}
+
+	if _, err := peerPeeringTestHandler.DeleteVPCPeering(info.IId); err != nil {
+		t.Error(err.Error())
+	}
+	infoList, _ := peeringTestHandler.ListVPCPeering()
+	if len(infoList) != 0 {
+		t.Errorf("VPCPeering list size is %d, but expected 0", len(infoList))
+	}
+}
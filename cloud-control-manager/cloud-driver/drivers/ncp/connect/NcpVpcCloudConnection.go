@@ -183,3 +183,27 @@ func (cloudConn *NcpVpcCloudConnection) CreateFileSystemHandler() (irs.FileSyste
 	cblogger.Info("NCP VPC Cloud Driver: called CreateFileSystemHandler()!")
 	return nil, fmt.Errorf("NCP VPC Cloud Driver: CreateFileSystemHandler is not implemented")
 }
+
+func (cloudConn *NcpVpcCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return nil, fmt.Errorf("NCP VPC Cloud Driver does not support VNicHandler yet.")
+}
+
+func (cloudConn *NcpVpcCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	return nil, fmt.Errorf("NCP VPC Cloud Driver does not support PublicIPHandler yet.")
+}
+
+func (cloudConn *NcpVpcCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, fmt.Errorf("NCP VPC Cloud Driver does not support VPCPeeringHandler yet.")
+}
+
+func (cloudConn *NcpVpcCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, fmt.Errorf("NCP VPC Cloud Driver does not support RouteTableHandler yet.")
+}
+
+func (cloudConn *NcpVpcCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, fmt.Errorf("NCP VPC Cloud Driver does not support NATGatewayHandler yet.")
+}
+
+func (cloudConn *NcpVpcCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, fmt.Errorf("NCP VPC Cloud Driver does not support DiskSnapshotHandler yet.")
+}
@@ -387,6 +387,10 @@ func (diskHandler *NcpVpcDiskHandler) ChangeDiskSize(diskIID irs.IID, size strin
 	return true, nil
 }
 
+func (diskHandler *NcpVpcDiskHandler) ChangeDiskType(diskIID irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	return irs.DiskInfo{}, fmt.Errorf("NCP VPC Cloud Driver does not support ChangeDiskType yet.")
+}
+
 func (diskHandler *NcpVpcDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 	cblogger.Info("NCP VPC Driver: called DeleteDisk()")
 	InitLog()
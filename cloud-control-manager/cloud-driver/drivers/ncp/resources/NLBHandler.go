@@ -1610,6 +1610,14 @@ func (nlbHandler *NcpVpcNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.
 	return irs.ListenerInfo{}, fmt.Errorf("Does not support yet!!")
 }
 
+func (nlbHandler *NcpVpcNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	return irs.NLBInfo{}, fmt.Errorf("NCP VPC Cloud Driver does not support AddListener yet.")
+}
+
+func (nlbHandler *NcpVpcNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	return false, fmt.Errorf("NCP VPC Cloud Driver does not support RemoveListener yet.")
+}
+
 // ------ Backend Control
 func (nlbHandler *NcpVpcNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup irs.VMGroupInfo) (irs.VMGroupInfo, error) {
 
@@ -150,7 +150,7 @@ func (vmHandler *NcpVpcVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 			}
 		} else {
 			var createErr error
-			initScriptNo, createErr = vmHandler.createLinuxInitScript(vmReqInfo.ImageIID, keyPairId)
+			initScriptNo, createErr = vmHandler.createLinuxInitScript(vmReqInfo.ImageIID, keyPairId, vmReqInfo.UserData)
 			if createErr != nil {
 				newErr := fmt.Errorf("Failed to Create Cloud-Init Script with the KeyPairId : [%v]", createErr)
 				cblogger.Error(newErr.Error())
@@ -258,7 +258,7 @@ func (vmHandler *NcpVpcVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 			}
 		} else {
 			var createErr error
-			initScriptNo, createErr = vmHandler.createLinuxInitScript(vmReqInfo.ImageIID, keyPairId)
+			initScriptNo, createErr = vmHandler.createLinuxInitScript(vmReqInfo.ImageIID, keyPairId, vmReqInfo.UserData)
 			if createErr != nil {
 				newErr := fmt.Errorf("Failed to Create Cloud-Init Script with the KeyPairId : [%v]", createErr)
 				cblogger.Error(newErr.Error())
@@ -809,6 +809,10 @@ func (vmHandler *NcpVpcVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, erro
 	}
 }
 
+func (vmHandler *NcpVpcVMHandler) ChangeVMSpec(vmIID irs.IID, specName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, errors.New("NCP VPC Cloud Driver does not support ChangeVMSpec yet.")
+}
+
 /*
 # NCP serverInstanceStatusName
 init
@@ -1182,7 +1186,7 @@ func (vmHandler *NcpVpcVMHandler) mappingVMInfo(NcpInstance *vserver.ServerInsta
 	return vmInfo, nil
 }
 
-func (vmHandler *NcpVpcVMHandler) createLinuxInitScript(imageIID irs.IID, keyPairId string) (*string, error) {
+func (vmHandler *NcpVpcVMHandler) createLinuxInitScript(imageIID irs.IID, keyPairId string, userData string) (*string, error) {
 	cblogger.Info("NCP VPC Cloud driver: called createLinuxInitScript()!!")
 
 	var originImagePlatform string
@@ -1287,6 +1291,19 @@ func (vmHandler *NcpVpcVMHandler) createLinuxInitScript(imageIID irs.IID, keyPai
 	cmdString = strings.ReplaceAll(cmdString, "{{public_key}}", keyValue.Value)
 	// cblogger.Info("cmdString : ", cmdString)
 
+	// Append user's user-data after cb-user setup
+	// Caution!!) NCP Init Script is not a cloud-init data, so only a script('#!...') is supported.
+	if userData != "" {
+		if keycommon.GetUserDataContentType(userData) != "text/x-shellscript" {
+			newErr := fmt.Errorf("NCP supports only a script starting with '#!' as UserData, not a cloud-config!!")
+			cblogger.Error(newErr.Error())
+			return nil, newErr
+		}
+		cmdString += "\n# User Data\n" +
+			"cat > /tmp/cb-user-data.sh <<'CB_SPIDER_USER_DATA'\n" + userData + "\nCB_SPIDER_USER_DATA\n" +
+			"chmod +x /tmp/cb-user-data.sh && /tmp/cb-user-data.sh\n"
+	}
+
 	// Create Cloud-Init Script
 	// LnxTypeOs string = "LNX" // LNX (LINUX)
 	// WinTypeOS string = "WND" // WND (WINDOWS)
@@ -36,7 +36,7 @@ func init() {
 	if err != nil {
 		panic("failed to connect database")
 	}
-	db.AutoMigrate(&SecurityGroupInfo{})
+	infostore.AutoMigrate(db, &SecurityGroupInfo{})
 	infostore.Close(db)
 }
 
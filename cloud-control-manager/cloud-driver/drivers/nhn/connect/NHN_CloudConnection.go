@@ -166,3 +166,27 @@ func (cloudConn *NhnCloudConnection) Close() error {
 func (cloudConn *NhnCloudConnection) CreateTagHandler() (irs.TagHandler, error) {
 	return nil, errors.New("NHN Cloud Driver: not implemented")
 }
+
+func (cloudConn *NhnCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return nil, fmt.Errorf("NHN Cloud Driver does not support VNicHandler yet.")
+}
+
+func (cloudConn *NhnCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	return nil, fmt.Errorf("NHN Cloud Driver does not support PublicIPHandler yet.")
+}
+
+func (cloudConn *NhnCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, fmt.Errorf("NHN Cloud Driver does not support VPCPeeringHandler yet.")
+}
+
+func (cloudConn *NhnCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, fmt.Errorf("NHN Cloud Driver does not support RouteTableHandler yet.")
+}
+
+func (cloudConn *NhnCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, fmt.Errorf("NHN Cloud Driver does not support NATGatewayHandler yet.")
+}
+
+func (cloudConn *NhnCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, fmt.Errorf("NHN Cloud Driver does not support DiskSnapshotHandler yet.")
+}
@@ -287,6 +287,10 @@ func (diskHandler *NhnCloudDiskHandler) ChangeDiskSize(diskIID irs.IID, newDiskS
 	return true, nil
 }
 
+func (diskHandler *NhnCloudDiskHandler) ChangeDiskType(diskIID irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	return irs.DiskInfo{}, errors.New("NHN Cloud Driver does not support ChangeDiskType yet.")
+}
+
 func (diskHandler *NhnCloudDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 	cblogger.Info("NHN Cloud Driver: called DeleteDisk()")
 	callLogInfo := getCallLogScheme(diskHandler.RegionInfo.Region, call.DISK, "DeleteDisk()", "DeleteDisk()")
@@ -947,6 +947,14 @@ func (nlbHandler *NhnCloudNLBHandler) ChangeListener(nlbIID irs.IID, listenerInf
 	return updated, nil
 }
 
+func (nlbHandler *NhnCloudNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	return irs.NLBInfo{}, errors.New("NHN Cloud Driver does not support AddListener yet.")
+}
+
+func (nlbHandler *NhnCloudNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	return false, errors.New("NHN Cloud Driver does not support RemoveListener yet.")
+}
+
 func (nlbHandler *NhnCloudNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, healthChecker irs.HealthCheckerInfo) (irs.HealthCheckerInfo, error) {
 	rawLB, err := nlbHandler.getRawNLB(nlbIID)
 	if err != nil {
@@ -41,6 +41,7 @@ import (
 	//	images "github.com/cloud-barista/nhncloud-sdk-go/openstack/imageservice/v2/images" // imageservice/v2/images : For Visibility parameter
 
 	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
@@ -285,6 +286,16 @@ func (vmHandler *NhnCloudVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo
 	}
 	// cblogger.Infof("init UserData : [%s]", *initUserData)
 
+	// Append user's user-data(script or cloud-config) after cb-user setup
+	mergedUserData := cdcom.MergeUserData(*initUserData, vmReqInfo.UserData)
+	sizeErr := cdcom.ValidateUserDataSize("NHN", mergedUserData)
+	if sizeErr != nil {
+		cblogger.Error(sizeErr.Error())
+		LoggingError(callLogInfo, sizeErr)
+		return irs.VMInfo{}, sizeErr
+	}
+	initUserData = &mergedUserData
+
 	// Preparing VM Creation Options
 	serverCreateOpts := servers.CreateOpts{
 		Name:           vmReqInfo.IId.NameId,
@@ -749,6 +760,10 @@ func (vmHandler *NhnCloudVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, er
 	return irs.Terminating, nil
 }
 
+func (vmHandler *NhnCloudVMHandler) ChangeVMSpec(vmIID irs.IID, specName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, errors.New("NHN Cloud Driver does not support ChangeVMSpec yet.")
+}
+
 func (vmHandler *NhnCloudVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	cblogger.Info("NHN Cloud Driver: called ListVMStatus()")
 	callLogInfo := getCallLogScheme(vmHandler.RegionInfo.Region, call.VM, "ListVMStatus()", "ListVMStatus()")
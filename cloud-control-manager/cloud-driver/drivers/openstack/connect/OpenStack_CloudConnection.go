@@ -203,3 +203,27 @@ func (cloudConn *OpenStackCloudConnection) CreateFileSystemHandler() (irs.FileSy
 	}
 	return &fileSystemHandler, nil
 }
+
+func (cloudConn *OpenStackCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return nil, errors.New("OpenStack Driver does not support VNicHandler yet.")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	return nil, errors.New("OpenStack Driver does not support PublicIPHandler yet.")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("OpenStack Driver does not support VPCPeeringHandler yet.")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("OpenStack Driver does not support RouteTableHandler yet.")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("OpenStack Driver does not support NATGatewayHandler yet.")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("OpenStack Driver does not support DiskSnapshotHandler yet.")
+}
@@ -148,6 +148,10 @@ func (diskHandler *OpenstackDiskHandler) ChangeDiskSize(diskIID irs.IID, size st
 	return true, nil
 }
 
+func (diskHandler *OpenstackDiskHandler) ChangeDiskType(diskIID irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	return irs.DiskInfo{}, errors.New("OpenStack Driver does not support ChangeDiskType yet.")
+}
+
 func (diskHandler *OpenstackDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 	hiscallInfo := GetCallLogScheme(diskHandler.CredentialInfo.IdentityEndpoint, call.DISK, diskIID.NameId, "DeleteDisk()")
 	start := call.Start()
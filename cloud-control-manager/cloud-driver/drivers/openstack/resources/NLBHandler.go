@@ -343,6 +343,14 @@ func (nlbHandler *OpenStackNLBHandler) ChangeListener(nlbIID irs.IID, listener i
 	return info, nil
 }
 
+func (nlbHandler *OpenStackNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	return irs.NLBInfo{}, errors.New("OpenStack Driver does not support AddListener yet.")
+}
+
+func (nlbHandler *OpenStackNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	return false, errors.New("OpenStack Driver does not support RemoveListener yet.")
+}
+
 // ------ Backend Control
 func (nlbHandler *OpenStackNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup irs.VMGroupInfo) (irs.VMGroupInfo, error) {
 	hiscallInfo := GetCallLogScheme(nlbHandler.Region.Region, "NETWORKLOADBALANCE", nlbIID.NameId, "ChangeVMGroupInfo()")
@@ -451,6 +451,10 @@ func (vmHandler *OpenStackVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, e
 	return irs.Terminated, nil
 }
 
+func (vmHandler *OpenStackVMHandler) ChangeVMSpec(vmIID irs.IID, specName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, errors.New("OpenStack Driver does not support ChangeVMSpec yet.")
+}
+
 func (vmHandler *OpenStackVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	// log HisCall
 	hiscallInfo := GetCallLogScheme(vmHandler.ComputeClient.IdentityEndpoint, call.VM, VM, "ListVMStatus()")
@@ -1131,7 +1135,7 @@ func checkWindowVMReqInfo(vmReqInfo irs.VMReqInfo) error {
 	return nil
 }
 
-func linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt servers.CreateOpts, keyPairIID irs.IID, computeClient *gophercloud.ServiceClient) (keypairs.CreateOptsExt, error) {
+func linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt servers.CreateOpts, keyPairIID irs.IID, userData string, computeClient *gophercloud.ServiceClient) (keypairs.CreateOptsExt, error) {
 	keyPair, err := GetRawKey(computeClient, keyPairIID)
 	if err != nil {
 		return keypairs.CreateOptsExt{}, err
@@ -1144,6 +1148,12 @@ func linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt servers.Creat
 	fileStr := string(fileData)
 	fileStr = strings.ReplaceAll(fileStr, "{{username}}", SSHDefaultUser)
 	fileStr = strings.ReplaceAll(fileStr, "{{public_key}}", keyPair.PublicKey)
+	// append user's user-data(script or cloud-config) after cb-user setup
+	fileStr = cdcom.MergeUserData(fileStr, userData)
+	err = cdcom.ValidateUserDataSize("OPENSTACK", fileStr)
+	if err != nil {
+		return keypairs.CreateOptsExt{}, err
+	}
 
 	baseServerCreateOpt.UserData = []byte(fileStr)
 	createOptsExt := keypairs.CreateOptsExt{
@@ -1191,7 +1201,7 @@ func severCreatePublicImageLinuxOS(baseServerCreateOpt servers.CreateOpts, vmReq
 			rootBlockDeviceSet,
 		}
 		// Linux
-		createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, computeClient)
+		createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, vmReqInfo.UserData, computeClient)
 		if err != nil {
 			return servers.Server{}, err
 		}
@@ -1207,6 +1217,9 @@ func severCreatePublicImageLinuxOS(baseServerCreateOpt servers.CreateOpts, vmReq
 	} else {
 		// Disk Size 변경 X
 		if VolumeClient == nil { // Disk Size 변경 X && VolumeClient == nil
+			if vmReqInfo.UserData != "" {
+				baseServerCreateOpt.UserData = []byte(vmReqInfo.UserData)
+			}
 			server, err := servers.Create(computeClient, baseServerCreateOpt).Extract()
 			if err != nil {
 				return servers.Server{}, err
@@ -1224,7 +1237,7 @@ func severCreatePublicImageLinuxOS(baseServerCreateOpt servers.CreateOpts, vmReq
 			blockDeviceSet := []bootfromvolume.BlockDevice{
 				rootBlockDeviceSet,
 			}
-			createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, computeClient)
+			createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, vmReqInfo.UserData, computeClient)
 			if err != nil {
 				return servers.Server{}, err
 			}
@@ -1357,7 +1370,7 @@ func severCreateMyImageLinuxOS(baseServerCreateOpt servers.CreateOpts, vmReqInfo
 		return servers.Server{}, errors.New(fmt.Sprintf("Failed to startVM err = this Openstack cannot provide VolumeClient. BlockDevice information is located within the snapshot."))
 	}
 
-	createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, computeClient)
+	createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, vmReqInfo.UserData, computeClient)
 	server, err := servers.Create(computeClient, createOptsExt).Extract()
 	if err != nil {
 		return servers.Server{}, err
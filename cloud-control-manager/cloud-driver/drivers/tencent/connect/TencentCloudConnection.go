@@ -170,3 +170,27 @@ func (cloudConn *TencentCloudConnection) CreateTagHandler() (irs.TagHandler, err
 	}
 	return &handler, nil
 }
+
+func (cloudConn *TencentCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return nil, errors.New("Tencent Driver does not support VNicHandler yet.")
+}
+
+func (cloudConn *TencentCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	return nil, errors.New("Tencent Driver does not support PublicIPHandler yet.")
+}
+
+func (cloudConn *TencentCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("Tencent Driver does not support VPCPeeringHandler yet.")
+}
+
+func (cloudConn *TencentCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("Tencent Driver does not support RouteTableHandler yet.")
+}
+
+func (cloudConn *TencentCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("Tencent Driver does not support NATGatewayHandler yet.")
+}
+
+func (cloudConn *TencentCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("Tencent Driver does not support DiskSnapshotHandler yet.")
+}
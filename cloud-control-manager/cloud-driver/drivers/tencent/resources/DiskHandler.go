@@ -186,6 +186,10 @@ func (DiskHandler *TencentDiskHandler) ChangeDiskSize(diskIID irs.IID, size stri
 	return true, nil
 }
 
+func (DiskHandler *TencentDiskHandler) ChangeDiskType(diskIID irs.IID, diskType string, iops string, throughput string) (irs.DiskInfo, error) {
+	return irs.DiskInfo{}, errors.New("Tencent Driver does not support ChangeDiskType yet.")
+}
+
 func (DiskHandler *TencentDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 	hiscallInfo := GetCallLogScheme(DiskHandler.Region, call.DISK, diskIID.NameId, "DeleteDisk()")
 	start := call.Start()
@@ -421,6 +421,14 @@ func (NLBHandler *TencentNLBHandler) ChangeListener(nlbIID irs.IID, listener irs
 	return irs.ListenerInfo{}, errors.New("TENCENT_CANNOT_CHANGE_LISTENER")
 }
 
+func (NLBHandler *TencentNLBHandler) AddListener(nlbIID irs.IID, listenerBinding irs.ListenerBindingInfo) (irs.NLBInfo, error) {
+	return irs.NLBInfo{}, errors.New("Tencent Driver does not support AddListener yet.")
+}
+
+func (NLBHandler *TencentNLBHandler) RemoveListener(nlbIID irs.IID, listener irs.ListenerInfo) (bool, error) {
+	return false, errors.New("Tencent Driver does not support RemoveListener yet.")
+}
+
 func (NLBHandler *TencentNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup irs.VMGroupInfo) (irs.VMGroupInfo, error) {
 
 	vmGroupInfo, vmGroupInfoErr := NLBHandler.ExtractVMGroupInfo(nlbIID)
@@ -691,7 +699,6 @@ func (NLBHandler *TencentNLBHandler) GetVMGroupHealthInfo(nlbIID irs.IID) (irs.H
 }
 
 func (NLBHandler *TencentNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, healthChecker irs.HealthCheckerInfo) (irs.HealthCheckerInfo, error) {
-
 	newNLBId := nlbIID.SystemId
 
 	// logger for HisCall
@@ -372,6 +372,13 @@ func (vmHandler *TencentVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 	userData := string(fileDataCloudInit)
 	//userData = strings.ReplaceAll(userData, "{{username}}", CBDefaultVmUserName)
 	//userData = strings.ReplaceAll(userData, "{{public_key}}", keyPairInfo.PublicKey)
+	// append user's user-data(script or cloud-config) after cb-user setup
+	userData = cdcom.MergeUserData(userData, vmReqInfo.UserData)
+	err = cdcom.ValidateUserDataSize("TENCENT", userData)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
 	userDataBase64 := base64.StdEncoding.EncodeToString([]byte(userData))
 	cblogger.Debugf("cloud-init data : [%s]", userDataBase64)
 	request.UserData = common.StringPtr(userDataBase64)
@@ -623,6 +630,10 @@ func (vmHandler *TencentVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, err
 	return irs.VMStatus("Terminating"), nil
 }
 
+func (vmHandler *TencentVMHandler) ChangeVMSpec(vmIID irs.IID, specName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, errors.New("Tencent Driver does not support ChangeVMSpec yet.")
+}
+
 func (vmHandler *TencentVMHandler) GetVM(vmIID irs.IID) (irs.VMInfo, error) {
 	cblogger.Infof("vmNameId : [%s]", vmIID.SystemId)
 
@@ -35,6 +35,13 @@ type DriverCapabilityInfo struct {
 	NLBHandler        bool // support: true, do not support: false
 	ClusterHandler    bool // support: true, do not support: false
 	FileSystemHandler bool // support: true, do not support: false
+	VNicHandler       bool // support: true, do not support: false
+	PublicIPHandler   bool // support: true, do not support: false
+	VPCPeeringHandler bool // support: true, do not support: false
+	RouteTableHandler bool // support: true, do not support: false
+	NATGatewayHandler bool // support: true, do not support: false
+
+	DiskSnapshotHandler bool // support: true, do not support: false
 
 	TagHandler bool // support: true, do not support: false
 	// ex) {ires.VPC, ires.SUBNET, ires.SG, ires.KEY, ires.VM, ires.NLB, ires.DISK, ires.MYIMAGE, ires.CLUSTER}
@@ -45,9 +52,15 @@ type DriverCapabilityInfo struct {
 	EMULATED_VPC bool // support: true, do not support: false
 	SINGLE_VPC   bool // support: true, do not support: false
 
-	// reserved for future use
-	// VNicHandler     bool // support: true, do not support: false
-	// PublicIPHandler bool // support: true, do not support: false
+	SG_REFERENCE_RULE bool // support: true, do not support: false
+	SG_DENY_RULE      bool // support: true, do not support: false
+	IPV6_DUAL_STACK   bool // support: true, do not support: false
+	SPOT_VM           bool // support: true, do not support: false
+	SPOT_PRICE        bool // support: true, do not support: false, Spot prices of the PriceInfoHandler
+	VM_SPEC_CHANGE    bool // support: true, do not support: false
+
+	NLB_MULTI_LISTENER bool // support: true, do not support: false
+	EMULATED_NLB       bool // true: NLB is emulated by CB-Spider with a proxy VM, used only if NLBHandler is false
 }
 
 type CredentialInfo struct {
@@ -99,3 +112,9 @@ type CloudDriver interface {
 	ConnectCloud(connectionInfo ConnectionInfo) (icon.CloudConnection, error)
 	//ConnectNetworkCloud(connectionInfo ConnectionInfo) (icon.CloudConnection, error)
 }
+
+// DriverCapabilityLoader is implemented by a CloudDriver which loads its capability remotely,
+// ex) a driver plugin, so the loading can fail.
+type DriverCapabilityLoader interface {
+	LoadDriverCapability() (DriverCapabilityInfo, error)
+}
@@ -40,6 +40,16 @@ type CloudConnection interface {
 
 	CreateFileSystemHandler() (irs.FileSystemHandler, error)
 
+	CreateVNicHandler() (irs.VNicHandler, error)
+	CreatePublicIPHandler() (irs.PublicIPHandler, error)
+
+	CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error)
+
+	CreateRouteTableHandler() (irs.RouteTableHandler, error)
+	CreateNATGatewayHandler() (irs.NATGatewayHandler, error)
+
+	CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error)
+
 	IsConnected() (bool, error)
 	Close() error
 }
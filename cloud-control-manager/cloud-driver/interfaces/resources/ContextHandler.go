@@ -0,0 +1,492 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import "context"
+
+// Context-aware variants of the handler interfaces.
+//
+// A driver that supports cancellation implements the *WithContext methods
+// and stops calling the CSP when ctx is done.
+// Drivers that have not migrated yet are wrapped by To*HandlerWithContext(),
+// which checks ctx before each call, so a canceled request does not start
+// new CSP work, but a CSP call already in progress, including its polling,
+// runs to completion.
+//
+// Implemented by: AWS(VM, NLB, Cluster, Disk, MyImage) and MOCK(VM).
+
+// -------- VMHandler
+
+type VMHandlerWithContext interface {
+	VMHandler
+
+	ListIIDWithContext(ctx context.Context) ([]*IID, error)
+	StartVMWithContext(ctx context.Context, vmReqInfo VMReqInfo) (VMInfo, error)
+	SuspendVMWithContext(ctx context.Context, vmIID IID) (VMStatus, error)
+	ResumeVMWithContext(ctx context.Context, vmIID IID) (VMStatus, error)
+	RebootVMWithContext(ctx context.Context, vmIID IID) (VMStatus, error)
+	TerminateVMWithContext(ctx context.Context, vmIID IID) (VMStatus, error)
+	ListVMStatusWithContext(ctx context.Context) ([]*VMStatusInfo, error)
+	GetVMStatusWithContext(ctx context.Context, vmIID IID) (VMStatus, error)
+	ListVMWithContext(ctx context.Context) ([]*VMInfo, error)
+	GetVMWithContext(ctx context.Context, vmIID IID) (VMInfo, error)
+}
+
+// ToVMHandlerWithContext returns handler itself if it supports context,
+// otherwise wraps it with an adapter.
+func ToVMHandlerWithContext(handler VMHandler) VMHandlerWithContext {
+	if h, ok := handler.(VMHandlerWithContext); ok {
+		return h
+	}
+	return &vmHandlerContextAdapter{handler}
+}
+
+type vmHandlerContextAdapter struct {
+	VMHandler
+}
+
+func (a *vmHandlerContextAdapter) ListIIDWithContext(ctx context.Context) ([]*IID, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.VMHandler.ListIID()
+}
+
+func (a *vmHandlerContextAdapter) StartVMWithContext(ctx context.Context, vmReqInfo VMReqInfo) (VMInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return VMInfo{}, err
+	}
+	return a.VMHandler.StartVM(vmReqInfo)
+}
+
+func (a *vmHandlerContextAdapter) SuspendVMWithContext(ctx context.Context, vmIID IID) (VMStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return a.VMHandler.SuspendVM(vmIID)
+}
+
+func (a *vmHandlerContextAdapter) ResumeVMWithContext(ctx context.Context, vmIID IID) (VMStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return a.VMHandler.ResumeVM(vmIID)
+}
+
+func (a *vmHandlerContextAdapter) RebootVMWithContext(ctx context.Context, vmIID IID) (VMStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return a.VMHandler.RebootVM(vmIID)
+}
+
+func (a *vmHandlerContextAdapter) TerminateVMWithContext(ctx context.Context, vmIID IID) (VMStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return a.VMHandler.TerminateVM(vmIID)
+}
+
+func (a *vmHandlerContextAdapter) ListVMStatusWithContext(ctx context.Context) ([]*VMStatusInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.VMHandler.ListVMStatus()
+}
+
+func (a *vmHandlerContextAdapter) GetVMStatusWithContext(ctx context.Context, vmIID IID) (VMStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return a.VMHandler.GetVMStatus(vmIID)
+}
+
+func (a *vmHandlerContextAdapter) ListVMWithContext(ctx context.Context) ([]*VMInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.VMHandler.ListVM()
+}
+
+func (a *vmHandlerContextAdapter) GetVMWithContext(ctx context.Context, vmIID IID) (VMInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return VMInfo{}, err
+	}
+	return a.VMHandler.GetVM(vmIID)
+}
+
+// -------- NLBHandler
+
+type NLBHandlerWithContext interface {
+	NLBHandler
+
+	ListIIDWithContext(ctx context.Context) ([]*IID, error)
+	CreateNLBWithContext(ctx context.Context, nlbReqInfo NLBInfo) (NLBInfo, error)
+	ListNLBWithContext(ctx context.Context) ([]*NLBInfo, error)
+	GetNLBWithContext(ctx context.Context, nlbIID IID) (NLBInfo, error)
+	DeleteNLBWithContext(ctx context.Context, nlbIID IID) (bool, error)
+	GetVMGroupHealthInfoWithContext(ctx context.Context, nlbIID IID) (HealthInfo, error)
+	AddVMsWithContext(ctx context.Context, nlbIID IID, vmIIDs *[]IID) (VMGroupInfo, error)
+	RemoveVMsWithContext(ctx context.Context, nlbIID IID, vmIIDs *[]IID) (bool, error)
+	ChangeListenerWithContext(ctx context.Context, nlbIID IID, listener ListenerInfo) (ListenerInfo, error)
+	ChangeVMGroupInfoWithContext(ctx context.Context, nlbIID IID, vmGroup VMGroupInfo) (VMGroupInfo, error)
+	ChangeHealthCheckerInfoWithContext(ctx context.Context, nlbIID IID, healthChecker HealthCheckerInfo) (HealthCheckerInfo, error)
+}
+
+// ToNLBHandlerWithContext returns handler itself if it supports context,
+// otherwise wraps it with an adapter.
+func ToNLBHandlerWithContext(handler NLBHandler) NLBHandlerWithContext {
+	if h, ok := handler.(NLBHandlerWithContext); ok {
+		return h
+	}
+	return &nlbHandlerContextAdapter{handler}
+}
+
+type nlbHandlerContextAdapter struct {
+	NLBHandler
+}
+
+func (a *nlbHandlerContextAdapter) ListIIDWithContext(ctx context.Context) ([]*IID, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.NLBHandler.ListIID()
+}
+
+func (a *nlbHandlerContextAdapter) CreateNLBWithContext(ctx context.Context, nlbReqInfo NLBInfo) (NLBInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return NLBInfo{}, err
+	}
+	return a.NLBHandler.CreateNLB(nlbReqInfo)
+}
+
+func (a *nlbHandlerContextAdapter) ListNLBWithContext(ctx context.Context) ([]*NLBInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.NLBHandler.ListNLB()
+}
+
+func (a *nlbHandlerContextAdapter) GetNLBWithContext(ctx context.Context, nlbIID IID) (NLBInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return NLBInfo{}, err
+	}
+	return a.NLBHandler.GetNLB(nlbIID)
+}
+
+func (a *nlbHandlerContextAdapter) DeleteNLBWithContext(ctx context.Context, nlbIID IID) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.NLBHandler.DeleteNLB(nlbIID)
+}
+
+func (a *nlbHandlerContextAdapter) GetVMGroupHealthInfoWithContext(ctx context.Context, nlbIID IID) (HealthInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return HealthInfo{}, err
+	}
+	return a.NLBHandler.GetVMGroupHealthInfo(nlbIID)
+}
+
+func (a *nlbHandlerContextAdapter) AddVMsWithContext(ctx context.Context, nlbIID IID, vmIIDs *[]IID) (VMGroupInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return VMGroupInfo{}, err
+	}
+	return a.NLBHandler.AddVMs(nlbIID, vmIIDs)
+}
+
+func (a *nlbHandlerContextAdapter) RemoveVMsWithContext(ctx context.Context, nlbIID IID, vmIIDs *[]IID) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.NLBHandler.RemoveVMs(nlbIID, vmIIDs)
+}
+
+func (a *nlbHandlerContextAdapter) ChangeListenerWithContext(ctx context.Context, nlbIID IID, listener ListenerInfo) (ListenerInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return ListenerInfo{}, err
+	}
+	return a.NLBHandler.ChangeListener(nlbIID, listener)
+}
+
+func (a *nlbHandlerContextAdapter) ChangeVMGroupInfoWithContext(ctx context.Context, nlbIID IID, vmGroup VMGroupInfo) (VMGroupInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return VMGroupInfo{}, err
+	}
+	return a.NLBHandler.ChangeVMGroupInfo(nlbIID, vmGroup)
+}
+
+func (a *nlbHandlerContextAdapter) ChangeHealthCheckerInfoWithContext(ctx context.Context, nlbIID IID, healthChecker HealthCheckerInfo) (HealthCheckerInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return HealthCheckerInfo{}, err
+	}
+	return a.NLBHandler.ChangeHealthCheckerInfo(nlbIID, healthChecker)
+}
+
+// -------- ClusterHandler
+
+type ClusterHandlerWithContext interface {
+	ClusterHandler
+
+	ListIIDWithContext(ctx context.Context) ([]*IID, error)
+	CreateClusterWithContext(ctx context.Context, clusterReqInfo ClusterInfo) (ClusterInfo, error)
+	ListClusterWithContext(ctx context.Context) ([]*ClusterInfo, error)
+	GetClusterWithContext(ctx context.Context, clusterIID IID) (ClusterInfo, error)
+	DeleteClusterWithContext(ctx context.Context, clusterIID IID) (bool, error)
+	GenerateClusterTokenWithContext(ctx context.Context, clusterIID IID) (string, error)
+	AddNodeGroupWithContext(ctx context.Context, clusterIID IID, nodeGroupReqInfo NodeGroupInfo) (NodeGroupInfo, error)
+	SetNodeGroupAutoScalingWithContext(ctx context.Context, clusterIID IID, nodeGroupIID IID, on bool) (bool, error)
+	ChangeNodeGroupScalingWithContext(ctx context.Context, clusterIID IID, nodeGroupIID IID, desiredNodeSize int, minNodeSize int, maxNodeSize int) (NodeGroupInfo, error)
+	RemoveNodeGroupWithContext(ctx context.Context, clusterIID IID, nodeGroupIID IID) (bool, error)
+	UpgradeClusterWithContext(ctx context.Context, clusterIID IID, newVersion string) (ClusterInfo, error)
+}
+
+// ToClusterHandlerWithContext returns handler itself if it supports context,
+// otherwise wraps it with an adapter.
+func ToClusterHandlerWithContext(handler ClusterHandler) ClusterHandlerWithContext {
+	if h, ok := handler.(ClusterHandlerWithContext); ok {
+		return h
+	}
+	return &clusterHandlerContextAdapter{handler}
+}
+
+type clusterHandlerContextAdapter struct {
+	ClusterHandler
+}
+
+func (a *clusterHandlerContextAdapter) ListIIDWithContext(ctx context.Context) ([]*IID, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ClusterHandler.ListIID()
+}
+
+func (a *clusterHandlerContextAdapter) CreateClusterWithContext(ctx context.Context, clusterReqInfo ClusterInfo) (ClusterInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return ClusterInfo{}, err
+	}
+	return a.ClusterHandler.CreateCluster(clusterReqInfo)
+}
+
+func (a *clusterHandlerContextAdapter) ListClusterWithContext(ctx context.Context) ([]*ClusterInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ClusterHandler.ListCluster()
+}
+
+func (a *clusterHandlerContextAdapter) GetClusterWithContext(ctx context.Context, clusterIID IID) (ClusterInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return ClusterInfo{}, err
+	}
+	return a.ClusterHandler.GetCluster(clusterIID)
+}
+
+func (a *clusterHandlerContextAdapter) DeleteClusterWithContext(ctx context.Context, clusterIID IID) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.ClusterHandler.DeleteCluster(clusterIID)
+}
+
+func (a *clusterHandlerContextAdapter) GenerateClusterTokenWithContext(ctx context.Context, clusterIID IID) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return a.ClusterHandler.GenerateClusterToken(clusterIID)
+}
+
+func (a *clusterHandlerContextAdapter) AddNodeGroupWithContext(ctx context.Context, clusterIID IID, nodeGroupReqInfo NodeGroupInfo) (NodeGroupInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return NodeGroupInfo{}, err
+	}
+	return a.ClusterHandler.AddNodeGroup(clusterIID, nodeGroupReqInfo)
+}
+
+func (a *clusterHandlerContextAdapter) SetNodeGroupAutoScalingWithContext(ctx context.Context, clusterIID IID, nodeGroupIID IID, on bool) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.ClusterHandler.SetNodeGroupAutoScaling(clusterIID, nodeGroupIID, on)
+}
+
+func (a *clusterHandlerContextAdapter) ChangeNodeGroupScalingWithContext(ctx context.Context, clusterIID IID, nodeGroupIID IID, desiredNodeSize int, minNodeSize int, maxNodeSize int) (NodeGroupInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return NodeGroupInfo{}, err
+	}
+	return a.ClusterHandler.ChangeNodeGroupScaling(clusterIID, nodeGroupIID, desiredNodeSize, minNodeSize, maxNodeSize)
+}
+
+func (a *clusterHandlerContextAdapter) RemoveNodeGroupWithContext(ctx context.Context, clusterIID IID, nodeGroupIID IID) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.ClusterHandler.RemoveNodeGroup(clusterIID, nodeGroupIID)
+}
+
+func (a *clusterHandlerContextAdapter) UpgradeClusterWithContext(ctx context.Context, clusterIID IID, newVersion string) (ClusterInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return ClusterInfo{}, err
+	}
+	return a.ClusterHandler.UpgradeCluster(clusterIID, newVersion)
+}
+
+// -------- DiskHandler
+
+type DiskHandlerWithContext interface {
+	DiskHandler
+
+	ListIIDWithContext(ctx context.Context) ([]*IID, error)
+	CreateDiskWithContext(ctx context.Context, diskReqInfo DiskInfo) (DiskInfo, error)
+	ListDiskWithContext(ctx context.Context) ([]*DiskInfo, error)
+	GetDiskWithContext(ctx context.Context, diskIID IID) (DiskInfo, error)
+	ChangeDiskSizeWithContext(ctx context.Context, diskIID IID, size string) (bool, error)
+	DeleteDiskWithContext(ctx context.Context, diskIID IID) (bool, error)
+	AttachDiskWithContext(ctx context.Context, diskIID IID, ownerVM IID) (DiskInfo, error)
+	DetachDiskWithContext(ctx context.Context, diskIID IID, ownerVM IID) (bool, error)
+}
+
+// ToDiskHandlerWithContext returns handler itself if it supports context,
+// otherwise wraps it with an adapter.
+func ToDiskHandlerWithContext(handler DiskHandler) DiskHandlerWithContext {
+	if h, ok := handler.(DiskHandlerWithContext); ok {
+		return h
+	}
+	return &diskHandlerContextAdapter{handler}
+}
+
+type diskHandlerContextAdapter struct {
+	DiskHandler
+}
+
+func (a *diskHandlerContextAdapter) ListIIDWithContext(ctx context.Context) ([]*IID, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.DiskHandler.ListIID()
+}
+
+func (a *diskHandlerContextAdapter) CreateDiskWithContext(ctx context.Context, diskReqInfo DiskInfo) (DiskInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return DiskInfo{}, err
+	}
+	return a.DiskHandler.CreateDisk(diskReqInfo)
+}
+
+func (a *diskHandlerContextAdapter) ListDiskWithContext(ctx context.Context) ([]*DiskInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.DiskHandler.ListDisk()
+}
+
+func (a *diskHandlerContextAdapter) GetDiskWithContext(ctx context.Context, diskIID IID) (DiskInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return DiskInfo{}, err
+	}
+	return a.DiskHandler.GetDisk(diskIID)
+}
+
+func (a *diskHandlerContextAdapter) ChangeDiskSizeWithContext(ctx context.Context, diskIID IID, size string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.DiskHandler.ChangeDiskSize(diskIID, size)
+}
+
+func (a *diskHandlerContextAdapter) DeleteDiskWithContext(ctx context.Context, diskIID IID) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.DiskHandler.DeleteDisk(diskIID)
+}
+
+func (a *diskHandlerContextAdapter) AttachDiskWithContext(ctx context.Context, diskIID IID, ownerVM IID) (DiskInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return DiskInfo{}, err
+	}
+	return a.DiskHandler.AttachDisk(diskIID, ownerVM)
+}
+
+func (a *diskHandlerContextAdapter) DetachDiskWithContext(ctx context.Context, diskIID IID, ownerVM IID) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.DiskHandler.DetachDisk(diskIID, ownerVM)
+}
+
+// -------- MyImageHandler
+
+type MyImageHandlerWithContext interface {
+	MyImageHandler
+
+	SnapshotVMWithContext(ctx context.Context, snapshotReqInfo MyImageInfo) (MyImageInfo, error)
+	ListIIDWithContext(ctx context.Context) ([]*IID, error)
+	ListMyImageWithContext(ctx context.Context) ([]*MyImageInfo, error)
+	GetMyImageWithContext(ctx context.Context, myImageIID IID) (MyImageInfo, error)
+	CheckWindowsImageWithContext(ctx context.Context, myImageIID IID) (bool, error)
+	DeleteMyImageWithContext(ctx context.Context, myImageIID IID) (bool, error)
+}
+
+// ToMyImageHandlerWithContext returns handler itself if it supports context,
+// otherwise wraps it with an adapter.
+func ToMyImageHandlerWithContext(handler MyImageHandler) MyImageHandlerWithContext {
+	if h, ok := handler.(MyImageHandlerWithContext); ok {
+		return h
+	}
+	return &myImageHandlerContextAdapter{handler}
+}
+
+type myImageHandlerContextAdapter struct {
+	MyImageHandler
+}
+
+func (a *myImageHandlerContextAdapter) SnapshotVMWithContext(ctx context.Context, snapshotReqInfo MyImageInfo) (MyImageInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return MyImageInfo{}, err
+	}
+	return a.MyImageHandler.SnapshotVM(snapshotReqInfo)
+}
+
+func (a *myImageHandlerContextAdapter) ListIIDWithContext(ctx context.Context) ([]*IID, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.MyImageHandler.ListIID()
+}
+
+func (a *myImageHandlerContextAdapter) ListMyImageWithContext(ctx context.Context) ([]*MyImageInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.MyImageHandler.ListMyImage()
+}
+
+func (a *myImageHandlerContextAdapter) GetMyImageWithContext(ctx context.Context, myImageIID IID) (MyImageInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return MyImageInfo{}, err
+	}
+	return a.MyImageHandler.GetMyImage(myImageIID)
+}
+
+func (a *myImageHandlerContextAdapter) CheckWindowsImageWithContext(ctx context.Context, myImageIID IID) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.MyImageHandler.CheckWindowsImage(myImageIID)
+}
+
+func (a *myImageHandlerContextAdapter) DeleteMyImageWithContext(ctx context.Context, myImageIID IID) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.MyImageHandler.DeleteMyImage(myImageIID)
+}
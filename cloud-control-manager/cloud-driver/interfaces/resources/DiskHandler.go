@@ -32,6 +32,9 @@ type DiskInfo struct {
 	DiskType string `json:"DiskType" validate:"required" example:"gp2"` // "gp2", "Premium SSD", ...
 	DiskSize string `json:"DiskSize" validate:"required" example:"100"` // "default", "50", "1000" (unit is GB)
 
+	IOPS       string `json:"IOPS,omitempty" validate:"omitempty" example:"3000"`      // "", "3000" (provisioned IOPS, only for the Disk Types with provisioned performance)
+	Throughput string `json:"Throughput,omitempty" validate:"omitempty" example:"125"` // "", "125" (provisioned throughput, unit is MB/s)
+
 	Status  DiskStatus `json:"Status" validate:"required" example:"Available"`
 	OwnerVM IID        `json:"OwnerVM" validate:"omitempty"` // When the Status is DiskAttached
 
@@ -49,6 +52,7 @@ type DiskHandler interface {
 	ListDisk() ([]*DiskInfo, error)
 	GetDisk(diskIID IID) (DiskInfo, error)
 	ChangeDiskSize(diskIID IID, size string) (bool, error)
+	ChangeDiskType(diskIID IID, diskType string, iops string, throughput string) (DiskInfo, error) // iops, throughput: "" to keep or to use the CSP default
 	DeleteDisk(diskIID IID) (bool, error)
 
 	//------ Disk Attachment
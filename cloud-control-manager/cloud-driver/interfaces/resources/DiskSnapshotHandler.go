@@ -0,0 +1,56 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import "time"
+
+// -------- Const
+type DiskSnapshotStatus string
+
+const (
+	DiskSnapshotCreating  DiskSnapshotStatus = "Creating"
+	DiskSnapshotAvailable DiskSnapshotStatus = "Available"
+	DiskSnapshotDeleting  DiskSnapshotStatus = "Deleting"
+	DiskSnapshotError     DiskSnapshotStatus = "Error"
+)
+
+// -------- Info Structure
+// DiskSnapshotInfo represents the information of a snapshot of a disk.
+// A disk snapshot can be restored to a new disk in any zone of the region.
+type DiskSnapshotInfo struct {
+	IId        IID    `json:"IId" validate:"required"` // {NameId, SystemId}
+	SourceDisk IID    `json:"SourceDisk" validate:"required"`
+	Zone       string `json:"Zone,omitempty" validate:"omitempty" example:"us-east-1a"` // Zone of the source disk
+
+	DiskSize string `json:"DiskSize" validate:"required" example:"100"` // size of the source disk (unit is GB)
+
+	Status DiskSnapshotStatus `json:"Status" validate:"required" example:"Available"` // Creating | Available | Deleting | Error
+
+	CreatedTime  time.Time  `json:"CreatedTime" validate:"required"`
+	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
+}
+
+// -------- DiskSnapshot API
+type DiskSnapshotHandler interface {
+
+	//------ DiskSnapshot Management
+	ListIID() ([]*IID, error)
+	CreateDiskSnapshot(snapshotReqInfo DiskSnapshotInfo) (DiskSnapshotInfo, error)
+	ListDiskSnapshot() ([]*DiskSnapshotInfo, error)
+	GetDiskSnapshot(snapshotIID IID) (DiskSnapshotInfo, error)
+	DeleteDiskSnapshot(snapshotIID IID) (bool, error)
+
+	//------ Restore
+	// diskReqInfo.Zone: any zone of the region, empty: the zone of the connection
+	// diskReqInfo.DiskSize: empty or "default": the DiskSize of the snapshot, it cannot be smaller than the snapshot
+	CreateDiskFromSnapshot(snapshotIID IID, diskReqInfo DiskInfo) (DiskInfo, error)
+}
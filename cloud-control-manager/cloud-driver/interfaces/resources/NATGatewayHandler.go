@@ -0,0 +1,49 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+// -------- Const
+type NATGatewayStatus string
+
+const (
+	NATGatewayPending   NATGatewayStatus = "Pending"
+	NATGatewayAvailable NATGatewayStatus = "Available"
+	NATGatewayDeleting  NATGatewayStatus = "Deleting"
+	NATGatewayFailed    NATGatewayStatus = "Failed"
+)
+
+// -------- Info Structure
+// NATGatewayInfo represents the information of a NAT gateway,
+// which gives private subnets outbound access through a route to it.
+type NATGatewayInfo struct {
+	IId       IID `json:"IId" validate:"required"` // {NameId, SystemId}
+	VpcIID    IID `json:"VpcIID" validate:"required"`
+	SubnetIID IID `json:"SubnetIID" validate:"required"` // a public subnet to place the NAT gateway
+
+	PublicIP  string `json:"PublicIP,omitempty" validate:"omitempty" example:"3.34.1.10"` // assigned by the CSP
+	PrivateIP string `json:"PrivateIP,omitempty" validate:"omitempty" example:"10.0.1.10"`
+
+	Status NATGatewayStatus `json:"Status" validate:"required" example:"Available"`
+
+	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
+}
+
+// -------- NATGateway API
+type NATGatewayHandler interface {
+
+	//------ NATGateway Management
+	ListIID() ([]*IID, error)
+	CreateNATGateway(natGatewayReqInfo NATGatewayInfo) (NATGatewayInfo, error)
+	ListNATGateway() ([]*NATGatewayInfo, error)
+	GetNATGateway(natGatewayIID IID) (NATGatewayInfo, error)
+	DeleteNATGateway(natGatewayIID IID) (bool, error)
+}
@@ -10,7 +10,9 @@
 
 package resources
 
-import "time"
+import (
+	"time"
+)
 
 // -------- Info Structure
 // NLBInfo represents the details of a Network Load Balancer (NLB).
@@ -23,12 +25,15 @@ type NLBInfo struct {
 	Scope string `json:"Scope" validate:"required" example:"REGION"` // REGION(V) | GLOBAL
 
 	//------ Frontend
-	Listener ListenerInfo `json:"Listener" validate:"required"`
+	Listener ListenerInfo `json:"Listener" validate:"required"` // the first Listener of ListenerList
 
 	//------ Backend
-	VMGroup       VMGroupInfo       `json:"VMGroup" validate:"required"`
+	VMGroup       VMGroupInfo       `json:"VMGroup" validate:"required"` // the first VMGroup of ListenerList, the VMs are shared by all listeners
 	HealthChecker HealthCheckerInfo `json:"HealthChecker" validate:"required"`
 
+	//------ Frontend => Backend bindings, ex) TCP:80 => TCP:8080, TCP:443 => TCP:8443
+	ListenerList []ListenerBindingInfo `json:"ListenerList,omitempty" validate:"omitempty"`
+
 	CreatedTime  time.Time  `json:"CreatedTime" validate:"required" example:"2024-08-27T10:00:00Z"`
 	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
 	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
@@ -46,6 +51,13 @@ type ListenerInfo struct {
 	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
 }
 
+// ListenerBindingInfo represents a frontend listener bound to the backend VM group port of an NLB.
+// @description Listener to VM Group Binding Information for a Network Load Balancer (NLB)
+type ListenerBindingInfo struct {
+	Listener ListenerInfo `json:"Listener" validate:"required"`
+	VMGroup  VMGroupInfo  `json:"VMGroup" validate:"required"` // Protocol and Port of the backend, VMs are the VMs of the NLB's VMGroup
+}
+
 // VMGroupInfo represents the backend VM group configuration for an NLB.
 // @description VM Group Information for a Network Load Balancer (NLB)
 type VMGroupInfo struct {
@@ -66,6 +78,11 @@ type HealthCheckerInfo struct {
 	Timeout   int    `json:"Timeout" validate:"required" example:"5"`    // secs, Waiting time to decide an unhealthy VM when no response.
 	Threshold int    `json:"Threshold" validate:"required" example:"3"`  // num, The number of continuous health checks to change the VM status.
 
+	// HTTP only, empty: set up with the provider's default value
+	HTTPPath            string `json:"HTTPPath,omitempty" validate:"omitempty" example:"/health"`            // Path of the health check request, default: "/"
+	ExpectedStatusCodes string `json:"ExpectedStatusCodes,omitempty" validate:"omitempty" example:"200-299"` // ex) "200", "200,302", "200-399"
+	HostHeader          string `json:"HostHeader,omitempty" validate:"omitempty" example:"www.example.com"`  // Host header of the health check request
+
 	CspID        string     `json:"CspID,omitempty" validate:"omitempty"`
 	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
 }
@@ -98,6 +115,8 @@ type NLBHandler interface {
 
 	//------ Frontend Control
 	ChangeListener(nlbIID IID, listener ListenerInfo) (ListenerInfo, error)
+	AddListener(nlbIID IID, listenerBinding ListenerBindingInfo) (NLBInfo, error)
+	RemoveListener(nlbIID IID, listener ListenerInfo) (bool, error) // listener: Protocol and Port of the listener to remove
 	//------ Backend Control
 	ChangeVMGroupInfo(nlbIID IID, vmGroup VMGroupInfo) (VMGroupInfo, error)
 	ChangeHealthCheckerInfo(nlbIID IID, healthChecker HealthCheckerInfo) (HealthCheckerInfo, error)
@@ -43,8 +43,10 @@ type ProductInfo struct {
 
 // PriceInfo represents the pricing details for a product.
 type PriceInfo struct {
-	OnDemand     OnDemand    `json:"OnDemand" validate:"required" description:"Ondemand pricing details"`  // Ondemand pricing details
-	CSPPriceInfo interface{} `json:"CSPPriceInfo" validate:"required" description:"Additional price info"` // Additional price information specific to CSP
+	OnDemand     OnDemand    `json:"OnDemand" validate:"required" description:"Ondemand pricing details"`                 // Ondemand pricing details
+	Reserved     []Reserved  `json:"Reserved,omitempty" validate:"omitempty" description:"Reserved-term pricing details"` // Reserved-term pricing details, ex) 1 Year, 3 Year
+	Spot         []Spot      `json:"Spot,omitempty" validate:"omitempty" description:"Spot pricing details"`              // Spot pricing details, for the region or each zone
+	CSPPriceInfo interface{} `json:"CSPPriceInfo" validate:"required" description:"Additional price info"`                // Additional price information specific to CSP
 }
 
 // OnDemand represents the OnDemand pricing details.
@@ -56,6 +58,40 @@ type OnDemand struct {
 	Description string `json:"Description,omitempty" example:"Pricing for t2.micro"` // Description of the pricing policy
 }
 
+// Reserved represents the Reserved-term pricing details.
+type Reserved struct {
+	PricingId      string `json:"PricingId" validate:"required" example:"price-456"`                      // ID of the pricing policy
+	Term           string `json:"Term" validate:"required" example:"1 Year"`                              // Term of the reservation
+	PurchaseOption string `json:"PurchaseOption,omitempty" example:"No Upfront"`                          // Payment option of the reservation
+	Unit           string `json:"Unit" validate:"required" example:"Hour"`                                // Unit of the pricing (e.g., per hour)
+	Currency       string `json:"Currency" validate:"required" example:"USD"`                             // Currency of the pricing
+	Price          string `json:"Price" validate:"required" example:"0.012"`                              // Recurring price in the specified currency per unit
+	UpfrontPrice   string `json:"UpfrontPrice,omitempty" example:"0"`                                     // One-time upfront price in the specified currency
+	Description    string `json:"Description,omitempty" example:"1 Year No Upfront pricing for t2.micro"` // Description of the pricing policy
+}
+
+// Spot represents the Spot pricing details.
+type Spot struct {
+	PricingId   string `json:"PricingId" validate:"required" example:"price-789"`         // ID of the pricing policy
+	ZoneName    string `json:"ZoneName,omitempty" example:"us-east-1a"`                   // Name of the zone, empty for the region
+	Unit        string `json:"Unit" validate:"required" example:"Hour"`                   // Unit of the pricing (e.g., per hour)
+	Currency    string `json:"Currency" validate:"required" example:"USD"`                // Currency of the pricing
+	Price       string `json:"Price" validate:"required" example:"0.006"`                 // Current Spot price in the specified currency per unit
+	Description string `json:"Description,omitempty" example:"Spot pricing for t2.micro"` // Description of the pricing policy
+}
+
+// PricingModel selects the pricing details of GetPriceInfo with the PricingModelFilterKey filter.
+type PricingModel string
+
+const (
+	OnDemandPricing PricingModel = "OnDemand"
+	ReservedPricing PricingModel = "Reserved"
+	SpotPricing     PricingModel = "Spot"
+)
+
+// filter key of the pricing models, ex) {Key: "PricingModel", Value: "Spot"} or {Key: "PricingModel", Value: "OnDemand,Reserved"}
+const PricingModelFilterKey = "PricingModel"
+
 type PriceInfoHandler interface {
 	ListProductFamily(regionName string) ([]string, error)
 	GetPriceInfo(productFamily string, regionName string, filterList []KeyValue, simpleVMSpecInfo bool) (string, error) // return string: json format
@@ -0,0 +1,49 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+// -------- Const
+type PublicIPStatus string
+
+const (
+	PublicIPAvailable PublicIPStatus = "Available"
+	PublicIPAttached  PublicIPStatus = "Attached"
+	PublicIPError     PublicIPStatus = "Error"
+)
+
+// -------- Info Structure
+// PublicIPInfo represents the information of a static(reserved) public IP address.
+type PublicIPInfo struct {
+	IId      IID    `json:"IId" validate:"required"` // {NameId, SystemId}
+	PublicIP string `json:"PublicIP" validate:"required" example:"3.34.1.10"`
+
+	Status  PublicIPStatus `json:"Status" validate:"required" example:"Available"`
+	OwnerVM IID            `json:"OwnerVM" validate:"omitempty"` // When the Status is PublicIPAttached
+
+	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
+}
+
+// -------- PublicIP API
+type PublicIPHandler interface {
+
+	//------ PublicIP Management
+	ListIID() ([]*IID, error)
+	CreatePublicIP(publicIPReqInfo PublicIPInfo) (PublicIPInfo, error)
+	ListPublicIP() ([]*PublicIPInfo, error)
+	GetPublicIP(publicIPIID IID) (PublicIPInfo, error)
+	DeletePublicIP(publicIPIID IID) (bool, error)
+
+	//------ PublicIP Attachment
+	// A PublicIP is attached to the primary network interface of the VM.
+	AttachPublicIP(publicIPIID IID, ownerVM IID) (PublicIPInfo, error)
+	DetachPublicIP(publicIPIID IID, ownerVM IID) (bool, error)
+}
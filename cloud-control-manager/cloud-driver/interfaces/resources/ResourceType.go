@@ -32,6 +32,16 @@ const (
 	NODEGROUP RSType = "nodegroup"
 
 	FILESYSTEM RSType = "filesystem"
+
+	VNIC     RSType = "vnic"
+	PUBLICIP RSType = "publicip"
+
+	VPCPEERING RSType = "vpcpeering"
+
+	ROUTETABLE RSType = "routetable"
+	NATGATEWAY RSType = "natgateway"
+
+	DISKSNAPSHOT RSType = "disksnapshot"
 )
 
 func RSTypeString(rsType RSType) string {
@@ -62,6 +72,18 @@ func RSTypeString(rsType RSType) string {
 		return "Kubernetes NodeGroup"
 	case FILESYSTEM:
 		return "FileSystem"
+	case VNIC:
+		return "Virtual Network Interface"
+	case PUBLICIP:
+		return "Public IP"
+	case VPCPEERING:
+		return "VPC Peering"
+	case ROUTETABLE:
+		return "Route Table"
+	case NATGATEWAY:
+		return "NAT Gateway"
+	case DISKSNAPSHOT:
+		return "Disk Snapshot"
 	default:
 		return string(rsType) + " is not supported Resource!!"
 
@@ -99,6 +121,18 @@ func StringToRSType(str string) (RSType, error) {
 		return NODEGROUP, nil
 	case "filesystem":
 		return FILESYSTEM, nil
+	case "vnic":
+		return VNIC, nil
+	case "publicip":
+		return PUBLICIP, nil
+	case "vpcpeering":
+		return VPCPEERING, nil
+	case "routetable":
+		return ROUTETABLE, nil
+	case "natgateway":
+		return NATGATEWAY, nil
+	case "disksnapshot":
+		return DISKSNAPSHOT, nil
 	default:
 		return "", fmt.Errorf("%s is not a valid resource type", str)
 	}
@@ -0,0 +1,63 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+// -------- Const
+type RouteTargetType string
+
+const (
+	RouteTargetLocal           RouteTargetType = "Local"           // the VPC itself, added by the CSP
+	RouteTargetInternetGateway RouteTargetType = "InternetGateway" // the Internet Gateway of the VPC
+	RouteTargetNATGateway      RouteTargetType = "NATGateway"
+	RouteTargetVPCPeering      RouteTargetType = "VPCPeering"
+	RouteTargetVNic            RouteTargetType = "VNic"
+)
+
+// -------- Info Structure
+// RouteInfo represents a route of a route table.
+type RouteInfo struct {
+	DestinationCIDR string          `json:"DestinationCIDR" validate:"required" example:"0.0.0.0/0"`
+	TargetType      RouteTargetType `json:"TargetType" validate:"required" example:"NATGateway"`
+	TargetIID       IID             `json:"TargetIID" validate:"omitempty"` // empty for Local and InternetGateway
+}
+
+// RouteTableInfo represents the information of a route table of a VPC.
+type RouteTableInfo struct {
+	IId    IID  `json:"IId" validate:"required"` // {NameId, SystemId}
+	VpcIID IID  `json:"VpcIID" validate:"required"`
+	IsMain bool `json:"IsMain" validate:"omitempty"` // true: the default route table created with the VPC
+
+	RouteList  []RouteInfo `json:"RouteList" validate:"omitempty"`
+	SubnetIIDs []IID       `json:"SubnetIIDs" validate:"omitempty"` // associated subnets
+
+	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
+}
+
+// -------- RouteTable API
+type RouteTableHandler interface {
+
+	//------ RouteTable Management
+	ListIID() ([]*IID, error)
+	CreateRouteTable(routeTableReqInfo RouteTableInfo) (RouteTableInfo, error)
+	ListRouteTable() ([]*RouteTableInfo, error)
+	GetRouteTable(routeTableIID IID) (RouteTableInfo, error)
+	DeleteRouteTable(routeTableIID IID) (bool, error)
+
+	//------ Route Management
+	AddRoutes(routeTableIID IID, routeList []RouteInfo) (RouteTableInfo, error)
+	RemoveRoutes(routeTableIID IID, routeList []RouteInfo) (bool, error)
+
+	//------ Subnet Association
+	// A subnet is associated with only one route table, so it is moved from the current one.
+	AssociateSubnet(routeTableIID IID, subnetIID IID) (RouteTableInfo, error)
+	DisassociateSubnet(routeTableIID IID, subnetIID IID) (bool, error)
+}
@@ -26,7 +26,14 @@ type SecurityRuleInfo struct {
 	IPProtocol string `json:"IPProtocol" validate:"required" example:"TCP"`            // TCP, UDP, ICMP, ALL
 	FromPort   string `json:"FromPort" validate:"required" example:"22"`               // TCP, UDP: 1~65535, ICMP, ALL: -1
 	ToPort     string `json:"ToPort" validate:"required" example:"22"`                 // TCP, UDP: 1~65535, ICMP, ALL: -1
-	CIDR       string `json:"CIDR,omitempty" validate:"omitempty" example:"0.0.0.0/0"` // if not specified, defaults to 0.0.0.0/0
+	CIDR       string `json:"CIDR,omitempty" validate:"omitempty" example:"0.0.0.0/0"` // IPv4 or IPv6, if not specified, defaults to 0.0.0.0/0
+
+	// source(inbound) or target(outbound) SecurityGroup in the same VPC, an alternative to CIDR
+	RemoteSecurityGroupIID *IID `json:"RemoteSecurityGroupIID,omitempty" validate:"omitempty"`
+
+	Description string `json:"Description,omitempty" validate:"omitempty" example:"allow ssh"`
+	Priority    string `json:"Priority,omitempty" validate:"omitempty" example:"100"` // lower is evaluated first, ignored by CSPs without rule priorities
+	Action      string `json:"Action,omitempty" validate:"omitempty" example:"allow"` // allow or deny, if not specified, defaults to allow
 }
 
 type SecurityInfo struct {
@@ -50,9 +50,34 @@ type VMReqInfo struct {
 	VMUserPasswd string
 	WindowsType  bool
 
+	UserData string // "", "#!/bin/bash\n...", "#cloud-config\n...", "#cloud-boothook\n..." (plain text, not base64-encoded)
+
+	PurchaseOption VMPurchaseOption // default: OnDemand
+
 	TagList []KeyValue
 }
 
+type PurchaseType string
+
+const (
+	PurchaseOnDemand PurchaseType = "OnDemand"
+	PurchaseSpot     PurchaseType = "Spot" // spot or preemptible capacity, can be interrupted by the CSP
+)
+
+type InterruptionBehavior string
+
+const (
+	InterruptionTerminate InterruptionBehavior = "Terminate"
+	InterruptionStop      InterruptionBehavior = "Stop"
+	InterruptionHibernate InterruptionBehavior = "Hibernate"
+)
+
+type VMPurchaseOption struct {
+	PurchaseType         PurchaseType         `json:"PurchaseType" validate:"required" example:"Spot"`                         // OnDemand | Spot, default: OnDemand
+	MaxPrice             string               `json:"MaxPrice,omitempty" validate:"omitempty" example:"0.05"`                  // Spot only, max price per hour(USD), "": up to the OnDemand price
+	InterruptionBehavior InterruptionBehavior `json:"InterruptionBehavior,omitempty" validate:"omitempty" example:"Terminate"` // Spot only, Terminate | Stop | Hibernate, default: Terminate
+}
+
 type VMStatusInfo struct {
 	IId      IID      `json:"IId" validate:"required" example:"` // {NameId: 'vm-01', SystemId: 'i-12345678'}"
 	VmStatus VMStatus `json:"VmStatus" validate:"required" example:"Running"`
@@ -117,9 +142,12 @@ type VMInfo struct {
 	PublicDNS        string `json:"PublicDNS,omitempty" validate:"omitempty" example:"ec2-1-2-3-4.compute-1.amazonaws.com"`
 	PrivateIP        string `json:"PrivateIP" validate:"required" example:"192.168.1.1"`
 	PrivateDNS       string `json:"PrivateDNS,omitempty" validate:"omitempty" example:"ip-192-168-1-1.ec2.internal"`
+	IPv6Address      string `json:"IPv6Address,omitempty" validate:"omitempty" example:"2600:1f18:abcd:1201::10"` // in a dual-stack Subnet
 
 	Platform Platform `json:"Platform" validate:"required" example:"LINUX"` // LINUX | WINDOWS
 
+	PurchaseOption VMPurchaseOption `json:"PurchaseOption" validate:"required"` // example:"{PurchaseType: 'Spot', MaxPrice: '0.05', InterruptionBehavior: 'Terminate'}"
+
 	SSHAccessPoint string `json:"SSHAccessPoint,omitempty" validate:"omitempty" example:"10.2.3.2:22"` // Deprecated
 	AccessPoint    string `json:"AccessPoint" validate:"required" example:"1.2.3.4:22"`                // 10.2.3.2:22, 123.456.789.123:432
 
@@ -137,6 +165,9 @@ type VMHandler interface {
 	RebootVM(vmIID IID) (VMStatus, error)
 	TerminateVM(vmIID IID) (VMStatus, error)
 
+	// ChangeVMSpec changes the VMSpec of a suspended(stopped) VM.
+	ChangeVMSpec(vmIID IID, specName string) (VMInfo, error)
+
 	ListVMStatus() ([]*VMStatusInfo, error)
 	GetVMStatus(vmIID IID) (VMStatus, error)
 
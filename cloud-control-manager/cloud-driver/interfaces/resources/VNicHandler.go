@@ -0,0 +1,56 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+// -------- Const
+type VNicStatus string
+
+const (
+	VNicCreating  VNicStatus = "Creating"
+	VNicAvailable VNicStatus = "Available"
+	VNicAttached  VNicStatus = "Attached"
+	VNicDeleting  VNicStatus = "Deleting"
+	VNicError     VNicStatus = "Error"
+)
+
+// -------- Info Structure
+// VNicInfo represents the information of a virtual network interface.
+type VNicInfo struct {
+	IId               IID   `json:"IId" validate:"required"` // {NameId, SystemId}
+	VpcIID            IID   `json:"VpcIID" validate:"required"`
+	SubnetIID         IID   `json:"SubnetIID" validate:"required"`
+	SecurityGroupIIDs []IID `json:"SecurityGroupIIDs" validate:"omitempty"`
+
+	PrivateIP  string `json:"PrivateIP,omitempty" validate:"omitempty" example:"10.0.1.10"` // empty: assigned by the CSP
+	PublicIP   string `json:"PublicIP,omitempty" validate:"omitempty" example:"3.34.1.10"`  // When a PublicIP is attached
+	MacAddress string `json:"MacAddress,omitempty" validate:"omitempty" example:"02:42:ac:11:00:02"`
+
+	Status  VNicStatus `json:"Status" validate:"required" example:"Available"`
+	OwnerVM IID        `json:"OwnerVM" validate:"omitempty"` // When the Status is VNicAttached
+
+	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
+}
+
+// -------- VNic API
+type VNicHandler interface {
+
+	//------ VNic Management
+	ListIID() ([]*IID, error)
+	CreateVNic(vNicReqInfo VNicInfo) (VNicInfo, error)
+	ListVNic() ([]*VNicInfo, error)
+	GetVNic(vNicIID IID) (VNicInfo, error)
+	DeleteVNic(vNicIID IID) (bool, error)
+
+	//------ VNic Attachment
+	AttachVNic(vNicIID IID, ownerVM IID) (VNicInfo, error)
+	DetachVNic(vNicIID IID, ownerVM IID) (bool, error)
+}
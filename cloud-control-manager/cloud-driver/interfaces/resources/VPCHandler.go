@@ -15,6 +15,7 @@ package resources
 type VPCReqInfo struct {
 	IId            IID // {NameId, SystemId}
 	IPv4_CIDR      string
+	EnableIPv6     bool // dual-stack: the CSP assigns an IPv6 CIDR block to the VPC
 	SubnetInfoList []SubnetInfo
 
 	TagList []KeyValue
@@ -23,6 +24,7 @@ type VPCReqInfo struct {
 type VPCInfo struct {
 	IId            IID          `json:"IId" validate:"required"` // {NameId, SystemId}
 	IPv4_CIDR      string       `json:"IPv4_CIDR" validate:"required" example:"10.0.0.0/16" description:"The IPv4 CIDR block for the VPC"`
+	IPv6_CIDR      string       `json:"IPv6_CIDR,omitempty" validate:"omitempty" example:"2600:1f18:abcd:1200::/56" description:"The IPv6 CIDR block for the dual-stack VPC"`
 	SubnetInfoList []SubnetInfo `json:"SubnetInfoList" validate:"required" description:"A list of subnet information associated with this VPC"`
 
 	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty" description:"A list of tags associated with this VPC"`
@@ -33,6 +35,7 @@ type SubnetInfo struct {
 	IId       IID    `json:"IId" validate:"required"` // {NameId, SystemId}
 	Zone      string `json:"Zone" validate:"required" example:"us-east-1a"`
 	IPv4_CIDR string `json:"IPv4_CIDR" validate:"required" example:"10.0.8.0/22" description:"The IPv4 CIDR block for the subnet"`
+	IPv6_CIDR string `json:"IPv6_CIDR,omitempty" validate:"omitempty" example:"2600:1f18:abcd:1201::/64" description:"The IPv6 /64 block for the subnet in a dual-stack VPC, assigned automatically if not specified"`
 
 	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty" description:"A list of tags associated with this subnet"`
 	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty" description:"Additional key-value pairs associated with this subnet"`
@@ -0,0 +1,69 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+// -------- Const
+type VPCPeeringStatus string
+
+const (
+	VPCPeeringPendingAcceptance VPCPeeringStatus = "PendingAcceptance"
+	VPCPeeringActive            VPCPeeringStatus = "Active"
+	VPCPeeringRejected          VPCPeeringStatus = "Rejected"
+	VPCPeeringFailed            VPCPeeringStatus = "Failed"
+	VPCPeeringDeleting          VPCPeeringStatus = "Deleting"
+)
+
+// -------- Info Structure
+// VPCPeeringInfo represents the information of a peering between two VPCs.
+// The requester and the accepter VPCs can belong to different connections(accounts or regions) of a CSP.
+type VPCPeeringInfo struct {
+	IId IID `json:"IId" validate:"required"` // {NameId, SystemId}
+
+	RequesterVpcIID  IID    `json:"RequesterVpcIID" validate:"required"`
+	RequesterVpcCIDR string `json:"RequesterVpcCIDR,omitempty" validate:"omitempty" example:"10.0.0.0/16"`
+
+	AccepterVpcIID    IID    `json:"AccepterVpcIID" validate:"required"`
+	AccepterVpcCIDR   string `json:"AccepterVpcCIDR,omitempty" validate:"omitempty" example:"10.1.0.0/16"`
+	AccepterAccountId string `json:"AccepterAccountId,omitempty" validate:"omitempty"`                       // empty: the same account as the requester
+	AccepterRegion    string `json:"AccepterRegion,omitempty" validate:"omitempty" example:"ap-northeast-2"` // empty: the same region as the requester
+
+	Status VPCPeeringStatus `json:"Status" validate:"required" example:"Active"`
+
+	// true when the routes to the peer VPC's CIDR are added to the route tables of the VPC
+	RequesterRoutePropagated bool `json:"RequesterRoutePropagated" validate:"omitempty"`
+	AccepterRoutePropagated  bool `json:"AccepterRoutePropagated" validate:"omitempty"`
+
+	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
+}
+
+// -------- VPCPeering API
+// A peering is requested with the requester's connection and accepted with the accepter's connection.
+// Both are the same connection when the two VPCs are in the same connection.
+type VPCPeeringHandler interface {
+
+	//------ VPCPeering Management
+	ListIID() ([]*IID, error)
+	RequestVPCPeering(peeringReqInfo VPCPeeringInfo) (VPCPeeringInfo, error)
+	AcceptVPCPeering(peeringIID IID) (VPCPeeringInfo, error)
+	ListVPCPeering() ([]*VPCPeeringInfo, error)
+	GetVPCPeering(peeringIID IID) (VPCPeeringInfo, error)
+	DeleteVPCPeering(peeringIID IID) (bool, error)
+
+	//------ Route Propagation
+	// PropagateRoutes adds the routes to the peer VPC's CIDR into the route tables of vpcIID,
+	// which is the requester or the accepter VPC of this connection.
+	PropagateRoutes(peeringIID IID, vpcIID IID) (VPCPeeringInfo, error)
+
+	// GetOwnerAccountId returns the account ID of this connection,
+	// which is used as the AccepterAccountId of a peering requested by another connection.
+	GetOwnerAccountId() (string, error)
+}
@@ -23,6 +23,17 @@ type CloudOSMetaInfo struct {
 	DiskSize             []string `json:"DiskSize" validate:"required"`             // Supported additional disk sizes (in GB).
 	IdMaxLength          []string `json:"IdMaxLength" validate:"required"`          // Maximum allowed length for IDs in the cloud provider.
 	DefaultRegionToQuery []string `json:"DefaultRegionToQuery" validate:"required"` // Default region to use if none is specified for a query.
+	UserDataMaxSize      []string `json:"UserDataMaxSize" validate:"required"`      // Maximum size of VM user-data (in bytes, before base64 encoding).
+
+	NLBHealthCheckDefault    []string `json:"NLBHealthCheckDefault,omitempty"`    // Default Interval|Timeout|Threshold of NLB Health Checker by Protocol (e.g., TCP|10|10|3).
+	NLBHTTPHealthCheckOption []string `json:"NLBHTTPHealthCheckOption,omitempty"` // Supported options of NLB HTTP Health Checker (HTTPPath, ExpectedStatusCodes, HostHeader).
+	NLBHTTPHealthCheckStatus []string `json:"NLBHTTPHealthCheckStatus,omitempty"` // Default expected status codes of NLB HTTP Health Checker (e.g., 200-399).
+	NLBProxyVMSpec           []string `json:"NLBProxyVMSpec,omitempty"`           // Default VM spec of the proxy VM of the emulated NLB (e.g., t3.small).
+
+	DiskPerformance []string `json:"DiskPerformance,omitempty"` // Provisioned IOPS and Throughput(MB/s) ranges by Disk Type (e.g., gp3|3000|16000|125|1000).
+	DiskChangeType  []string `json:"DiskChangeType,omitempty"`  // Disk Types that can be changed online with ChangeDiskType.
+
+	RootDiskChangeType []string `json:"RootDiskChangeType,omitempty"` // Disk Types of a root disk that can be changed online with ChangeDiskType.
 }
 
 // struct for unmarshal
@@ -36,6 +47,17 @@ type YamlMetaInfo struct {
 	DiskSize             string
 	IdMaxLength          string
 	DefaultRegionToQuery string
+	UserDataMaxSize      string
+
+	NLBHealthCheckDefault    string
+	NLBHTTPHealthCheckOption string
+	NLBHTTPHealthCheckStatus string
+	NLBProxyVMSpec           string
+
+	DiskPerformance string
+	DiskChangeType  string
+
+	RootDiskChangeType string
 }
 
 // global variable to prevent file opereations
@@ -77,6 +99,17 @@ func GetCloudOSMetaInfo(cloudOS string) (CloudOSMetaInfo, error) {
 		DiskSize:             cloneSlice(mInfo.DiskSize),
 		IdMaxLength:          cloneSlice(mInfo.IdMaxLength),
 		DefaultRegionToQuery: cloneSlice(mInfo.DefaultRegionToQuery),
+		UserDataMaxSize:      cloneSlice(mInfo.UserDataMaxSize),
+
+		NLBHealthCheckDefault:    cloneSlice(mInfo.NLBHealthCheckDefault),
+		NLBHTTPHealthCheckOption: cloneSlice(mInfo.NLBHTTPHealthCheckOption),
+		NLBHTTPHealthCheckStatus: cloneSlice(mInfo.NLBHTTPHealthCheckStatus),
+		NLBProxyVMSpec:           cloneSlice(mInfo.NLBProxyVMSpec),
+
+		DiskPerformance: cloneSlice(mInfo.DiskPerformance),
+		DiskChangeType:  cloneSlice(mInfo.DiskChangeType),
+
+		RootDiskChangeType: cloneSlice(mInfo.RootDiskChangeType),
 	}
 	rwMutex.Unlock()
 	return ret, nil
@@ -138,6 +171,17 @@ func convertAndSetMetaInfo(yamlMetaInfo map[string]YamlMetaInfo) {
 			DiskSize:             splitAndTrim(v.DiskSize),
 			IdMaxLength:          splitAndTrim(v.IdMaxLength),
 			DefaultRegionToQuery: splitAndTrim(v.DefaultRegionToQuery),
+			UserDataMaxSize:      splitAndTrim(v.UserDataMaxSize),
+
+			NLBHealthCheckDefault:    splitAndTrim(v.NLBHealthCheckDefault),
+			NLBHTTPHealthCheckOption: splitAndTrim(v.NLBHTTPHealthCheckOption),
+			NLBHTTPHealthCheckStatus: splitAndTrim(v.NLBHTTPHealthCheckStatus),
+			NLBProxyVMSpec:           splitAndTrim(v.NLBProxyVMSpec),
+
+			DiskPerformance: splitAndTrim(v.DiskPerformance),
+			DiskChangeType:  splitAndTrim(v.DiskChangeType),
+
+			RootDiskChangeType: splitAndTrim(v.RootDiskChangeType),
 		}
 		metaInfo[k] = cloudOSMetaInfo
 	}
@@ -9,6 +9,7 @@
 package connectionconfiginfomanager
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -31,6 +32,39 @@ type ConnectionConfigInfo struct {
 	RegionName     string `json:"RegionName" validate:"required" example:"region01"`                   // The name of the region for the cloud connection.
 }
 
+// ErrConnectionConfigNotFound is returned when no connection config has the requested name.
+var ErrConnectionConfigNotFound = errors.New("does not exist")
+
+// The rows of the driver, credential and region referred by a connection config.
+// They are declared here to check the references without an import cycle,
+// the credential and region info managers import this package.
+type driverRefInfo struct {
+	DriverName   string `gorm:"primaryKey"`
+	ProviderName string
+}
+
+func (driverRefInfo) TableName() string {
+	return "cloud_driver_infos"
+}
+
+type credentialRefInfo struct {
+	CredentialName string `gorm:"primaryKey"`
+	ProviderName   string
+}
+
+func (credentialRefInfo) TableName() string {
+	return "credential_infos"
+}
+
+type regionRefInfo struct {
+	RegionName   string `gorm:"primaryKey"`
+	ProviderName string
+}
+
+func (regionRefInfo) TableName() string {
+	return "region_infos"
+}
+
 //====================================================================
 
 var cblog *logrus.Logger
@@ -42,7 +76,7 @@ func init() {
 	if err != nil {
 		panic("Failed to Connect to Database")
 	}
-	db.AutoMigrate(&ConnectionConfigInfo{})
+	infostore.AutoMigrate(db, &ConnectionConfigInfo{})
 	infostore.Close(db)
 }
 
@@ -115,6 +149,58 @@ func GetConnectionConfig(configName string) (*ConnectionConfigInfo, error) {
 	return &connectionConfigInfo, err
 }
 
+// 1. check params
+// 2. check the existence of the connection config
+// 3. check the driver, credential and region of the connection config
+// 4. replace the ConnectionConfigInfo in info-store
+// 5. invalidate the cached state of the connection
+func UpdateConnectionConfigInfo(configInfo ConnectionConfigInfo) (*ConnectionConfigInfo, error) {
+	cblog.Info("call UpdateConnectionConfigInfo()")
+
+	cblog.Debug("check params")
+	err := checkParams(configInfo.ConfigName,
+		configInfo.ProviderName, configInfo.DriverName, configInfo.CredentialName, configInfo.RegionName)
+	if err != nil {
+		return nil, err
+	}
+
+	// trim user inputs
+	configInfo.ConfigName = strings.TrimSpace(configInfo.ConfigName)
+	configInfo.ProviderName = strings.ToUpper(strings.TrimSpace(configInfo.ProviderName))
+	configInfo.DriverName = strings.TrimSpace(configInfo.DriverName)
+	configInfo.CredentialName = strings.TrimSpace(configInfo.CredentialName)
+	configInfo.RegionName = strings.TrimSpace(configInfo.RegionName)
+
+	exist, err := infostore.Has(&ConnectionConfigInfo{}, KEY_COLUMN_NAME, configInfo.ConfigName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if !exist {
+		err := fmt.Errorf("%s: %w!", configInfo.ConfigName, ErrConnectionConfigNotFound)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = checkReferences(configInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cblog.Debug("update metainfo in store")
+
+	err = infostore.Insert(&configInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	InvalidateConnection(configInfo.ConfigName)
+
+	return &configInfo, nil
+}
+
 func DeleteConnectionConfig(configName string) (bool, error) {
 	cblog.Info("call DeleteConnectionConfig()")
 
@@ -128,6 +214,8 @@ func DeleteConnectionConfig(configName string) (bool, error) {
 		return false, err
 	}
 
+	InvalidateConnection(configName)
+
 	return result, nil
 }
 
@@ -153,6 +241,35 @@ func checkParams(configName string, providerName string, driverName string, cred
 	return nil
 }
 
+// checkReferences checks that the driver, credential and region exist for the provider of the connection config.
+func checkReferences(configInfo ConnectionConfigInfo) error {
+	var driverInfo driverRefInfo
+	if err := infostore.Get(&driverInfo, "driver_name", configInfo.DriverName); err != nil {
+		return fmt.Errorf("Driver %v", err)
+	}
+	if driverInfo.ProviderName != configInfo.ProviderName {
+		return fmt.Errorf("Driver %s: is not for %s!", configInfo.DriverName, configInfo.ProviderName)
+	}
+
+	var credentialInfo credentialRefInfo
+	if err := infostore.Get(&credentialInfo, "credential_name", configInfo.CredentialName); err != nil {
+		return fmt.Errorf("Credential %v", err)
+	}
+	if credentialInfo.ProviderName != configInfo.ProviderName {
+		return fmt.Errorf("Credential %s: is not for %s!", configInfo.CredentialName, configInfo.ProviderName)
+	}
+
+	var regionInfo regionRefInfo
+	if err := infostore.Get(&regionInfo, "region_name", configInfo.RegionName); err != nil {
+		return fmt.Errorf("Region %v", err)
+	}
+	if regionInfo.ProviderName != configInfo.ProviderName {
+		return fmt.Errorf("Region %s: is not for %s!", configInfo.RegionName, configInfo.ProviderName)
+	}
+
+	return nil
+}
+
 func CountAllConnections() (int64, error) {
 	cblog.Info("call ListConnectionConfig()")
 
@@ -0,0 +1,76 @@
+// Cloud ConnectionConfig Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// The update and delete functions of the credential, region and connection config
+// invalidate the connections which use them, so all callers(REST, CLI, ...) drop the stale state.
+//
+// by CB-Spider Team, 2026.10.
+
+package connectionconfiginfomanager
+
+import (
+	"sync"
+)
+
+// ConnectionInvalidator drops the cached state of a connection.
+type ConnectionInvalidator func(connectionName string)
+
+var (
+	connectionInvalidatorList []ConnectionInvalidator
+	connectionInvalidatorLock sync.RWMutex
+)
+
+// AddConnectionInvalidator registers fn to be called
+// when the credential, region or config of a connection is changed.
+func AddConnectionInvalidator(fn ConnectionInvalidator) {
+	connectionInvalidatorLock.Lock()
+	defer connectionInvalidatorLock.Unlock()
+	connectionInvalidatorList = append(connectionInvalidatorList, fn)
+}
+
+// InvalidateConnection drops the cached state of the connections.
+func InvalidateConnection(connectionNames ...string) {
+	connectionInvalidatorLock.RLock()
+	defer connectionInvalidatorLock.RUnlock()
+
+	for _, connectionName := range connectionNames {
+		cblog.Infof("invalidate the cached state of the connection: %s", connectionName)
+		for _, fn := range connectionInvalidatorList {
+			fn(connectionName)
+		}
+	}
+}
+
+// InvalidateConnectionByCredentialName drops the cached state of the connections which use the credential.
+func InvalidateConnectionByCredentialName(credentialName string) error {
+	return invalidateConnectionBy(func(configInfo *ConnectionConfigInfo) bool {
+		return configInfo.CredentialName == credentialName
+	})
+}
+
+// InvalidateConnectionByRegionName drops the cached state of the connections which use the region.
+func InvalidateConnectionByRegionName(regionName string) error {
+	return invalidateConnectionBy(func(configInfo *ConnectionConfigInfo) bool {
+		return configInfo.RegionName == regionName
+	})
+}
+
+func invalidateConnectionBy(match func(configInfo *ConnectionConfigInfo) bool) error {
+	configInfoList, err := ListConnectionConfig()
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
+	connectionNameList := []string{}
+	for _, configInfo := range configInfoList {
+		if match(configInfo) {
+			connectionNameList = append(connectionNameList, configInfo.ConfigName)
+		}
+	}
+	InvalidateConnection(connectionNameList...)
+	return nil
+}
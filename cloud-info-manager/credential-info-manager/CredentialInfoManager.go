@@ -10,21 +10,17 @@
 package credentialinfomanager
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	cblogger "github.com/cloud-barista/cb-log"
 	icdrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
 	infostore "github.com/cloud-barista/cb-spider/info-store"
 
 	"github.com/sirupsen/logrus"
-
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/rand"
-	"encoding/base64"
-	"io"
 )
 
 // ====================================================================
@@ -39,6 +35,9 @@ type CredentialInfo struct {
 	KeyValueInfoList infostore.KVList `json:"KeyValueInfoList" gorm:"type:blob" validate:"required"`                       // Key-value pairs for credential authentication.
 }
 
+// ErrCredentialNotFound is returned when no credential has the requested name.
+var ErrCredentialNotFound = errors.New("does not exist")
+
 //====================================================================
 
 var cblog *logrus.Logger
@@ -49,7 +48,7 @@ func init() {
 	if err != nil {
 		panic("failed to connect database")
 	}
-	db.AutoMigrate(&CredentialInfo{})
+	infostore.AutoMigrate(db, &CredentialInfo{})
 	infostore.Close(db)
 }
 
@@ -238,6 +237,82 @@ func GetCredentialDecrypt(credentialName string) (*CredentialInfo, error) {
 	return &credentialInfo, nil
 }
 
+// 1. check params
+// 2. check the existence and the provider of the credential
+// 3. replace the KeyValueInfoList in info-store
+// 4. invalidate the cached state of the connections which use the credential
+func UpdateCredentialInfo(crdInfo CredentialInfo) (*CredentialInfo, error) {
+	cblog.Info("call UpdateCredentialInfo()")
+
+	// If Input credential Key are csp format, we convert Key Names to spider Key Names
+	kvInfoList, err := mapCredentialsCSPKeyToSpiderKeys(crdInfo.ProviderName, crdInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+	crdInfo.KeyValueInfoList = kvInfoList
+
+	// check params and validation of credential-key
+	err = checkParams(crdInfo.CredentialName, crdInfo.ProviderName, crdInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	// trim user inputs
+	crdInfo.CredentialName = strings.TrimSpace(crdInfo.CredentialName)
+	crdInfo.ProviderName = strings.ToUpper(strings.TrimSpace(crdInfo.ProviderName))
+
+	exist, err := infostore.Has(&CredentialInfo{}, KEY_COLUMN_NAME, crdInfo.CredentialName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if !exist {
+		err := fmt.Errorf("%s: %w!", crdInfo.CredentialName, ErrCredentialNotFound)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var oldInfo CredentialInfo
+	err = infostore.Get(&oldInfo, KEY_COLUMN_NAME, crdInfo.CredentialName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	// connection configs refer to this credential with their provider
+	if oldInfo.ProviderName != crdInfo.ProviderName {
+		err := fmt.Errorf("%s: ProviderName can not be changed from %s to %s!", crdInfo.CredentialName, oldInfo.ProviderName, crdInfo.ProviderName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// update metainfo in store
+	err = encryptKeyValueList(crdInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	err = infostore.Insert(&crdInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = ccim.InvalidateConnectionByCredentialName(crdInfo.CredentialName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hide credential data for security
+	kvList := []icdrs.KeyValue{}
+	for _, kv := range crdInfo.KeyValueInfoList {
+		kv.Value = "Hidden for security."
+		kvList = append(kvList, kv)
+	}
+	crdInfo.KeyValueInfoList = kvList
+
+	return &crdInfo, nil
+}
+
 func UnRegisterCredential(credentialName string) (bool, error) {
 	cblog.Info("call UnRegisterCredential()")
 
@@ -251,6 +326,11 @@ func UnRegisterCredential(credentialName string) (bool, error) {
 		return false, err
 	}
 
+	err = ccim.InvalidateConnectionByCredentialName(credentialName)
+	if err != nil {
+		return false, err
+	}
+
 	return result, nil
 }
 
@@ -284,15 +364,15 @@ func checkParams(credentialName string, providerName string, keyValueInfoList []
 	return nil
 }
 
-// #######################################################################
-// @todo get from Env file. by powerkim, 2020.06.01.
-var SPIDER_KEY = []byte("cloud-barista-cb-spider-cloud-ba") // 32 bytes
-//#######################################################################
-
 func encryptKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
 
+	spiderKey, err := GetSpiderKey()
+	if err != nil {
+		return err
+	}
+
 	for i, kv := range keyValueInfoList {
-		encString, err := Encrypt(SPIDER_KEY, []byte(kv.Value))
+		encString, err := Encrypt(spiderKey, []byte(kv.Value))
 		if err != nil {
 			return err
 		}
@@ -304,8 +384,13 @@ func encryptKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
 
 func decryptKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
 
+	spiderKey, err := GetSpiderKey()
+	if err != nil {
+		return err
+	}
+
 	for i, kv := range keyValueInfoList {
-		decString, err := Decrypt(SPIDER_KEY, []byte(kv.Value))
+		decString, err := Decrypt(spiderKey, []byte(kv.Value))
 		if err != nil {
 			return err
 		}
@@ -314,46 +399,3 @@ func decryptKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
 	}
 	return nil
 }
-
-// encription with spider key
-func Encrypt(spider_key, contents []byte) (string, error) {
-
-	block, err := aes.NewCipher(spider_key)
-	if err != nil {
-		return "", err
-	}
-
-	ciphertext := make([]byte, aes.BlockSize+len(contents))
-	iv := ciphertext[:aes.BlockSize]
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
-		return "", err
-	}
-
-	stream := cipher.NewCTR(block, iv)
-	stream.XORKeyStream(ciphertext[aes.BlockSize:], contents)
-
-	return base64.StdEncoding.EncodeToString(ciphertext), nil
-}
-
-// decryption with spider key
-func Decrypt(spider_key, contents []byte) (string, error) {
-
-	ciphertext, err := base64.StdEncoding.DecodeString(string(contents))
-	if err != nil {
-		return "", err
-	}
-
-	block, err := aes.NewCipher(spider_key)
-	if err != nil {
-		return "", err
-	}
-
-	iv := ciphertext[:aes.BlockSize]
-	ciphertext = ciphertext[aes.BlockSize:]
-
-	stream := cipher.NewCTR(block, iv)
-	plaintext := make([]byte, len(ciphertext))
-	stream.XORKeyStream(plaintext, ciphertext)
-
-	return string(plaintext), nil
-}
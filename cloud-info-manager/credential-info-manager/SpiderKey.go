@@ -0,0 +1,326 @@
+// Cloud Credential Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package credentialinfomanager
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"gorm.io/gorm"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// The master key to encrypt credentials and local private keys.
+//   - SPIDER_KEY_FILE: path of a file which has the key
+//   - SPIDER_KEY: the key itself, used if SPIDER_KEY_FILE is not set
+//
+// The key is 32 characters or the base64 encoding of 32 bytes.
+// If none is set, the legacy built-in key is used.
+// It is loaded by InitSpiderKey(), use GetSpiderKey() to read it.
+var spiderKey []byte
+var spiderKeyLock sync.Mutex
+
+// LEGACY_SPIDER_KEY is the built-in key of the old versions.
+// It is used to read the legacy ciphertexts, which have no envelope.
+var LEGACY_SPIDER_KEY = []byte("cloud-barista-cb-spider-cloud-ba") // 32 bytes
+
+// envelope format: "spider:v1:{key id}:{base64(nonce + AES-256-GCM ciphertext)}"
+const envelopePrefixV1 = "spider:v1:"
+
+// InitSpiderKey loads the master key from SPIDER_KEY_FILE or SPIDER_KEY.
+// The API server and the CLI commands call it at the start to fail early with a wrong key.
+func InitSpiderKey() error {
+	spiderKeyLock.Lock()
+	defer spiderKeyLock.Unlock()
+
+	return initSpiderKey()
+}
+
+func initSpiderKey() error {
+	key, err := LoadSpiderKey()
+	if err != nil {
+		err = fmt.Errorf("failed to load the spider key: %v", err)
+		cblog.Error(err)
+		return err
+	}
+	spiderKey = key
+	return nil
+}
+
+// GetSpiderKey returns the master key, it loads the key at the first call if InitSpiderKey() was not called.
+func GetSpiderKey() ([]byte, error) {
+	spiderKeyLock.Lock()
+	defer spiderKeyLock.Unlock()
+
+	if spiderKey == nil {
+		if err := initSpiderKey(); err != nil {
+			return nil, err
+		}
+	}
+	return spiderKey, nil
+}
+
+// LoadSpiderKey loads the master key from SPIDER_KEY_FILE or SPIDER_KEY.
+func LoadSpiderKey() ([]byte, error) {
+	if keyFile := strings.TrimSpace(os.Getenv("SPIDER_KEY_FILE")); keyFile != "" {
+		return ReadSpiderKeyFile(keyFile)
+	}
+	if strKey := os.Getenv("SPIDER_KEY"); strKey != "" {
+		return ParseSpiderKey(strKey)
+	}
+
+	cblog.Warn("SPIDER_KEY_FILE and SPIDER_KEY are not set, the built-in key is used to encrypt credentials.")
+	return LEGACY_SPIDER_KEY, nil
+}
+
+// ReadSpiderKeyFile reads a master key from a key file.
+func ReadSpiderKeyFile(keyFile string) ([]byte, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ParseSpiderKey(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", keyFile, err)
+	}
+	return key, nil
+}
+
+// ParseSpiderKey parses 32 characters or the base64 encoding of 32 bytes.
+func ParseSpiderKey(strKey string) ([]byte, error) {
+	strKey = strings.TrimSpace(strKey)
+	if len(strKey) == 32 {
+		return []byte(strKey), nil
+	}
+	key, err := base64.StdEncoding.DecodeString(strKey)
+	if err != nil || len(key) != 32 {
+		return nil, fmt.Errorf("the key must be 32 characters or the base64 encoding of 32 bytes")
+	}
+	return key, nil
+}
+
+// GenerateSpiderKey returns a new random key in base64.
+func GenerateSpiderKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// keyID identifies a key in the envelope without exposing the key.
+func keyID(spider_key []byte) string {
+	sum := sha256.Sum256(spider_key)
+	return hex.EncodeToString(sum[:4])
+}
+
+// encription with spider key
+// It returns the versioned envelope of AES-256-GCM.
+func Encrypt(spider_key, contents []byte) (string, error) {
+
+	block, err := aes.NewCipher(spider_key)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(contents)+gcm.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	ciphertext := gcm.Seal(nonce, nonce, contents, nil)
+
+	return envelopePrefixV1 + keyID(spider_key) + ":" + base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// decryption with spider key
+// It reads the versioned envelope, and the legacy ciphertext with LEGACY_SPIDER_KEY.
+func Decrypt(spider_key, contents []byte) (string, error) {
+
+	strContents := string(contents)
+	if !strings.HasPrefix(strContents, envelopePrefixV1) {
+		return decryptLegacy(LEGACY_SPIDER_KEY, contents)
+	}
+
+	idAndData := strings.SplitN(strings.TrimPrefix(strContents, envelopePrefixV1), ":", 2)
+	if len(idAndData) != 2 {
+		return "", fmt.Errorf("invalid encrypted data format")
+	}
+	if idAndData[0] != keyID(spider_key) {
+		return "", fmt.Errorf("the data was encrypted with another key(id:%s), the current key id is %s", idAndData[0], keyID(spider_key))
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(idAndData[1])
+	if err != nil {
+		return "", err
+	}
+
+	block, err := aes.NewCipher(spider_key)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return "", fmt.Errorf("invalid encrypted data length")
+	}
+
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+// decryption of the legacy AES-CTR ciphertext
+func decryptLegacy(spider_key, contents []byte) (string, error) {
+
+	ciphertext, err := base64.StdEncoding.DecodeString(string(contents))
+	if err != nil {
+		return "", err
+	}
+	if len(ciphertext) < aes.BlockSize {
+		return "", fmt.Errorf("invalid encrypted data length")
+	}
+
+	block, err := aes.NewCipher(spider_key)
+	if err != nil {
+		return "", err
+	}
+
+	iv := ciphertext[:aes.BlockSize]
+	ciphertext = ciphertext[aes.BlockSize:]
+
+	stream := cipher.NewCTR(block, iv)
+	plaintext := make([]byte, len(ciphertext))
+	stream.XORKeyStream(plaintext, ciphertext)
+
+	return string(plaintext), nil
+}
+
+//----------------------------------------- Key Rotation
+
+// localKeyInfo is the row of local_key_infos(cloud-driver/common.LocalKeyInfo).
+// It is declared here to re-encrypt the private keys without an import cycle.
+type localKeyInfo struct {
+	ProviderName string `gorm:"primaryKey"`
+	HashString   string `gorm:"primaryKey"`
+	NameId       string `gorm:"primaryKey"`
+	PrivateKey   string
+}
+
+func (localKeyInfo) TableName() string {
+	return "local_key_infos"
+}
+
+// RotatedKeyInfo is the result of a key rotation.
+type RotatedKeyInfo struct {
+	OldKeyID        string
+	NewKeyID        string
+	CredentialCount int // number of re-encrypted CredentialInfo
+	LocalKeyCount   int // number of re-encrypted LocalKeyInfo
+}
+
+// RotateKey re-encrypts all CredentialInfo and LocalKeyInfo with newKey in one transaction,
+// and then uses newKey as the master key.
+// Legacy ciphertexts are re-encrypted, too.
+// A running server keeps the old key and can not read the re-encrypted data,
+// so the caller holds the server lock(infostore.AcquireServerLock()) during the rotation.
+// After the rotation, set SPIDER_KEY_FILE or SPIDER_KEY to the new key before restarting CB-Spider.
+func RotateKey(newKey []byte) (RotatedKeyInfo, error) {
+	cblog.Info("call RotateKey()")
+
+	if len(newKey) != 32 {
+		return RotatedKeyInfo{}, fmt.Errorf("the new key must be 32 bytes")
+	}
+
+	oldKey, err := GetSpiderKey()
+	if err != nil {
+		return RotatedKeyInfo{}, err
+	}
+
+	rotated := RotatedKeyInfo{OldKeyID: keyID(oldKey), NewKeyID: keyID(newKey)}
+
+	reEncrypt := func(encValue string) (string, error) {
+		plainValue, err := Decrypt(oldKey, []byte(encValue))
+		if err != nil {
+			return "", err
+		}
+		return Encrypt(newKey, []byte(plainValue))
+	}
+
+	err = infostore.Transaction(func(tx *gorm.DB) error {
+		// (1) CredentialInfo
+		var credentialInfoList []*CredentialInfo
+		if err := tx.Find(&credentialInfoList).Error; err != nil {
+			return err
+		}
+		for _, credentialInfo := range credentialInfoList {
+			for i, kv := range credentialInfo.KeyValueInfoList {
+				encValue, err := reEncrypt(kv.Value)
+				if err != nil {
+					return fmt.Errorf("credential %s: %v", credentialInfo.CredentialName, err)
+				}
+				credentialInfo.KeyValueInfoList[i].Value = encValue
+			}
+			if err := infostore.InsertTx(tx, credentialInfo); err != nil {
+				return err
+			}
+		}
+		rotated.CredentialCount = len(credentialInfoList)
+
+		// (2) LocalKeyInfo
+		if !tx.Migrator().HasTable(&localKeyInfo{}) {
+			return nil
+		}
+		var localKeyInfoList []*localKeyInfo
+		if err := tx.Find(&localKeyInfoList).Error; err != nil {
+			return err
+		}
+		for _, keyInfo := range localKeyInfoList {
+			encValue, err := reEncrypt(keyInfo.PrivateKey)
+			if err != nil {
+				return fmt.Errorf("local key %s: %v", keyInfo.NameId, err)
+			}
+			keyInfo.PrivateKey = encValue
+			if err := infostore.InsertTx(tx, keyInfo); err != nil {
+				return err
+			}
+		}
+		rotated.LocalKeyCount = len(localKeyInfoList)
+
+		return nil
+	})
+	if err != nil {
+		cblog.Error(err)
+		return RotatedKeyInfo{}, err
+	}
+
+	spiderKeyLock.Lock()
+	spiderKey = newKey
+	spiderKeyLock.Unlock()
+	return rotated, nil
+}
@@ -42,7 +42,7 @@ func init() {
 	if err != nil {
 		panic("failed to connect database")
 	}
-	db.AutoMigrate(&CloudDriverInfo{})
+	infostore.AutoMigrate(db, &CloudDriverInfo{})
 	infostore.Close(db)
 }
 
@@ -10,12 +10,14 @@
 package regioninfomanager
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	cblogger "github.com/cloud-barista/cb-log"
 	icdrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
 
 	"github.com/sirupsen/logrus"
 
@@ -35,6 +37,9 @@ type RegionInfo struct {
 	AvailableZoneList infostore.AZList `json:"AvailableZoneList" gorm:"type:text" validate:"required"`              // A list of available zones within the region.
 }
 
+// ErrRegionNotFound is returned when no region has the requested name.
+var ErrRegionNotFound = errors.New("does not exist")
+
 //====================================================================
 
 var cblog *logrus.Logger
@@ -46,7 +51,7 @@ func init() {
 	if err != nil {
 		panic("failed to connect database")
 	}
-	db.AutoMigrate(&RegionInfo{})
+	infostore.AutoMigrate(db, &RegionInfo{})
 	infostore.Close(db)
 }
 
@@ -127,6 +132,69 @@ func GetRegion(regionName string) (*RegionInfo, error) {
 	return &regionInfo, err
 }
 
+// 1. check params and zones
+// 2. check the existence and the provider of the region
+// 3. replace the RegionInfo in info-store
+// 4. invalidate the cached state of the connections which use the region
+func UpdateRegionInfo(rgnInfo RegionInfo) (*RegionInfo, error) {
+	cblog.Info("call UpdateRegionInfo()")
+
+	cblog.Debug("check params")
+	err := checkParams(rgnInfo.RegionName, rgnInfo.ProviderName, rgnInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	// trim user inputs
+	rgnInfo.RegionName = strings.TrimSpace(rgnInfo.RegionName)
+	rgnInfo.ProviderName = strings.ToUpper(strings.TrimSpace(rgnInfo.ProviderName))
+
+	rgnInfo.AvailableZoneList, err = checkZones(rgnInfo.KeyValueInfoList, rgnInfo.AvailableZoneList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	exist, err := infostore.Has(&RegionInfo{}, KEY_COLUMN_NAME, rgnInfo.RegionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if !exist {
+		err := fmt.Errorf("%s: %w!", rgnInfo.RegionName, ErrRegionNotFound)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var oldInfo RegionInfo
+	err = infostore.Get(&oldInfo, KEY_COLUMN_NAME, rgnInfo.RegionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	// connection configs refer to this region with their provider
+	if oldInfo.ProviderName != rgnInfo.ProviderName {
+		err := fmt.Errorf("%s: ProviderName can not be changed from %s to %s!", rgnInfo.RegionName, oldInfo.ProviderName, rgnInfo.ProviderName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cblog.Debug("update metainfo in store")
+
+	err = infostore.Insert(&rgnInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = ccim.InvalidateConnectionByRegionName(rgnInfo.RegionName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rgnInfo, nil
+}
+
 func UnRegisterRegion(regionName string) (bool, error) {
 	cblog.Info("call UnRegisterRegion()")
 
@@ -140,6 +208,11 @@ func UnRegisterRegion(regionName string) (bool, error) {
 		return false, err
 	}
 
+	err = ccim.InvalidateConnectionByRegionName(regionName)
+	if err != nil {
+		return false, err
+	}
+
 	return result, nil
 }
 
@@ -172,3 +245,37 @@ func checkParams(regionName string, providerName string, keyValueInfoList []icdr
 
 	return nil
 }
+
+// checkZones trims the zones and checks that they are not empty or duplicated,
+// and that the Zone of the KeyValueInfoList is one of them.
+func checkZones(keyValueInfoList []icdrs.KeyValue, zoneList []string) ([]string, error) {
+	trimmedList := []string{}
+	for _, zone := range zoneList {
+		zone = strings.TrimSpace(zone)
+		if zone == "" {
+			return nil, fmt.Errorf("AvailableZoneList has an empty zone!")
+		}
+		for _, trimmed := range trimmedList {
+			if trimmed == zone {
+				return nil, fmt.Errorf("AvailableZoneList has a duplicated zone: %s!", zone)
+			}
+		}
+		trimmedList = append(trimmedList, zone)
+	}
+
+	if len(trimmedList) == 0 {
+		return trimmedList, nil
+	}
+	for _, kv := range keyValueInfoList {
+		if kv.Key != "Zone" || kv.Value == "" {
+			continue
+		}
+		for _, zone := range trimmedList {
+			if zone == strings.TrimSpace(kv.Value) {
+				return trimmedList, nil
+			}
+		}
+		return nil, fmt.Errorf("Zone %s is not in the AvailableZoneList %v!", kv.Value, trimmedList)
+	}
+	return trimmedList, nil
+}
@@ -0,0 +1,161 @@
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package spiderkeytest
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+
+	icdrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	strKey, err := cim.GenerateSpiderKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := cim.ParseSpiderKey(strKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encValue, err := cim.Encrypt(key, []byte("secret-value"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encValue, "spider:v1:") {
+		t.Fatalf("unexpected envelope: %s", encValue)
+	}
+
+	decValue, err := cim.Decrypt(key, []byte(encValue))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decValue != "secret-value" {
+		t.Fatalf("unexpected decrypted value: %s", decValue)
+	}
+
+	// tampered data must be rejected
+	tampered := encValue[:len(encValue)-4] + "AAA="
+	if _, err := cim.Decrypt(key, []byte(tampered)); err == nil {
+		t.Fatal("tampered data should not be decrypted")
+	}
+
+	// data of another key must be rejected
+	if _, err := cim.Decrypt(cim.LEGACY_SPIDER_KEY, []byte(encValue)); err == nil {
+		t.Fatal("data of another key should not be decrypted")
+	}
+}
+
+func TestDecryptLegacy(t *testing.T) {
+	// legacy format: base64(iv + AES-CTR ciphertext) with the built-in key
+	block, err := aes.NewCipher(cim.LEGACY_SPIDER_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contents := []byte("legacy-secret")
+	ciphertext := make([]byte, aes.BlockSize+len(contents))
+	iv := ciphertext[:aes.BlockSize]
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		t.Fatal(err)
+	}
+	cipher.NewCTR(block, iv).XORKeyStream(ciphertext[aes.BlockSize:], contents)
+	legacyValue := base64.StdEncoding.EncodeToString(ciphertext)
+
+	strKey, err := cim.GenerateSpiderKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := cim.ParseSpiderKey(strKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decValue, err := cim.Decrypt(key, []byte(legacyValue))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decValue != "legacy-secret" {
+		t.Fatalf("unexpected decrypted value: %s", decValue)
+	}
+}
+
+func TestParseSpiderKey(t *testing.T) {
+	if _, err := cim.ParseSpiderKey("cloud-barista-cb-spider-cloud-ba"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cim.ParseSpiderKey("too-short"); err == nil {
+		t.Fatal("a short key should be rejected")
+	}
+}
+
+func TestRotateKey(t *testing.T) {
+	const credentialName = "spiderkey-test-credential"
+
+	oldKey, err := cim.GetSpiderKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cim.RegisterCredential(credentialName, "MOCK", []icdrs.KeyValue{{Key: "MockName", Value: "mock01"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		// rotate back, so the MetaDB stays readable with the original key
+		if _, err := cim.RotateKey(oldKey); err != nil {
+			t.Error(err)
+		}
+		cim.UnRegisterCredential(credentialName)
+	})
+
+	strKey, err := cim.GenerateSpiderKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, err := cim.ParseSpiderKey(strKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, err := cim.RotateKey(newKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated.CredentialCount < 1 || rotated.OldKeyID == rotated.NewKeyID {
+		t.Fatalf("unexpected rotation result: %+v", rotated)
+	}
+
+	// the stored value is encrypted with the new key only
+	var storedInfo cim.CredentialInfo
+	if err := infostore.Get(&storedInfo, "credential_name", credentialName); err != nil {
+		t.Fatal(err)
+	}
+	encValue := storedInfo.KeyValueInfoList[0].Value
+	if decValue, err := cim.Decrypt(newKey, []byte(encValue)); err != nil || decValue != "mock01" {
+		t.Fatalf("the rotated value should be decrypted with the new key: %s, %v", decValue, err)
+	}
+	if _, err := cim.Decrypt(oldKey, []byte(encValue)); err == nil {
+		t.Fatal("the rotated value should not be decrypted with the old key")
+	}
+
+	// the credential round-trips through the rotation
+	crdInfo, err := cim.GetCredentialDecrypt(credentialName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crdInfo.KeyValueInfoList[0] != (icdrs.KeyValue{Key: "MockName", Value: "mock01"}) {
+		t.Fatalf("unexpected decrypted credential: %v", crdInfo.KeyValueInfoList)
+	}
+}
@@ -0,0 +1,88 @@
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package updatetest
+
+import (
+	"testing"
+	"time"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	icdrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+)
+
+func TestConnectionCache(t *testing.T) {
+	setup(t)
+	ccm.SetConnectionCacheTTL(time.Minute)
+	t.Cleanup(func() { ccm.SetConnectionCacheTTL(0) })
+
+	before := ccm.GetConnectionCacheStats()
+
+	conn1, err := ccm.GetCloudConnection(configName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn2, err := ccm.GetCloudConnection(configName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn1 != conn2 {
+		t.Fatal("the second call should return the cached connection")
+	}
+
+	// another zone is another cache entry
+	if _, err := ccm.GetZoneLevelCloudConnection(configName, "zone-b"); err != nil {
+		t.Fatal(err)
+	}
+
+	stats := ccm.GetConnectionCacheStats()
+	if stats.Size != 2 || stats.Hits-before.Hits != 1 || stats.Misses-before.Misses != 2 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	// the credential update evicts the entries of all zones
+	_, err = cim.UpdateCredentialInfo(cim.CredentialInfo{CredentialName: credentialName, ProviderName: "MOCK",
+		KeyValueInfoList: []icdrs.KeyValue{{Key: "MockName", Value: "mock01"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats = ccm.GetConnectionCacheStats()
+	if stats.Size != 0 || stats.Invalidated-before.Invalidated != 2 {
+		t.Fatalf("unexpected stats after invalidation: %+v", stats)
+	}
+
+	conn3, err := ccm.GetCloudConnection(configName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn3 == conn1 {
+		t.Fatal("a new connection should be made after invalidation")
+	}
+
+	// expiration
+	ccm.SetConnectionCacheTTL(time.Millisecond)
+	if _, err := ccm.GetCloudConnection(configName); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := ccm.GetCloudConnection(configName); err != nil {
+		t.Fatal(err)
+	}
+	if stats := ccm.GetConnectionCacheStats(); stats.Expired-before.Expired != 1 {
+		t.Fatalf("unexpected stats after expiration: %+v", stats)
+	}
+}
+
+func TestConnectionCacheTTLCap(t *testing.T) {
+	ccm.SetConnectionCacheTTL(2 * time.Hour)
+	t.Cleanup(func() { ccm.SetConnectionCacheTTL(0) })
+
+	if stats := ccm.GetConnectionCacheStats(); stats.TTL != (time.Hour).String() {
+		t.Fatalf("the TTL should be capped at 1h, but got: %s", stats.TTL)
+	}
+}
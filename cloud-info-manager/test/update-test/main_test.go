@@ -0,0 +1,21 @@
+// Update Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package updatetest
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cloud-barista/cb-spider/info-store/test/testenv"
+)
+
+// the tests register and update the infos, so they use a temporary MetaDB
+func TestMain(m *testing.M) {
+	os.Exit(testenv.RunWithTempRoot(m))
+}
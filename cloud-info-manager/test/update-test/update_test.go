@@ -0,0 +1,164 @@
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package updatetest
+
+import (
+	"errors"
+	"testing"
+
+	icdrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
+	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+)
+
+const (
+	driverName     = "update-test-driver"
+	credentialName = "update-test-credential"
+	regionName     = "update-test-region"
+	configName     = "update-test-config"
+)
+
+func setup(t *testing.T) map[string]int {
+	_, err := dim.RegisterCloudDriver(driverName, "MOCK", "mock-driver-v1.0.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cim.RegisterCredential(credentialName, "MOCK", []icdrs.KeyValue{{Key: "MockName", Value: "mock01"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = rim.RegisterRegion(regionName, "MOCK", []icdrs.KeyValue{{Key: "Region", Value: "default"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ccim.CreateConnectionConfig(configName, "MOCK", driverName, credentialName, regionName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		ccim.DeleteConnectionConfig(configName)
+		rim.UnRegisterRegion(regionName)
+		cim.UnRegisterCredential(credentialName)
+		dim.UnRegisterCloudDriver(driverName)
+	})
+
+	invalidated := map[string]int{}
+	ccim.AddConnectionInvalidator(func(connectionName string) {
+		invalidated[connectionName]++
+	})
+	return invalidated
+}
+
+func TestUpdate(t *testing.T) {
+	invalidated := setup(t)
+
+	// (1) credential
+	_, err := cim.UpdateCredentialInfo(cim.CredentialInfo{CredentialName: credentialName, ProviderName: "MOCK",
+		KeyValueInfoList: []icdrs.KeyValue{{Key: "MockName", Value: "mock02"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	crdInfo, err := cim.GetCredentialDecrypt(credentialName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crdInfo.KeyValueInfoList[0].Value != "mock02" {
+		t.Fatalf("credential is not updated: %v", crdInfo.KeyValueInfoList)
+	}
+	if invalidated[configName] != 1 {
+		t.Fatalf("the connection should be invalidated by the credential update: %v", invalidated)
+	}
+
+	// (2) region
+	_, err = rim.UpdateRegionInfo(rim.RegionInfo{RegionName: regionName, ProviderName: "MOCK",
+		KeyValueInfoList: []icdrs.KeyValue{{Key: "Region", Value: "default2"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invalidated[configName] != 2 {
+		t.Fatalf("the connection should be invalidated by the region update: %v", invalidated)
+	}
+
+	// (3) connection config
+	_, err = ccim.UpdateConnectionConfigInfo(ccim.ConnectionConfigInfo{ConfigName: configName, ProviderName: "MOCK",
+		DriverName: driverName, CredentialName: credentialName, RegionName: regionName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invalidated[configName] != 3 {
+		t.Fatalf("the connection should be invalidated by the connection config update: %v", invalidated)
+	}
+
+	// (4) delete
+	if _, err := ccim.DeleteConnectionConfig(configName); err != nil {
+		t.Fatal(err)
+	}
+	if invalidated[configName] != 4 {
+		t.Fatalf("the connection should be invalidated by the connection config delete: %v", invalidated)
+	}
+}
+
+func TestUpdateInvalid(t *testing.T) {
+	setup(t)
+
+	// ProviderName can not be changed
+	_, err := cim.UpdateCredentialInfo(cim.CredentialInfo{CredentialName: credentialName, ProviderName: "CLOUDTWIN",
+		KeyValueInfoList: []icdrs.KeyValue{{Key: "IdentityEndpoint", Value: "x"}, {Key: "DomainName", Value: "x"}, {Key: "MockName", Value: "x"}}})
+	if err == nil {
+		t.Fatal("the provider of a credential should not be changed")
+	}
+
+	// invalid key of the credential
+	_, err = cim.UpdateCredentialInfo(cim.CredentialInfo{CredentialName: credentialName, ProviderName: "MOCK",
+		KeyValueInfoList: []icdrs.KeyValue{{Key: "Unknown", Value: "x"}}})
+	if err == nil {
+		t.Fatal("an invalid credential key should be rejected")
+	}
+
+	// not existing credential, region and connection config
+	_, err = cim.UpdateCredentialInfo(cim.CredentialInfo{CredentialName: "not-exist-credential", ProviderName: "MOCK",
+		KeyValueInfoList: []icdrs.KeyValue{{Key: "MockName", Value: "x"}}})
+	if !errors.Is(err, cim.ErrCredentialNotFound) {
+		t.Fatalf("a not existing credential should not be updated: %v", err)
+	}
+	_, err = rim.UpdateRegionInfo(rim.RegionInfo{RegionName: "not-exist-region", ProviderName: "MOCK",
+		KeyValueInfoList: []icdrs.KeyValue{{Key: "Region", Value: "default"}}})
+	if !errors.Is(err, rim.ErrRegionNotFound) {
+		t.Fatalf("a not existing region should not be updated: %v", err)
+	}
+	_, err = ccim.UpdateConnectionConfigInfo(ccim.ConnectionConfigInfo{ConfigName: "not-exist-config", ProviderName: "MOCK",
+		DriverName: driverName, CredentialName: credentialName, RegionName: regionName})
+	if !errors.Is(err, ccim.ErrConnectionConfigNotFound) {
+		t.Fatalf("a not existing connection config should not be updated: %v", err)
+	}
+
+	// invalid zones of the region
+	for _, zoneList := range [][]string{{"zone-a", " "}, {"zone-a", "zone-a"}, {"zone-b"}} {
+		_, err = rim.UpdateRegionInfo(rim.RegionInfo{RegionName: regionName, ProviderName: "MOCK",
+			KeyValueInfoList:  []icdrs.KeyValue{{Key: "Region", Value: "default"}, {Key: "Zone", Value: "zone-a"}},
+			AvailableZoneList: zoneList})
+		if err == nil {
+			t.Errorf("the zones %v should be rejected", zoneList)
+		}
+	}
+
+	// not existing driver, credential and region of the connection config
+	for _, configInfo := range []ccim.ConnectionConfigInfo{
+		{ConfigName: configName, ProviderName: "MOCK", DriverName: "not-exist-driver", CredentialName: credentialName, RegionName: regionName},
+		{ConfigName: configName, ProviderName: "MOCK", DriverName: driverName, CredentialName: "not-exist-credential", RegionName: regionName},
+		{ConfigName: configName, ProviderName: "MOCK", DriverName: driverName, CredentialName: credentialName, RegionName: "not-exist-region"},
+		{ConfigName: configName, ProviderName: "AWS", DriverName: driverName, CredentialName: credentialName, RegionName: regionName},
+	} {
+		_, err = ccim.UpdateConnectionConfigInfo(configInfo)
+		if err == nil {
+			t.Errorf("the connection config should not be updated: %+v", configInfo)
+		}
+	}
+}
@@ -14,10 +14,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
 
 	cblogger "github.com/cloud-barista/cb-log"
 	icdrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
@@ -28,14 +36,55 @@ var cblog *logrus.Logger
 
 var DB_FILE_PATH string
 
+// MetaDB backend types
+const (
+	SQLITE   = "sqlite" // default
+	POSTGRES = "postgres"
+	MYSQL    = "mysql"
+)
+
+// MetaDB backend configuration
+//   - META_DB_TYPE: sqlite(default) | postgres | mysql
+//   - META_DB_DSN: DSN of postgres or mysql, not used for sqlite
+//     ex) postgres: "host=localhost user=spider password=**** dbname=cb_spider port=5432 sslmode=disable"
+//     ex) mysql: "spider:****@tcp(localhost:3306)/cb_spider?charset=utf8mb4&parseTime=True&loc=Local"
+var DB_TYPE string
+var DB_DSN string
+
+// Connection pool configuration of the shared MetaDB handle
+//   - META_DB_MAX_OPEN_CONNS: max number of open connections (default: 20)
+//   - META_DB_MAX_IDLE_CONNS: max number of idle connections (default: 10)
+//   - META_DB_CONN_MAX_LIFETIME: max lifetime of a connection in seconds (default: 1800, 0: unlimited)
+var DB_MAX_OPEN_CONNS = 20
+var DB_MAX_IDLE_CONNS = 10
+var DB_CONN_MAX_LIFETIME = 1800
+
+// shared MetaDB handle, opened once and used by all Info APIs
+var sharedDB atomic.Pointer[gorm.DB]
+var sharedDBLock sync.Mutex
+
 func init() {
 	cblog = cblogger.GetLogger("CLOUD-BARISTA")
 
 	/*###############################################################*/
 	DB_PATH := os.Getenv("CBSPIDER_ROOT") + "/meta_db"
 	DB_FILE_PATH = DB_PATH + "/cb-spider.db"
+
+	DB_TYPE = strings.ToLower(strings.TrimSpace(os.Getenv("META_DB_TYPE")))
+	if DB_TYPE == "" {
+		DB_TYPE = SQLITE
+	}
+	DB_DSN = strings.TrimSpace(os.Getenv("META_DB_DSN"))
+
+	DB_MAX_OPEN_CONNS = getEnvInt("META_DB_MAX_OPEN_CONNS", DB_MAX_OPEN_CONNS)
+	DB_MAX_IDLE_CONNS = getEnvInt("META_DB_MAX_IDLE_CONNS", DB_MAX_IDLE_CONNS)
+	DB_CONN_MAX_LIFETIME = getEnvInt("META_DB_CONN_MAX_LIFETIME", DB_CONN_MAX_LIFETIME)
 	/*###############################################################*/
 
+	if DB_TYPE != SQLITE {
+		return
+	}
+
 	// if no path, makes it
 	_, err := os.Stat(DB_PATH)
 	if os.IsNotExist(err) {
@@ -47,6 +96,19 @@ func init() {
 	}
 }
 
+func getEnvInt(envName string, defaultValue int) int {
+	strValue := strings.TrimSpace(os.Getenv(envName))
+	if strValue == "" {
+		return defaultValue
+	}
+	value, err := strconv.Atoi(strValue)
+	if err != nil || value < 0 {
+		cblog.Errorf("%s(%s) is not a valid number, use default(%d).", envName, strValue, defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
 func Ping() error {
 	// check database connection
 	db, err := Open()
@@ -73,8 +135,11 @@ func Ping() error {
 type KVList []icdrs.KeyValue
 
 func (o *KVList) Scan(src any) error {
-	bytes := []byte(src.(string))
-	err := json.Unmarshal(bytes, o)
+	bytes, err := scanBytes(src)
+	if err != nil || bytes == nil {
+		return err
+	}
+	err = json.Unmarshal(bytes, o)
 	if err != nil {
 		return err
 	}
@@ -92,13 +157,25 @@ func (o KVList) Value() (driver.Value, error) {
 	return string(jsonData), nil
 }
 
+// GormDBDataType overrides the column type of a KVList by the backend.
+// PostgreSQL has no blob type, and KVList is stored as a JSON string.
+func (KVList) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	if db.Dialector.Name() == POSTGRES {
+		return "text"
+	}
+	return "" // use the type of the field's tag
+}
+
 // AZList represents a list of availability zones.
 // @Description A list of availability zones within a region.
 type AZList []string
 
 func (o *AZList) Scan(src any) error {
-	bytes := []byte(src.(string))
-	err := json.Unmarshal(bytes, o)
+	bytes, err := scanBytes(src)
+	if err != nil || bytes == nil {
+		return err
+	}
+	err = json.Unmarshal(bytes, o)
 	if err != nil {
 		return err
 	}
@@ -116,11 +193,95 @@ func (o AZList) Value() (driver.Value, error) {
 	return string(jsonData), nil
 }
 
+// scanBytes returns the bytes of a text column.
+// SQLite and PostgreSQL return a string, MySQL returns []byte.
+func scanBytes(src any) ([]byte, error) {
+	switch v := src.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("cannot scan %T into a JSON list", src)
+	}
+}
+
+// getDialector returns the GORM dialector of the configured MetaDB backend.
+func getDialector() (gorm.Dialector, error) {
+	switch DB_TYPE {
+	case SQLITE:
+		return sqlite.Open(sqliteDSN(DB_FILE_PATH)), nil
+	case POSTGRES, MYSQL:
+		if DB_DSN == "" {
+			return nil, fmt.Errorf("META_DB_DSN is required for the %s MetaDB!", DB_TYPE)
+		}
+		if DB_TYPE == POSTGRES {
+			return postgres.Open(DB_DSN), nil
+		}
+		return mysql.Open(DB_DSN), nil
+	default:
+		return nil, fmt.Errorf("META_DB_TYPE(%s) is not supported! Use one of %s, %s, %s.", DB_TYPE, SQLITE, POSTGRES, MYSQL)
+	}
+}
+
+// sqliteDSN returns the DSN of a SQLite file with the options for concurrent access.
+//   - busy timeout: 1 minutes
+//   - WAL journal mode: readers do not block the writer
+//   - immediate transaction lock: a write transaction takes the write lock at BEGIN,
+//     so two transactions can not deadlock while upgrading to the write lock
+func sqliteDSN(dbFilePath string) string {
+	return dbFilePath + "?_busy_timeout=60000&_journal_mode=WAL&_txlock=immediate"
+}
+
 // Meta DB Opener
+// It returns the shared MetaDB handle with a connection pool.
+// The handle is opened once, and Close() does not close it.
 func Open() (*gorm.DB, error) {
+	if db := sharedDB.Load(); db != nil {
+		return db, nil
+	}
+
+	sharedDBLock.Lock()
+	defer sharedDBLock.Unlock()
+	if db := sharedDB.Load(); db != nil {
+		return db, nil
+	}
+
+	dialector, err := getDialector()
+	if err != nil {
+		return nil, err
+	}
+	db, err := openDialector(dialector)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(DB_MAX_OPEN_CONNS)
+	sqlDB.SetMaxIdleConns(DB_MAX_IDLE_CONNS)
+	sqlDB.SetConnMaxLifetime(time.Duration(DB_CONN_MAX_LIFETIME) * time.Second)
+
+	sharedDB.Store(db)
+	return db, nil
+}
 
-	// Turn-on error logs of gorm: db, err := gorm.Open(sqlite.Open(DB_FILE_PATH), &gorm.Config{})
-	db, err := gorm.Open(sqlite.Open(DB_FILE_PATH+"?_busy_timeout=60000"), &gorm.Config{ // busy timneout: 1 minutes
+// OpenSQLite opens a SQLite MetaDB file regardless of the configured backend.
+// It is used to migrate an existing SQLite MetaDB into another backend.
+func OpenSQLite(dbFilePath string) (*gorm.DB, error) {
+	if _, err := os.Stat(dbFilePath); err != nil {
+		return nil, err
+	}
+	return openDialector(sqlite.Open(sqliteDSN(dbFilePath)))
+}
+
+func openDialector(dialector gorm.Dialector) (*gorm.DB, error) {
+	// Turn-on error logs of gorm: db, err := gorm.Open(dialector, &gorm.Config{})
+	db, err := gorm.Open(dialector, &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Silent)})
 	if err != nil {
 		db = nil
@@ -131,7 +292,11 @@ func Open() (*gorm.DB, error) {
 }
 
 // Meta DB Closer
+// It does nothing for the shared MetaDB handle, use Shutdown() to close it.
 func Close(db *gorm.DB) error {
+	if db == sharedDB.Load() {
+		return nil
+	}
 	sqlDB, err := db.DB()
 	if err != nil {
 		return err
@@ -140,6 +305,56 @@ func Close(db *gorm.DB) error {
 	return nil
 }
 
+// Shutdown closes the shared MetaDB handle.
+// It is called when the API server or a CLI command of CB-Spider stops, the next Open() opens a new one.
+func Shutdown() error {
+	sharedDBLock.Lock()
+	defer sharedDBLock.Unlock()
+
+	db := sharedDB.Swap(nil)
+	if db == nil {
+		return nil
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// Transaction runs fn in a transaction of the MetaDB.
+// If fn returns an error or panics, all changes made with tx are rolled back.
+func Transaction(fn func(tx *gorm.DB) error) error {
+	db, err := Open()
+	if err != nil {
+		return err
+	}
+	defer Close(db)
+
+	return db.Transaction(fn)
+}
+
+// Insert a Info in a transaction
+func InsertTx(tx *gorm.DB, info interface{}) error {
+	if err := tx.Save(info).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// Insert Infos atomically
+// If one of them fails, none of them is inserted.
+func InsertAll(infoList ...interface{}) error {
+	return Transaction(func(tx *gorm.DB) error {
+		for _, info := range infoList {
+			if err := InsertTx(tx, info); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // Insert a Info
 func Insert(info interface{}) error {
 	db, err := Open()
@@ -0,0 +1,120 @@
+// Info <-> MetaDB Store for CB-Spider
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package infostore
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+const copyBatchSize = 100
+
+// CopiedTableInfo is the result of copying a table.
+type CopiedTableInfo struct {
+	TableName string
+	RowCount  int
+	Skipped   string // reason if the table was skipped
+}
+
+var (
+	modelList     []interface{}
+	modelListLock sync.Mutex
+)
+
+// AutoMigrate creates or updates the tables of the Info types in db,
+// and registers the Info types, so CopyTables creates their tables in the target MetaDB.
+// It is called by the package init() of each Info type.
+func AutoMigrate(db *gorm.DB, models ...interface{}) error {
+	modelListLock.Lock()
+	modelList = append(modelList, models...)
+	modelListLock.Unlock()
+
+	return db.AutoMigrate(models...)
+}
+
+func registeredModels() []interface{} {
+	modelListLock.Lock()
+	defer modelListLock.Unlock()
+	return append([]interface{}{}, modelList...)
+}
+
+// CopyTables copies the rows of all tables in src into the same tables in dst.
+// The tables of the registered Info types are created in dst first by AutoMigrate,
+// out of the transaction, because MySQL commits a DDL implicitly.
+// A table which does not exist in dst or already has rows in dst is skipped,
+// so nothing is overwritten in dst.
+// All rows are copied in one transaction of dst, so if a table fails, nothing is copied.
+func CopyTables(src *gorm.DB, dst *gorm.DB) ([]CopiedTableInfo, error) {
+	if err := dst.AutoMigrate(registeredModels()...); err != nil {
+		return nil, fmt.Errorf("failed to create the tables in the target MetaDB: %v", err)
+	}
+
+	var copiedList []CopiedTableInfo
+	err := dst.Transaction(func(tx *gorm.DB) error {
+		var err error
+		copiedList, err = copyTablesTx(src, tx)
+		return err
+	})
+	return copiedList, err
+}
+
+// copyTablesTx is CopyTables in the transaction tx of the target MetaDB.
+func copyTablesTx(src *gorm.DB, dst *gorm.DB) ([]CopiedTableInfo, error) {
+	tableList, err := src.Migrator().GetTables()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(tableList)
+
+	var copiedList []CopiedTableInfo
+	for _, tableName := range tableList {
+		copied := CopiedTableInfo{TableName: tableName}
+
+		if !dst.Migrator().HasTable(tableName) {
+			copied.Skipped = "no table in the target MetaDB"
+			copiedList = append(copiedList, copied)
+			continue
+		}
+
+		var dstCount int64
+		if err := dst.Table(tableName).Count(&dstCount).Error; err != nil {
+			return copiedList, fmt.Errorf("%s: %v", tableName, err)
+		}
+		if dstCount > 0 {
+			copied.Skipped = fmt.Sprintf("the target table already has %d rows", dstCount)
+			copiedList = append(copiedList, copied)
+			continue
+		}
+
+		var rows []map[string]interface{}
+		if err := src.Table(tableName).Find(&rows).Error; err != nil {
+			return copiedList, fmt.Errorf("%s: %v", tableName, err)
+		}
+		if len(rows) > 0 {
+			for _, row := range rows {
+				for key, value := range row {
+					// SQLite returns []byte for blob columns, all of them are JSON strings.
+					if bytes, ok := value.([]byte); ok {
+						row[key] = string(bytes)
+					}
+				}
+			}
+			if err := dst.Table(tableName).CreateInBatches(rows, copyBatchSize).Error; err != nil {
+				return copiedList, fmt.Errorf("%s: %v", tableName, err)
+			}
+		}
+		copied.RowCount = len(rows)
+		copiedList = append(copiedList, copied)
+	}
+
+	return copiedList, nil
+}
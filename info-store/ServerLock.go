@@ -0,0 +1,171 @@
+// Info <-> MetaDB Store for CB-Spider
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// The server lock is a lease in the MetaDB held by the running API server.
+// Only one server uses a MetaDB at a time, and a CLI command which must not run
+// under a server, ex) rotate-key, checks it.
+//
+// by CB-Spider Team, 2026.10.
+
+package infostore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ServerLockInfo is the lease of the API server which uses the MetaDB.
+type ServerLockInfo struct {
+	LockName    string `gorm:"primaryKey"`
+	HolderId    string
+	HostName    string
+	Pid         int
+	RenewedTime time.Time
+}
+
+const serverLockName = "cb-spider-server"
+
+// a lease which is not renewed for this time is expired, ex) the server is killed
+var ServerLockTTL = 30 * time.Second
+
+// ErrServerLocked is returned when a running server holds the server lock.
+var ErrServerLocked = errors.New("a CB-Spider server is running on the MetaDB")
+
+func serverLockedError(lock ServerLockInfo) error {
+	return fmt.Errorf("%w(host: %s, pid: %d, renewed: %s)", ErrServerLocked, lock.HostName, lock.Pid, lock.RenewedTime.Format(time.RFC3339))
+}
+
+// AcquireServerLock takes the server lock, and renews it until the returned release() is called.
+// It fails with ErrServerLocked if another server holds the lock.
+func AcquireServerLock() (release func(), err error) {
+	db, err := Open()
+	if err != nil {
+		return nil, err
+	}
+	defer Close(db)
+
+	if err := db.AutoMigrate(&ServerLockInfo{}); err != nil {
+		return nil, err
+	}
+
+	hostName, _ := os.Hostname()
+	lock := ServerLockInfo{
+		LockName:    serverLockName,
+		HolderId:    fmt.Sprintf("%s-%d-%d", hostName, os.Getpid(), time.Now().UnixNano()),
+		HostName:    hostName,
+		Pid:         os.Getpid(),
+		RenewedTime: time.Now(),
+	}
+
+	var current ServerLockInfo
+	err = db.First(&current, "lock_name = ?", serverLockName).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		// another server may create it at the same time, then the primary key fails one of them
+		if err := db.Create(&lock).Error; err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrServerLocked, err)
+		}
+	case err != nil:
+		return nil, err
+	case time.Since(current.RenewedTime) < ServerLockTTL:
+		return nil, serverLockedError(current)
+	default:
+		// take over the expired lease
+		result := db.Model(&ServerLockInfo{}).Where("lock_name = ? AND holder_id = ?", serverLockName, current.HolderId).
+			Select("*").Updates(&lock)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil, ErrServerLocked
+		}
+	}
+
+	// check the holder again, two servers can take over an expired lease at the same time
+	if err := db.First(&current, "lock_name = ?", serverLockName).Error; err != nil {
+		return nil, err
+	}
+	if current.HolderId != lock.HolderId {
+		return nil, serverLockedError(current)
+	}
+
+	stop := make(chan struct{})
+	go renewServerLock(lock.HolderId, stop)
+
+	var once sync.Once
+	release = func() {
+		once.Do(func() {
+			close(stop)
+			db, err := Open()
+			if err != nil {
+				cblog.Error(err)
+				return
+			}
+			defer Close(db)
+			if err := db.Where("lock_name = ? AND holder_id = ?", serverLockName, lock.HolderId).Delete(&ServerLockInfo{}).Error; err != nil {
+				cblog.Error(err)
+			}
+		})
+	}
+	return release, nil
+}
+
+func renewServerLock(holderId string, stop chan struct{}) {
+	ticker := time.NewTicker(ServerLockTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		db, err := Open()
+		if err != nil {
+			cblog.Error(err)
+			continue
+		}
+		result := db.Model(&ServerLockInfo{}).Where("lock_name = ? AND holder_id = ?", serverLockName, holderId).
+			Update("renewed_time", time.Now())
+		Close(db)
+		if result.Error != nil {
+			cblog.Errorf("failed to renew the server lock: %v", result.Error)
+		} else if result.RowsAffected == 0 {
+			cblog.Errorf("the server lock is taken by another server, the MetaDB is shared by two servers!")
+		}
+	}
+}
+
+// CheckServerLock returns ErrServerLocked if a running server holds the server lock.
+func CheckServerLock() error {
+	db, err := Open()
+	if err != nil {
+		return err
+	}
+	defer Close(db)
+
+	if !db.Migrator().HasTable(&ServerLockInfo{}) {
+		return nil
+	}
+	var current ServerLockInfo
+	err = db.First(&current, "lock_name = ?", serverLockName).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if time.Since(current.RenewedTime) < ServerLockTTL {
+		return serverLockedError(current)
+	}
+	return nil
+}
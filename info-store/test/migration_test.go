@@ -0,0 +1,159 @@
+// MetaDB Migration Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package infostoretest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+type TestCredentialInfo struct {
+	CredentialName   string `gorm:"primaryKey"`
+	ProviderName     string
+	KeyValueInfoList infostore.KVList `gorm:"type:blob"`
+}
+
+func createTestDB(t *testing.T, name string) *gorm.DB {
+	dbFilePath := filepath.Join(t.TempDir(), name)
+	db, err := gorm.Open(sqlite.Open(dbFilePath), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&TestCredentialInfo{}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { infostore.Close(db) })
+	return db
+}
+
+func TestCopyTables(t *testing.T) {
+	srcDB := createTestDB(t, "src.db")
+	dstDB := createTestDB(t, "dst.db")
+
+	info := TestCredentialInfo{
+		CredentialName:   "aws-credential01",
+		ProviderName:     "AWS",
+		KeyValueInfoList: infostore.KVList{{Key: "ClientId", Value: "enc-value"}},
+	}
+	if err := srcDB.Create(&info).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	copiedList, err := infostore.CopyTables(srcDB, dstDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(copiedList) != 1 || copiedList[0].RowCount != 1 {
+		t.Fatalf("unexpected copied list: %+v", copiedList)
+	}
+
+	var copied TestCredentialInfo
+	if err := dstDB.First(&copied, "credential_name = ?", info.CredentialName).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(copied.KeyValueInfoList) != 1 || copied.KeyValueInfoList[0] != (irs.KeyValue{Key: "ClientId", Value: "enc-value"}) {
+		t.Fatalf("unexpected copied KeyValueInfoList: %v", copied.KeyValueInfoList)
+	}
+
+	// a table which already has rows is not overwritten
+	copiedList, err = infostore.CopyTables(srcDB, dstDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if copiedList[0].Skipped == "" {
+		t.Fatalf("the second copy should be skipped: %+v", copiedList)
+	}
+}
+
+type TestZoneInfo struct {
+	ZoneName string `gorm:"primaryKey"`
+}
+
+// the target table has a NOT NULL column which the source table does not have
+type TestZoneInfoWithRequired struct {
+	ZoneName string `gorm:"primaryKey"`
+	Required string `gorm:"not null"`
+}
+
+func (TestZoneInfoWithRequired) TableName() string {
+	return "test_zone_infos"
+}
+
+func TestCopyTablesRollback(t *testing.T) {
+	srcDB := createTestDB(t, "src.db")
+	dstDB := createTestDB(t, "dst.db")
+	if err := srcDB.AutoMigrate(&TestZoneInfo{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dstDB.AutoMigrate(&TestZoneInfoWithRequired{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := srcDB.Create(&TestCredentialInfo{CredentialName: "aws-credential01", ProviderName: "AWS"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := srcDB.Create(&TestZoneInfo{ZoneName: "us-east-1a"}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// test_credential_infos is copied first, then test_zone_infos fails
+	if _, err := infostore.CopyTables(srcDB, dstDB); err == nil {
+		t.Fatal("copying into a table with a missing NOT NULL column should fail")
+	}
+
+	var count int64
+	if err := dstDB.Model(&TestCredentialInfo{}).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Fatalf("the copied rows should be rolled back, but %d rows remain", count)
+	}
+}
+
+type TestRegionInfo struct {
+	RegionName   string `gorm:"primaryKey"`
+	ProviderName string
+}
+
+func TestCopyTablesAutoMigrate(t *testing.T) {
+	srcDB := createTestDB(t, "src.db")
+	dstDB := createTestDB(t, "dst.db")
+
+	// the Info type is registered by the package init() with infostore.AutoMigrate
+	if err := infostore.AutoMigrate(srcDB, &TestRegionInfo{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := srcDB.Create(&TestRegionInfo{RegionName: "aws-region01", ProviderName: "AWS"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if dstDB.Migrator().HasTable(&TestRegionInfo{}) {
+		t.Fatal("the target MetaDB should not have the table before the migration")
+	}
+
+	copiedList, err := infostore.CopyTables(srcDB, dstDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, copied := range copiedList {
+		if copied.TableName == "test_region_infos" && (copied.Skipped != "" || copied.RowCount != 1) {
+			t.Fatalf("the table of a registered Info type should be created and copied: %+v", copied)
+		}
+	}
+
+	var copied TestRegionInfo
+	if err := dstDB.First(&copied, "region_name = ?", "aws-region01").Error; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,45 @@
+// MetaDB Server Lock Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package infostoretest
+
+import (
+	"errors"
+	"testing"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+func TestServerLock(t *testing.T) {
+	if err := infostore.CheckServerLock(); err != nil {
+		t.Fatalf("no server holds the lock: %v", err)
+	}
+
+	release, err := infostore.AcquireServerLock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a second server and a CLI command see the lock
+	if _, err := infostore.AcquireServerLock(); !errors.Is(err, infostore.ErrServerLocked) {
+		t.Fatalf("a second server should fail with ErrServerLocked: %v", err)
+	}
+	if err := infostore.CheckServerLock(); !errors.Is(err, infostore.ErrServerLocked) {
+		t.Fatalf("CheckServerLock() should return ErrServerLocked: %v", err)
+	}
+
+	release()
+	if err := infostore.CheckServerLock(); err != nil {
+		t.Fatalf("the lock should be released: %v", err)
+	}
+	release2, err := infostore.AcquireServerLock()
+	if err != nil {
+		t.Fatalf("the released lock should be acquired again: %v", err)
+	}
+	release2()
+}
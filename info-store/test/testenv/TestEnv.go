@@ -0,0 +1,84 @@
+// Test Environment of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// It runs the tests with a temporary CBSPIDER_ROOT,
+// so the tests do not change the MetaDB of the developer.
+//
+// by CB-Spider Team, 2026.10.
+
+package testenv
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// set in the test process which runs with the temporary CBSPIDER_ROOT
+const ENV_TEMP_ROOT = "SPIDER_TEST_TEMP_ROOT"
+
+// entries of CBSPIDER_ROOT which are not linked into the temporary root
+var privateEntries = map[string]bool{
+	"meta_db": true,
+	"log":     true,
+	"cache":   true,
+}
+
+// RunWithTempRoot runs the tests with a temporary CBSPIDER_ROOT, it is called by TestMain.
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(testenv.RunWithTempRoot(m))
+//	}
+//
+// The package init() of CB-Spider opens the MetaDB before TestMain,
+// so the test binary is run again in a child process with the temporary root.
+// The temporary root links the entries of the original root(conf, cloud-driver-libs, ...)
+// except meta_db, log and cache.
+func RunWithTempRoot(m *testing.M) int {
+	if os.Getenv(ENV_TEMP_ROOT) != "" {
+		return m.Run()
+	}
+
+	tempRoot, err := os.MkdirTemp("", "spider-test-root-")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer os.RemoveAll(tempRoot)
+
+	if orgRoot := os.Getenv("CBSPIDER_ROOT"); orgRoot != "" {
+		entries, err := os.ReadDir(orgRoot)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		for _, entry := range entries {
+			if privateEntries[entry.Name()] {
+				continue
+			}
+			if err := os.Symlink(filepath.Join(orgRoot, entry.Name()), filepath.Join(tempRoot, entry.Name())); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return 1
+			}
+		}
+	}
+
+	cmd := exec.Command(os.Args[0], os.Args[1:]...)
+	cmd.Env = append(os.Environ(), "CBSPIDER_ROOT="+tempRoot, ENV_TEMP_ROOT+"="+tempRoot)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode()
+		}
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
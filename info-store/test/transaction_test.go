@@ -0,0 +1,84 @@
+// MetaDB Transaction Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package infostoretest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+	"github.com/cloud-barista/cb-spider/info-store/test/testenv"
+)
+
+type TestIIDInfo struct {
+	ConnectionName string `gorm:"primaryKey"`
+	NameId         string `gorm:"primaryKey"`
+	SystemId       string
+}
+
+func TestSharedDB(t *testing.T) {
+	db1, err := infostore.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	infostore.Close(db1)
+
+	db2, err := infostore.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db1 != db2 {
+		t.Fatal("Open() should return the shared MetaDB handle")
+	}
+	if err := infostore.Ping(); err != nil {
+		t.Fatalf("the shared MetaDB handle should not be closed by Close(): %v", err)
+	}
+}
+
+func TestInsertAllRollback(t *testing.T) {
+	// TestMain runs the tests with a temporary CBSPIDER_ROOT
+	if infostore.DB_TYPE != infostore.SQLITE || filepath.Dir(filepath.Dir(infostore.DB_FILE_PATH)) != filepath.Clean(os.Getenv(testenv.ENV_TEMP_ROOT)) {
+		t.Skipf("the MetaDB(%s) is not a temporary MetaDB", infostore.DB_FILE_PATH)
+	}
+
+	db, err := infostore.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&TestIIDInfo{}); err != nil {
+		t.Fatal(err)
+	}
+	defer db.Migrator().DropTable(&TestIIDInfo{})
+
+	// the second Info is not a table, so the first one must be rolled back.
+	err = infostore.InsertAll(&TestIIDInfo{"test-conn", "vm-01", "i-01"}, "not-a-table")
+	if err == nil {
+		t.Fatal("InsertAll() with an invalid Info should fail")
+	}
+	has, err := infostore.HasByConditions(&TestIIDInfo{}, "connection_name", "test-conn", "name_id", "vm-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if has {
+		t.Fatal("vm-01 should be rolled back")
+	}
+
+	err = infostore.InsertAll(&TestIIDInfo{"test-conn", "vm-01", "i-01"}, &TestIIDInfo{"test-conn", "vm-02", "i-02"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var infoList []*TestIIDInfo
+	if err := infostore.ListByCondition(&infoList, "connection_name", "test-conn"); err != nil {
+		t.Fatal(err)
+	}
+	if len(infoList) != 2 {
+		t.Fatalf("2 Infos should be inserted, but %d", len(infoList))
+	}
+}